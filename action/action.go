@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
 
 // SignatureLength indicates the length of signature generated by SECP256K1 crypto library
@@ -79,9 +80,35 @@ func AssembleSealedEnvelope(act Envelope, pk crypto.PublicKey, sig []byte) Seale
 	return sealed
 }
 
-// Verify verifies the action using sender's public key
+// Verify verifies the action using sender's public key, or the cosignatures if it is signed by the keys of a multisig
+// account. Whether the cosignatures reach the threshold is verified against the states by VerifyMultisig. The signature
+// of the sponsor, or the cosignatures of a multisig sponsor, is verified too if the action is sponsored.
 func Verify(sealed SealedEnvelope) error {
-	hash := sealed.Envelope.Hash()
+	if err := verifySender(sealed); err != nil {
+		return err
+	}
+	if sealed.IsSponsored() {
+		return verifySponsor(sealed)
+	}
+	return nil
+}
+
+func verifySender(sealed SealedEnvelope) error {
+	switch sealed.Encoding() {
+	case iotextypes.Encoding_IOTEX_PROTOBUF:
+	case iotextypes.Encoding_ETHEREUM_RLP:
+		return verifyRLPSender(sealed)
+	default:
+		return errors.Wrapf(ErrAction, "unknown encoding %d", sealed.Encoding())
+	}
+	if sealed.IsMultisig() {
+		return verifyCosignatures(sealed)
+	}
+	sponsor, err := sponsorAddress(sealed)
+	if err != nil {
+		return err
+	}
+	hash := SenderHash(sealed.Envelope, sponsor)
 	if len(sealed.Signature()) != SignatureLength {
 		return errors.New("incorrect length of signature")
 	}
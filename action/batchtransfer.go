@@ -0,0 +1,166 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+const (
+	// BatchTransferBaseIntrinsicGas represents the base intrinsic gas for batch transfer
+	BatchTransferBaseIntrinsicGas = uint64(10000)
+	// BatchTransferRecipientGas represents the intrinsic gas for each recipient of batch transfer
+	BatchTransferRecipientGas = uint64(5000)
+	// MaxBatchTransferRecipients is the max number of recipients of a batch transfer
+	MaxBatchTransferRecipients = 500
+)
+
+// BatchTransferItem is a recipient of a batch transfer and the amount it receives
+type BatchTransferItem struct {
+	Recipient string
+	Amount    *big.Int
+}
+
+// BatchTransfer defines the struct of a transfer paying multiple recipients
+type BatchTransfer struct {
+	AbstractAction
+
+	items   []BatchTransferItem
+	payload []byte
+}
+
+// NewBatchTransfer returns a BatchTransfer instance
+func NewBatchTransfer(
+	nonce uint64,
+	items []BatchTransferItem,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*BatchTransfer, error) {
+	return &BatchTransfer{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		items:   items,
+		payload: payload,
+		// SenderPublicKey and Signature will be populated in Sign()
+	}, nil
+}
+
+// Items returns the recipients and the amounts
+func (bt *BatchTransfer) Items() []BatchTransferItem {
+	items := make([]BatchTransferItem, len(bt.items))
+	copy(items, bt.items)
+	return items
+}
+
+// Payload returns the payload bytes
+func (bt *BatchTransfer) Payload() []byte { return bt.payload }
+
+// TotalAmount returns the sum of the amounts paid to the recipients
+func (bt *BatchTransfer) TotalAmount() *big.Int {
+	total := big.NewInt(0)
+	for _, item := range bt.items {
+		if item.Amount != nil {
+			total.Add(total, item.Amount)
+		}
+	}
+	return total
+}
+
+// TotalSize returns the total size of this BatchTransfer
+func (bt *BatchTransfer) TotalSize() uint32 {
+	size := bt.BasicActionSize()
+	for _, item := range bt.items {
+		size += uint32(len(item.Recipient))
+		if item.Amount != nil {
+			size += uint32(len(item.Amount.Bytes()))
+		}
+	}
+	return size + uint32(len(bt.payload))
+}
+
+// Serialize returns a raw byte stream of this BatchTransfer
+func (bt *BatchTransfer) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(bt.Proto()))
+}
+
+// Proto converts BatchTransfer to protobuf's BatchTransfer
+func (bt *BatchTransfer) Proto() *iotextypes.BatchTransfer {
+	act := &iotextypes.BatchTransfer{
+		Items:   make([]*iotextypes.BatchTransferItem, 0, len(bt.items)),
+		Payload: bt.payload,
+	}
+	for _, item := range bt.items {
+		pbItem := &iotextypes.BatchTransferItem{Recipient: item.Recipient}
+		if item.Amount != nil {
+			pbItem.Amount = item.Amount.String()
+		}
+		act.Items = append(act.Items, pbItem)
+	}
+	return act
+}
+
+// LoadProto converts a protobuf's BatchTransfer to BatchTransfer
+func (bt *BatchTransfer) LoadProto(pbAct *iotextypes.BatchTransfer) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if bt == nil {
+		return errors.New("nil action to load proto")
+	}
+	*bt = BatchTransfer{}
+
+	bt.items = make([]BatchTransferItem, 0, len(pbAct.GetItems()))
+	for _, pbItem := range pbAct.GetItems() {
+		amount, ok := new(big.Int).SetString(pbItem.GetAmount(), 10)
+		if !ok {
+			return errors.Errorf("invalid amount %s", pbItem.GetAmount())
+		}
+		bt.items = append(bt.items, BatchTransferItem{
+			Recipient: pbItem.GetRecipient(),
+			Amount:    amount,
+		})
+	}
+	bt.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a batch transfer
+func (bt *BatchTransfer) IntrinsicGas() (uint64, error) {
+	payloadSize := uint64(len(bt.payload))
+	numItems := uint64(len(bt.items))
+	if (math.MaxUint64-BatchTransferBaseIntrinsicGas)/BatchTransferRecipientGas < numItems {
+		return 0, ErrOutOfGas
+	}
+	gas := BatchTransferBaseIntrinsicGas + numItems*BatchTransferRecipientGas
+	if (math.MaxUint64-gas)/TransferPayloadGas < payloadSize {
+		return 0, ErrOutOfGas
+	}
+	return gas + payloadSize*TransferPayloadGas, nil
+}
+
+// Cost returns the total cost of a batch transfer
+func (bt *BatchTransfer) Cost() (*big.Int, error) {
+	intrinsicGas, err := bt.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the batch transfer")
+	}
+	fee := big.NewInt(0).Mul(bt.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return fee.Add(fee, bt.TotalAmount()), nil
+}
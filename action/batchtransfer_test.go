@@ -0,0 +1,62 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestBatchTransfer(t *testing.T) {
+	require := require.New(t)
+	senderKey := identityset.PrivateKey(27)
+	items := []BatchTransferItem{
+		{Recipient: identityset.Address(28).String(), Amount: big.NewInt(10)},
+		{Recipient: identityset.Address(29).String(), Amount: big.NewInt(20)},
+	}
+
+	bt, err := NewBatchTransfer(1, items, []byte("memo"), uint64(100000), big.NewInt(10))
+	require.NoError(err)
+	require.Equal(items, bt.Items())
+	require.Equal([]byte("memo"), bt.Payload())
+	require.Equal("30", bt.TotalAmount().String())
+
+	gas, err := bt.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(BatchTransferBaseIntrinsicGas+2*BatchTransferRecipientGas+4*TransferPayloadGas, gas)
+	cs, err := bt.Cost()
+	require.NoError(err)
+	require.Equal(new(big.Int).SetUint64(10*gas+30), cs)
+
+	// the batch transfer survives the round trip through the action proto
+	elp := (&EnvelopeBuilder{}).SetNonce(1).
+		SetGasLimit(uint64(100000)).
+		SetGasPrice(big.NewInt(10)).
+		SetAction(bt).Build()
+	selp, err := Sign(elp, senderKey)
+	require.NoError(err)
+	require.NoError(Verify(selp))
+
+	selp2 := SealedEnvelope{}
+	require.NoError(selp2.LoadProto(selp.Proto()))
+	require.Equal(selp.Hash(), selp2.Hash())
+	bt2, ok := selp2.Action().(*BatchTransfer)
+	require.True(ok)
+	require.Equal(items, bt2.Items())
+	require.Equal([]byte("memo"), bt2.Payload())
+	require.Equal(uint64(1), bt2.Nonce())
+	require.NoError(Verify(selp2))
+
+	// an invalid amount fails to load
+	pb := bt.Proto()
+	pb.Items[0].Amount = "ten"
+	require.Error(bt2.LoadProto(pb))
+}
@@ -117,6 +117,12 @@ func (b *EnvelopeBuilder) SetGasPriceByBytes(buf []byte) *EnvelopeBuilder {
 	return b
 }
 
+// SetChainID sets the chain ID the action is signed for.
+func (b *EnvelopeBuilder) SetChainID(chainID uint32) *EnvelopeBuilder {
+	b.elp.chainID = chainID
+	return b
+}
+
 // SetAction sets the action payload for the Envelope Builder is building.
 func (b *EnvelopeBuilder) SetAction(action actionPayload) *EnvelopeBuilder {
 	b.elp.payload = action
@@ -0,0 +1,137 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+// CandidateRegister defines the action of registering a candidate owned by the sender, together with its self-stake
+// bucket
+type CandidateRegister struct {
+	AbstractAction
+
+	name      string
+	operator  string
+	reward    string
+	amount    *big.Int
+	duration  uint32
+	autoStake bool
+	payload   []byte
+}
+
+// NewCandidateRegister returns a CandidateRegister instance
+func NewCandidateRegister(
+	nonce uint64,
+	name string,
+	operatorAddress string,
+	rewardAddress string,
+	amount *big.Int,
+	duration uint32,
+	autoStake bool,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*CandidateRegister, error) {
+	return &CandidateRegister{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		name:      name,
+		operator:  operatorAddress,
+		reward:    rewardAddress,
+		amount:    amount,
+		duration:  duration,
+		autoStake: autoStake,
+		payload:   payload,
+	}, nil
+}
+
+// Name returns the name of the candidate
+func (cr *CandidateRegister) Name() string { return cr.name }
+
+// OperatorAddress returns the address operating the node of the candidate
+func (cr *CandidateRegister) OperatorAddress() string { return cr.operator }
+
+// RewardAddress returns the address receiving the rewards of the candidate
+func (cr *CandidateRegister) RewardAddress() string { return cr.reward }
+
+// Amount returns the self-staked amount
+func (cr *CandidateRegister) Amount() *big.Int { return cr.amount }
+
+// Duration returns the self-staked duration in days
+func (cr *CandidateRegister) Duration() uint32 { return cr.duration }
+
+// AutoStake returns whether the self-staked duration is kept from decaying
+func (cr *CandidateRegister) AutoStake() bool { return cr.autoStake }
+
+// Payload returns the payload bytes
+func (cr *CandidateRegister) Payload() []byte { return cr.payload }
+
+// Serialize returns a raw byte stream of the CandidateRegister
+func (cr *CandidateRegister) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(cr.Proto()))
+}
+
+// Proto converts CandidateRegister to protobuf's CandidateRegister
+func (cr *CandidateRegister) Proto() *iotextypes.CandidateRegister {
+	act := &iotextypes.CandidateRegister{
+		Name:            cr.name,
+		OperatorAddress: cr.operator,
+		RewardAddress:   cr.reward,
+		StakedDuration:  cr.duration,
+		AutoStake:       cr.autoStake,
+		Payload:         cr.payload,
+	}
+	if cr.amount != nil {
+		act.StakedAmount = cr.amount.String()
+	}
+	return act
+}
+
+// LoadProto converts a protobuf's CandidateRegister to CandidateRegister
+func (cr *CandidateRegister) LoadProto(pbAct *iotextypes.CandidateRegister) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if cr == nil {
+		return errors.New("nil action to load proto")
+	}
+	*cr = CandidateRegister{}
+	amount, ok := new(big.Int).SetString(pbAct.GetStakedAmount(), 10)
+	if !ok {
+		return errors.Errorf("invalid amount %s", pbAct.GetStakedAmount())
+	}
+	cr.name = pbAct.GetName()
+	cr.operator = pbAct.GetOperatorAddress()
+	cr.reward = pbAct.GetRewardAddress()
+	cr.amount = amount
+	cr.duration = pbAct.GetStakedDuration()
+	cr.autoStake = pbAct.GetAutoStake()
+	cr.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a CandidateRegister
+func (cr *CandidateRegister) IntrinsicGas() (uint64, error) {
+	return stakeIntrinsicGas(cr.payload)
+}
+
+// Cost returns the total cost of a CandidateRegister
+func (cr *CandidateRegister) Cost() (*big.Int, error) {
+	return stakeCost(cr.payload, cr.GasPrice(), cr.amount)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+// CandidateUnregister defines the action of unregistering the candidate owned by the sender, which releases its
+// self-stake bucket
+type CandidateUnregister struct {
+	AbstractAction
+
+	payload []byte
+}
+
+// NewCandidateUnregister returns a CandidateUnregister instance
+func NewCandidateUnregister(
+	nonce uint64,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*CandidateUnregister, error) {
+	return &CandidateUnregister{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		payload: payload,
+	}, nil
+}
+
+// Payload returns the payload bytes
+func (cu *CandidateUnregister) Payload() []byte { return cu.payload }
+
+// Serialize returns a raw byte stream of the CandidateUnregister
+func (cu *CandidateUnregister) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(cu.Proto()))
+}
+
+// Proto converts CandidateUnregister to protobuf's CandidateUnregister
+func (cu *CandidateUnregister) Proto() *iotextypes.CandidateUnregister {
+	return &iotextypes.CandidateUnregister{
+		Payload: cu.payload,
+	}
+}
+
+// LoadProto converts a protobuf's CandidateUnregister to CandidateUnregister
+func (cu *CandidateUnregister) LoadProto(pbAct *iotextypes.CandidateUnregister) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if cu == nil {
+		return errors.New("nil action to load proto")
+	}
+	*cu = CandidateUnregister{}
+	cu.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a CandidateUnregister
+func (cu *CandidateUnregister) IntrinsicGas() (uint64, error) {
+	return stakeIntrinsicGas(cu.payload)
+}
+
+// Cost returns the total cost of a CandidateUnregister
+func (cu *CandidateUnregister) Cost() (*big.Int, error) {
+	return stakeCost(cu.payload, cu.GasPrice(), nil)
+}
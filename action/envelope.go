@@ -19,6 +19,8 @@ type Envelope struct {
 	gasLimit uint64
 	payload  actionPayload
 	gasPrice *big.Int
+	// chainID is the chain an action encoded in Ethereum RLP is signed for
+	chainID uint32
 }
 
 // Version returns the version
@@ -37,6 +39,9 @@ func (elp *Envelope) Destination() (string, bool) {
 	return r.Destination(), true
 }
 
+// ChainID returns the chain ID the action is signed for, which is 0 if the action isn't encoded in RLP
+func (elp *Envelope) ChainID() uint32 { return elp.chainID }
+
 // GasLimit returns the gas limit
 func (elp *Envelope) GasLimit() uint64 { return elp.gasLimit }
 
@@ -68,6 +73,7 @@ func (elp *Envelope) Proto() *iotextypes.ActionCore {
 		Version:  elp.version,
 		Nonce:    elp.nonce,
 		GasLimit: elp.gasLimit,
+		ChainID:  elp.chainID,
 	}
 	if elp.gasPrice != nil {
 		actCore.GasPrice = elp.gasPrice.String()
@@ -88,6 +94,34 @@ func (elp *Envelope) Proto() *iotextypes.ActionCore {
 		actCore.Action = &iotextypes.ActionCore_DepositToRewardingFund{DepositToRewardingFund: act.Proto()}
 	case *PutPollResult:
 		actCore.Action = &iotextypes.ActionCore_PutPollResult{PutPollResult: act.Proto()}
+	case *BatchTransfer:
+		actCore.Action = &iotextypes.ActionCore_BatchTransfer{BatchTransfer: act.Proto()}
+	case *CreateStake:
+		actCore.Action = &iotextypes.ActionCore_StakeCreate{StakeCreate: act.Proto()}
+	case *Unstake:
+		actCore.Action = &iotextypes.ActionCore_StakeUnstake{StakeUnstake: act.Proto()}
+	case *WithdrawStake:
+		actCore.Action = &iotextypes.ActionCore_StakeWithdraw{StakeWithdraw: act.Proto()}
+	case *DepositToStake:
+		actCore.Action = &iotextypes.ActionCore_StakeAddDeposit{StakeAddDeposit: act.Proto()}
+	case *Restake:
+		actCore.Action = &iotextypes.ActionCore_StakeRestake{StakeRestake: act.Proto()}
+	case *ChangeCandidate:
+		actCore.Action = &iotextypes.ActionCore_StakeChangeCandidate{StakeChangeCandidate: act.Proto()}
+	case *TransferStake:
+		actCore.Action = &iotextypes.ActionCore_StakeTransferOwnership{StakeTransferOwnership: act.Proto()}
+	case *CandidateRegister:
+		actCore.Action = &iotextypes.ActionCore_CandidateRegister{CandidateRegister: act.Proto()}
+	case *CandidateUnregister:
+		actCore.Action = &iotextypes.ActionCore_CandidateUnregister{CandidateUnregister: act.Proto()}
+	case *SetMultisigPolicy:
+		actCore.Action = &iotextypes.ActionCore_SetMultisigPolicy{SetMultisigPolicy: act.Proto()}
+	case *ScheduleAction:
+		actCore.Action = &iotextypes.ActionCore_ScheduleAction{ScheduleAction: act.Proto()}
+	case *CancelScheduledAction:
+		actCore.Action = &iotextypes.ActionCore_CancelScheduledAction{CancelScheduledAction: act.Proto()}
+	case *ExecuteScheduledActions:
+		actCore.Action = &iotextypes.ActionCore_ExecuteScheduledActions{ExecuteScheduledActions: act.Proto()}
 	default:
 		log.S().Panicf("Cannot convert type of action %T.\r\n", act)
 	}
@@ -108,6 +142,7 @@ func (elp *Envelope) LoadProto(pbAct *iotextypes.ActionCore) error {
 	elp.gasLimit = pbAct.GetGasLimit()
 	elp.gasPrice = &big.Int{}
 	elp.gasPrice.SetString(pbAct.GetGasPrice(), 10)
+	elp.chainID = pbAct.GetChainID()
 
 	switch {
 	case pbAct.GetTransfer() != nil:
@@ -146,6 +181,90 @@ func (elp *Envelope) LoadProto(pbAct *iotextypes.ActionCore) error {
 			return err
 		}
 		elp.payload = act
+	case pbAct.GetBatchTransfer() != nil:
+		act := &BatchTransfer{}
+		if err := act.LoadProto(pbAct.GetBatchTransfer()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetStakeCreate() != nil:
+		act := &CreateStake{}
+		if err := act.LoadProto(pbAct.GetStakeCreate()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetStakeUnstake() != nil:
+		act := &Unstake{}
+		if err := act.LoadProto(pbAct.GetStakeUnstake()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetStakeWithdraw() != nil:
+		act := &WithdrawStake{}
+		if err := act.LoadProto(pbAct.GetStakeWithdraw()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetStakeAddDeposit() != nil:
+		act := &DepositToStake{}
+		if err := act.LoadProto(pbAct.GetStakeAddDeposit()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetStakeRestake() != nil:
+		act := &Restake{}
+		if err := act.LoadProto(pbAct.GetStakeRestake()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetStakeChangeCandidate() != nil:
+		act := &ChangeCandidate{}
+		if err := act.LoadProto(pbAct.GetStakeChangeCandidate()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetStakeTransferOwnership() != nil:
+		act := &TransferStake{}
+		if err := act.LoadProto(pbAct.GetStakeTransferOwnership()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetCandidateRegister() != nil:
+		act := &CandidateRegister{}
+		if err := act.LoadProto(pbAct.GetCandidateRegister()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetCandidateUnregister() != nil:
+		act := &CandidateUnregister{}
+		if err := act.LoadProto(pbAct.GetCandidateUnregister()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetSetMultisigPolicy() != nil:
+		act := &SetMultisigPolicy{}
+		if err := act.LoadProto(pbAct.GetSetMultisigPolicy()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetScheduleAction() != nil:
+		act := &ScheduleAction{}
+		if err := act.LoadProto(pbAct.GetScheduleAction()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetCancelScheduledAction() != nil:
+		act := &CancelScheduledAction{}
+		if err := act.LoadProto(pbAct.GetCancelScheduledAction()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetExecuteScheduledActions() != nil:
+		act := &ExecuteScheduledActions{}
+		if err := act.LoadProto(pbAct.GetExecuteScheduledActions()); err != nil {
+			return err
+		}
+		elp.payload = act
 	default:
 		return errors.Errorf("no applicable action to handle in action proto %+v", pbAct)
 	}
@@ -0,0 +1,434 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+const (
+	// SetMultisigPolicyBaseIntrinsicGas represents the base intrinsic gas for setting a multisig policy
+	SetMultisigPolicyBaseIntrinsicGas = uint64(10000)
+	// SetMultisigPolicyKeyGas represents the intrinsic gas for each key of a multisig policy
+	SetMultisigPolicyKeyGas = uint64(5000)
+	// MaxMultisigKeys is the max number of keys of a multisig policy
+	MaxMultisigKeys = 20
+)
+
+var (
+	// ErrMultisigPolicy indicates that the multisig policy is invalid
+	ErrMultisigPolicy = errors.New("invalid multisig policy")
+	// ErrMultisigThreshold indicates that the cosignatures don't reach the threshold of the multisig policy
+	ErrMultisigThreshold = errors.New("cosignatures don't reach the threshold")
+	// ErrMultisigUnauthorized indicates that the action doesn't satisfy the multisig policy in the states it runs on
+	ErrMultisigUnauthorized = errors.New("action isn't authorized by the multisig policy")
+)
+
+type (
+	// WeightedKey is a public key which can sign for a multisig account with a weight
+	WeightedKey struct {
+		PubKey crypto.PublicKey
+		Weight uint64
+	}
+
+	// MultisigPolicy is the M-of-N control of an account. An action of the account is valid only if the total weight
+	// of the keys signing it reaches the threshold.
+	MultisigPolicy struct {
+		Keys      []WeightedKey
+		Threshold uint64
+	}
+
+	// Cosignature is the signature of an action envelope by one of the keys of a multisig account
+	Cosignature struct {
+		PubKey    crypto.PublicKey
+		Signature []byte
+	}
+
+	// SetMultisigPolicy defines the action of putting the sender under the control of a multisig policy, or removing
+	// the policy if it has no key
+	SetMultisigPolicy struct {
+		AbstractAction
+
+		policy MultisigPolicy
+	}
+)
+
+// Validate checks that the keys are distinct with positive weights, and the threshold is reachable
+func (mp *MultisigPolicy) Validate() error {
+	if len(mp.Keys) > MaxMultisigKeys {
+		return errors.Wrapf(ErrMultisigPolicy, "too many keys %d", len(mp.Keys))
+	}
+	total := uint64(0)
+	for i, k := range mp.Keys {
+		if k.PubKey == nil {
+			return errors.Wrapf(ErrMultisigPolicy, "empty key %d", i)
+		}
+		if k.Weight == 0 {
+			return errors.Wrapf(ErrMultisigPolicy, "zero weight of key %x", k.PubKey.Bytes())
+		}
+		for _, prev := range mp.Keys[:i] {
+			if bytes.Equal(prev.PubKey.Bytes(), k.PubKey.Bytes()) {
+				return errors.Wrapf(ErrMultisigPolicy, "duplicate key %x", k.PubKey.Bytes())
+			}
+		}
+		total += k.Weight
+		if total < k.Weight {
+			return errors.Wrap(ErrMultisigPolicy, "total weight overflows")
+		}
+	}
+	if len(mp.Keys) == 0 {
+		if mp.Threshold != 0 {
+			return errors.Wrap(ErrMultisigPolicy, "threshold without key")
+		}
+		return nil
+	}
+	if mp.Threshold == 0 || mp.Threshold > total {
+		return errors.Wrapf(ErrMultisigPolicy, "threshold %d is not in (0, %d]", mp.Threshold, total)
+	}
+	return nil
+}
+
+// Weight returns the weight of the key, which is zero if the key is not in the policy
+func (mp *MultisigPolicy) Weight(pubKey crypto.PublicKey) uint64 {
+	for _, k := range mp.Keys {
+		if bytes.Equal(k.PubKey.Bytes(), pubKey.Bytes()) {
+			return k.Weight
+		}
+	}
+	return 0
+}
+
+// Proto converts MultisigPolicy to protobuf's MultisigPolicy
+func (mp *MultisigPolicy) Proto() *iotextypes.MultisigPolicy {
+	pb := &iotextypes.MultisigPolicy{Threshold: mp.Threshold}
+	for _, k := range mp.Keys {
+		pb.Keys = append(pb.Keys, &iotextypes.WeightedKey{
+			PubKey: k.PubKey.Bytes(),
+			Weight: k.Weight,
+		})
+	}
+	return pb
+}
+
+// LoadProto converts a protobuf's MultisigPolicy to MultisigPolicy
+func (mp *MultisigPolicy) LoadProto(pb *iotextypes.MultisigPolicy) error {
+	if pb == nil {
+		return errors.New("empty multisig policy proto to load")
+	}
+	*mp = MultisigPolicy{Threshold: pb.GetThreshold()}
+	for _, k := range pb.GetKeys() {
+		pubKey, err := crypto.BytesToPublicKey(k.GetPubKey())
+		if err != nil {
+			return err
+		}
+		mp.Keys = append(mp.Keys, WeightedKey{PubKey: pubKey, Weight: k.GetWeight()})
+	}
+	return nil
+}
+
+// Proto converts Cosignature to protobuf's Cosignature
+func (cs *Cosignature) Proto() *iotextypes.Cosignature {
+	return &iotextypes.Cosignature{
+		PubKey:    cs.PubKey.Bytes(),
+		Signature: cs.Signature,
+	}
+}
+
+// LoadProto converts a protobuf's Cosignature to Cosignature
+func (cs *Cosignature) LoadProto(pb *iotextypes.Cosignature) error {
+	if pb == nil {
+		return errors.New("empty cosignature proto to load")
+	}
+	pubKey, err := crypto.BytesToPublicKey(pb.GetPubKey())
+	if err != nil {
+		return err
+	}
+	cs.PubKey = pubKey
+	cs.Signature = make([]byte, len(pb.GetSignature()))
+	copy(cs.Signature, pb.GetSignature())
+	return nil
+}
+
+// NewSetMultisigPolicy returns a SetMultisigPolicy instance
+func NewSetMultisigPolicy(
+	nonce uint64,
+	policy MultisigPolicy,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*SetMultisigPolicy, error) {
+	return &SetMultisigPolicy{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		policy: policy,
+	}, nil
+}
+
+// Policy returns the multisig policy to set
+func (smp *SetMultisigPolicy) Policy() MultisigPolicy { return smp.policy }
+
+// Serialize returns a raw byte stream of the SetMultisigPolicy
+func (smp *SetMultisigPolicy) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(smp.Proto()))
+}
+
+// Proto converts SetMultisigPolicy to protobuf's SetMultisigPolicy
+func (smp *SetMultisigPolicy) Proto() *iotextypes.SetMultisigPolicy {
+	return &iotextypes.SetMultisigPolicy{Policy: smp.policy.Proto()}
+}
+
+// LoadProto converts a protobuf's SetMultisigPolicy to SetMultisigPolicy
+func (smp *SetMultisigPolicy) LoadProto(pbAct *iotextypes.SetMultisigPolicy) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if smp == nil {
+		return errors.New("nil action to load proto")
+	}
+	*smp = SetMultisigPolicy{}
+	return smp.policy.LoadProto(pbAct.GetPolicy())
+}
+
+// IntrinsicGas returns the intrinsic gas of a SetMultisigPolicy
+func (smp *SetMultisigPolicy) IntrinsicGas() (uint64, error) {
+	return SetMultisigPolicyBaseIntrinsicGas + SetMultisigPolicyKeyGas*uint64(len(smp.policy.Keys)), nil
+}
+
+// Cost returns the total cost of a SetMultisigPolicy
+func (smp *SetMultisigPolicy) Cost() (*big.Int, error) {
+	intrinsicGas, err := smp.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the SetMultisigPolicy")
+	}
+	return big.NewInt(0).Mul(smp.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas)), nil
+}
+
+// cosignHashPrefix separates the hash signed by a key of a multisig account from the hash of the envelope signed by a
+// sender
+const cosignHashPrefix = "cosign"
+
+// CosignHash returns the hash signed by the keys of a multisig account. It binds the envelope to the account, so a
+// cosignature can neither pass as an action sent by the account of its own key, nor be replayed to another multisig
+// account sharing the key. Like SenderHash, it binds the envelope to the sponsor too, if any.
+func CosignHash(elp Envelope, account crypto.PublicKey, sponsor address.Address) hash.Hash256 {
+	h := SenderHash(elp, sponsor)
+	data := append([]byte(cosignHashPrefix), h[:]...)
+	return hash.Hash256b(append(data, account.Hash()...))
+}
+
+// Cosign signs the action envelope of a multisig account, identified by its own public key, with one of its keys. The
+// sponsor is nil unless the action is to be paid by it.
+func Cosign(act Envelope, account crypto.PublicKey, sponsor address.Address, sk crypto.PrivateKey) (Cosignature, error) {
+	if account == nil {
+		return Cosignature{}, errors.New("empty public key of multisig account")
+	}
+	hash := CosignHash(act, account, sponsor)
+	sig, err := sk.Sign(hash[:])
+	if err != nil {
+		return Cosignature{}, errors.Wrapf(ErrAction, "failed to sign cosign hash = %x", hash)
+	}
+	return Cosignature{PubKey: sk.PublicKey(), Signature: sig}, nil
+}
+
+// SponsorCosignHash returns the hash signed by the keys of a multisig sponsor. Like CosignHash, it binds the hash
+// signed by a sponsor to the sponsoring account, so a cosignature can't sponsor the action on behalf of another account
+// sharing the key.
+func SponsorCosignHash(elp Envelope, sender crypto.PublicKey, sponsor crypto.PublicKey) hash.Hash256 {
+	h := SponsorHash(elp, sender)
+	data := append([]byte(cosignHashPrefix), h[:]...)
+	return hash.Hash256b(append(data, sponsor.Hash()...))
+}
+
+// CosignSponsor signs the action sealed by its sender with one of the keys of a multisig sponsor, identified by its
+// own public key
+func CosignSponsor(sealed SealedEnvelope, sponsor crypto.PublicKey, sk crypto.PrivateKey) (Cosignature, error) {
+	if sealed.SrcPubkey() == nil {
+		return Cosignature{}, errors.New("empty public key of sender")
+	}
+	if sponsor == nil {
+		return Cosignature{}, errors.New("empty public key of multisig sponsor")
+	}
+	hash := SponsorCosignHash(sealed.Envelope, sealed.SrcPubkey(), sponsor)
+	sig, err := sk.Sign(hash[:])
+	if err != nil {
+		return Cosignature{}, errors.Wrapf(ErrAction, "failed to sign sponsor cosign hash = %x", hash)
+	}
+	return Cosignature{PubKey: sk.PublicKey(), Signature: sig}, nil
+}
+
+// AssembleMultisigEnvelope assembles a SealedEnvelope of a multisig account, identified by its own public key, with
+// the cosignatures collected from the keys of its policy, which are put in the canonical order of their keys
+func AssembleMultisigEnvelope(act Envelope, pk crypto.PublicKey, cosigs []Cosignature) SealedEnvelope {
+	sealed := SealedEnvelope{
+		Envelope:     act,
+		srcPubkey:    pk,
+		cosignatures: sortCosignatures(cosigs),
+	}
+	sealed.payload.SetEnvelopeContext(sealed)
+	return sealed
+}
+
+// AssembleMultisigSponsor sponsors the action sealed by its sender on behalf of a multisig account, identified by its
+// own public key, with the cosignatures collected with CosignSponsor from the keys of its policy. Like Sponsor, the
+// action must have been signed for the sponsor.
+func AssembleMultisigSponsor(
+	sealed SealedEnvelope,
+	sponsor crypto.PublicKey,
+	cosigs []Cosignature,
+) (SealedEnvelope, error) {
+	if sealed.SrcPubkey() == nil {
+		return sealed, errors.New("empty public key of sender")
+	}
+	sponsored := sealed
+	sponsored.sponsorPubkey = sponsor
+	if err := verifySender(sponsored); err != nil {
+		return sealed, errors.Wrap(err, "action isn't signed for the sponsor")
+	}
+	sponsored.sponsorSignature = nil
+	sponsored.sponsorCosignatures = sortCosignatures(cosigs)
+	sponsored.payload.SetEnvelopeContext(sponsored)
+	return sponsored, nil
+}
+
+// VerifyPolicy verifies the action against the multisig policy of its sender, which is nil if the sender is controlled
+// by its own key. The own key of a multisig account can only sign as one of the keys of its policy.
+func VerifyPolicy(sealed SealedEnvelope, policy *MultisigPolicy) error {
+	if policy == nil {
+		if sealed.IsMultisig() {
+			return errors.Wrap(ErrAction, "sender has no multisig policy")
+		}
+		return nil
+	}
+	if !sealed.IsMultisig() {
+		return errors.Wrap(ErrAction, "sender requires the cosignatures of its multisig policy")
+	}
+	return VerifyMultisig(sealed, policy)
+}
+
+// VerifySponsorPolicy verifies the sponsor of the action against its multisig policy, which is nil if the sponsor is
+// controlled by its own key. It passes if the action isn't sponsored.
+func VerifySponsorPolicy(sealed SealedEnvelope, policy *MultisigPolicy) error {
+	if !sealed.IsSponsored() {
+		return nil
+	}
+	if policy == nil {
+		if sealed.IsMultisigSponsored() {
+			return errors.Wrap(ErrAction, "sponsor has no multisig policy")
+		}
+		return nil
+	}
+	if !sealed.IsMultisigSponsored() {
+		return errors.Wrap(ErrAction, "sponsor requires the cosignatures of its multisig policy")
+	}
+	if err := verifySponsorCosignatures(sealed); err != nil {
+		return err
+	}
+	return verifyThreshold(sealed.sponsorCosignatures, policy)
+}
+
+// VerifyMultisig verifies that the cosignatures of the action reach the threshold of the policy of its sender. Each
+// key counts once, and the keys out of the policy are rejected.
+func VerifyMultisig(sealed SealedEnvelope, policy *MultisigPolicy) error {
+	if err := verifyCosignatures(sealed); err != nil {
+		return err
+	}
+	return verifyThreshold(sealed.cosignatures, policy)
+}
+
+func verifyThreshold(cosigs []Cosignature, policy *MultisigPolicy) error {
+	total := uint64(0)
+	for _, cosig := range cosigs {
+		weight := policy.Weight(cosig.PubKey)
+		if weight == 0 {
+			return errors.Wrapf(ErrAction, "key %x is not in the multisig policy", cosig.PubKey.Bytes())
+		}
+		total += weight
+	}
+	if total < policy.Threshold {
+		return errors.Wrapf(ErrMultisigThreshold, "total weight %d, threshold %d", total, policy.Threshold)
+	}
+	return nil
+}
+
+// verifyCosignatures verifies the cosignatures, which replace the signature of the sender
+func verifyCosignatures(sealed SealedEnvelope) error {
+	if len(sealed.signature) != 0 {
+		return errors.Wrap(ErrAction, "multisig action cannot carry the signature of the sender")
+	}
+	if sealed.SrcPubkey() == nil {
+		return errors.New("empty public key of multisig account")
+	}
+	sponsor, err := sponsorAddress(sealed)
+	if err != nil {
+		return err
+	}
+	return verifyCosignatureSet(sealed.cosignatures, CosignHash(sealed.Envelope, sealed.SrcPubkey(), sponsor))
+}
+
+// verifySponsorCosignatures verifies the cosignatures, which replace the signature of the sponsor
+func verifySponsorCosignatures(sealed SealedEnvelope) error {
+	if len(sealed.sponsorSignature) != 0 {
+		return errors.Wrap(ErrAction, "multisig sponsor cannot carry the signature of the sponsor")
+	}
+	return verifyCosignatureSet(
+		sealed.sponsorCosignatures,
+		SponsorCosignHash(sealed.Envelope, sealed.SrcPubkey(), sealed.sponsorPubkey),
+	)
+}
+
+// verifyCosignatureSet verifies the cosignatures of the hash, which have to be sorted by their keys without
+// duplicates, so that an action has only one valid form
+func verifyCosignatureSet(cosigs []Cosignature, hash hash.Hash256) error {
+	if len(cosigs) == 0 {
+		return errors.Wrap(ErrAction, "no cosignature")
+	}
+	for i := 1; i < len(cosigs); i++ {
+		prev, cur := cosigs[i-1].PubKey.Bytes(), cosigs[i].PubKey.Bytes()
+		if bytes.Compare(prev, cur) >= 0 {
+			return errors.Wrapf(ErrAction, "cosignature of key %x is duplicate or out of order", cur)
+		}
+	}
+	for _, cosig := range cosigs {
+		if len(cosig.Signature) != SignatureLength {
+			return errors.New("incorrect length of cosignature")
+		}
+		if !cosig.PubKey.Verify(hash[:], cosig.Signature) {
+			return errors.Wrapf(
+				ErrAction,
+				"failed to verify cosign hash = %x and cosignature = %x",
+				hash,
+				cosig.Signature,
+			)
+		}
+	}
+	return nil
+}
+
+// sortCosignatures returns a copy of the cosignatures in the canonical order of their keys
+func sortCosignatures(cosigs []Cosignature) []Cosignature {
+	sorted := make([]Cosignature, len(cosigs))
+	copy(sorted, cosigs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].PubKey.Bytes(), sorted[j].PubKey.Bytes()) < 0
+	})
+	return sorted
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestMultisigPolicy_Validate(t *testing.T) {
+	require := require.New(t)
+	key := func(i int, weight uint64) WeightedKey {
+		return WeightedKey{PubKey: identityset.PrivateKey(i).PublicKey(), Weight: weight}
+	}
+	tooMany := MultisigPolicy{Threshold: 1}
+	for i := 0; i <= MaxMultisigKeys; i++ {
+		tooMany.Keys = append(tooMany.Keys, key(i, 1))
+	}
+
+	tests := []struct {
+		policy MultisigPolicy
+		err    error
+	}{
+		{MultisigPolicy{Keys: []WeightedKey{key(1, 1), key(2, 2)}, Threshold: 3}, nil},
+		{MultisigPolicy{}, nil},
+		{MultisigPolicy{Threshold: 1}, ErrMultisigPolicy},
+		{MultisigPolicy{Keys: []WeightedKey{key(1, 1), key(2, 2)}, Threshold: 4}, ErrMultisigPolicy},
+		{MultisigPolicy{Keys: []WeightedKey{key(1, 1)}, Threshold: 0}, ErrMultisigPolicy},
+		{MultisigPolicy{Keys: []WeightedKey{key(1, 1), key(1, 2)}, Threshold: 1}, ErrMultisigPolicy},
+		{MultisigPolicy{Keys: []WeightedKey{key(1, 0)}, Threshold: 1}, ErrMultisigPolicy},
+		{MultisigPolicy{Keys: []WeightedKey{{Weight: 1}}, Threshold: 1}, ErrMultisigPolicy},
+		{MultisigPolicy{Keys: []WeightedKey{key(1, math.MaxUint64), key(2, 1)}, Threshold: 1}, ErrMultisigPolicy},
+		{tooMany, ErrMultisigPolicy},
+	}
+	for _, test := range tests {
+		require.Equal(test.err, errors.Cause(test.policy.Validate()))
+	}
+}
+
+func TestSetMultisigPolicy(t *testing.T) {
+	require := require.New(t)
+	policy := MultisigPolicy{
+		Keys: []WeightedKey{
+			{PubKey: identityset.PrivateKey(28).PublicKey(), Weight: 1},
+			{PubKey: identityset.PrivateKey(29).PublicKey(), Weight: 2},
+		},
+		Threshold: 2,
+	}
+	smp, err := NewSetMultisigPolicy(1, policy, uint64(100000), big.NewInt(10))
+	require.NoError(err)
+	require.Equal(policy, smp.Policy())
+	gas, err := smp.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(SetMultisigPolicyBaseIntrinsicGas+2*SetMultisigPolicyKeyGas, gas)
+	cs, err := smp.Cost()
+	require.NoError(err)
+	require.Equal(new(big.Int).SetUint64(10*gas), cs)
+
+	// the policy survives the round trip through the action proto
+	elp := (&EnvelopeBuilder{}).SetNonce(1).
+		SetGasLimit(uint64(100000)).
+		SetGasPrice(big.NewInt(10)).
+		SetAction(smp).Build()
+	selp, err := Sign(elp, identityset.PrivateKey(27))
+	require.NoError(err)
+	selp2 := SealedEnvelope{}
+	require.NoError(selp2.LoadProto(selp.Proto()))
+	require.Equal(selp.Hash(), selp2.Hash())
+	smp2, ok := selp2.Action().(*SetMultisigPolicy)
+	require.True(ok)
+	require.Equal(policy, smp2.Policy())
+	require.NoError(Verify(selp2))
+}
+
+func TestMultisigEnvelope(t *testing.T) {
+	require := require.New(t)
+	account := identityset.PrivateKey(27).PublicKey()
+	sk1, sk2, sk3 := identityset.PrivateKey(28), identityset.PrivateKey(29), identityset.PrivateKey(30)
+	policy := &MultisigPolicy{
+		Keys: []WeightedKey{
+			{PubKey: sk1.PublicKey(), Weight: 1},
+			{PubKey: sk2.PublicKey(), Weight: 1},
+			{PubKey: sk3.PublicKey(), Weight: 2},
+		},
+		Threshold: 2,
+	}
+	tsf, err := NewTransfer(1, big.NewInt(10), identityset.Address(31).String(), nil, uint64(100000), big.NewInt(10))
+	require.NoError(err)
+	elp := (&EnvelopeBuilder{}).SetNonce(1).
+		SetGasLimit(uint64(100000)).
+		SetGasPrice(big.NewInt(10)).
+		SetAction(tsf).Build()
+	cosig1, err := Cosign(elp, account, nil, sk1)
+	require.NoError(err)
+	cosig2, err := Cosign(elp, account, nil, sk2)
+	require.NoError(err)
+	cosig3, err := Cosign(elp, account, nil, sk3)
+	require.NoError(err)
+
+	// the cosignatures survive the round trip through the action proto
+	selp := AssembleMultisigEnvelope(elp, account, []Cosignature{cosig1, cosig2})
+	require.True(selp.IsMultisig())
+	require.Equal(account, selp.SrcPubkey())
+	selp2 := SealedEnvelope{}
+	require.NoError(selp2.LoadProto(selp.Proto()))
+	require.Equal(selp.Hash(), selp2.Hash())
+	require.Equal(selp.Cosignatures(), selp2.Cosignatures())
+	require.NoError(Verify(selp2))
+	require.NoError(VerifyMultisig(selp2, policy))
+	// the cosignatures change the hash of the sealed action, but not the signed one
+	other := AssembleMultisigEnvelope(elp, account, []Cosignature{cosig3})
+	require.NotEqual(other.Hash(), selp.Hash())
+	require.Equal(other.Envelope.Hash(), selp.Envelope.Hash())
+
+	// a single key of enough weight reaches the threshold
+	require.NoError(VerifyMultisig(AssembleMultisigEnvelope(elp, account, []Cosignature{cosig3}), policy))
+	// a single key of less weight doesn't
+	require.Equal(ErrMultisigThreshold, errors.Cause(
+		VerifyMultisig(AssembleMultisigEnvelope(elp, account, []Cosignature{cosig1}), policy)))
+	// a key counts only once
+	require.Equal(ErrAction, errors.Cause(
+		VerifyMultisig(AssembleMultisigEnvelope(elp, account, []Cosignature{cosig1, cosig1}), policy)))
+	// the cosignatures are assembled in the order of their keys, and only that order is valid
+	sorted := AssembleMultisigEnvelope(elp, account, []Cosignature{cosig3, cosig2, cosig1})
+	require.NoError(Verify(sorted))
+	inOrder := AssembleMultisigEnvelope(elp, account, []Cosignature{cosig1, cosig2, cosig3})
+	require.Equal(inOrder.Hash(), sorted.Hash())
+	pb := sorted.Proto()
+	pb.Cosignatures[0], pb.Cosignatures[1] = pb.Cosignatures[1], pb.Cosignatures[0]
+	unsorted := SealedEnvelope{}
+	require.NoError(unsorted.LoadProto(pb))
+	require.Equal(ErrAction, errors.Cause(Verify(unsorted)))
+	require.Equal(ErrAction, errors.Cause(VerifyMultisig(unsorted, policy)))
+	// a multisig action cannot carry the signature of the sender too
+	h := elp.Hash()
+	sig, err := identityset.PrivateKey(27).Sign(h[:])
+	require.NoError(err)
+	pb = sorted.Proto()
+	pb.Signature = sig
+	signed := SealedEnvelope{}
+	require.NoError(signed.LoadProto(pb))
+	require.Equal(ErrAction, errors.Cause(Verify(signed)))
+	// a key out of the policy is rejected
+	foreign, err := Cosign(elp, account, nil, identityset.PrivateKey(31))
+	require.NoError(err)
+	require.Equal(ErrAction, errors.Cause(
+		VerifyMultisig(AssembleMultisigEnvelope(elp, account, []Cosignature{cosig3, foreign}), policy)))
+	// a cosignature not made by its key is rejected
+	tampered := cosig2
+	tampered.Signature = cosig3.Signature
+	require.Error(Verify(AssembleMultisigEnvelope(elp, account, []Cosignature{tampered})))
+	require.Error(VerifyMultisig(AssembleMultisigEnvelope(elp, account, []Cosignature{tampered}), policy))
+	// an envelope without cosignature is signed by its sender
+	require.Error(Verify(AssembleMultisigEnvelope(elp, account, nil)))
+
+	// a cosignature cannot be replayed as an action sent by the account of its own key
+	require.Error(Verify(AssembleSealedEnvelope(elp, sk1.PublicKey(), cosig1.Signature)))
+	// nor to another multisig account sharing the keys
+	require.Error(Verify(AssembleMultisigEnvelope(elp, identityset.PrivateKey(26).PublicKey(),
+		[]Cosignature{cosig1, cosig2})))
+	other = AssembleMultisigEnvelope(elp, identityset.PrivateKey(26).PublicKey(), []Cosignature{cosig3})
+	require.Error(VerifyMultisig(other, policy))
+	// and a signature of the envelope doesn't pass as a cosignature
+	sig, err = sk3.Sign(h[:])
+	require.NoError(err)
+	require.Error(Verify(AssembleMultisigEnvelope(elp, account,
+		[]Cosignature{{PubKey: sk3.PublicKey(), Signature: sig}})))
+}
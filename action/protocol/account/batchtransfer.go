@@ -0,0 +1,175 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package account
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+)
+
+// BatchTransferTopic is the first topic of the log emitted for each recipient of a batch transfer, followed by the
+// sender and the recipient
+var BatchTransferTopic = hash.Hash256b([]byte("batchTransfer"))
+
+// handleBatchTransfer handles a batch transfer, which pays either all the recipients or none of them
+func (p *Protocol) handleBatchTransfer(
+	ctx context.Context,
+	bt *action.BatchTransfer,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	// check sender
+	sender, err := accountutil.LoadOrCreateAccount(sm, actionCtx.Caller.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of sender %s", actionCtx.Caller.String())
+	}
+
+	if blkCtx.GasLimit < actionCtx.IntrinsicGas {
+		return nil, action.ErrHitGasLimit
+	}
+
+	total := bt.TotalAmount()
+	gasFee := big.NewInt(0).Mul(bt.GasPrice(), big.NewInt(0).SetUint64(actionCtx.IntrinsicGas))
+	senderGasFee, err := accountutil.SenderGasFee(ctx, sm, gasFee)
+	if err != nil {
+		return nil, err
+	}
+	if big.NewInt(0).Add(total, senderGasFee).Cmp(sender.Balance) == 1 {
+		return nil, errors.Wrapf(
+			state.ErrNotEnoughBalance,
+			"sender %s balance %s, required amount %s",
+			actionCtx.Caller.String(),
+			sender.Balance,
+			big.NewInt(0).Add(total, senderGasFee),
+		)
+	}
+
+	items := bt.Items()
+	recipients := make([]address.Address, 0, len(items))
+	status := uint64(iotextypes.ReceiptStatus_Success)
+	for _, item := range items {
+		recipientAddr, err := address.FromString(item.Recipient)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode recipient address %s", item.Recipient)
+		}
+		recipientAcct, err := accountutil.LoadAccount(sm, hash.BytesToHash160(recipientAddr.Bytes()))
+		if err == nil && recipientAcct.IsContract() {
+			// a contract cannot be paid without executing it, so the whole batch fails
+			status = uint64(iotextypes.ReceiptStatus_Failure)
+			break
+		}
+		recipients = append(recipients, recipientAddr)
+	}
+
+	if status == uint64(iotextypes.ReceiptStatus_Success) {
+		// update sender Balance
+		if err := sender.SubBalance(total); err != nil {
+			return nil, errors.Wrapf(err, "failed to update the Balance of sender %s", actionCtx.Caller.String())
+		}
+	}
+	// update sender Nonce
+	accountutil.SetNonce(bt, sender)
+	// put updated sender's state to trie
+	if err := accountutil.StoreAccount(sm, actionCtx.Caller.String(), sender); err != nil {
+		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+
+	var logs []*action.Log
+	if status == uint64(iotextypes.ReceiptStatus_Success) {
+		senderTopic := hash.BytesToHash256(actionCtx.Caller.Bytes())
+		for i, item := range items {
+			recipient, err := accountutil.LoadOrCreateAccount(sm, item.Recipient)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to load or create the account of recipient %s", item.Recipient)
+			}
+			if err := recipient.AddBalance(item.Amount); err != nil {
+				return nil, errors.Wrapf(err, "failed to update the Balance of recipient %s", item.Recipient)
+			}
+			// put updated recipient's state to trie
+			if err := accountutil.StoreAccount(sm, item.Recipient, recipient); err != nil {
+				return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+			}
+			logs = append(logs, &action.Log{
+				Address: p.addr.String(),
+				Topics: []hash.Hash256{
+					BatchTransferTopic,
+					senderTopic,
+					hash.BytesToHash256(recipients[i].Bytes()),
+				},
+				Data:        item.Amount.Bytes(),
+				BlockHeight: blkCtx.BlockHeight,
+				ActionHash:  actionCtx.ActionHash,
+				Index:       uint(i),
+			})
+		}
+	}
+
+	if p.depositGas != nil {
+		if err := p.depositGas(ctx, sm, gasFee); err != nil {
+			return nil, err
+		}
+	}
+
+	return &action.Receipt{
+		Status:          status,
+		BlockHeight:     blkCtx.BlockHeight,
+		ActionHash:      actionCtx.ActionHash,
+		GasConsumed:     actionCtx.IntrinsicGas,
+		ContractAddress: p.addr.String(),
+		Logs:            logs,
+	}, nil
+}
+
+// validateBatchTransfer validates a batch transfer
+func (p *Protocol) validateBatchTransfer(ctx context.Context, bt *action.BatchTransfer) error {
+	// Reject batch transfer before it is activated
+	if blkCtx, ok := protocol.GetBlockCtx(ctx); ok {
+		bcCtx := protocol.MustGetBlockchainCtx(ctx)
+		hu := config.NewHeightUpgrade(&bcCtx.Genesis)
+		if hu.IsPre(config.Fairbank, blkCtx.BlockHeight) {
+			return errors.Wrap(action.ErrActPool, "batch transfer is not activated yet")
+		}
+	}
+	items := bt.Items()
+	if len(items) == 0 {
+		return errors.Wrap(action.ErrActPool, "no recipient")
+	}
+	if len(items) > action.MaxBatchTransferRecipients {
+		return errors.Wrapf(action.ErrActPool, "too many recipients %d", len(items))
+	}
+	// Reject oversized batch transfer
+	if bt.TotalSize() > TransferSizeLimit {
+		return errors.Wrap(action.ErrActPool, "oversized data")
+	}
+	// Reject batch transfer of negative gas price
+	if bt.GasPrice().Sign() < 0 {
+		return errors.Wrap(action.ErrGasPrice, "negative value")
+	}
+	for _, item := range items {
+		// Reject transfer of negative amount
+		if item.Amount == nil || item.Amount.Sign() < 0 {
+			return errors.Wrapf(action.ErrBalance, "invalid amount to %s", item.Recipient)
+		}
+		// check if recipient's address is valid
+		if _, err := address.FromString(item.Recipient); err != nil {
+			return errors.Wrapf(err, "error when validating recipient's address %s", item.Recipient)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package account
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
+	"github.com/iotexproject/iotex-core/testutil"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+)
+
+func TestProtocol_HandleBatchTransfer(t *testing.T) {
+	require := require.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := config.Default
+	sm := mock_chainmanager.NewMockStateManager(ctrl)
+	cb := db.NewCachedBatch()
+	sm.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160, account interface{}) error {
+			val, err := cb.Get("state", addrHash[:])
+			if err != nil {
+				return state.ErrStateNotExist
+			}
+			return state.Deserialize(account, val)
+		}).AnyTimes()
+	sm.EXPECT().PutState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160, account interface{}) error {
+			ss, err := state.Serialize(account)
+			if err != nil {
+				return err
+			}
+			cb.Put("state", addrHash[:], ss, "failed to put state")
+			return nil
+		}).AnyTimes()
+
+	p := NewProtocol(rewarding.DepositGas)
+	reward := rewarding.NewProtocol(nil, rolldpos.NewProtocol(1, 1, 1))
+	registry := protocol.NewRegistry()
+	require.NoError(reward.Register(registry))
+	cfg.Genesis.Rewarding.InitBalanceStr = "0"
+	cfg.Genesis.Rewarding.BlockRewardStr = "0"
+	cfg.Genesis.Rewarding.EpochRewardStr = "0"
+	cfg.Genesis.Rewarding.NumDelegatesForEpochReward = 1
+	cfg.Genesis.Rewarding.ExemptAddrStrsFromEpochReward = []string{}
+	cfg.Genesis.Rewarding.FoundationBonusStr = "0"
+	cfg.Genesis.Rewarding.NumDelegatesForFoundationBonus = 0
+	cfg.Genesis.Rewarding.FoundationBonusLastEpoch = 0
+	cfg.Genesis.Rewarding.ProductivityThreshold = 0
+	ctx := protocol.WithBlockchainCtx(context.Background(),
+		protocol.BlockchainCtx{
+			Registry: registry,
+			Genesis:  cfg.Genesis,
+		})
+	ctx = protocol.WithBlockCtx(ctx,
+		protocol.BlockCtx{
+			BlockHeight: 0,
+			Producer:    identityset.Address(27),
+			GasLimit:    testutil.TestGasLimit,
+		})
+	ctx = protocol.WithActionCtx(ctx,
+		protocol.ActionCtx{
+			Caller: identityset.Address(28),
+		})
+	require.NoError(reward.CreateGenesisStates(ctx, sm))
+
+	senderHash := hash.BytesToHash160(identityset.Address(28).Bytes())
+	require.NoError(sm.PutState(senderHash, &state.Account{Balance: big.NewInt(100000)}))
+
+	bt, err := action.NewBatchTransfer(
+		uint64(1),
+		[]action.BatchTransferItem{
+			{Recipient: identityset.Address(29).String(), Amount: big.NewInt(2)},
+			{Recipient: identityset.Address(30).String(), Amount: big.NewInt(3)},
+		},
+		nil,
+		uint64(100000),
+		big.NewInt(1),
+	)
+	require.NoError(err)
+	gas, err := bt.IntrinsicGas()
+	require.NoError(err)
+
+	actHash := hash.Hash256b([]byte("batch"))
+	ctx = protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+		Caller:       identityset.Address(28),
+		ActionHash:   actHash,
+		IntrinsicGas: gas,
+	})
+	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+		BlockHeight: 1,
+		Producer:    identityset.Address(27),
+		GasLimit:    testutil.TestGasLimit,
+	})
+	ctx = protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{
+		Registry: registry,
+	})
+
+	receipt, err := p.Handle(ctx, bt, sm)
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+	require.Equal(gas, receipt.GasConsumed)
+	require.Equal(2, len(receipt.Logs))
+	for i, l := range receipt.Logs {
+		require.Equal(p.addr.String(), l.Address)
+		require.Equal(uint(i), l.Index)
+		require.Equal(actHash, l.ActionHash)
+		require.Equal(BatchTransferTopic, l.Topics[0])
+		require.Equal(hash.BytesToHash256(identityset.Address(28).Bytes()), l.Topics[1])
+		require.Equal(hash.BytesToHash256(identityset.Address(29+i).Bytes()), l.Topics[2])
+		require.Equal(big.NewInt(int64(2+i)).Bytes(), l.Data)
+	}
+
+	var acct state.Account
+	require.NoError(sm.State(senderHash, &acct))
+	require.Equal(new(big.Int).SetUint64(100000-5-gas).String(), acct.Balance.String())
+	require.Equal(uint64(1), acct.Nonce)
+	require.NoError(sm.State(hash.BytesToHash160(identityset.Address(29).Bytes()), &acct))
+	require.Equal("2", acct.Balance.String())
+	require.NoError(sm.State(hash.BytesToHash160(identityset.Address(30).Bytes()), &acct))
+	require.Equal("3", acct.Balance.String())
+
+	// paying a contract fails the whole batch, but the gas is charged
+	contractHash := hash.BytesToHash160(identityset.Address(32).Bytes())
+	require.NoError(sm.PutState(contractHash, &state.Account{CodeHash: []byte("codeHash")}))
+	bt, err = action.NewBatchTransfer(
+		uint64(2),
+		[]action.BatchTransferItem{
+			{Recipient: identityset.Address(29).String(), Amount: big.NewInt(2)},
+			{Recipient: identityset.Address(32).String(), Amount: big.NewInt(3)},
+		},
+		nil,
+		uint64(100000),
+		big.NewInt(1),
+	)
+	require.NoError(err)
+	receipt, err = p.Handle(ctx, bt, sm)
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Failure), receipt.Status)
+	require.Empty(receipt.Logs)
+	require.NoError(sm.State(senderHash, &acct))
+	require.Equal(uint64(2), acct.Nonce)
+	require.Equal(new(big.Int).SetUint64(100000-5-2*gas).String(), acct.Balance.String())
+	require.NoError(sm.State(hash.BytesToHash160(identityset.Address(29).Bytes()), &acct))
+	require.Equal("2", acct.Balance.String())
+
+	// not enough balance
+	bt, err = action.NewBatchTransfer(
+		uint64(3),
+		[]action.BatchTransferItem{
+			{Recipient: identityset.Address(29).String(), Amount: big.NewInt(100000)},
+		},
+		nil,
+		uint64(100000),
+		big.NewInt(1),
+	)
+	require.NoError(err)
+	_, err = p.Handle(ctx, bt, sm)
+	require.Equal(state.ErrNotEnoughBalance, errors.Cause(err))
+}
+
+func TestProtocol_ValidateBatchTransfer(t *testing.T) {
+	require := require.New(t)
+	p := NewProtocol(rewarding.DepositGas)
+	recipient := identityset.Address(28).String()
+
+	newBatchTransfer := func(items []action.BatchTransferItem, payload []byte, gasPrice int64) *action.BatchTransfer {
+		bt, err := action.NewBatchTransfer(uint64(1), items, payload, uint64(100000), big.NewInt(gasPrice))
+		require.NoError(err)
+		return bt
+	}
+	valid := []action.BatchTransferItem{{Recipient: recipient, Amount: big.NewInt(1)}}
+	require.NoError(p.Validate(context.Background(), newBatchTransfer(valid, nil, 0)))
+
+	// Case I: No recipient
+	err := p.Validate(context.Background(), newBatchTransfer(nil, nil, 0))
+	require.Equal(action.ErrActPool, errors.Cause(err))
+	// Case II: Too many recipients
+	tooMany := make([]action.BatchTransferItem, action.MaxBatchTransferRecipients+1)
+	for i := range tooMany {
+		tooMany[i] = valid[0]
+	}
+	err = p.Validate(context.Background(), newBatchTransfer(tooMany, nil, 0))
+	require.Equal(action.ErrActPool, errors.Cause(err))
+	// Case III: Oversized data
+	err = p.Validate(context.Background(), newBatchTransfer(valid, make([]byte, TransferSizeLimit), 0))
+	require.Equal(action.ErrActPool, errors.Cause(err))
+	// Case IV: Negative amount
+	err = p.Validate(context.Background(), newBatchTransfer(
+		[]action.BatchTransferItem{{Recipient: recipient, Amount: big.NewInt(-1)}}, nil, 0))
+	require.Equal(action.ErrBalance, errors.Cause(err))
+	// Case V: Invalid recipient address
+	err = p.Validate(context.Background(), newBatchTransfer(
+		[]action.BatchTransferItem{{Recipient: recipient + "aaa", Amount: big.NewInt(1)}}, nil, 0))
+	require.Error(err)
+	require.Contains(err.Error(), "error when validating recipient's address")
+	// Case VI: Negative gas price
+	err = p.Validate(context.Background(), newBatchTransfer(valid, nil, -1))
+	require.Equal(action.ErrGasPrice, errors.Cause(err))
+
+	// Case VII: Not activated yet
+	cfg := config.Default
+	cfg.Genesis.FairbankBlockHeight = 10
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Genesis: cfg.Genesis})
+	err = p.Validate(protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: 9}), newBatchTransfer(valid, nil, 0))
+	require.Equal(action.ErrActPool, errors.Cause(err))
+	require.NoError(p.Validate(protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: 10}), newBatchTransfer(valid, nil, 0)))
+}
@@ -66,6 +66,8 @@ func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.St
 	switch act := act.(type) {
 	case *action.Transfer:
 		return p.handleTransfer(ctx, act, sm)
+	case *action.BatchTransfer:
+		return p.handleBatchTransfer(ctx, act, sm)
 	}
 	return nil, nil
 }
@@ -77,6 +79,10 @@ func (p *Protocol) Validate(ctx context.Context, act action.Action) error {
 		if err := p.validateTransfer(ctx, act); err != nil {
 			return errors.Wrap(err, "error when validating transfer action")
 		}
+	case *action.BatchTransfer:
+		if err := p.validateBatchTransfer(ctx, act); err != nil {
+			return errors.Wrap(err, "error when validating batch transfer action")
+		}
 	}
 	return nil
 }
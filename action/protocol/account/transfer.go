@@ -45,13 +45,17 @@ func (p *Protocol) handleTransfer(ctx context.Context, act action.Action, sm pro
 	}
 
 	gasFee := big.NewInt(0).Mul(tsf.GasPrice(), big.NewInt(0).SetUint64(actionCtx.IntrinsicGas))
-	if big.NewInt(0).Add(tsf.Amount(), gasFee).Cmp(sender.Balance) == 1 {
+	senderGasFee, err := accountutil.SenderGasFee(ctx, sm, gasFee)
+	if err != nil {
+		return nil, err
+	}
+	if big.NewInt(0).Add(tsf.Amount(), senderGasFee).Cmp(sender.Balance) == 1 {
 		return nil, errors.Wrapf(
 			state.ErrNotEnoughBalance,
 			"sender %s balance %s, required amount %s",
 			actionCtx.Caller.String(),
 			sender.Balance,
-			big.NewInt(0).Add(tsf.Amount(), gasFee),
+			big.NewInt(0).Add(tsf.Amount(), senderGasFee),
 		)
 	}
 
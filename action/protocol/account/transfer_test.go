@@ -163,6 +163,34 @@ func TestProtocol_HandleTransfer(t *testing.T) {
 	require.NoError(sm.State(pubKeyAlfa, &acct))
 	require.Equal(uint64(2), acct.Nonce)
 	require.Equal("20003", acct.Balance.String())
+
+	// the sponsor pays the gas of a sponsored transfer
+	require.NoError(sm.PutState(pubKeyCharlie, &state.Account{Balance: big.NewInt(5)}))
+	transfer, err = action.NewTransfer(uint64(1), big.NewInt(5), identityset.Address(29).String(), []byte{},
+		uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	sponsoredCtx := protocol.WithActionCtx(ctx, protocol.ActionCtx{
+		Caller:       identityset.Address(30),
+		IntrinsicGas: gas,
+		GasPayer:     identityset.Address(28),
+	})
+	receipt, err = p.Handle(sponsoredCtx, transfer, sm)
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+	require.NoError(sm.State(pubKeyCharlie, &acct))
+	require.Equal(uint64(1), acct.Nonce)
+	require.Equal("0", acct.Balance.String())
+	require.NoError(sm.State(pubKeyAlfa, &acct))
+	require.Equal("10003", acct.Balance.String())
+	require.NoError(sm.State(pubKeyBravo, &acct))
+	require.Equal("7", acct.Balance.String())
+
+	// the sponsor holds the gas fee
+	transfer, err = action.NewTransfer(uint64(2), big.NewInt(0), identityset.Address(29).String(), []byte{},
+		uint64(10000), big.NewInt(2))
+	require.NoError(err)
+	_, err = p.Handle(sponsoredCtx, transfer, sm)
+	require.Equal(state.ErrNotEnoughBalance, errors.Cause(err))
 }
 
 func TestProtocol_ValidateTransfer(t *testing.T) {
@@ -7,14 +7,19 @@
 package accountutil
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
 
 type noncer interface {
@@ -75,6 +80,28 @@ func StoreAccount(sm protocol.StateManager, encodedAddr string, account *state.A
 	return sm.PutState(addrHash, account)
 }
 
+// MoveTokens moves the amount from one account to another, which are created if they don't exist yet
+func MoveTokens(sm protocol.StateManager, from, to address.Address, amount *big.Int) error {
+	sender, err := LoadOrCreateAccount(sm, from.String())
+	if err != nil {
+		return errors.Wrapf(err, "failed to load or create the account of %s", from.String())
+	}
+	if err := sender.SubBalance(amount); err != nil {
+		return errors.Wrapf(err, "failed to update the balance of %s", from.String())
+	}
+	if err := StoreAccount(sm, from.String(), sender); err != nil {
+		return errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	recipient, err := LoadOrCreateAccount(sm, to.String())
+	if err != nil {
+		return errors.Wrapf(err, "failed to load or create the account of %s", to.String())
+	}
+	if err := recipient.AddBalance(amount); err != nil {
+		return errors.Wrapf(err, "failed to update the balance of %s", to.String())
+	}
+	return StoreAccount(sm, to.String(), recipient)
+}
+
 // Recorded tests if an account has been actually stored
 func Recorded(sm protocol.StateManager, addr address.Address) (bool, error) {
 	var account state.Account
@@ -87,3 +114,107 @@ func Recorded(sm protocol.StateManager, addr address.Address) (bool, error) {
 	}
 	return false, err
 }
+
+// SenderGasFee returns the part of the gas fee which the caller holds on top of the amount of the action, which is
+// nothing if the action is sponsored. The balance of the sponsor is asserted to hold the gas fee instead.
+func SenderGasFee(ctx context.Context, sm protocol.StateManager, gasFee *big.Int) (*big.Int, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	if actionCtx.GasPayer == nil {
+		return gasFee, nil
+	}
+	sponsor, err := LoadAccount(sm, hash.BytesToHash160(actionCtx.GasPayer.Bytes()))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load the account of sponsor %s", actionCtx.GasPayer.String())
+	}
+	if gasFee.Cmp(sponsor.Balance) == 1 {
+		return nil, errors.Wrapf(
+			state.ErrNotEnoughBalance,
+			"sponsor %s balance %s, required amount %s",
+			actionCtx.GasPayer.String(),
+			sponsor.Balance,
+			gasFee,
+		)
+	}
+	return big.NewInt(0), nil
+}
+
+// SettleAction charges the gas of the action on top of the amount it spends, handles the action and bumps the nonce
+// of the caller. If handling the action fails with an error caused by the action itself, as told by isRuleViolation,
+// the changes made by it are reverted and the receipt fails, but the gas is charged nonetheless.
+func SettleAction(
+	ctx context.Context,
+	sm protocol.StateManager,
+	contract address.Address,
+	amount *big.Int,
+	depositGas func(context.Context, protocol.StateManager, *big.Int) error,
+	handle func() error,
+	isRuleViolation func(error) bool,
+) (*action.Receipt, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	if blkCtx.GasLimit < actionCtx.IntrinsicGas {
+		return nil, action.ErrHitGasLimit
+	}
+	caller, err := LoadOrCreateAccount(sm, actionCtx.Caller.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of caller %s", actionCtx.Caller.String())
+	}
+	gasFee := big.NewInt(0).Mul(actionCtx.GasPrice, big.NewInt(0).SetUint64(actionCtx.IntrinsicGas))
+	callerGasFee, err := SenderGasFee(ctx, sm, gasFee)
+	if err != nil {
+		return nil, err
+	}
+	required := big.NewInt(0).Set(callerGasFee)
+	if amount != nil {
+		required.Add(required, amount)
+	}
+	if required.Cmp(caller.Balance) == 1 {
+		return nil, errors.Wrapf(
+			state.ErrNotEnoughBalance,
+			"caller %s balance %s, required amount %s",
+			actionCtx.Caller.String(),
+			caller.Balance,
+			required,
+		)
+	}
+
+	status := uint64(iotextypes.ReceiptStatus_Success)
+	si := sm.Snapshot()
+	if err := handle(); err != nil {
+		if !isRuleViolation(err) {
+			return nil, err
+		}
+		log.L().Debug("Action failed.", zap.String("contract", contract.String()), zap.Error(err))
+		status = uint64(iotextypes.ReceiptStatus_Failure)
+		if err := sm.Revert(si); err != nil {
+			return nil, err
+		}
+	}
+	if depositGas != nil {
+		if err := depositGas(ctx, sm, gasFee); err != nil {
+			return nil, err
+		}
+	}
+	if err := IncreaseNonce(sm, actionCtx.Caller, actionCtx.Nonce); err != nil {
+		return nil, err
+	}
+	return &action.Receipt{
+		Status:          status,
+		BlockHeight:     blkCtx.BlockHeight,
+		ActionHash:      actionCtx.ActionHash,
+		GasConsumed:     actionCtx.IntrinsicGas,
+		ContractAddress: contract.String(),
+	}, nil
+}
+
+// IncreaseNonce raises the nonce of the account to the given one, if it's lower
+func IncreaseNonce(sm protocol.StateManager, addr address.Address, nonce uint64) error {
+	acc, err := LoadOrCreateAccount(sm, addr.String())
+	if err != nil {
+		return err
+	}
+	if nonce > acc.Nonce {
+		acc.Nonce = nonce
+	}
+	return StoreAccount(sm, addr.String(), acc)
+}
@@ -69,6 +69,8 @@ type ActionCtx struct {
 	IntrinsicGas uint64
 	// Nonce is the nonce of the action
 	Nonce uint64
+	// GasPayer is the address of the sponsor paying the gas of this action, which is nil if the caller pays it
+	GasPayer address.Address
 	// History indicates whether to save account/contract history or not
 }
 
@@ -42,6 +42,7 @@ type (
 		RootHash() hash.Hash256
 		LoadRoot() error
 		Iterator() (trie.Iterator, error)
+		Prove(hash.Hash256) ([][]byte, error)
 		Snapshot() Contract
 	}
 
@@ -76,6 +77,11 @@ func (c *contract) Iterator() (trie.Iterator, error) {
 	return trie.NewLeafIterator(c.trie)
 }
 
+// Prove returns the proof of a key in the storage trie
+func (c *contract) Prove(key hash.Hash256) ([][]byte, error) {
+	return c.trie.Prove(key[:])
+}
+
 // GetState get the committed value of a key
 func (c *contract) GetCommittedState(key hash.Hash256) ([]byte, error) {
 	if v, ok := c.committed[key]; ok {
@@ -186,23 +192,13 @@ func newContract(addr hash.Hash160, account *state.Account, dao db.KVStore, batc
 			log.L().Panic("failed to execute contract creation option")
 		}
 	}
-	dbForTrie, err := db.NewKVStoreForTrie(ContractKVNameSpace, PruneKVNameSpace, dao, db.CachedBatchOption(batch))
+	options, err := storageTrieOptions(addr, account.Root, dao, batch)
 	if err != nil {
 		return nil, err
 	}
-	options := []trie.Option{
-		trie.KVStoreOption(dbForTrie),
-		trie.KeyLengthOption(len(hash.Hash256{})),
-		trie.HashFuncOption(func(data []byte) []byte {
-			return trie.DefaultHashFunc(append(addr[:], data...))
-		}),
-	}
 	if c.saveHistory {
 		options = append(options, trie.HistoryRetentionOption(c.height))
 	}
-	if account.Root != hash.ZeroHash256 {
-		options = append(options, trie.RootHashOption(account.Root[:]))
-	}
 
 	tr, err := trie.NewTrie(options...)
 	if err != nil {
@@ -214,3 +210,52 @@ func newContract(addr hash.Hash160, account *state.Account, dao db.KVStore, batc
 	c.trie = tr
 	return c, nil
 }
+
+// NewStorageTrie opens the storage trie of a contract at the given root, whose pending writes go into the batch
+func NewStorageTrie(addr hash.Hash160, root hash.Hash256, dao db.KVStore, batch db.CachedBatch) (trie.Trie, error) {
+	options, err := storageTrieOptions(addr, root, dao, batch)
+	if err != nil {
+		return nil, err
+	}
+	tr, err := trie.NewTrie(options...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create storage trie of contract %x", addr)
+	}
+	if err := tr.Start(context.Background()); err != nil {
+		return nil, err
+	}
+	return tr, nil
+}
+
+func storageTrieOptions(addr hash.Hash160, root hash.Hash256, dao db.KVStore, batch db.CachedBatch) ([]trie.Option, error) {
+	dbForTrie, err := db.NewKVStoreForTrie(ContractKVNameSpace, PruneKVNameSpace, dao, db.CachedBatchOption(batch))
+	if err != nil {
+		return nil, err
+	}
+	options := []trie.Option{
+		trie.KVStoreOption(dbForTrie),
+		trie.KeyLengthOption(len(hash.Hash256{})),
+		trie.HashFuncOption(StorageHashFunc(addr)),
+	}
+	if root != hash.ZeroHash256 {
+		options = append(options, trie.RootHashOption(root[:]))
+	}
+	return options, nil
+}
+
+// StorageHashFunc returns the hash function of the storage trie of a contract, which is salted by the address
+func StorageHashFunc(addr hash.Hash160) trie.HashFunc {
+	return func(data []byte) []byte {
+		return trie.DefaultHashFunc(append(addr[:], data...))
+	}
+}
+
+// VerifyStorageProof verifies the proof of a key in the storage trie of a contract against the root hash in its
+// account state. It returns the value of the key, or trie.ErrNotExist if the key does not exist.
+func VerifyStorageProof(addr hash.Hash160, root hash.Hash256, key hash.Hash256, proof [][]byte) ([]byte, error) {
+	if root == hash.ZeroHash256 {
+		// the storage trie is empty
+		return nil, trie.ErrNotExist
+	}
+	return trie.VerifyProof(root[:], key[:], proof, StorageHashFunc(addr))
+}
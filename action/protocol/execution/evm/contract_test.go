@@ -21,6 +21,7 @@ import (
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/trie"
 	"github.com/iotexproject/iotex-core/state"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
@@ -240,3 +241,34 @@ func TestSnapshot(t *testing.T) {
 	require.Equal(big.NewInt(5), c2.SelfState().Balance)
 	require.NotEqual(c1.RootHash(), c2.RootHash())
 }
+
+func TestProve(t *testing.T) {
+	require := require.New(t)
+
+	addr := hash.BytesToHash160(identityset.Address(28).Bytes())
+	c1, err := newContract(addr, &state.Account{}, db.NewMemKVStore(), db.NewCachedBatch())
+	require.NoError(err)
+	// empty storage
+	_, err = VerifyStorageProof(addr, c1.RootHash(), k1b, nil)
+	require.Equal(trie.ErrNotExist, err)
+
+	require.NoError(c1.SetState(k1b, v1b[:]))
+	require.NoError(c1.SetState(k2b, v2b[:]))
+	require.NoError(c1.Commit())
+	root := c1.RootHash()
+	proof, err := c1.Prove(k1b)
+	require.NoError(err)
+	v, err := VerifyStorageProof(addr, root, k1b, proof)
+	require.NoError(err)
+	require.Equal(v1b[:], v)
+	proof, err = c1.Prove(k3b)
+	require.NoError(err)
+	_, err = VerifyStorageProof(addr, root, k3b, proof)
+	require.Equal(trie.ErrNotExist, err)
+
+	// the hash of storage trie nodes is salted by the contract address
+	proof, err = c1.Prove(k2b)
+	require.NoError(err)
+	_, err = VerifyStorageProof(hash.BytesToHash160(identityset.Address(29).Bytes()), root, k2b, proof)
+	require.Equal(trie.ErrInvalidProof, errors.Cause(err))
+}
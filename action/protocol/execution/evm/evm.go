@@ -57,6 +57,9 @@ type (
 		contract           *common.Address
 		gas                uint64
 		data               []byte
+		tracer             vm.Tracer
+		// gasPayer pays the gas of the execution, which is the sponsor if it is sponsored, or else the executor
+		gasPayer common.Address
 	}
 )
 
@@ -107,6 +110,11 @@ func NewParams(
 		GasLimit:    gasLimit,
 		GasPrice:    execution.GasPrice(),
 	}
+	tracer, _ := GetTracerCtx(ctx)
+	gasPayer := executorAddr
+	if actionCtx.GasPayer != nil {
+		gasPayer = common.BytesToAddress(actionCtx.GasPayer.Bytes())
+	}
 
 	return &Params{
 		context,
@@ -116,6 +124,8 @@ func NewParams(
 		contractAddrPointer,
 		gasLimit,
 		execution.Data(),
+		tracer,
+		gasPayer,
 	}, nil
 }
 
@@ -130,10 +140,10 @@ func securityDeposit(ps *Params, stateDB vm.StateDB, gasLimit uint64) error {
 		return action.ErrHitGasLimit
 	}
 	maxGasValue := new(big.Int).Mul(new(big.Int).SetUint64(ps.gas), ps.context.GasPrice)
-	if stateDB.GetBalance(ps.context.Origin).Cmp(maxGasValue) < 0 {
+	if stateDB.GetBalance(ps.gasPayer).Cmp(maxGasValue) < 0 {
 		return action.ErrInsufficientBalanceForGas
 	}
-	stateDB.SubBalance(ps.context.Origin, maxGasValue)
+	stateDB.SubBalance(ps.gasPayer, maxGasValue)
 	return nil
 }
 
@@ -180,14 +190,18 @@ func ExecuteContract(
 	}
 
 	receipt.Status = statusCode
+	if statusCode == uint64(iotextypes.ReceiptStatus_ErrExecutionReverted) {
+		// the revert reason is not committed by the receipt root, so it is decoded at any height
+		receipt.ExecutionRevertMsg = RevertReason(retval)
+	}
 
 	if hu.IsPost(config.Pacific, blkCtx.BlockHeight) {
 		// Refund all deposit and, actual gas fee will be subtracted when depositing gas fee to the rewarding protocol
-		stateDB.AddBalance(ps.context.Origin, big.NewInt(0).Mul(big.NewInt(0).SetUint64(depositGas), ps.context.GasPrice))
+		stateDB.AddBalance(ps.gasPayer, big.NewInt(0).Mul(big.NewInt(0).SetUint64(depositGas), ps.context.GasPrice))
 	} else {
 		if remainingGas > 0 {
 			remainingValue := new(big.Int).Mul(new(big.Int).SetUint64(remainingGas), ps.context.GasPrice)
-			stateDB.AddBalance(ps.context.Origin, remainingValue)
+			stateDB.AddBalance(ps.gasPayer, remainingValue)
 		}
 	}
 	if depositGas-remainingGas > 0 {
@@ -223,6 +237,10 @@ func executeInEVM(evmParams *Params, stateDB *StateDBAdapter, hu config.HeightUp
 		return nil, 0, 0, action.EmptyAddress, uint64(iotextypes.ReceiptStatus_Failure), err
 	}
 	var config vm.Config
+	if evmParams.tracer != nil {
+		config.Debug = true
+		config.Tracer = evmParams.tracer
+	}
 	chainConfig := getChainConfig(hu.BeringBlockHeight())
 	evm := vm.NewEVM(evmParams.context, stateDB, chainConfig, config)
 	intriGas, err := intrinsicGas(evmParams.data)
@@ -239,13 +257,19 @@ func executeInEVM(evmParams *Params, stateDB *StateDBAdapter, hu config.HeightUp
 	var evmErr error
 	if evmParams.contract == nil {
 		// create contract
-		var evmContractAddress common.Address
-		_, evmContractAddress, remainingGas, evmErr = evm.Create(executor, evmParams.data, remainingGas, evmParams.amount)
+		var (
+			evmContractAddress common.Address
+			createRet          []byte
+		)
+		createRet, evmContractAddress, remainingGas, evmErr = evm.Create(executor, evmParams.data, remainingGas, evmParams.amount)
 		log.L().Debug("evm Create.", log.Hex("addrHash", evmContractAddress[:]))
 		if evmErr == nil {
 			if contractAddress, err := address.FromBytes(evmContractAddress.Bytes()); err == nil {
 				contractRawAddress = contractAddress.String()
 			}
+		} else {
+			// the return data of a failed creation carries the revert reason
+			ret = createRet
 		}
 	} else {
 		stateDB.SetNonce(evmParams.context.Origin, stateDB.GetNonce(evmParams.context.Origin)+1)
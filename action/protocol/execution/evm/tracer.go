@@ -0,0 +1,358 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/iotexproject/iotex-address/address"
+)
+
+const (
+	// CallTypeCall is a message call
+	CallTypeCall = "CALL"
+	// CallTypeCreate is a contract creation
+	CallTypeCreate = "CREATE"
+	// CallTypeSelfDestruct is a contract self destruction
+	CallTypeSelfDestruct = "SELFDESTRUCT"
+
+	errExecutionReverted = "execution reverted"
+	errInternalFailure   = "internal failure"
+)
+
+var (
+	// selector of Error(string)
+	errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	// selector of Panic(uint256)
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+	// panicReasons are the panic codes defined by solidity
+	panicReasons = map[uint64]string{
+		0x00: "generic panic",
+		0x01: "assert(false)",
+		0x11: "arithmetic underflow or overflow",
+		0x12: "division or modulo by zero",
+		0x21: "enum overflow",
+		0x22: "invalid encoded storage byte array accessed",
+		0x31: "out-of-bounds array access; popping on an empty array",
+		0x32: "out-of-bounds access of an array or bytesN",
+		0x41: "out of memory",
+		0x51: "uninitialized function",
+	}
+)
+
+type (
+	// CallFrame is a call in the call tree of a traced execution
+	CallFrame struct {
+		Type         string         `json:"type"`
+		From         string         `json:"from"`
+		To           string         `json:"to,omitempty"`
+		Value        string         `json:"value,omitempty"`
+		Gas          hexutil.Uint64 `json:"gas"`
+		GasUsed      hexutil.Uint64 `json:"gasUsed"`
+		Input        hexutil.Bytes  `json:"input"`
+		Output       hexutil.Bytes  `json:"output,omitempty"`
+		Error        string         `json:"error,omitempty"`
+		RevertReason string         `json:"revertReason,omitempty"`
+		Calls        []*CallFrame   `json:"calls,omitempty"`
+
+		// bookkeeping of a pending inner call
+		gasIn     uint64
+		gasCost   uint64
+		outOffset uint64
+		outSize   uint64
+		entered   bool
+	}
+
+	// ExecutionTrace is the result of tracing an execution
+	ExecutionTrace struct {
+		Call       *CallFrame     `json:"call"`
+		StructLogs []vm.StructLog `json:"structLogs,omitempty"`
+	}
+
+	// CallTracer is a vm.Tracer which records the call tree of an execution, and optionally the opcode level struct
+	// logs
+	CallTracer struct {
+		callstack    []*CallFrame
+		descended    bool
+		structLogger *vm.StructLogger
+	}
+
+	tracerContextKey struct{}
+)
+
+// NewCallTracer creates a new call tracer
+func NewCallTracer(structLogs bool) *CallTracer {
+	t := &CallTracer{}
+	if structLogs {
+		t.structLogger = vm.NewStructLogger(nil)
+	}
+	return t
+}
+
+// WithTracerCtx adds a tracer into context, so that the executions run under the context are traced by it
+func WithTracerCtx(ctx context.Context, tracer vm.Tracer) context.Context {
+	return context.WithValue(ctx, tracerContextKey{}, tracer)
+}
+
+// GetTracerCtx gets the tracer from context
+func GetTracerCtx(ctx context.Context) (vm.Tracer, bool) {
+	tracer, ok := ctx.Value(tracerContextKey{}).(vm.Tracer)
+	return tracer, ok
+}
+
+// Result returns the trace of the execution
+func (t *CallTracer) Result() *ExecutionTrace {
+	trace := &ExecutionTrace{}
+	if len(t.callstack) > 0 {
+		trace.Call = t.callstack[0]
+	}
+	if t.structLogger != nil {
+		trace.StructLogs = t.structLogger.StructLogs()
+	}
+	return trace
+}
+
+// CaptureStart records the top level call
+func (t *CallTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	if t.structLogger != nil {
+		t.structLogger.CaptureStart(from, to, create, input, gas, value)
+	}
+	root := &CallFrame{
+		Type:  CallTypeCall,
+		From:  ioAddress(from),
+		To:    ioAddress(to),
+		Value: value.String(),
+		Gas:   hexutil.Uint64(gas),
+		Input: common.CopyBytes(input),
+	}
+	if create {
+		root.Type = CallTypeCreate
+	}
+	t.callstack = []*CallFrame{root}
+	return nil
+}
+
+// CaptureState tracks the inner calls by the call related opcodes and the change of depth
+func (t *CallTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if t.structLogger != nil {
+		t.structLogger.CaptureState(env, pc, op, gas, cost, memory, stack, contract, depth, err)
+	}
+	if len(t.callstack) == 0 {
+		return nil
+	}
+	// retrieve the true gas allowance of an inner call from within it, which could differ from the requested gas
+	if t.descended {
+		if depth >= len(t.callstack) {
+			top := t.callstack[len(t.callstack)-1]
+			top.Gas = hexutil.Uint64(gas)
+			top.entered = true
+		}
+		t.descended = false
+	}
+	// an inner call has returned, pop it off the call stack
+	if depth == len(t.callstack)-1 {
+		t.popCall(env, memory, stack, gas)
+	}
+	if err != nil {
+		t.fault(err)
+		return nil
+	}
+	switch op {
+	case vm.CREATE, vm.CREATE2:
+		offset, size := stack.Back(1).Uint64(), stack.Back(2).Uint64()
+		t.callstack = append(t.callstack, &CallFrame{
+			Type:    op.String(),
+			From:    ioAddress(contract.Address()),
+			Value:   stack.Back(0).String(),
+			Input:   memorySlice(memory, offset, size),
+			gasIn:   gas,
+			gasCost: cost,
+		})
+		t.descended = true
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		to := common.BigToAddress(stack.Back(1))
+		if _, ok := vm.PrecompiledContractsByzantium[to]; ok {
+			return nil
+		}
+		off := 1
+		if op == vm.DELEGATECALL || op == vm.STATICCALL {
+			off = 0
+		}
+		call := &CallFrame{
+			Type:      op.String(),
+			From:      ioAddress(contract.Address()),
+			To:        ioAddress(to),
+			Input:     memorySlice(memory, stack.Back(2+off).Uint64(), stack.Back(3+off).Uint64()),
+			gasIn:     gas,
+			gasCost:   cost,
+			outOffset: stack.Back(4 + off).Uint64(),
+			outSize:   stack.Back(5 + off).Uint64(),
+		}
+		if off == 1 {
+			call.Value = stack.Back(2).String()
+		}
+		t.callstack = append(t.callstack, call)
+		t.descended = true
+	case vm.REVERT:
+		top := t.callstack[len(t.callstack)-1]
+		top.Error = errExecutionReverted
+		top.Output = memorySlice(memory, stack.Back(0).Uint64(), stack.Back(1).Uint64())
+		top.RevertReason = RevertReason(top.Output)
+	case vm.SELFDESTRUCT:
+		top := t.callstack[len(t.callstack)-1]
+		top.Calls = append(top.Calls, &CallFrame{
+			Type:  CallTypeSelfDestruct,
+			From:  ioAddress(contract.Address()),
+			To:    ioAddress(common.BigToAddress(stack.Back(0))),
+			Value: env.StateDB.GetBalance(contract.Address()).String(),
+		})
+	}
+	return nil
+}
+
+// CaptureFault records the failure of the current call
+func (t *CallTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if t.structLogger != nil {
+		t.structLogger.CaptureFault(env, pc, op, gas, cost, memory, stack, contract, depth, err)
+	}
+	t.fault(err)
+	return nil
+}
+
+// CaptureEnd records the result of the top level call
+func (t *CallTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	if t.structLogger != nil {
+		t.structLogger.CaptureEnd(output, gasUsed, d, err)
+	}
+	if len(t.callstack) == 0 {
+		return nil
+	}
+	root := t.callstack[0]
+	root.GasUsed = hexutil.Uint64(gasUsed)
+	root.Output = common.CopyBytes(output)
+	if err != nil {
+		root.Error = err.Error()
+		if root.Error == "evm: execution reverted" {
+			root.Error = errExecutionReverted
+			root.RevertReason = RevertReason(output)
+		}
+	}
+	return nil
+}
+
+func (t *CallTracer) popCall(env *vm.EVM, memory *vm.Memory, stack *vm.Stack, gas uint64) {
+	call := t.callstack[len(t.callstack)-1]
+	t.callstack = t.callstack[:len(t.callstack)-1]
+	ret := stack.Back(0)
+	if call.Type == vm.CREATE.String() || call.Type == vm.CREATE2.String() {
+		call.GasUsed = hexutil.Uint64(call.gasIn - call.gasCost - gas)
+		if ret.Sign() != 0 {
+			addr := common.BigToAddress(ret)
+			call.To = ioAddress(addr)
+			call.Output = env.StateDB.GetCode(addr)
+		} else if call.Error == "" {
+			call.Error = errInternalFailure
+		}
+	} else {
+		if call.entered {
+			call.GasUsed = hexutil.Uint64(call.gasIn - call.gasCost + uint64(call.Gas) - gas)
+		}
+		if ret.Sign() != 0 {
+			call.Output = memorySlice(memory, call.outOffset, call.outSize)
+		} else if call.Error == "" {
+			call.Error = errInternalFailure
+		}
+	}
+	parent := t.callstack[len(t.callstack)-1]
+	parent.Calls = append(parent.Calls, call)
+}
+
+func (t *CallTracer) fault(err error) {
+	if len(t.callstack) == 0 {
+		return
+	}
+	// the call has reverted, which is followed by a fault of the same call
+	call := t.callstack[len(t.callstack)-1]
+	if call.Error != "" {
+		return
+	}
+	call.Error = err.Error()
+	call.GasUsed = call.Gas
+	if len(t.callstack) == 1 {
+		// keep the top level call in the stack
+		return
+	}
+	t.callstack = t.callstack[:len(t.callstack)-1]
+	parent := t.callstack[len(t.callstack)-1]
+	parent.Calls = append(parent.Calls, call)
+}
+
+// RevertReason decodes the reason out of the revert data encoded as Error(string) or Panic(uint256), and returns empty
+// string if the data is in neither form
+func RevertReason(data []byte) string {
+	if len(data) < 4+32 {
+		return ""
+	}
+	switch {
+	case bytes.Equal(data[:4], errorSelector):
+		return errorReason(data[4:])
+	case bytes.Equal(data[:4], panicSelector):
+		code := new(big.Int).SetBytes(data[4 : 4+32])
+		if desc, ok := panicReasons[code.Uint64()]; ok && code.IsUint64() {
+			return fmt.Sprintf("panic: %s (0x%x)", desc, code)
+		}
+		return fmt.Sprintf("panic: unknown code 0x%x", code)
+	}
+	return ""
+}
+
+func errorReason(data []byte) string {
+	if len(data) < 32+32 {
+		return ""
+	}
+	// the bounds are compared without adding to the words read from the data, which may overflow
+	offset := new(big.Int).SetBytes(data[:32])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(data)-32) {
+		return ""
+	}
+	start := offset.Uint64() + 32
+	size := new(big.Int).SetBytes(data[offset.Uint64():start])
+	if !size.IsUint64() || size.Uint64() > uint64(len(data))-start {
+		return ""
+	}
+	return string(data[start : start+size.Uint64()])
+}
+
+func memorySlice(memory *vm.Memory, offset, size uint64) []byte {
+	if size == 0 {
+		return []byte{}
+	}
+	data := memory.Data()
+	if offset >= uint64(len(data)) {
+		return []byte{}
+	}
+	end := offset + size
+	if end < offset || end > uint64(len(data)) {
+		end = uint64(len(data))
+	}
+	return common.CopyBytes(data[offset:end])
+}
+
+func ioAddress(addr common.Address) string {
+	ioAddr, err := address.FromBytes(addr.Bytes())
+	if err != nil {
+		return ""
+	}
+	return ioAddr.String()
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevertReason(t *testing.T) {
+	require := require.New(t)
+
+	// Error("not enough balance")
+	data, err := hex.DecodeString("08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000012" +
+		"6e6f7420656e6f7567682062616c616e63650000000000000000000000000000")
+	require.NoError(err)
+	require.Equal("not enough balance", RevertReason(data))
+
+	require.Empty(RevertReason(nil))
+	require.Empty(RevertReason(data[:40]))
+	// wrong selector
+	wrong := common.CopyBytes(data)
+	wrong[0] = 0
+	require.Empty(RevertReason(wrong))
+	// size out of range
+	wrong = common.CopyBytes(data)
+	wrong[4+63] = 0xff
+	require.Empty(RevertReason(wrong))
+	word := func(s string) string {
+		return strings.Repeat("0", 64-len(s)) + s
+	}
+	for _, c := range []struct {
+		offset, size string
+	}{
+		// words wrapping around when added to
+		{"ffffffffffffffff", "12"},
+		{"ffffffffffffffe0", "12"},
+		{"20", "ffffffffffffffff"},
+		{"ffffffffffffffff", "ffffffffffffffff"},
+		// words out of range
+		{"41", "12"},
+		{"20", "21"},
+		{strings.Repeat("f", 64), "12"},
+		{"20", strings.Repeat("f", 64)},
+	} {
+		wrong, err = hex.DecodeString("08c379a0" + word(c.offset) + word(c.size) +
+			"6e6f7420656e6f7567682062616c616e63650000000000000000000000000000")
+		require.NoError(err)
+		require.Empty(RevertReason(wrong), "offset %s, size %s", c.offset, c.size)
+	}
+
+	// Panic(0x11)
+	data, err = hex.DecodeString("4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000011")
+	require.NoError(err)
+	require.Equal("panic: arithmetic underflow or overflow (0x11)", RevertReason(data))
+	data[4+31] = 0x99
+	require.Equal("panic: unknown code 0x99", RevertReason(data))
+	require.Empty(RevertReason(data[:20]))
+}
+
+func TestTracerCtx(t *testing.T) {
+	require := require.New(t)
+
+	_, ok := GetTracerCtx(context.Background())
+	require.False(ok)
+	tracer := NewCallTracer(false)
+	got, ok := GetTracerCtx(WithTracerCtx(context.Background(), tracer))
+	require.True(ok)
+	require.Equal(tracer, got)
+}
+
+func TestCallTracer(t *testing.T) {
+	require := require.New(t)
+
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()))
+	require.NoError(err)
+	// PUSH1 0 PUSH1 0 REVERT
+	callee := common.BytesToAddress([]byte{0xbb})
+	statedb.SetCode(callee, []byte{0x60, 0x00, 0x60, 0x00, 0xfd})
+
+	tracer := NewCallTracer(true)
+	// CALL(GAS, 0xbb, 0, 0, 0, 0, 0) STOP
+	code := []byte{0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0xbb, 0x5a, 0xf1, 0x00}
+	_, _, err = runtime.Execute(code, []byte{1, 2}, &runtime.Config{
+		ChainConfig: getChainConfig(0),
+		State:       statedb,
+		EVMConfig: vm.Config{
+			Debug:  true,
+			Tracer: tracer,
+		},
+	})
+	require.NoError(err)
+
+	trace := tracer.Result()
+	require.NotNil(trace.Call)
+	require.Equal(CallTypeCall, trace.Call.Type)
+	require.Equal([]byte{1, 2}, []byte(trace.Call.Input))
+	require.Empty(trace.Call.Error)
+	require.True(trace.Call.GasUsed > 0)
+	require.Len(trace.Call.Calls, 1)
+	inner := trace.Call.Calls[0]
+	require.Equal(vm.CALL.String(), inner.Type)
+	require.Equal(ioAddress(callee), inner.To)
+	require.Equal(errExecutionReverted, inner.Error)
+	require.True(inner.GasUsed > 0 && inner.GasUsed <= inner.Gas)
+	require.Len(trace.StructLogs, 12)
+}
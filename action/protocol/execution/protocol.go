@@ -16,6 +16,7 @@ import (
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/pkg/log"
 )
@@ -101,6 +102,23 @@ func (p *Protocol) Validate(_ context.Context, act action.Action) error {
 	return nil
 }
 
+// ValidateWithState validates that an action encoded in RLP is an execution if its recipient has code in the states it
+// runs on, and a transfer otherwise, so that a transaction signed for a contract cannot be resubmitted as a transfer
+// skipping the code, or the other way around
+func (p *Protocol) ValidateWithState(_ context.Context, selp action.SealedEnvelope, sm protocol.StateManager) error {
+	return action.VerifyRLPRecipient(selp, func(encodedAddr string) (bool, error) {
+		addr, err := address.FromString(encodedAddr)
+		if err != nil {
+			return false, err
+		}
+		account, err := accountutil.LoadAccount(sm, hash.BytesToHash160(addr.Bytes()))
+		if err != nil {
+			return false, err
+		}
+		return account.IsContract(), nil
+	})
+}
+
 // ReadState read the state on blockchain via protocol
 func (p *Protocol) ReadState(context.Context, protocol.StateManager, []byte, ...[]byte) ([]byte, error) {
 	return nil, protocol.ErrUnimplemented
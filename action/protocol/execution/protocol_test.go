@@ -42,7 +42,9 @@ import (
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/state"
 	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
 	"github.com/iotexproject/iotex-core/testutil"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
@@ -87,6 +89,7 @@ type ExecutionConfig struct {
 	RawReturnValue          string            `json:"rawReturnValue"`
 	RawExpectedGasConsumed  uint              `json:"rawExpectedGasConsumed"`
 	ExpectedStatus          uint64            `json:"expectedStatus"`
+	ExpectedRevertMsg       string            `json:"expectedRevertMsg"`
 	ExpectedBalances        []ExpectedBalance `json:"expectedBalances"`
 	ExpectedLogs            []Log             `json:"expectedLogs"`
 }
@@ -394,6 +397,7 @@ func (sct *SmartContractTest) run(r *require.Assertions) {
 				r.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
 			}
 		}
+		r.Equal(exec.ExpectedRevertMsg, receipt.ExecutionRevertMsg)
 		if exec.ExpectedGasConsumed() != 0 {
 			r.Equal(exec.ExpectedGasConsumed(), receipt.GasConsumed, i)
 		}
@@ -754,6 +758,10 @@ func TestProtocol_Handle(t *testing.T) {
 	t.Run("infiniteloop-bering", func(t *testing.T) {
 		NewSmartContractTest(t, "testdata/infiniteloop-bering.json")
 	})
+	// revert-reason
+	t.Run("revert-reason", func(t *testing.T) {
+		NewSmartContractTest(t, "testdata/revert-reason.json")
+	})
 
 }
 
@@ -798,6 +806,61 @@ func TestProtocol_Validate(t *testing.T) {
 	require.Equal(action.ErrGasPrice, errors.Cause(err))
 }
 
+func TestProtocol_ValidateWithState(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm := mock_chainmanager.NewMockStateManager(ctrl)
+	cb := db.NewCachedBatch()
+	sm.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160, s interface{}) error {
+			val, err := cb.Get("state", addrHash[:])
+			if err != nil {
+				return state.ErrStateNotExist
+			}
+			return state.Deserialize(s, val)
+		}).AnyTimes()
+	codeHash := hash.Hash256b([]byte("contract code"))
+	contract := identityset.Address(30)
+	ss, err := state.Serialize(&state.Account{Balance: big.NewInt(0), CodeHash: codeHash[:]})
+	require.NoError(err)
+	cb.Put("state", contract.Bytes(), ss, "failed to put state")
+
+	p := NewProtocol(func(uint64) (hash.Hash256, error) {
+		return hash.ZeroHash256, nil
+	})
+	for _, test := range []struct {
+		recipient string
+		execution bool
+		valid     bool
+	}{
+		{contract.String(), true, true},
+		{contract.String(), false, false},
+		{identityset.Address(29).String(), false, true},
+		{identityset.Address(29).String(), true, false},
+	} {
+		bd := (&action.EnvelopeBuilder{}).SetNonce(1).SetGasLimit(100000).SetGasPrice(big.NewInt(10)).SetChainID(1)
+		if test.execution {
+			exec, err := action.NewExecution(test.recipient, 1, big.NewInt(10), 100000, big.NewInt(10), []byte{1})
+			require.NoError(err)
+			bd.SetAction(exec)
+		} else {
+			tsf, err := action.NewTransfer(1, big.NewInt(10), test.recipient, []byte{1}, 100000, big.NewInt(10))
+			require.NoError(err)
+			bd.SetAction(tsf)
+		}
+		selp, err := action.SignRLP(bd.Build(), identityset.PrivateKey(27))
+		require.NoError(err)
+		err = p.ValidateWithState(context.Background(), selp, sm)
+		if test.valid {
+			require.NoError(err)
+		} else {
+			require.Equal(action.ErrRLPRecipient, errors.Cause(err))
+		}
+	}
+}
+
 func TestMaxTime(t *testing.T) {
 	t.Run("max-time", func(t *testing.T) {
 		NewSmartContractTest(t, "testdata/maxtime.json")
@@ -10,26 +10,68 @@ import (
 	"context"
 	"sync"
 
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/config"
 )
 
 type (
 	// Nonce defines a function to return the nonce of a given address
 	Nonce func(string) (uint64, error)
+	// MultisigPolicy defines a function to return the multisig policy of a given address, which is nil if the address
+	// is controlled by its own key
+	MultisigPolicy func(string) (*action.MultisigPolicy, error)
+	// IsContract defines a function to return whether a given address is a contract
+	IsContract func(string) (bool, error)
 	// GenericValidator is the validator for generic action verification
 	GenericValidator struct {
-		mu    sync.RWMutex
-		nonce Nonce
+		mu             sync.RWMutex
+		nonce          Nonce
+		multisigPolicy MultisigPolicy
+		isContract     IsContract
+		chainID        uint32
 	}
+	// GenericValidatorOption sets GenericValidator construction parameter
+	GenericValidatorOption func(*GenericValidator)
 )
 
+// WithMultisigPolicy sets the function to look up the multisig policies, without which every action is verified
+// against the key of its sender
+func WithMultisigPolicy(multisigPolicy MultisigPolicy) GenericValidatorOption {
+	return func(v *GenericValidator) {
+		v.multisigPolicy = multisigPolicy
+	}
+}
+
+// WithChainID sets the ID of the chain, which an action encoded in Ethereum RLP must be signed for. Without it, every
+// action encoded in RLP is rejected.
+func WithChainID(chainID uint32) GenericValidatorOption {
+	return func(v *GenericValidator) {
+		v.chainID = chainID
+	}
+}
+
+// WithRecipientCheck sets the function to tell whether the recipient of an action encoded in Ethereum RLP is a contract,
+// which the action must be an execution of, and the other recipients a transfer to. Without it, the type of the action
+// is only checked against the states the action runs on.
+func WithRecipientCheck(isContract IsContract) GenericValidatorOption {
+	return func(v *GenericValidator) {
+		v.isContract = isContract
+	}
+}
+
 // NewGenericValidator constructs a new genericValidator
-func NewGenericValidator(nonce Nonce) *GenericValidator {
-	return &GenericValidator{
+func NewGenericValidator(nonce Nonce, opts ...GenericValidatorOption) *GenericValidator {
+	v := &GenericValidator{
 		nonce: nonce,
 	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
 }
 
 // Validate validates a generic action
@@ -40,10 +82,48 @@ func (v *GenericValidator) Validate(ctx context.Context, act action.SealedEnvelo
 	if intrinsicGas > act.GasLimit() || err != nil {
 		return errors.Wrap(action.ErrInsufficientBalanceForGas, "insufficient gas")
 	}
-	// Verify action using action sender's public key
+	// Reject sponsored action before it is activated
+	if act.IsSponsored() {
+		if blkCtx, ok := GetBlockCtx(ctx); ok {
+			bcCtx := MustGetBlockchainCtx(ctx)
+			hu := config.NewHeightUpgrade(&bcCtx.Genesis)
+			if hu.IsPre(config.Greenland, blkCtx.BlockHeight) {
+				return errors.Wrap(action.ErrActPool, "sponsored action is not activated yet")
+			}
+		}
+	}
+	// Reject action encoded in RLP before it is activated, or which is signed for another chain
+	if act.Encoding() == iotextypes.Encoding_ETHEREUM_RLP {
+		if blkCtx, ok := GetBlockCtx(ctx); ok {
+			bcCtx := MustGetBlockchainCtx(ctx)
+			hu := config.NewHeightUpgrade(&bcCtx.Genesis)
+			if hu.IsPre(config.Hawaii, blkCtx.BlockHeight) {
+				return errors.Wrap(action.ErrActPool, "action encoded in RLP is not activated yet")
+			}
+		}
+		if act.ChainID() != v.chainID {
+			return errors.Wrapf(action.ErrAction, "action is signed for chain %d instead of %d", act.ChainID(), v.chainID)
+		}
+		if v.isContract != nil {
+			if err := action.VerifyRLPRecipient(act, v.isContract); err != nil {
+				return err
+			}
+		}
+	}
+	// Verify action using action sender's public key, or the keys cosigning for it, and the key of its sponsor
 	if err := action.Verify(act); err != nil {
 		return errors.Wrap(err, "failed to verify action signature")
 	}
+	// Reject action not satisfying the multisig policy of its sender or its sponsor, except for the system actions the
+	// producer of the block signs with its own key
+	if !IsProducerSystemAction(ctx, act.Action()) {
+		if err := v.verifyMultisig(actionCtx.Caller.String(), act); err != nil {
+			return err
+		}
+		if err := v.verifySponsorMultisig(act); err != nil {
+			return err
+		}
+	}
 	// Reject action if nonce is too low
 	confirmedNonce, err := v.nonce(actionCtx.Caller.String())
 	if err != nil {
@@ -56,3 +136,44 @@ func (v *GenericValidator) Validate(ctx context.Context, act action.SealedEnvelo
 	}
 	return nil
 }
+
+func (v *GenericValidator) verifyMultisig(caller string, act action.SealedEnvelope) error {
+	policy, err := v.policy(caller)
+	if err != nil {
+		return err
+	}
+	if err := action.VerifyPolicy(act, policy); err != nil {
+		return errors.Wrapf(err, "failed to verify action against the multisig policy of account %s", caller)
+	}
+	return nil
+}
+
+func (v *GenericValidator) verifySponsorMultisig(act action.SealedEnvelope) error {
+	if !act.IsSponsored() {
+		return nil
+	}
+	sponsor, err := address.FromBytes(act.SponsorPubkey().Hash())
+	if err != nil {
+		return errors.Wrap(err, "invalid public key of sponsor")
+	}
+	policy, err := v.policy(sponsor.String())
+	if err != nil {
+		return err
+	}
+	if err := action.VerifySponsorPolicy(act, policy); err != nil {
+		return errors.Wrapf(err, "failed to verify sponsor against the multisig policy of account %s", sponsor.String())
+	}
+	return nil
+}
+
+// policy returns the multisig policy of the account, which is nil if no multisig policy is looked up
+func (v *GenericValidator) policy(addr string) (*action.MultisigPolicy, error) {
+	if v.multisigPolicy == nil {
+		return nil, nil
+	}
+	policy, err := v.multisigPolicy(addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get multisig policy of account %s", addr)
+	}
+	return policy, nil
+}
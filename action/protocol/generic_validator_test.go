@@ -17,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotexproject/go-pkgs/crypto"
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/config"
@@ -128,3 +129,242 @@ func TestActionProto(t *testing.T) {
 		require.True(strings.Contains(err.Error(), "nonce is too low"))
 	}
 }
+
+func TestGenericValidator_Multisig(t *testing.T) {
+	require := require.New(t)
+	account := identityset.PrivateKey(27)
+	sk1, sk2 := identityset.PrivateKey(28), identityset.PrivateKey(29)
+	policy := &action.MultisigPolicy{
+		Keys: []action.WeightedKey{
+			{PubKey: sk1.PublicKey(), Weight: 1},
+			{PubKey: sk2.PublicKey(), Weight: 1},
+		},
+		Threshold: 2,
+	}
+	nonce := func(string) (uint64, error) { return 0, nil }
+	valid := NewGenericValidator(nonce, WithMultisigPolicy(func(addr string) (*action.MultisigPolicy, error) {
+		if addr == identityset.Address(27).String() {
+			return policy, nil
+		}
+		return nil, nil
+	}))
+
+	tsf, err := action.NewTransfer(1, big.NewInt(10), identityset.Address(30).String(), nil, uint64(100000),
+		big.NewInt(10))
+	require.NoError(err)
+	elp := (&action.EnvelopeBuilder{}).SetNonce(1).
+		SetGasLimit(uint64(100000)).
+		SetGasPrice(big.NewInt(10)).
+		SetAction(tsf).Build()
+	cosig1, err := action.Cosign(elp, account.PublicKey(), nil, sk1)
+	require.NoError(err)
+	cosig2, err := action.Cosign(elp, account.PublicKey(), nil, sk2)
+	require.NoError(err)
+	ctx := WithActionCtx(context.Background(), ActionCtx{Caller: identityset.Address(27)})
+
+	// the cosignatures reaching the threshold are accepted
+	selp := action.AssembleMultisigEnvelope(elp, account.PublicKey(), []action.Cosignature{cosig1, cosig2})
+	require.NoError(valid.Validate(ctx, selp))
+	require.NoError(NewGenericValidator(nonce).Validate(WithActionCtx(context.Background(),
+		ActionCtx{Caller: identityset.Address(28)}), mustSign(t, elp, sk1)))
+	// the cosignatures below the threshold are rejected
+	selp = action.AssembleMultisigEnvelope(elp, account.PublicKey(), []action.Cosignature{cosig1})
+	require.Equal(action.ErrMultisigThreshold, errors.Cause(valid.Validate(ctx, selp)))
+	// the own key of a multisig account can't sign alone
+	require.Equal(action.ErrAction, errors.Cause(valid.Validate(ctx, mustSign(t, elp, account))))
+	// except for the system actions of the block producer
+	gb := action.GrantRewardBuilder{}
+	grant := gb.SetRewardType(action.BlockReward).Build()
+	grantElp := (&action.EnvelopeBuilder{}).SetGasLimit(grant.GasLimit()).SetAction(&grant).Build()
+	grantSelp := mustSign(t, grantElp, account)
+	require.NoError(valid.Validate(WithBlockCtx(ctx, BlockCtx{Producer: identityset.Address(27)}), grantSelp))
+	// which a multisig account not producing the block can't sign alone
+	require.Equal(action.ErrAction, errors.Cause(valid.Validate(ctx, grantSelp)))
+	require.Equal(action.ErrAction, errors.Cause(valid.Validate(
+		WithBlockCtx(ctx, BlockCtx{Producer: identityset.Address(28)}), grantSelp)))
+	// an account without multisig policy can't send a multisig action
+	ctx = WithActionCtx(context.Background(), ActionCtx{Caller: identityset.Address(28)})
+	selp = action.AssembleMultisigEnvelope(elp, sk1.PublicKey(), []action.Cosignature{cosig1, cosig2})
+	require.Equal(action.ErrAction, errors.Cause(valid.Validate(ctx, selp)))
+	require.Equal(action.ErrAction, errors.Cause(NewGenericValidator(nonce).Validate(ctx, selp)))
+}
+
+func TestGenericValidator_Sponsored(t *testing.T) {
+	require := require.New(t)
+	valid := NewGenericValidator(func(string) (uint64, error) { return 0, nil })
+	g := config.Default.Genesis
+
+	tsf, err := action.NewTransfer(1, big.NewInt(10), identityset.Address(30).String(), nil, uint64(100000),
+		big.NewInt(10))
+	require.NoError(err)
+	elp := (&action.EnvelopeBuilder{}).SetNonce(1).
+		SetGasLimit(uint64(100000)).
+		SetGasPrice(big.NewInt(10)).
+		SetAction(tsf).Build()
+	selp, err := action.Sponsor(mustSignSponsored(t, elp, identityset.PrivateKey(27), identityset.Address(28)),
+		identityset.PrivateKey(28))
+	require.NoError(err)
+	ctx := WithActionCtx(context.Background(), ActionCtx{Caller: identityset.Address(27)})
+	ctx = WithBlockchainCtx(ctx, BlockchainCtx{Genesis: g})
+	require.NoError(valid.Validate(WithBlockCtx(ctx, BlockCtx{BlockHeight: g.GreenlandBlockHeight}), selp))
+	// sponsored action is rejected before the Greenland height
+	require.Equal(action.ErrActPool, errors.Cause(valid.Validate(
+		WithBlockCtx(ctx, BlockCtx{BlockHeight: g.GreenlandBlockHeight - 1}),
+		selp,
+	)))
+	// the signature of the sponsor is bound to the sender
+	other, err := action.Sponsor(mustSignSponsored(t, elp, identityset.PrivateKey(29), identityset.Address(28)),
+		identityset.PrivateKey(28))
+	require.NoError(err)
+	pb := other.Proto()
+	pb.SenderPubKey = selp.SrcPubkey().Bytes()
+	pb.Signature = selp.Signature()
+	forged := action.SealedEnvelope{}
+	require.NoError(forged.LoadProto(pb))
+	require.True(forged.IsSponsored())
+	require.Equal(action.ErrAction, errors.Cause(valid.Validate(ctx, forged)))
+	// and the signature of the sender is bound to the sponsor, which can't be stripped off
+	pb = selp.Proto()
+	pb.SponsorPubKey, pb.SponsorSignature = nil, nil
+	stripped := action.SealedEnvelope{}
+	require.NoError(stripped.LoadProto(pb))
+	require.False(stripped.IsSponsored())
+	require.Equal(action.ErrAction, errors.Cause(valid.Validate(ctx, stripped)))
+
+	// a single key can't sponsor on behalf of a multisig account
+	sk1, sk2 := identityset.PrivateKey(31), identityset.PrivateKey(32)
+	valid = NewGenericValidator(
+		func(string) (uint64, error) { return 0, nil },
+		WithMultisigPolicy(func(addr string) (*action.MultisigPolicy, error) {
+			if addr == identityset.Address(28).String() {
+				return &action.MultisigPolicy{
+					Keys: []action.WeightedKey{
+						{PubKey: sk1.PublicKey(), Weight: 1},
+						{PubKey: sk2.PublicKey(), Weight: 1},
+					},
+					Threshold: 2,
+				}, nil
+			}
+			return nil, nil
+		}),
+	)
+	require.Equal(action.ErrAction, errors.Cause(valid.Validate(ctx, selp)))
+	signed := mustSignSponsored(t, elp, identityset.PrivateKey(27), identityset.Address(28))
+	cosig1, err := action.CosignSponsor(signed, identityset.PrivateKey(28).PublicKey(), sk1)
+	require.NoError(err)
+	cosig2, err := action.CosignSponsor(signed, identityset.PrivateKey(28).PublicKey(), sk2)
+	require.NoError(err)
+	cosponsored, err := action.AssembleMultisigSponsor(signed, identityset.PrivateKey(28).PublicKey(),
+		[]action.Cosignature{cosig1})
+	require.NoError(err)
+	require.Equal(action.ErrMultisigThreshold, errors.Cause(valid.Validate(ctx, cosponsored)))
+	cosponsored, err = action.AssembleMultisigSponsor(signed, identityset.PrivateKey(28).PublicKey(),
+		[]action.Cosignature{cosig1, cosig2})
+	require.NoError(err)
+	require.NoError(valid.Validate(ctx, cosponsored))
+}
+
+func TestGenericValidator_ChainID(t *testing.T) {
+	require := require.New(t)
+	nonce := func(string) (uint64, error) { return 0, nil }
+	valid := NewGenericValidator(nonce, WithChainID(1))
+	g := config.Default.Genesis
+	ctx := WithActionCtx(context.Background(), ActionCtx{Caller: identityset.Address(27)})
+	ctx = WithBlockchainCtx(ctx, BlockchainCtx{Genesis: g})
+
+	tsf, err := action.NewTransfer(1, big.NewInt(10), identityset.Address(30).String(), nil, uint64(100000),
+		big.NewInt(10))
+	require.NoError(err)
+	for _, test := range []struct {
+		chainID uint32
+		valid   bool
+	}{
+		{1, true},
+		{0, false},
+		{2, false},
+	} {
+		elp := (&action.EnvelopeBuilder{}).SetNonce(1).
+			SetGasLimit(uint64(100000)).
+			SetGasPrice(big.NewInt(10)).
+			SetChainID(test.chainID).
+			SetAction(tsf).Build()
+		selp, err := action.SignRLP(elp, identityset.PrivateKey(27))
+		require.NoError(err)
+		if test.valid {
+			require.NoError(valid.Validate(ctx, selp))
+			require.NoError(valid.Validate(WithBlockCtx(ctx, BlockCtx{BlockHeight: g.HawaiiBlockHeight}), selp))
+			// action encoded in RLP is rejected before the Hawaii height
+			require.Equal(action.ErrActPool, errors.Cause(valid.Validate(
+				WithBlockCtx(ctx, BlockCtx{BlockHeight: g.HawaiiBlockHeight - 1}),
+				selp,
+			)))
+		} else {
+			require.Equal(action.ErrAction, errors.Cause(valid.Validate(ctx, selp)))
+		}
+		// the validator not knowing its chain rejects any chain
+		require.Equal(action.ErrAction, errors.Cause(NewGenericValidator(nonce).Validate(ctx, selp)))
+	}
+}
+
+func TestGenericValidator_Recipient(t *testing.T) {
+	require := require.New(t)
+	nonce := func(string) (uint64, error) { return 0, nil }
+	contract := identityset.Address(30).String()
+	valid := NewGenericValidator(nonce, WithChainID(1), WithRecipientCheck(func(addr string) (bool, error) {
+		return addr == contract, nil
+	}))
+	ctx := WithActionCtx(context.Background(), ActionCtx{Caller: identityset.Address(27)})
+	ctx = WithBlockchainCtx(ctx, BlockchainCtx{Genesis: config.Default.Genesis})
+
+	for _, test := range []struct {
+		recipient string
+		execution bool
+		valid     bool
+	}{
+		{contract, true, true},
+		{contract, false, false},
+		{identityset.Address(29).String(), false, true},
+		{identityset.Address(29).String(), true, false},
+	} {
+		bd := (&action.EnvelopeBuilder{}).SetNonce(1).
+			SetGasLimit(uint64(100000)).
+			SetGasPrice(big.NewInt(10)).
+			SetChainID(1)
+		if test.execution {
+			exec, err := action.NewExecution(test.recipient, 1, big.NewInt(10), uint64(100000), big.NewInt(10), []byte{1})
+			require.NoError(err)
+			bd.SetAction(exec)
+		} else {
+			tsf, err := action.NewTransfer(1, big.NewInt(10), test.recipient, []byte{1}, uint64(100000), big.NewInt(10))
+			require.NoError(err)
+			bd.SetAction(tsf)
+		}
+		elp := bd.Build()
+		selp, err := action.SignRLP(elp, identityset.PrivateKey(27))
+		require.NoError(err)
+		if test.valid {
+			require.NoError(valid.Validate(ctx, selp))
+		} else {
+			require.Equal(action.ErrRLPRecipient, errors.Cause(valid.Validate(ctx, selp)))
+		}
+		// the action not encoded in RLP is not checked
+		require.NoError(valid.Validate(ctx, mustSign(t, elp, identityset.PrivateKey(27))))
+	}
+}
+
+func mustSign(t *testing.T, elp action.Envelope, sk crypto.PrivateKey) action.SealedEnvelope {
+	selp, err := action.Sign(elp, sk)
+	require.NoError(t, err)
+	return selp
+}
+
+func mustSignSponsored(
+	t *testing.T,
+	elp action.Envelope,
+	sk crypto.PrivateKey,
+	sponsor address.Address,
+) action.SealedEnvelope {
+	selp, err := action.SignSponsored(elp, sk, sponsor)
+	require.NoError(t, err)
+	return selp
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package multisig
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+)
+
+const (
+	// TODO: it works only for one instance per protocol definition now
+	protocolID = "multisig"
+)
+
+const policyKeyPrefix = "pol"
+
+// DepositGas deposits gas to some pool
+type DepositGas func(ctx context.Context, sm protocol.StateManager, amount *big.Int) error
+
+// StateReader defines the interface to read the states, which is satisfied by both the state factory and the state
+// managers
+type StateReader interface {
+	State(hash.Hash160, interface{}) error
+}
+
+// Protocol defines the protocol of multisig accounts. It allows an account to put itself under the control of a set of
+// weighted keys, after which its actions must be cosigned by the keys whose total weight reaches the threshold.
+type Protocol struct {
+	keyPrefix  []byte
+	addr       address.Address
+	depositGas DepositGas
+}
+
+// policy is the multisig policy stored in the states
+type policy struct {
+	action.MultisigPolicy
+}
+
+// Serialize serializes the policy into bytes
+func (p policy) Serialize() ([]byte, error) {
+	return proto.Marshal(p.Proto())
+}
+
+// Deserialize deserializes bytes into the policy
+func (p *policy) Deserialize(data []byte) error {
+	pb := iotextypes.MultisigPolicy{}
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	return p.LoadProto(&pb)
+}
+
+// NewProtocol instantiates the protocol of multisig accounts
+func NewProtocol(depositGas DepositGas) *Protocol {
+	h := hash.Hash160b([]byte(protocolID))
+	addr, err := address.FromBytes(h[:])
+	if err != nil {
+		log.L().Panic("Error when constructing the address of multisig protocol", zap.Error(err))
+	}
+	return &Protocol{
+		keyPrefix:  h[:],
+		addr:       addr,
+		depositGas: depositGas,
+	}
+}
+
+// FindProtocol finds the registered protocol from registry
+func FindProtocol(registry *protocol.Registry) *Protocol {
+	if registry == nil {
+		return nil
+	}
+	p, ok := registry.Find(protocolID)
+	if !ok {
+		return nil
+	}
+	mp, ok := p.(*Protocol)
+	if !ok {
+		log.S().Panic("fail to cast multisig protocol")
+	}
+	return mp
+}
+
+// Handle handles the actions on the multisig protocol
+func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+	smp, ok := act.(*action.SetMultisigPolicy)
+	if !ok {
+		return nil, nil
+	}
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	if blkCtx.GasLimit < actionCtx.IntrinsicGas {
+		return nil, action.ErrHitGasLimit
+	}
+	caller, err := accountutil.LoadOrCreateAccount(sm, actionCtx.Caller.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of caller %s", actionCtx.Caller.String())
+	}
+	gasFee := big.NewInt(0).Mul(actionCtx.GasPrice, big.NewInt(0).SetUint64(actionCtx.IntrinsicGas))
+	callerGasFee, err := accountutil.SenderGasFee(ctx, sm, gasFee)
+	if err != nil {
+		return nil, err
+	}
+	if callerGasFee.Cmp(caller.Balance) == 1 {
+		return nil, errors.Wrapf(
+			state.ErrNotEnoughBalance,
+			"caller %s balance %s, required amount %s",
+			actionCtx.Caller.String(),
+			caller.Balance,
+			callerGasFee,
+		)
+	}
+
+	key := policyKey(actionCtx.Caller)
+	if len(smp.Policy().Keys) == 0 {
+		if err := p.deleteState(sm, key); err != nil {
+			return nil, errors.Wrapf(err, "failed to remove the multisig policy of %s", actionCtx.Caller.String())
+		}
+	} else if err := p.putState(sm, key, &policy{smp.Policy()}); err != nil {
+		return nil, errors.Wrapf(err, "failed to put the multisig policy of %s", actionCtx.Caller.String())
+	}
+
+	if p.depositGas != nil {
+		if err := p.depositGas(ctx, sm, gasFee); err != nil {
+			return nil, err
+		}
+	}
+	caller, err = accountutil.LoadOrCreateAccount(sm, actionCtx.Caller.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of caller %s", actionCtx.Caller.String())
+	}
+	accountutil.SetNonce(smp, caller)
+	if err := accountutil.StoreAccount(sm, actionCtx.Caller.String(), caller); err != nil {
+		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	return &action.Receipt{
+		Status:          uint64(iotextypes.ReceiptStatus_Success),
+		BlockHeight:     blkCtx.BlockHeight,
+		ActionHash:      actionCtx.ActionHash,
+		GasConsumed:     actionCtx.IntrinsicGas,
+		ContractAddress: p.addr.String(),
+	}, nil
+}
+
+// Validate validates the actions on the multisig protocol
+func (p *Protocol) Validate(ctx context.Context, act action.Action) error {
+	smp, ok := act.(*action.SetMultisigPolicy)
+	if !ok {
+		return nil
+	}
+	// Reject multisig policy before it is activated
+	if blkCtx, ok := protocol.GetBlockCtx(ctx); ok {
+		bcCtx := protocol.MustGetBlockchainCtx(ctx)
+		hu := config.NewHeightUpgrade(&bcCtx.Genesis)
+		if hu.IsPre(config.Greenland, blkCtx.BlockHeight) {
+			return errors.Wrap(action.ErrActPool, "multisig policy is not activated yet")
+		}
+	}
+	// Reject action of negative gas price
+	if smp.GasPrice().Sign() < 0 {
+		return errors.Wrap(action.ErrGasPrice, "negative value")
+	}
+	policy := smp.Policy()
+	if err := policy.Validate(); err != nil {
+		return errors.Wrap(err, "error when validating set multisig policy action")
+	}
+	return nil
+}
+
+// ValidateWithState validates the action against the multisig policies of its sender and its sponsor in the states
+// it runs on, so that the policy set by a previous action in the same block applies. System actions are signed by the
+// producer of the block with its own key, so they are exempt from the policy of the producer only.
+func (p *Protocol) ValidateWithState(ctx context.Context, selp action.SealedEnvelope, sm protocol.StateManager) error {
+	if protocol.IsProducerSystemAction(ctx, selp.Action()) {
+		return nil
+	}
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	policy, err := p.Policy(sm, actionCtx.Caller.String())
+	if err != nil {
+		return err
+	}
+	if err := action.VerifyPolicy(selp, policy); err != nil {
+		return errors.Wrapf(action.ErrMultisigUnauthorized, "account %s: %v", actionCtx.Caller.String(), err)
+	}
+	if !selp.IsSponsored() {
+		return nil
+	}
+	sponsor, err := address.FromBytes(selp.SponsorPubkey().Hash())
+	if err != nil {
+		return errors.Wrap(err, "invalid public key of sponsor")
+	}
+	if policy, err = p.Policy(sm, sponsor.String()); err != nil {
+		return err
+	}
+	if err := action.VerifySponsorPolicy(selp, policy); err != nil {
+		return errors.Wrapf(action.ErrMultisigUnauthorized, "sponsor %s: %v", sponsor.String(), err)
+	}
+	return nil
+}
+
+// ReadState read the state on blockchain via protocol
+func (p *Protocol) ReadState(
+	ctx context.Context,
+	sm protocol.StateManager,
+	method []byte,
+	args ...[]byte,
+) ([]byte, error) {
+	switch string(method) {
+	case "Policy":
+		if len(args) != 1 {
+			return nil, errors.Errorf("invalid number of arguments %d", len(args))
+		}
+		policy, err := p.Policy(sm, string(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		if policy == nil {
+			// an account without multisig policy has an empty one
+			return proto.Marshal(&iotextypes.MultisigPolicy{})
+		}
+		return proto.Marshal(policy.Proto())
+	default:
+		return nil, errors.New("corresponding method isn't found")
+	}
+}
+
+// Register registers the protocol with a unique ID
+func (p *Protocol) Register(r *protocol.Registry) error {
+	return r.Register(protocolID, p)
+}
+
+// ForceRegister registers the protocol with a unique ID and force replacing the previous protocol if it exists
+func (p *Protocol) ForceRegister(r *protocol.Registry) error {
+	return r.ForceRegister(protocolID, p)
+}
+
+// Policy returns the multisig policy of the account, which is nil if the account is controlled by its own key
+func (p *Protocol) Policy(sr StateReader, encodedAddr string) (*action.MultisigPolicy, error) {
+	addr, err := address.FromString(encodedAddr)
+	if err != nil {
+		return nil, err
+	}
+	policy := policy{}
+	err = p.state(sr, policyKey(addr), &policy)
+	switch errors.Cause(err) {
+	case nil:
+		return &policy.MultisigPolicy, nil
+	case state.ErrStateNotExist:
+		return nil, nil
+	default:
+		return nil, errors.Wrapf(err, "failed to load the multisig policy of %s", encodedAddr)
+	}
+}
+
+func policyKey(addr address.Address) []byte {
+	return append([]byte(policyKeyPrefix), addr.Bytes()...)
+}
+
+func (p *Protocol) state(sr StateReader, key []byte, value interface{}) error {
+	keyHash := hash.Hash160b(append(p.keyPrefix, key...))
+	return sr.State(keyHash, value)
+}
+
+func (p *Protocol) putState(sm protocol.StateManager, key []byte, value interface{}) error {
+	keyHash := hash.Hash160b(append(p.keyPrefix, key...))
+	return sm.PutState(keyHash, value)
+}
+
+func (p *Protocol) deleteState(sm protocol.StateManager, key []byte) error {
+	keyHash := hash.Hash160b(append(p.keyPrefix, key...))
+	return sm.DelState(keyHash)
+}
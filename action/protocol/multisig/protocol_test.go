@@ -0,0 +1,226 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package multisig
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
+	"github.com/iotexproject/iotex-core/testutil"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+)
+
+func TestProtocol_Handle(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm := mock_chainmanager.NewMockStateManager(ctrl)
+	cb := db.NewCachedBatch()
+	sm.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160, s interface{}) error {
+			val, err := cb.Get("state", addrHash[:])
+			if err != nil {
+				return state.ErrStateNotExist
+			}
+			return state.Deserialize(s, val)
+		}).AnyTimes()
+	sm.EXPECT().PutState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160, s interface{}) error {
+			ss, err := state.Serialize(s)
+			if err != nil {
+				return err
+			}
+			cb.Put("state", addrHash[:], ss, "failed to put state")
+			return nil
+		}).AnyTimes()
+	sm.EXPECT().DelState(gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160) error {
+			cb.Delete("state", addrHash[:], "failed to delete state")
+			return nil
+		}).AnyTimes()
+
+	p := NewProtocol(func(ctx context.Context, sm protocol.StateManager, amount *big.Int) error {
+		actionCtx := protocol.MustGetActionCtx(ctx)
+		acc, err := accountutil.LoadAccount(sm, hash.BytesToHash160(actionCtx.Caller.Bytes()))
+		if err != nil {
+			return err
+		}
+		if err := acc.SubBalance(amount); err != nil {
+			return err
+		}
+		return accountutil.StoreAccount(sm, actionCtx.Caller.String(), acc)
+	})
+	caller := identityset.Address(27)
+	require.NoError(accountutil.StoreAccount(sm, caller.String(), &state.Account{
+		Balance:      big.NewInt(1000000),
+		VotingWeight: big.NewInt(0),
+	}))
+	policy, err := p.Policy(sm, caller.String())
+	require.NoError(err)
+	require.Nil(policy)
+
+	handle := func(nonce uint64, mp action.MultisigPolicy) {
+		smp, err := action.NewSetMultisigPolicy(nonce, mp, uint64(100000), big.NewInt(1))
+		require.NoError(err)
+		gas, err := smp.IntrinsicGas()
+		require.NoError(err)
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       caller,
+			GasPrice:     big.NewInt(1),
+			IntrinsicGas: gas,
+			Nonce:        nonce,
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight: 1,
+			GasLimit:    testutil.TestGasLimit,
+		})
+		receipt, err := p.Handle(ctx, smp, sm)
+		require.NoError(err)
+		require.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+		require.Equal(gas, receipt.GasConsumed)
+	}
+
+	// the actions of the caller are validated against the policy in the states they run on
+	tsf, err := action.NewTransfer(3, big.NewInt(1), identityset.Address(30).String(), nil, uint64(100000), big.NewInt(1))
+	require.NoError(err)
+	elp := (&action.EnvelopeBuilder{}).SetNonce(3).
+		SetGasLimit(uint64(100000)).
+		SetGasPrice(big.NewInt(1)).
+		SetAction(tsf).Build()
+	signed, err := action.Sign(elp, identityset.PrivateKey(27))
+	require.NoError(err)
+	cosig1, err := action.Cosign(elp, identityset.PrivateKey(27).PublicKey(), nil, identityset.PrivateKey(28))
+	require.NoError(err)
+	cosig2, err := action.Cosign(elp, identityset.PrivateKey(27).PublicKey(), nil, identityset.PrivateKey(29))
+	require.NoError(err)
+	cosigned := action.AssembleMultisigEnvelope(elp, identityset.PrivateKey(27).PublicKey(),
+		[]action.Cosignature{cosig2, cosig1})
+	validate := func(selp action.SealedEnvelope) error {
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{Caller: caller})
+		return p.ValidateWithState(ctx, selp, sm)
+	}
+	require.NoError(validate(signed))
+	require.Equal(action.ErrMultisigUnauthorized, errors.Cause(validate(cosigned)))
+	// and so are the actions it sponsors
+	signedForCaller, err := action.SignSponsored(elp, identityset.PrivateKey(30), caller)
+	require.NoError(err)
+	sponsored, err := action.Sponsor(signedForCaller, identityset.PrivateKey(27))
+	require.NoError(err)
+	sponsorCosig1, err := action.CosignSponsor(signedForCaller, identityset.PrivateKey(27).PublicKey(),
+		identityset.PrivateKey(28))
+	require.NoError(err)
+	sponsorCosig2, err := action.CosignSponsor(signedForCaller, identityset.PrivateKey(27).PublicKey(),
+		identityset.PrivateKey(29))
+	require.NoError(err)
+	cosponsored, err := action.AssembleMultisigSponsor(signedForCaller, identityset.PrivateKey(27).PublicKey(),
+		[]action.Cosignature{sponsorCosig1, sponsorCosig2})
+	require.NoError(err)
+	validateSponsored := func(selp action.SealedEnvelope) error {
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{Caller: identityset.Address(30)})
+		return p.ValidateWithState(ctx, selp, sm)
+	}
+	require.NoError(validateSponsored(sponsored))
+	require.Equal(action.ErrMultisigUnauthorized, errors.Cause(validateSponsored(cosponsored)))
+
+	mp := action.MultisigPolicy{
+		Keys: []action.WeightedKey{
+			{PubKey: identityset.PrivateKey(28).PublicKey(), Weight: 1},
+			{PubKey: identityset.PrivateKey(29).PublicKey(), Weight: 1},
+		},
+		Threshold: 2,
+	}
+	handle(1, mp)
+	require.Equal(action.ErrMultisigUnauthorized, errors.Cause(validate(signed)))
+	require.NoError(validate(cosigned))
+	// a single key can't sponsor on behalf of the multisig account
+	require.Equal(action.ErrMultisigUnauthorized, errors.Cause(validateSponsored(sponsored)))
+	require.NoError(validateSponsored(cosponsored))
+	// a block producer under a policy still signs the system actions with its own key
+	gb := action.GrantRewardBuilder{}
+	grant := gb.SetRewardType(action.BlockReward).Build()
+	grantElp := (&action.EnvelopeBuilder{}).SetGasLimit(grant.GasLimit()).SetAction(&grant).Build()
+	grantSelp, err := action.Sign(grantElp, identityset.PrivateKey(27))
+	require.NoError(err)
+	grantCtx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{Caller: caller})
+	require.NoError(p.ValidateWithState(protocol.WithBlockCtx(grantCtx, protocol.BlockCtx{Producer: caller}),
+		grantSelp, sm))
+	// but a multisig account not producing the block can't
+	require.Equal(action.ErrMultisigUnauthorized, errors.Cause(validate(grantSelp)))
+	require.Equal(action.ErrMultisigUnauthorized, errors.Cause(p.ValidateWithState(
+		protocol.WithBlockCtx(grantCtx, protocol.BlockCtx{Producer: identityset.Address(30)}), grantSelp, sm)))
+	policy, err = p.Policy(sm, caller.String())
+	require.NoError(err)
+	require.Equal(&mp, policy)
+	acc, err := accountutil.LoadAccount(sm, hash.BytesToHash160(caller.Bytes()))
+	require.NoError(err)
+	require.Equal(uint64(1), acc.Nonce)
+	require.Equal(big.NewInt(1000000-20000), acc.Balance)
+
+	data, err := p.ReadState(context.Background(), sm, []byte("Policy"), []byte(caller.String()))
+	require.NoError(err)
+	pb := &iotextypes.MultisigPolicy{}
+	require.NoError(proto.Unmarshal(data, pb))
+	require.True(proto.Equal(mp.Proto(), pb))
+
+	// an empty policy releases the account
+	handle(2, action.MultisigPolicy{})
+	policy, err = p.Policy(sm, caller.String())
+	require.NoError(err)
+	require.Nil(policy)
+	data, err = p.ReadState(context.Background(), sm, []byte("Policy"), []byte(caller.String()))
+	require.NoError(err)
+	require.NoError(proto.Unmarshal(data, pb))
+	require.Len(pb.Keys, 0)
+	require.NoError(validate(signed))
+	require.Equal(action.ErrMultisigUnauthorized, errors.Cause(validate(cosigned)))
+	require.NoError(validateSponsored(sponsored))
+	require.Equal(action.ErrMultisigUnauthorized, errors.Cause(validateSponsored(cosponsored)))
+}
+
+func TestProtocol_Validate(t *testing.T) {
+	require := require.New(t)
+
+	p := NewProtocol(nil)
+	g := config.Default.Genesis
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Genesis: g})
+	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: g.GreenlandBlockHeight})
+	mp := action.MultisigPolicy{
+		Keys:      []action.WeightedKey{{PubKey: identityset.PrivateKey(28).PublicKey(), Weight: 1}},
+		Threshold: 1,
+	}
+	smp, err := action.NewSetMultisigPolicy(1, mp, uint64(100000), big.NewInt(1))
+	require.NoError(err)
+	require.NoError(p.Validate(ctx, smp))
+
+	// multisig policy is rejected before the Greenland height
+	preCtx := protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: g.GreenlandBlockHeight - 1})
+	require.Equal(action.ErrActPool, errors.Cause(p.Validate(preCtx, smp)))
+
+	mp.Threshold = 2
+	smp, err = action.NewSetMultisigPolicy(1, mp, uint64(100000), big.NewInt(1))
+	require.NoError(err)
+	require.Equal(action.ErrMultisigPolicy, errors.Cause(p.Validate(ctx, smp)))
+	smp, err = action.NewSetMultisigPolicy(1, action.MultisigPolicy{}, uint64(100000), big.NewInt(-1))
+	require.NoError(err)
+	require.Equal(action.ErrGasPrice, errors.Cause(p.Validate(ctx, smp)))
+}
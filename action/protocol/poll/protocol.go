@@ -61,6 +61,10 @@ type GetEpochHeight func(uint64) uint64
 // GetEpochNum defines a function to get epoch number given a block height
 type GetEpochNum func(uint64) uint64
 
+// GetStakingState defines a function to get the states on top of the tip, from which the votes of the native staking
+// protocol are read
+type GetStakingState func() (protocol.StateManager, error)
+
 // Protocol defines the protocol of handling votes
 type Protocol interface {
 	protocol.Protocol
@@ -91,6 +95,28 @@ func MustGetProtocol(registry *protocol.Registry) Protocol {
 	return pp
 }
 
+// GenesisCandidates returns the candidates that the protocol puts into the genesis states, i.e., the candidates of the
+// first epoch, without a state manager
+func GenesisCandidates(p Protocol) (state.CandidateList, error) {
+	switch pp := p.(type) {
+	case *lifeLongDelegatesProtocol:
+		return pp.delegates, nil
+	case *governanceChainCommitteeProtocol:
+		ds, err := pp.delegatesByGravityChainHeight(pp.initGravityChainHeight)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateDelegates(ds); err != nil {
+			return nil, err
+		}
+		return ds, nil
+	case *stakingCommittee:
+		return GenesisCandidates(pp.governanceStaking)
+	default:
+		return nil, errors.Errorf("unsupported poll protocol %T", p)
+	}
+}
+
 type lifeLongDelegatesProtocol struct {
 	delegates state.CandidateList
 	addr      address.Address
@@ -103,6 +129,7 @@ func NewProtocol(
 	candidatesByHeight CandidatesByHeight,
 	electionCommittee committee.Committee,
 	getBlockTimeFunc GetBlockTime,
+	getStakingState GetStakingState,
 	rp *rolldpos.Protocol) (Protocol, error) {
 	genesisConfig := cfg.Genesis
 	if cfg.Consensus.Scheme == config.RollDPoSScheme && genesisConfig.EnableGravityChainVoting {
@@ -136,6 +163,7 @@ func NewProtocol(
 				rp.GetEpochNum,
 				cfg.Genesis.NativeStakingContractAddress,
 				cfg.Genesis.NativeStakingContractCode,
+				getStakingState,
 				rp,
 				scoreThreshold,
 			); err != nil {
@@ -118,6 +118,29 @@ func TestCreateGenesisStates(t *testing.T) {
 	}
 }
 
+func TestGenesisCandidates(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	p, ctx, sm, _, err := initConstruct(ctrl)
+	require.NoError(err)
+	require.NoError(p.CreateGenesisStates(ctx, sm))
+	var sc state.CandidateList
+	require.NoError(sm.State(candidatesutil.ConstructKey(1), &sc))
+	candidates, err := GenesisCandidates(p)
+	require.NoError(err)
+	require.Equal(len(sc), len(candidates))
+	for i, c := range candidates {
+		require.Equal(sc[i].Address, c.Address)
+		require.Equal(sc[i].Votes, c.Votes)
+	}
+
+	delegates := state.CandidateList{{Address: identityset.Address(1).String(), Votes: big.NewInt(1)}}
+	candidates, err = GenesisCandidates(&lifeLongDelegatesProtocol{delegates: delegates})
+	require.NoError(err)
+	require.Equal(delegates, candidates)
+}
+
 func TestCreatePostSystemActions(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
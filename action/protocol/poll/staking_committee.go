@@ -24,6 +24,7 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/state"
@@ -43,6 +44,7 @@ type stakingCommittee struct {
 	electionCommittee    committee.Committee
 	governanceStaking    Protocol
 	nativeStaking        *NativeStaking
+	getStakingState      GetStakingState
 	rp                   *rolldpos.Protocol
 	scoreThreshold       *big.Int
 	currentNativeBuckets []*types.Bucket
@@ -58,6 +60,7 @@ func NewStakingCommittee(
 	getEpochNum GetEpochNum,
 	nativeStakingContractAddress string,
 	nativeStakingContractCode string,
+	getStakingState GetStakingState,
 	rp *rolldpos.Protocol,
 	scoreThreshold *big.Int,
 ) (Protocol, error) {
@@ -82,6 +85,7 @@ func NewStakingCommittee(
 		electionCommittee:  ec,
 		governanceStaking:  gs,
 		nativeStaking:      ns,
+		getStakingState:    getStakingState,
 		getEpochHeight:     getEpochHeight,
 		getEpochNum:        getEpochNum,
 		rp:                 rp,
@@ -203,15 +207,21 @@ func (sc *stakingCommittee) DelegatesByHeight(ctx context.Context, height uint64
 	bcCtx := protocol.MustGetBlockchainCtx(ctx)
 	hu := config.NewHeightUpgrade(&bcCtx.Genesis)
 	// convert to epoch start height
-	if hu.IsPre(config.Cook, sc.getEpochHeight(sc.getEpochNum(height))) {
+	epochHeight := sc.getEpochHeight(sc.getEpochNum(height))
+	if hu.IsPre(config.Cook, epochHeight) {
 		return sc.filterDelegates(cand), nil
 	}
-	// native staking starts from Cook
-	if sc.nativeStaking == nil {
-		return nil, errors.New("native staking was not set after cook height")
+	var nativeVotes *VoteTally
+	if hu.IsPost(config.Greenland, epochHeight) {
+		// the native staking protocol replaces the staking contract from Greenland
+		nativeVotes, err = sc.stakingProtocolVotes(bcCtx.Registry, bcCtx.Tip.Timestamp)
+	} else {
+		// native staking starts from Cook
+		if sc.nativeStaking == nil {
+			return nil, errors.New("native staking was not set after cook height")
+		}
+		nativeVotes, err = sc.nativeStaking.Votes(bcCtx.Tip.Height, bcCtx.Tip.Timestamp)
 	}
-
-	nativeVotes, err := sc.nativeStaking.Votes(bcCtx.Tip.Height, bcCtx.Tip.Timestamp)
 	if err == ErrNoData {
 		// no native staking data
 		return sc.filterDelegates(cand), nil
@@ -268,23 +278,106 @@ func (sc *stakingCommittee) mergeDelegates(list state.CandidateList, votes *Vote
 	// votes cast to all outside address will not be counted and simply ignored
 	candidates := make(map[string]*state.Candidate)
 	candidateScores := make(map[string]*big.Int)
+	// candidates registered on the native staking protocol are keyed by their operator addresses, since their names
+	// may be taken by the delegates of other operators on Ethereum
+	operated := make(map[string]*state.Candidate)
+	for _, v := range votes.Candidates {
+		if v.Address != "" {
+			operated[v.Address] = v
+		}
+	}
 	for _, cand := range list {
 		clone := cand.Clone()
 		name := to12Bytes(clone.CanName)
-		if v, ok := votes.Candidates[name]; ok {
+		// the votes on the staking contract go to the delegate of the name
+		if v, ok := votes.Candidates[name]; ok && v.Address == "" {
+			clone.Votes.Add(clone.Votes, v.Votes)
+		}
+		// while a candidate registered on the native staking protocol is merged with the delegate of its operator
+		if v, ok := operated[clone.Address]; ok {
 			clone.Votes.Add(clone.Votes, v.Votes)
+			delete(operated, clone.Address)
 		}
 		if clone.Votes.Cmp(sc.scoreThreshold) >= 0 {
 			candidates[hex.EncodeToString(name[:])] = clone
 			candidateScores[hex.EncodeToString(name[:])] = clone.Votes
 		}
 	}
+	// the rest of them don't need to be registered on Ethereum
+	for operator, v := range operated {
+		if v.Votes.Cmp(sc.scoreThreshold) < 0 {
+			continue
+		}
+		candidates[operator] = v.Clone()
+		candidateScores[operator] = candidates[operator].Votes
+	}
 	sorted := util.Sort(candidateScores, uint64(ts.Unix()))
-	var merged state.CandidateList
-	for _, name := range sorted {
-		merged = append(merged, candidates[name])
+	var delegates state.CandidateList
+	for _, key := range sorted {
+		delegates = append(delegates, candidates[key])
+	}
+	return delegates
+}
+
+// stakingProtocolVotes tallies the active buckets of the native staking protocol, and fills the addresses of the
+// candidates registered on it
+func (sc *stakingCommittee) stakingProtocolVotes(registry *protocol.Registry, ts time.Time) (*VoteTally, error) {
+	sp := staking.FindProtocol(registry)
+	if sp == nil || sc.getStakingState == nil {
+		return nil, ErrNoData
+	}
+	sm, err := sc.getStakingState()
+	if err != nil {
+		return nil, err
+	}
+	buckets, err := sp.ActiveBuckets(sm)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := sp.Candidates(sm)
+	if err != nil {
+		return nil, err
+	}
+	converted := make([]*types.Bucket, 0, len(buckets))
+	for _, b := range buckets {
+		owner, err := address.FromString(b.Owner)
+		if err != nil {
+			return nil, err
+		}
+		bucket, err := types.NewBucket(
+			b.StakeStartTime,
+			time.Duration(b.StakedDuration)*24*time.Hour,
+			b.StakedAmount,
+			owner.Bytes(),
+			canName(b.Candidate),
+			!b.AutoStake,
+		)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, bucket)
 	}
-	return merged
+	votes := VoteTally{
+		Candidates: make(map[[12]byte]*state.Candidate),
+		Buckets:    make([]*types.Bucket, 0, len(converted)),
+	}
+	if err := votes.tally(converted, ts); err != nil {
+		return nil, err
+	}
+	for _, c := range candidates {
+		if v, ok := votes.Candidates[to12Bytes(canName(c.Name))]; ok {
+			v.Address = c.Operator
+			v.RewardAddress = c.Reward
+		}
+	}
+	return &votes, nil
+}
+
+// canName converts the name of a native staking candidate into the 12-byte name of a delegate
+func canName(name string) []byte {
+	b := make([]byte, 12)
+	copy(b, name)
+	return b
 }
 
 func (sc *stakingCommittee) persistNativeBuckets(ctx context.Context, receipt *action.Receipt, err error) error {
@@ -0,0 +1,150 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package poll
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
+	"github.com/iotexproject/iotex-core/testutil"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+)
+
+func TestStakingCommittee_StakingProtocolVotes(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm := mock_chainmanager.NewMockStateManager(ctrl)
+	cb := db.NewCachedBatch()
+	sm.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160, s interface{}) error {
+			val, err := cb.Get("state", addrHash[:])
+			if err != nil {
+				return state.ErrStateNotExist
+			}
+			return state.Deserialize(s, val)
+		}).AnyTimes()
+	sm.EXPECT().PutState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160, s interface{}) error {
+			ss, err := state.Serialize(s)
+			if err != nil {
+				return err
+			}
+			cb.Put("state", addrHash[:], ss, "failed to put state")
+			return nil
+		}).AnyTimes()
+	sm.EXPECT().Snapshot().DoAndReturn(cb.Snapshot).AnyTimes()
+
+	sp := staking.NewProtocol(nil)
+	registry := protocol.NewRegistry()
+	require.NoError(sp.Register(registry))
+	sc := &stakingCommittee{
+		getStakingState: func() (protocol.StateManager, error) { return sm, nil },
+		scoreThreshold:  big.NewInt(0),
+	}
+
+	// no data before anyone stakes
+	votes, err := sc.stakingProtocolVotes(registry, time.Now())
+	require.NoError(err)
+	require.Len(votes.Buckets, 0)
+	_, err = sc.stakingProtocolVotes(protocol.NewRegistry(), time.Now())
+	require.Equal(ErrNoData, err)
+
+	cand, voter, operator := identityset.Address(28), identityset.Address(29), identityset.Address(30)
+	for _, addr := range []string{cand.String(), voter.String()} {
+		require.NoError(accountutil.StoreAccount(sm, addr, &state.Account{
+			Balance:      big.NewInt(1000000),
+			VotingWeight: big.NewInt(0),
+		}))
+	}
+	now := time.Unix(1580000000, 0)
+	handle := func(caller *protocol.ActionCtx, act action.Action) {
+		ctx := protocol.WithActionCtx(context.Background(), *caller)
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockTimeStamp: now,
+			GasLimit:       testutil.TestGasLimit,
+		})
+		ctx = protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{Genesis: config.Default.Genesis})
+		receipt, err := sp.Handle(ctx, act, sm)
+		require.NoError(err)
+		require.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+	}
+	register, err := action.NewCandidateRegister(1, "alice", operator.String(), cand.String(), big.NewInt(100), 7,
+		true, nil, 100000, big.NewInt(0))
+	require.NoError(err)
+	handle(&protocol.ActionCtx{Caller: cand, GasPrice: big.NewInt(0), Nonce: 1}, register)
+	create, err := action.NewCreateStake(1, "alice", big.NewInt(50), 0, false, nil, 100000, big.NewInt(0))
+	require.NoError(err)
+	handle(&protocol.ActionCtx{Caller: voter, GasPrice: big.NewInt(0), Nonce: 1}, create)
+
+	votes, err = sc.stakingProtocolVotes(registry, now)
+	require.NoError(err)
+	require.Len(votes.Buckets, 2)
+	require.Len(votes.Candidates, 1)
+	c := votes.Candidates[to12Bytes(canName("alice"))]
+	require.Equal(operator.String(), c.Address)
+	require.Equal(cand.String(), c.RewardAddress)
+	// the self-stake bucket is weighted by its staked duration, while the other one gets no bonus
+	require.Equal(1, c.Votes.Cmp(big.NewInt(150)))
+
+	// the native candidate is merged with the candidates registered on Ethereum
+	list := state.CandidateList{
+		{
+			Address:       identityset.Address(31).String(),
+			Votes:         big.NewInt(10),
+			RewardAddress: identityset.Address(31).String(),
+			CanName:       canName("bob"),
+		},
+	}
+	merged := sc.mergeDelegates(list, votes, now)
+	require.Len(merged, 2)
+	require.Equal(operator.String(), merged[0].Address)
+	require.Equal(identityset.Address(31).String(), merged[1].Address)
+
+	// a delegate of the same name on Ethereum doesn't take over the votes of the native candidate
+	squatter := &state.Candidate{
+		Address:       identityset.Address(32).String(),
+		Votes:         big.NewInt(10),
+		RewardAddress: identityset.Address(32).String(),
+		CanName:       canName("alice"),
+	}
+	merged = sc.mergeDelegates(append(list, squatter), votes, now)
+	require.Len(merged, 3)
+	require.Equal(operator.String(), merged[0].Address)
+	require.Equal(c.Votes, merged[0].Votes)
+	for _, d := range merged[1:] {
+		require.Equal(big.NewInt(10), d.Votes)
+	}
+	// while the delegate of its operator does, whatever its name
+	operated := &state.Candidate{
+		Address:       operator.String(),
+		Votes:         big.NewInt(10),
+		RewardAddress: operator.String(),
+		CanName:       canName("carol"),
+	}
+	merged = sc.mergeDelegates(append(list, operated), votes, now)
+	require.Len(merged, 2)
+	require.Equal(operator.String(), merged[0].Address)
+	require.Equal(new(big.Int).Add(c.Votes, big.NewInt(10)), merged[0].Votes)
+	require.Equal(canName("carol"), merged[0].CanName)
+}
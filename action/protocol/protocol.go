@@ -53,9 +53,40 @@ type ActionEnvelopeValidator interface {
 	Validate(context.Context, action.SealedEnvelope) error
 }
 
+// ActionEnvelopeStateValidator is the interface of validating a SealedEnvelope against the states it is about to run
+// on, which include the changes made by the previous actions in the same block
+type ActionEnvelopeStateValidator interface {
+	ValidateWithState(context.Context, action.SealedEnvelope, StateManager) error
+}
+
 // ActionHandler is the interface for the action handlers. For each incoming action, the assembled actions will be
 // called one by one to process it. ActionHandler implementation is supposed to parse the sub-type of the action to
 // decide if it wants to handle this action or not.
 type ActionHandler interface {
 	Handle(context.Context, action.Action, StateManager) (*action.Receipt, error)
 }
+
+// IsSystemAction returns true if the action is a system action, which the block producer appends to the block and
+// signs with its own key
+func IsSystemAction(act action.Action) bool {
+	switch act.(type) {
+	case *action.GrantReward, *action.PutPollResult, *action.ExecuteScheduledActions:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsProducerSystemAction returns true if the action is a system action signed by the producer of the block containing
+// it, which is exempt from the multisig policy of the producer
+func IsProducerSystemAction(ctx context.Context, act action.Action) bool {
+	if !IsSystemAction(act) {
+		return false
+	}
+	blkCtx, ok := GetBlockCtx(ctx)
+	if !ok || blkCtx.Producer == nil {
+		return false
+	}
+	actionCtx, ok := GetActionCtx(ctx)
+	return ok && actionCtx.Caller != nil && actionCtx.Caller.String() == blkCtx.Producer.String()
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/action/protocol/rewarding/rewardingpb"
@@ -61,19 +62,23 @@ func (p *Protocol) Deposit(
 	amount *big.Int,
 ) error {
 	actionCtx := protocol.MustGetActionCtx(ctx)
+	return p.deposit(sm, actionCtx.Caller, amount)
+}
+
+func (p *Protocol) deposit(sm protocol.StateManager, from address.Address, amount *big.Int) error {
 	if err := p.assertAmount(amount); err != nil {
 		return err
 	}
-	if err := p.assertEnoughBalance(actionCtx, sm, amount); err != nil {
+	if err := p.assertEnoughBalance(from, sm, amount); err != nil {
 		return err
 	}
-	// Subtract balance from caller
-	acc, err := accountutil.LoadAccount(sm, hash.BytesToHash160(actionCtx.Caller.Bytes()))
+	// Subtract balance from the depositor
+	acc, err := accountutil.LoadAccount(sm, hash.BytesToHash160(from.Bytes()))
 	if err != nil {
 		return err
 	}
 	acc.Balance = big.NewInt(0).Sub(acc.Balance, amount)
-	if err := accountutil.StoreAccount(sm, actionCtx.Caller.String(), acc); err != nil {
+	if err := accountutil.StoreAccount(sm, from.String(), acc); err != nil {
 		return err
 	}
 	// Add balance to fund
@@ -111,11 +116,11 @@ func (p *Protocol) AvailableBalance(
 }
 
 func (p *Protocol) assertEnoughBalance(
-	actionCtx protocol.ActionCtx,
+	addr address.Address,
 	sm protocol.StateManager,
 	amount *big.Int,
 ) error {
-	acc, err := accountutil.LoadAccount(sm, hash.BytesToHash160(actionCtx.Caller.Bytes()))
+	acc, err := accountutil.LoadAccount(sm, hash.BytesToHash160(addr.Bytes()))
 	if err != nil {
 		return err
 	}
@@ -125,7 +130,7 @@ func (p *Protocol) assertEnoughBalance(
 	return nil
 }
 
-// DepositGas deposits gas into the rewarding fund
+// DepositGas deposits gas into the rewarding fund, from the sponsor of the action if it is sponsored
 func DepositGas(ctx context.Context, sm protocol.StateManager, amount *big.Int) error {
 	// If the gas fee is 0, return immediately
 	if amount.Cmp(big.NewInt(0)) == 0 {
@@ -145,5 +150,10 @@ func DepositGas(ctx context.Context, sm protocol.StateManager, amount *big.Int)
 	if rp == nil {
 		return nil
 	}
-	return rp.Deposit(ctx, sm, amount)
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	payer := actionCtx.Caller
+	if actionCtx.GasPayer != nil {
+		payer = actionCtx.GasPayer
+	}
+	return rp.deposit(sm, payer, amount)
 }
@@ -0,0 +1,319 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package schedule
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+)
+
+func (p *Protocol) handleSchedule(
+	ctx context.Context,
+	act *action.ScheduleAction,
+	sm protocol.StateManager,
+) (*ScheduledAction, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	if act.TargetHeight() <= blkCtx.BlockHeight {
+		return nil, errors.Wrapf(
+			ErrTargetHeightPassed,
+			"target height %d, current height %d",
+			act.TargetHeight(),
+			blkCtx.BlockHeight,
+		)
+	}
+	var count totalScheduleCount
+	if err := p.state(sm, []byte{totalScheduleCountKey}, &count); err != nil &&
+		errors.Cause(err) != state.ErrStateNotExist {
+		return nil, err
+	}
+	gasPayer := actionCtx.Caller
+	if actionCtx.GasPayer != nil {
+		gasPayer = actionCtx.GasPayer
+	}
+	sa := &ScheduledAction{
+		ID:           uint64(count),
+		Owner:        actionCtx.Caller.String(),
+		TargetHeight: act.TargetHeight(),
+		Recipient:    act.Recipient(),
+		Amount:       act.Amount(),
+		Data:         act.Data(),
+		CallGasLimit: act.CallGasLimit(),
+		GasPrice:     actionCtx.GasPrice,
+		GasPayer:     gasPayer.String(),
+	}
+	if err := p.putState(sm, scheduledActionKey(sa.ID), sa); err != nil {
+		return nil, err
+	}
+	if err := p.putState(sm, []byte{totalScheduleCountKey}, count+1); err != nil {
+		return nil, err
+	}
+	if err := p.updateIndices(sm, ownerIndexKey(actionCtx.Caller.Bytes()), sa.ID, true); err != nil {
+		return nil, err
+	}
+	if err := p.updateIndices(sm, heightIndexKey(sa.TargetHeight), sa.ID, true); err != nil {
+		return nil, err
+	}
+	cursor, err := p.cursor(sm)
+	if err != nil {
+		return nil, err
+	}
+	if cursor == nil {
+		cursor = &scheduleCursor{height: sa.TargetHeight}
+	} else if sa.TargetHeight < cursor.height {
+		cursor.height = sa.TargetHeight
+	}
+	cursor.pending++
+	if err := p.putState(sm, []byte{cursorKey}, cursor); err != nil {
+		return nil, err
+	}
+	if err := accountutil.MoveTokens(sm, actionCtx.Caller, p.addr, sa.Amount); err != nil {
+		return nil, err
+	}
+	// the gas fee of the call is kept until the call is executed or cancelled
+	if err := accountutil.MoveTokens(sm, gasPayer, p.addr, sa.gasFee(sa.CallGasLimit)); err != nil {
+		return nil, err
+	}
+	return sa, nil
+}
+
+func (p *Protocol) handleCancel(ctx context.Context, act *action.CancelScheduledAction, sm protocol.StateManager) error {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	sa, err := p.ScheduledAction(sm, act.ID())
+	if err != nil {
+		return err
+	}
+	if sa.Owner != actionCtx.Caller.String() {
+		return errors.Wrapf(ErrNotScheduledActionOwner, "scheduled action %d is owned by %s", sa.ID, sa.Owner)
+	}
+	if err := p.removeScheduledAction(sm, sa); err != nil {
+		return err
+	}
+	if err := accountutil.MoveTokens(sm, p.addr, actionCtx.Caller, sa.Amount); err != nil {
+		return err
+	}
+	// the call isn't executed, so its gas fee is refunded in full
+	return p.settleGas(ctx, sm, sa, 0)
+}
+
+// handleExecute executes the scheduled actions due at or before the height of the block. An action whose height
+// has been missed by the previous blocks, or which doesn't fit in the gas budget of the previous blocks, is executed
+// by the next block executing the scheduled actions. Like granting the block reward, it charges no gas, but still
+// settles the nonce of the producer.
+func (p *Protocol) handleExecute(
+	ctx context.Context,
+	act *action.ExecuteScheduledActions,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	receipt := &action.Receipt{
+		Status:          uint64(iotextypes.ReceiptStatus_Success),
+		BlockHeight:     blkCtx.BlockHeight,
+		ActionHash:      actionCtx.ActionHash,
+		ContractAddress: p.addr.String(),
+	}
+	if act.Height() != blkCtx.BlockHeight {
+		log.L().Debug(
+			"Scheduled actions are executed at another height.",
+			zap.Uint64("height", act.Height()),
+			zap.Uint64("blockHeight", blkCtx.BlockHeight),
+		)
+		receipt.Status = uint64(iotextypes.ReceiptStatus_Failure)
+	} else {
+		logs, gasConsumed, err := p.executeDue(ctx, sm, blkCtx.BlockHeight)
+		if err != nil {
+			return nil, err
+		}
+		receipt.Logs = logs
+		receipt.GasConsumed = gasConsumed
+	}
+	if err := accountutil.IncreaseNonce(sm, actionCtx.Caller, actionCtx.Nonce); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// executeDue executes the scheduled actions due at or before the height, in the order of their heights and ids, until
+// the next one doesn't fit in the gas budget of a block, and returns the logs and the gas consumed by them. The first
+// action is executed whatever its gas, so that none of them is stuck.
+func (p *Protocol) executeDue(
+	ctx context.Context,
+	sm protocol.StateManager,
+	height uint64,
+) ([]*action.Log, uint64, error) {
+	var (
+		logs        []*action.Log
+		gasConsumed uint64
+		gasBudget   = executeGasLimit(ctx)
+	)
+	cursor, err := p.cursor(sm)
+	if err != nil || cursor == nil {
+		return nil, 0, err
+	}
+	for h := cursor.height; h <= height; h++ {
+		ids, err := p.indices(sm, heightIndexKey(h))
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, id := range ids {
+			sa, err := p.ScheduledAction(sm, id)
+			if err != nil {
+				return nil, 0, err
+			}
+			gasLimit := sa.gasLimit()
+			if gasConsumed > 0 && gasConsumed+gasLimit > gasBudget {
+				// the rest actions are carried over to the next block
+				return logs, gasConsumed, p.moveCursor(sm, h)
+			}
+			if gasLimit < gasBudget-gasConsumed {
+				gasLimit = gasBudget - gasConsumed
+			}
+			if err := p.removeScheduledAction(sm, sa); err != nil {
+				return nil, 0, err
+			}
+			saLogs, saGas, err := p.execute(ctx, sm, sa, gasLimit)
+			if err != nil {
+				return nil, 0, err
+			}
+			logs = append(logs, saLogs...)
+			gasConsumed += saGas
+		}
+	}
+	return logs, gasConsumed, p.moveCursor(sm, height+1)
+}
+
+// execute pays the amount of a scheduled transfer to the recipient, or calls the contract on behalf of the owner with
+// the gas left to the scheduled actions of the block, and returns the gas consumed. A failed call is reverted, leaving
+// the amount to the owner, but consumes its whole gas limit.
+func (p *Protocol) execute(
+	ctx context.Context,
+	sm protocol.StateManager,
+	sa *ScheduledAction,
+	gasLimit uint64,
+) ([]*action.Log, uint64, error) {
+	if !sa.IsCall() {
+		recipient, err := address.FromString(sa.Recipient)
+		if err != nil {
+			return nil, 0, err
+		}
+		return nil, sa.gasLimit(), accountutil.MoveTokens(sm, p.addr, recipient, sa.Amount)
+	}
+	owner, err := address.FromString(sa.Owner)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := accountutil.MoveTokens(sm, p.addr, owner, sa.Amount); err != nil {
+		return nil, 0, err
+	}
+	// the gas of the call is paid out of the gas fee kept when it is scheduled
+	exec, err := action.NewExecution(sa.Recipient, sa.ID, sa.Amount, sa.CallGasLimit, big.NewInt(0), sa.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+	acc, err := accountutil.LoadOrCreateAccount(sm, sa.Owner)
+	if err != nil {
+		return nil, 0, err
+	}
+	nonce := acc.Nonce
+	callCtx := protocol.WithActionCtx(ctx, protocol.ActionCtx{
+		Caller:     owner,
+		ActionHash: exec.Hash(),
+		GasPrice:   big.NewInt(0),
+		Nonce:      sa.ID,
+	})
+	// the call is limited by the gas left to the scheduled actions rather than by the gas left in the block, which is
+	// the same to the producer and the validators
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	blkCtx.GasLimit = gasLimit
+	callCtx = protocol.WithBlockCtx(callCtx, blkCtx)
+
+	si := sm.Snapshot()
+	_, receipt, err := evm.ExecuteContract(callCtx, sm, exec, p.getBlockHash)
+	if err != nil {
+		log.L().Debug("Scheduled call failed.", zap.Uint64("id", sa.ID), zap.Error(err))
+		if err := sm.Revert(si); err != nil {
+			return nil, 0, err
+		}
+		return nil, sa.CallGasLimit, p.settleGas(ctx, sm, sa, sa.CallGasLimit)
+	}
+	// the call doesn't take the nonce of the owner, which is used by the actions sent by the owner
+	if acc, err = accountutil.LoadOrCreateAccount(sm, sa.Owner); err != nil {
+		return nil, 0, err
+	}
+	acc.Nonce = nonce
+	if err := accountutil.StoreAccount(sm, sa.Owner, acc); err != nil {
+		return nil, 0, err
+	}
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	for _, l := range receipt.Logs {
+		l.ActionHash = actionCtx.ActionHash
+	}
+	return receipt.Logs, receipt.GasConsumed, p.settleGas(ctx, sm, sa, receipt.GasConsumed)
+}
+
+// settleGas pays the fee of the gas consumed by the scheduled call out of its prepaid gas fee, and refunds the rest to
+// the gas payer
+func (p *Protocol) settleGas(ctx context.Context, sm protocol.StateManager, sa *ScheduledAction, gasConsumed uint64) error {
+	if !sa.IsCall() {
+		return nil
+	}
+	// like the other actions, the gas is free without a gas pool to deposit it into
+	gasFee := big.NewInt(0)
+	if p.depositGas != nil {
+		gasFee = sa.gasFee(gasConsumed)
+		if err := p.depositGas(protocol.WithActionCtx(ctx, protocol.ActionCtx{Caller: p.addr}), sm, gasFee); err != nil {
+			return err
+		}
+	}
+	gasPayer, err := address.FromString(sa.GasPayer)
+	if err != nil {
+		return err
+	}
+	return accountutil.MoveTokens(sm, p.addr, gasPayer, new(big.Int).Sub(sa.gasFee(sa.CallGasLimit), gasFee))
+}
+
+// removeScheduledAction deletes the scheduled action and its indices
+func (p *Protocol) removeScheduledAction(sm protocol.StateManager, sa *ScheduledAction) error {
+	owner, err := address.FromString(sa.Owner)
+	if err != nil {
+		return err
+	}
+	if err := p.updateIndices(sm, ownerIndexKey(owner.Bytes()), sa.ID, false); err != nil {
+		return err
+	}
+	if err := p.updateIndices(sm, heightIndexKey(sa.TargetHeight), sa.ID, false); err != nil {
+		return err
+	}
+	cursor, err := p.cursor(sm)
+	if err != nil {
+		return err
+	}
+	if cursor == nil || cursor.pending <= 1 {
+		if err := p.deleteState(sm, []byte{cursorKey}); err != nil {
+			return err
+		}
+	} else {
+		cursor.pending--
+		if err := p.putState(sm, []byte{cursorKey}, cursor); err != nil {
+			return err
+		}
+	}
+	return p.deleteState(sm, scheduledActionKey(sa.ID))
+}
@@ -0,0 +1,399 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package schedule
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/action/protocol/schedule/schedulepb"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+const (
+	// TODO: it works only for one instance per protocol definition now
+	protocolID = "schedule"
+)
+
+// key prefixes of the schedule states, which are further prefixed by the protocol key prefix
+const (
+	scheduledActionKeyPrefix byte = iota
+	ownerIndexKeyPrefix
+	heightIndexKeyPrefix
+	cursorKey
+	totalScheduleCountKey
+)
+
+var (
+	// ErrScheduledActionNotExist indicates that the scheduled action doesn't exist, or has been executed or cancelled
+	ErrScheduledActionNotExist = errors.New("scheduled action doesn't exist")
+	// ErrNotScheduledActionOwner indicates that the caller isn't the owner of the scheduled action
+	ErrNotScheduledActionOwner = errors.New("caller isn't the owner of the scheduled action")
+	// ErrTargetHeightPassed indicates that the target height isn't in the future
+	ErrTargetHeightPassed = errors.New("target height has passed")
+	// ErrNotBlockProducer indicates that the scheduled actions are executed by someone other than the block producer
+	ErrNotBlockProducer = errors.New("caller isn't the block producer")
+)
+
+type (
+	// DepositGas deposits gas to some pool
+	DepositGas func(ctx context.Context, sm protocol.StateManager, amount *big.Int) error
+
+	// StateReader defines the interface to read the states, which is satisfied by both the state factory and the
+	// state managers
+	StateReader interface {
+		State(hash.Hash160, interface{}) error
+	}
+
+	// GetTipState returns the states at the tip of the chain, from which the system actions of the next block are
+	// created
+	GetTipState func() (StateReader, error)
+)
+
+// Protocol defines the protocol of scheduled actions. It allows users to schedule transfers and contract calls at a
+// future height, which are executed by a system action of the block at that height unless they are cancelled.
+type Protocol struct {
+	keyPrefix    []byte
+	addr         address.Address
+	depositGas   DepositGas
+	getBlockHash evm.GetBlockHash
+	getTipState  GetTipState
+}
+
+// NewProtocol instantiates the protocol of scheduled actions
+func NewProtocol(depositGas DepositGas, getBlockHash evm.GetBlockHash, getTipState GetTipState) *Protocol {
+	h := hash.Hash160b([]byte(protocolID))
+	addr, err := address.FromBytes(h[:])
+	if err != nil {
+		log.L().Panic("Error when constructing the address of schedule protocol", zap.Error(err))
+	}
+	return &Protocol{
+		keyPrefix:    h[:],
+		addr:         addr,
+		depositGas:   depositGas,
+		getBlockHash: getBlockHash,
+		getTipState:  getTipState,
+	}
+}
+
+// FindProtocol finds the registered protocol from registry
+func FindProtocol(registry *protocol.Registry) *Protocol {
+	if registry == nil {
+		return nil
+	}
+	p, ok := registry.Find(protocolID)
+	if !ok {
+		return nil
+	}
+	sp, ok := p.(*Protocol)
+	if !ok {
+		log.S().Panic("fail to cast schedule protocol")
+	}
+	return sp
+}
+
+// CreatePreStates moves the cursor past the heights up to the block, which have no scheduled action left
+func (p *Protocol) CreatePreStates(ctx context.Context, sm protocol.StateManager) error {
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	cursor, err := p.cursor(sm)
+	if err != nil || cursor == nil {
+		return err
+	}
+	height, err := p.dueHeight(sm, cursor.height, blkCtx.BlockHeight)
+	if err != nil {
+		return err
+	}
+	return p.moveCursor(sm, height)
+}
+
+// CreatePostSystemActions creates the system action executing the scheduled actions, if any of them is due
+func (p *Protocol) CreatePostSystemActions(ctx context.Context) ([]action.Envelope, error) {
+	if p.getTipState == nil {
+		return nil, nil
+	}
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	sr, err := p.getTipState()
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := p.cursor(sr)
+	if err != nil || cursor == nil {
+		return nil, err
+	}
+	height, err := p.dueHeight(sr, cursor.height, blkCtx.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	if height > blkCtx.BlockHeight {
+		return nil, nil
+	}
+	builder := action.EnvelopeBuilder{}
+	return []action.Envelope{
+		builder.SetNonce(0).
+			SetGasPrice(big.NewInt(0)).
+			SetAction(action.NewExecuteScheduledActions(blkCtx.BlockHeight)).
+			Build(),
+	}, nil
+}
+
+// Handle handles the actions on the schedule protocol
+func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+	switch act := act.(type) {
+	case *action.ScheduleAction:
+		return p.settleSchedule(ctx, act, sm)
+	case *action.CancelScheduledAction:
+		return p.settleAction(ctx, sm, nil, func() error { return p.handleCancel(ctx, act, sm) })
+	case *action.ExecuteScheduledActions:
+		return p.handleExecute(ctx, act, sm)
+	}
+	return nil, nil
+}
+
+// Validate validates the actions on the schedule protocol
+func (p *Protocol) Validate(ctx context.Context, act action.Action) error {
+	switch act := act.(type) {
+	case *action.ScheduleAction:
+		if err := p.validateSchedule(ctx, act); err != nil {
+			return errors.Wrap(err, "error when validating schedule action")
+		}
+	case *action.CancelScheduledAction:
+		if _, err := validateCommon(ctx, act.GasPrice()); err != nil {
+			return errors.Wrap(err, "error when validating cancel scheduled action")
+		}
+	case *action.ExecuteScheduledActions:
+		if err := validateExecute(ctx); err != nil {
+			return errors.Wrap(err, "error when validating execute scheduled actions")
+		}
+	}
+	return nil
+}
+
+// ReadState read the state on blockchain via protocol
+func (p *Protocol) ReadState(
+	ctx context.Context,
+	sm protocol.StateManager,
+	method []byte,
+	args ...[]byte,
+) ([]byte, error) {
+	switch string(method) {
+	case "ScheduledActionsByOwner":
+		if len(args) != 1 {
+			return nil, errors.Errorf("invalid number of arguments %d", len(args))
+		}
+		owner, err := address.FromString(string(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		sas, err := p.ScheduledActionsByOwner(sm, owner)
+		if err != nil {
+			return nil, err
+		}
+		pbs := make([]*schedulepb.ScheduledAction, 0, len(sas))
+		for _, sa := range sas {
+			pbs = append(pbs, sa.toProto())
+		}
+		return proto.Marshal(&schedulepb.ScheduledActionList{Actions: pbs})
+	case "ScheduledAction":
+		if len(args) != 1 {
+			return nil, errors.Errorf("invalid number of arguments %d", len(args))
+		}
+		id, err := strconv.ParseUint(string(args[0]), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		sa, err := p.ScheduledAction(sm, id)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(sa.toProto())
+	default:
+		return nil, errors.New("corresponding method isn't found")
+	}
+}
+
+// Register registers the protocol with a unique ID
+func (p *Protocol) Register(r *protocol.Registry) error {
+	return r.Register(protocolID, p)
+}
+
+// ForceRegister registers the protocol with a unique ID and force replacing the previous protocol if it exists
+func (p *Protocol) ForceRegister(r *protocol.Registry) error {
+	return r.ForceRegister(protocolID, p)
+}
+
+// ScheduledAction returns the scheduled action of the given id, which hasn't been executed or cancelled yet
+func (p *Protocol) ScheduledAction(sr StateReader, id uint64) (*ScheduledAction, error) {
+	sa := ScheduledAction{}
+	if err := p.state(sr, scheduledActionKey(id), &sa); err != nil {
+		if errors.Cause(err) == state.ErrStateNotExist {
+			return nil, errors.Wrapf(ErrScheduledActionNotExist, "id %d", id)
+		}
+		return nil, errors.Wrapf(err, "failed to load scheduled action %d", id)
+	}
+	return &sa, nil
+}
+
+// ScheduledActionsByOwner returns the actions scheduled by the owner, which haven't been executed or cancelled yet
+func (p *Protocol) ScheduledActionsByOwner(sr StateReader, owner address.Address) ([]*ScheduledAction, error) {
+	ids, err := p.indices(sr, ownerIndexKey(owner.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	sas := make([]*ScheduledAction, 0, len(ids))
+	for _, id := range ids {
+		sa, err := p.ScheduledAction(sr, id)
+		if err != nil {
+			return nil, err
+		}
+		sas = append(sas, sa)
+	}
+	return sas, nil
+}
+
+// cursor returns the cursor of the scheduled actions, which is nil if none of them is pending
+func (p *Protocol) cursor(sr StateReader) (*scheduleCursor, error) {
+	cursor := scheduleCursor{}
+	switch err := p.state(sr, []byte{cursorKey}, &cursor); errors.Cause(err) {
+	case nil:
+		return &cursor, nil
+	case state.ErrStateNotExist:
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+// moveCursor moves the cursor up to the height, if any scheduled action is pending
+func (p *Protocol) moveCursor(sm protocol.StateManager, height uint64) error {
+	cursor, err := p.cursor(sm)
+	if err != nil || cursor == nil || cursor.height >= height {
+		return err
+	}
+	cursor.height = height
+	return p.putState(sm, []byte{cursorKey}, cursor)
+}
+
+// dueHeight returns the first height from the cursor up to the given one, which has scheduled actions left, or the
+// height next to the given one if there is none
+func (p *Protocol) dueHeight(sr StateReader, cursor uint64, height uint64) (uint64, error) {
+	for ; cursor <= height; cursor++ {
+		ids, err := p.indices(sr, heightIndexKey(cursor))
+		if err != nil {
+			return 0, err
+		}
+		if len(ids) > 0 {
+			return cursor, nil
+		}
+	}
+	return height + 1, nil
+}
+
+func (p *Protocol) indices(sr StateReader, key []byte) (scheduleIndices, error) {
+	indices := scheduleIndices{}
+	if err := p.state(sr, key, &indices); err != nil && errors.Cause(err) != state.ErrStateNotExist {
+		return nil, err
+	}
+	return indices, nil
+}
+
+// updateIndices adds the index to or removes it from the indices stored under the key, which are deleted once empty
+func (p *Protocol) updateIndices(sm protocol.StateManager, key []byte, index uint64, add bool) error {
+	indices, err := p.indices(sm, key)
+	if err != nil {
+		return err
+	}
+	if add {
+		indices = indices.add(index)
+	} else {
+		indices = indices.remove(index)
+	}
+	if len(indices) == 0 {
+		return p.deleteState(sm, key)
+	}
+	return p.putState(sm, key, indices)
+}
+
+func (p *Protocol) state(sr StateReader, key []byte, value interface{}) error {
+	keyHash := hash.Hash160b(append(p.keyPrefix, key...))
+	return sr.State(keyHash, value)
+}
+
+func (p *Protocol) putState(sm protocol.StateManager, key []byte, value interface{}) error {
+	keyHash := hash.Hash160b(append(p.keyPrefix, key...))
+	return sm.PutState(keyHash, value)
+}
+
+func (p *Protocol) deleteState(sm protocol.StateManager, key []byte) error {
+	keyHash := hash.Hash160b(append(p.keyPrefix, key...))
+	return sm.DelState(keyHash)
+}
+
+// settleAction charges the gas of the action and bumps the nonce of the caller. If handling the action violates the
+// schedule rules, the changes made by it are reverted and the receipt fails, but the gas is charged nonetheless.
+func (p *Protocol) settleAction(
+	ctx context.Context,
+	sm protocol.StateManager,
+	amount *big.Int,
+	handle func() error,
+) (*action.Receipt, error) {
+	return accountutil.SettleAction(ctx, sm, p.addr, amount, p.depositGas, handle, isRuleViolation)
+}
+
+// settleSchedule settles the schedule action like settleAction, except that the gas fee of the scheduled call is kept
+// by the protocol, to be paid for the gas the call consumes when it is executed and refunded for the rest
+func (p *Protocol) settleSchedule(
+	ctx context.Context,
+	act *action.ScheduleAction,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	prepaid := big.NewInt(0)
+	return accountutil.SettleAction(
+		ctx,
+		sm,
+		p.addr,
+		act.Amount(),
+		func(ctx context.Context, sm protocol.StateManager, gasFee *big.Int) error {
+			if p.depositGas == nil {
+				return nil
+			}
+			return p.depositGas(ctx, sm, new(big.Int).Sub(gasFee, prepaid))
+		},
+		func() error {
+			sa, err := p.handleSchedule(ctx, act, sm)
+			if err != nil {
+				return err
+			}
+			prepaid = sa.gasFee(sa.CallGasLimit)
+			return nil
+		},
+		isRuleViolation,
+	)
+}
+
+// isRuleViolation returns true if the error is caused by the action violating the schedule rules, rather than by
+// failing to access the states
+func isRuleViolation(err error) bool {
+	switch errors.Cause(err) {
+	case ErrScheduledActionNotExist,
+		ErrNotScheduledActionOwner,
+		ErrTargetHeightPassed:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,365 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package schedule
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/schedule/schedulepb"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
+	"github.com/iotexproject/iotex-core/testutil"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+)
+
+func newMockStateManager(ctrl *gomock.Controller) protocol.StateManager {
+	sm := mock_chainmanager.NewMockStateManager(ctrl)
+	cb := db.NewCachedBatch()
+	sm.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160, s interface{}) error {
+			val, err := cb.Get("state", addrHash[:])
+			if err != nil {
+				return state.ErrStateNotExist
+			}
+			return state.Deserialize(s, val)
+		}).AnyTimes()
+	sm.EXPECT().PutState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160, s interface{}) error {
+			ss, err := state.Serialize(s)
+			if err != nil {
+				return err
+			}
+			cb.Put("state", addrHash[:], ss, "failed to put state")
+			return nil
+		}).AnyTimes()
+	sm.EXPECT().DelState(gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160) error {
+			cb.Delete("state", addrHash[:], "failed to delete state")
+			return nil
+		}).AnyTimes()
+	sm.EXPECT().Snapshot().DoAndReturn(cb.Snapshot).AnyTimes()
+	sm.EXPECT().Revert(gomock.Any()).DoAndReturn(cb.Revert).AnyTimes()
+	return sm
+}
+
+func TestProtocol_Handle(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm := newMockStateManager(ctrl)
+	p := NewProtocol(nil, nil, func() (StateReader, error) { return sm, nil })
+	owner, other, recipient := identityset.Address(27), identityset.Address(28), identityset.Address(29)
+	for _, addr := range []address.Address{owner, other} {
+		require.NoError(accountutil.StoreAccount(sm, addr.String(), &state.Account{
+			Balance:      big.NewInt(1000000),
+			VotingWeight: big.NewInt(0),
+		}))
+	}
+	balance := func(addr address.Address) *big.Int {
+		acc, err := accountutil.LoadOrCreateAccount(sm, addr.String())
+		require.NoError(err)
+		return acc.Balance
+	}
+	newCtx := func(caller address.Address, nonce, height uint64) context.Context {
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:   caller,
+			GasPrice: big.NewInt(0),
+			Nonce:    nonce,
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight: height,
+			GasLimit:    testutil.TestGasLimit,
+		})
+		return protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{Genesis: config.Default.Genesis})
+	}
+	handle := func(ctx context.Context, act action.Action, status iotextypes.ReceiptStatus) {
+		receipt, err := p.Handle(ctx, act, sm)
+		require.NoError(err)
+		require.Equal(uint64(status), receipt.Status)
+	}
+	schedule := func(nonce, target uint64, amount int64) *action.ScheduleAction {
+		act, err := action.NewScheduleAction(nonce, target, recipient.String(), big.NewInt(amount), nil, 0, 100000,
+			big.NewInt(0))
+		require.NoError(err)
+		return act
+	}
+	cancel := func(nonce, id uint64) *action.CancelScheduledAction {
+		act, err := action.NewCancelScheduledAction(nonce, id, 100000, big.NewInt(0))
+		require.NoError(err)
+		return act
+	}
+	createExecute := func(height uint64) []action.Envelope {
+		elps, err := p.CreatePostSystemActions(newCtx(owner, 0, height))
+		require.NoError(err)
+		return elps
+	}
+
+	// nothing to execute before anything is scheduled
+	require.Len(createExecute(10), 0)
+
+	// a target height which has passed fails
+	handle(newCtx(owner, 1, 10), schedule(1, 10, 100), iotextypes.ReceiptStatus_Failure)
+	handle(newCtx(owner, 2, 10), schedule(2, 20, 100), iotextypes.ReceiptStatus_Success)
+	handle(newCtx(owner, 3, 10), schedule(3, 30, 200), iotextypes.ReceiptStatus_Success)
+	require.Equal(big.NewInt(1000000-300), balance(owner))
+	require.Equal(big.NewInt(300), balance(p.addr))
+	sas, err := p.ScheduledActionsByOwner(sm, owner)
+	require.NoError(err)
+	require.Len(sas, 2)
+	require.Equal(uint64(20), sas[0].TargetHeight)
+	require.Equal(uint64(30), sas[1].TargetHeight)
+
+	data, err := p.ReadState(context.Background(), sm, []byte("ScheduledActionsByOwner"), []byte(owner.String()))
+	require.NoError(err)
+	list := &schedulepb.ScheduledActionList{}
+	require.NoError(proto.Unmarshal(data, list))
+	require.Len(list.Actions, 2)
+	require.Equal(recipient.String(), list.Actions[0].Recipient)
+	data, err = p.ReadState(context.Background(), sm, []byte("ScheduledAction"),
+		[]byte(strconv.FormatUint(sas[1].ID, 10)))
+	require.NoError(err)
+	pb := &schedulepb.ScheduledAction{}
+	require.NoError(proto.Unmarshal(data, pb))
+	require.Equal("200", pb.Amount)
+
+	// only the owner cancels its scheduled action
+	handle(newCtx(other, 1, 15), cancel(1, sas[1].ID), iotextypes.ReceiptStatus_Failure)
+	handle(newCtx(owner, 4, 15), cancel(4, 100), iotextypes.ReceiptStatus_Failure)
+	handle(newCtx(owner, 5, 15), cancel(5, sas[1].ID), iotextypes.ReceiptStatus_Success)
+	require.Equal(big.NewInt(1000000-100), balance(owner))
+	_, err = p.ScheduledAction(sm, sas[1].ID)
+	require.Equal(ErrScheduledActionNotExist, errors.Cause(err))
+
+	// the scheduled transfer is executed by the system action at its target height
+	require.Len(createExecute(19), 0)
+	elps := createExecute(20)
+	require.Len(elps, 1)
+	ea, ok := elps[0].Action().(*action.ExecuteScheduledActions)
+	require.True(ok)
+	require.Equal(uint64(20), ea.Height())
+	// the system action of another height fails
+	handle(newCtx(other, 0, 21), ea, iotextypes.ReceiptStatus_Failure)
+	require.Equal(big.NewInt(0), balance(recipient))
+	handle(newCtx(other, 0, 20), ea, iotextypes.ReceiptStatus_Success)
+	require.Equal(big.NewInt(100), balance(recipient))
+	require.Equal(big.NewInt(0), balance(p.addr))
+	sas, err = p.ScheduledActionsByOwner(sm, owner)
+	require.NoError(err)
+	require.Len(sas, 0)
+	require.Len(createExecute(21), 0)
+	// executing again changes nothing
+	handle(newCtx(other, 0, 20), ea, iotextypes.ReceiptStatus_Success)
+	require.Equal(big.NewInt(100), balance(recipient))
+
+	// the actions of a missed height are executed by the next execution
+	handle(newCtx(owner, 6, 21), schedule(6, 25, 10), iotextypes.ReceiptStatus_Success)
+	handle(newCtx(owner, 7, 21), schedule(7, 26, 20), iotextypes.ReceiptStatus_Success)
+	handle(newCtx(other, 0, 27), action.NewExecuteScheduledActions(27), iotextypes.ReceiptStatus_Success)
+	require.Equal(big.NewInt(130), balance(recipient))
+	require.Len(createExecute(28), 0)
+
+	// the execution settles the nonce of the producer
+	handle(newCtx(other, 1, 28), action.NewExecuteScheduledActions(28), iotextypes.ReceiptStatus_Success)
+	acc, err := accountutil.LoadOrCreateAccount(sm, other.String())
+	require.NoError(err)
+	require.Equal(uint64(1), acc.Nonce)
+
+	// the actions beyond the gas budget of a block are carried over to the next block
+	for i := uint64(0); i < 3; i++ {
+		handle(newCtx(owner, 8+i, 30), schedule(8+i, 40, 1), iotextypes.ReceiptStatus_Success)
+	}
+	g := config.Default.Genesis
+	g.ActionGasLimit = 2*action.TransferBaseIntrinsicGas + 1
+	executeAt := func(height uint64) *action.Receipt {
+		ctx := protocol.WithBlockchainCtx(newCtx(other, height-39, height), protocol.BlockchainCtx{Genesis: g})
+		receipt, err := p.Handle(ctx, action.NewExecuteScheduledActions(height), sm)
+		require.NoError(err)
+		require.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+		return receipt
+	}
+	require.Equal(2*action.TransferBaseIntrinsicGas, executeAt(40).GasConsumed)
+	require.Equal(big.NewInt(132), balance(recipient))
+	require.Len(createExecute(41), 1)
+	require.Equal(action.TransferBaseIntrinsicGas, executeAt(41).GasConsumed)
+	require.Equal(big.NewInt(133), balance(recipient))
+	require.Len(createExecute(42), 0)
+
+	// the first action is executed even if it doesn't fit in the budget
+	handle(newCtx(owner, 11, 41), schedule(11, 45, 1), iotextypes.ReceiptStatus_Success)
+	g.ActionGasLimit = 1
+	require.Equal(action.TransferBaseIntrinsicGas, executeAt(45).GasConsumed)
+	require.Equal(big.NewInt(134), balance(recipient))
+
+	// the cursor moves past the heights whose actions are cancelled as the blocks go by
+	handle(newCtx(owner, 12, 46), schedule(12, 50, 1), iotextypes.ReceiptStatus_Success)
+	handle(newCtx(owner, 13, 46), schedule(13, 60, 1), iotextypes.ReceiptStatus_Success)
+	sas, err = p.ScheduledActionsByOwner(sm, owner)
+	require.NoError(err)
+	require.Len(sas, 2)
+	handle(newCtx(owner, 14, 47), cancel(14, sas[0].ID), iotextypes.ReceiptStatus_Success)
+	cursor, err := p.cursor(sm)
+	require.NoError(err)
+	require.Equal(&scheduleCursor{height: 50, pending: 1}, cursor)
+	require.NoError(p.CreatePreStates(newCtx(other, 0, 55), sm))
+	cursor, err = p.cursor(sm)
+	require.NoError(err)
+	require.Equal(&scheduleCursor{height: 56, pending: 1}, cursor)
+	require.Len(createExecute(59), 0)
+	require.Len(createExecute(60), 1)
+	require.NoError(p.CreatePreStates(newCtx(other, 0, 60), sm))
+	cursor, err = p.cursor(sm)
+	require.NoError(err)
+	require.Equal(&scheduleCursor{height: 60, pending: 1}, cursor)
+	handle(newCtx(other, 0, 60), action.NewExecuteScheduledActions(60), iotextypes.ReceiptStatus_Success)
+	require.Equal(big.NewInt(135), balance(recipient))
+	cursor, err = p.cursor(sm)
+	require.NoError(err)
+	require.Nil(cursor)
+	require.NoError(p.CreatePreStates(newCtx(other, 0, 61), sm))
+}
+
+func TestProtocol_CallGas(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm := newMockStateManager(ctrl)
+	pool := big.NewInt(0)
+	p := NewProtocol(func(ctx context.Context, sm protocol.StateManager, amount *big.Int) error {
+		actionCtx := protocol.MustGetActionCtx(ctx)
+		acc, err := accountutil.LoadAccount(sm, hash.BytesToHash160(actionCtx.Caller.Bytes()))
+		if err != nil {
+			return err
+		}
+		if err := acc.SubBalance(amount); err != nil {
+			return err
+		}
+		pool.Add(pool, amount)
+		return accountutil.StoreAccount(sm, actionCtx.Caller.String(), acc)
+	}, nil, nil)
+	owner, contract := identityset.Address(27), identityset.Address(29)
+	require.NoError(accountutil.StoreAccount(sm, owner.String(), &state.Account{
+		Balance:      big.NewInt(1000000),
+		VotingWeight: big.NewInt(0),
+	}))
+	balance := func(addr address.Address) *big.Int {
+		acc, err := accountutil.LoadOrCreateAccount(sm, addr.String())
+		require.NoError(err)
+		return acc.Balance
+	}
+	handle := func(act action.Action, gas, nonce uint64, gasPrice int64) {
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       owner,
+			GasPrice:     big.NewInt(gasPrice),
+			IntrinsicGas: gas,
+			Nonce:        nonce,
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: 10, GasLimit: gas})
+		receipt, err := p.Handle(ctx, act, sm)
+		require.NoError(err)
+		require.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+	}
+
+	// the gas fee of the scheduled call is kept by the protocol along with its amount
+	sa, err := action.NewScheduleAction(1, 20, contract.String(), big.NewInt(100), []byte{1}, 10000, 100000,
+		big.NewInt(1))
+	require.NoError(err)
+	gas, err := sa.IntrinsicGas()
+	require.NoError(err)
+	handle(sa, gas, 1, 1)
+	require.Equal(big.NewInt(int64(gas-10000)), pool)
+	require.Equal(big.NewInt(100+10000), balance(p.addr))
+	require.Equal(big.NewInt(1000000-100-int64(gas)), balance(owner))
+
+	// and is refunded when the call is cancelled
+	ca, err := action.NewCancelScheduledAction(2, 0, 100000, big.NewInt(0))
+	require.NoError(err)
+	handle(ca, action.CancelScheduledActionIntrinsicGas, 2, 0)
+	require.Equal(big.NewInt(int64(gas-10000)), pool)
+	require.Equal(big.NewInt(0), balance(p.addr))
+	require.Equal(big.NewInt(1000000-int64(gas-10000)), balance(owner))
+}
+
+func TestProtocol_Validate(t *testing.T) {
+	require := require.New(t)
+
+	p := NewProtocol(nil, nil, nil)
+	g := config.Default.Genesis
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Genesis: g})
+	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: g.GreenlandBlockHeight})
+	recipient := identityset.Address(28).String()
+	newSchedule := func(target uint64, recipient string, amount int64, data []byte, callGas uint64) action.Action {
+		act, err := action.NewScheduleAction(1, target, recipient, big.NewInt(amount), data, callGas, 100000,
+			big.NewInt(1))
+		require.NoError(err)
+		return act
+	}
+	// the cause of an invalid address varies with how it is malformed
+	errInvalidAddr := errors.New("invalid address")
+	negativeGas, err := action.NewCancelScheduledAction(1, 0, 100000, big.NewInt(-1))
+	require.NoError(err)
+
+	tests := []struct {
+		act action.Action
+		err error
+	}{
+		{newSchedule(100, recipient, 1, nil, 0), nil},
+		{newSchedule(100, recipient, 1, []byte{1}, 10000), nil},
+		{newSchedule(0, recipient, 1, nil, 0), ErrTargetHeightPassed},
+		{newSchedule(100, "io1invalid", 1, nil, 0), errInvalidAddr},
+		{newSchedule(100, recipient, -1, nil, 0), action.ErrBalance},
+		{newSchedule(100, recipient, 1, []byte{1}, 0), action.ErrActPool},
+		{newSchedule(100, recipient, 0, nil, 0), action.ErrActPool},
+		{newSchedule(100, recipient, 0, nil, 10000), action.ErrActPool},
+		{newSchedule(100, recipient, 0, []byte{1}, 10000), nil},
+		{newSchedule(100, recipient, 1, make([]byte, DataSizeLimit+1), 10000), action.ErrActPool},
+		{newSchedule(100, recipient, 1, nil, g.ActionGasLimit+1), action.ErrActPool},
+		{negativeGas, action.ErrGasPrice},
+	}
+	for _, test := range tests {
+		err := p.Validate(ctx, test.act)
+		switch test.err {
+		case nil:
+			require.NoError(err)
+		case errInvalidAddr:
+			require.Error(err)
+		default:
+			require.Equal(test.err, errors.Cause(err))
+		}
+	}
+
+	// schedule actions are rejected before the Greenland height
+	preCtx := protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: g.GreenlandBlockHeight - 1})
+	require.Equal(action.ErrActPool, errors.Cause(p.Validate(preCtx, newSchedule(100, recipient, 1, nil, 0))))
+
+	// the scheduled actions are executed only by the block producer, which the action pool doesn't know
+	producer, other := identityset.Address(27), identityset.Address(28)
+	execute := action.NewExecuteScheduledActions(g.GreenlandBlockHeight)
+	exeCtx := protocol.WithActionCtx(ctx, protocol.ActionCtx{Caller: producer})
+	require.Equal(ErrNotBlockProducer, errors.Cause(p.Validate(exeCtx, execute)))
+	exeCtx = protocol.WithBlockCtx(exeCtx, protocol.BlockCtx{BlockHeight: g.GreenlandBlockHeight, Producer: producer})
+	require.NoError(p.Validate(exeCtx, execute))
+	exeCtx = protocol.WithActionCtx(exeCtx, protocol.ActionCtx{Caller: other})
+	require.Equal(ErrNotBlockProducer, errors.Cause(p.Validate(exeCtx, execute)))
+}
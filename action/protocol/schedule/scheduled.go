@@ -0,0 +1,191 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package schedule
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/schedule/schedulepb"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// ScheduledAction is a transfer or a contract call scheduled at a future height. Its amount, and the gas fee of the
+// call prepaid by the gas payer, are kept by the protocol until it is executed or cancelled.
+type ScheduledAction struct {
+	ID           uint64
+	Owner        string
+	TargetHeight uint64
+	Recipient    string
+	Amount       *big.Int
+	Data         []byte
+	CallGasLimit uint64
+	GasPrice     *big.Int
+	GasPayer     string
+}
+
+// IsCall returns true if the scheduled action is a contract call rather than a transfer
+func (sa *ScheduledAction) IsCall() bool { return sa.CallGasLimit > 0 }
+
+// gasFee returns the fee of the gas at the price the call is scheduled with
+func (sa *ScheduledAction) gasFee(gas uint64) *big.Int {
+	if sa.GasPrice == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Mul(sa.GasPrice, new(big.Int).SetUint64(gas))
+}
+
+// gasLimit returns the gas the scheduled action takes from the gas budget of the block executing it, which is the gas
+// limit of a call, or the intrinsic gas of a transfer
+func (sa *ScheduledAction) gasLimit() uint64 {
+	if sa.IsCall() {
+		return sa.CallGasLimit
+	}
+	return action.TransferBaseIntrinsicGas
+}
+
+// Serialize serializes the scheduled action into bytes
+func (sa *ScheduledAction) Serialize() ([]byte, error) {
+	return proto.Marshal(sa.toProto())
+}
+
+// Deserialize deserializes bytes into the scheduled action
+func (sa *ScheduledAction) Deserialize(data []byte) error {
+	pb := schedulepb.ScheduledAction{}
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	return sa.fromProto(&pb)
+}
+
+func (sa *ScheduledAction) toProto() *schedulepb.ScheduledAction {
+	pb := &schedulepb.ScheduledAction{
+		Id:           sa.ID,
+		Owner:        sa.Owner,
+		TargetHeight: sa.TargetHeight,
+		Recipient:    sa.Recipient,
+		Amount:       sa.Amount.String(),
+		Data:         sa.Data,
+		CallGasLimit: sa.CallGasLimit,
+		GasPrice:     "0",
+		GasPayer:     sa.GasPayer,
+	}
+	if sa.GasPrice != nil {
+		pb.GasPrice = sa.GasPrice.String()
+	}
+	return pb
+}
+
+func (sa *ScheduledAction) fromProto(pb *schedulepb.ScheduledAction) error {
+	amount, ok := new(big.Int).SetString(pb.GetAmount(), 10)
+	if !ok {
+		return errors.Errorf("invalid amount %s", pb.GetAmount())
+	}
+	gasPrice, ok := new(big.Int).SetString(pb.GetGasPrice(), 10)
+	if !ok {
+		return errors.Errorf("invalid gas price %s", pb.GetGasPrice())
+	}
+	*sa = ScheduledAction{
+		ID:           pb.GetId(),
+		Owner:        pb.GetOwner(),
+		TargetHeight: pb.GetTargetHeight(),
+		Recipient:    pb.GetRecipient(),
+		Amount:       amount,
+		Data:         pb.GetData(),
+		CallGasLimit: pb.GetCallGasLimit(),
+		GasPrice:     gasPrice,
+		GasPayer:     pb.GetGasPayer(),
+	}
+	return nil
+}
+
+// scheduleIndices is a list of the ids of scheduled actions
+type scheduleIndices []uint64
+
+// Serialize serializes the indices into bytes
+func (sis scheduleIndices) Serialize() ([]byte, error) {
+	return proto.Marshal(&schedulepb.ScheduleIndices{Indices: sis})
+}
+
+// Deserialize deserializes bytes into the indices
+func (sis *scheduleIndices) Deserialize(data []byte) error {
+	pb := schedulepb.ScheduleIndices{}
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	*sis = pb.GetIndices()
+	return nil
+}
+
+func (sis scheduleIndices) add(index uint64) scheduleIndices {
+	return append(sis, index)
+}
+
+func (sis scheduleIndices) remove(index uint64) scheduleIndices {
+	res := make(scheduleIndices, 0, len(sis))
+	for _, i := range sis {
+		if i != index {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+// totalScheduleCount is the number of actions ever scheduled, which is also the id of the next one
+type totalScheduleCount uint64
+
+// Serialize serializes the count into bytes
+func (tc totalScheduleCount) Serialize() ([]byte, error) {
+	return proto.Marshal(&schedulepb.TotalScheduleCount{Count: uint64(tc)})
+}
+
+// Deserialize deserializes bytes into the count
+func (tc *totalScheduleCount) Deserialize(data []byte) error {
+	pb := schedulepb.TotalScheduleCount{}
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	*tc = totalScheduleCount(pb.GetCount())
+	return nil
+}
+
+// scheduleCursor is the lowest height which may have scheduled actions left, along with the number of the actions
+// pending. It moves up as the blocks go by, so that finding the due actions reads a few heights at most.
+type scheduleCursor struct {
+	height  uint64
+	pending uint64
+}
+
+// Serialize serializes the cursor into bytes
+func (sc *scheduleCursor) Serialize() ([]byte, error) {
+	return proto.Marshal(&schedulepb.ScheduleCursor{Height: sc.height, Pending: sc.pending})
+}
+
+// Deserialize deserializes bytes into the cursor
+func (sc *scheduleCursor) Deserialize(data []byte) error {
+	pb := schedulepb.ScheduleCursor{}
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	sc.height, sc.pending = pb.GetHeight(), pb.GetPending()
+	return nil
+}
+
+func scheduledActionKey(id uint64) []byte {
+	return append([]byte{scheduledActionKeyPrefix}, byteutil.Uint64ToBytesBigEndian(id)...)
+}
+
+func ownerIndexKey(owner []byte) []byte {
+	return append([]byte{ownerIndexKeyPrefix}, owner...)
+}
+
+func heightIndexKey(height uint64) []byte {
+	return append([]byte{heightIndexKeyPrefix}, byteutil.Uint64ToBytesBigEndian(height)...)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package schedule
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+)
+
+// DataSizeLimit is the max size of the data of a scheduled contract call
+const DataSizeLimit = 32 * 1024
+
+func (p *Protocol) validateSchedule(ctx context.Context, act *action.ScheduleAction) error {
+	g, err := validateCommon(ctx, act.GasPrice())
+	if err != nil {
+		return err
+	}
+	if act.TargetHeight() == 0 {
+		return errors.Wrap(ErrTargetHeightPassed, "zero target height")
+	}
+	if _, err := address.FromString(act.Recipient()); err != nil {
+		return errors.Wrapf(err, "error when validating recipient's address %s", act.Recipient())
+	}
+	if act.Amount() == nil || act.Amount().Sign() < 0 {
+		return errors.Wrap(action.ErrBalance, "negative value")
+	}
+	if !act.IsCall() && len(act.Data()) > 0 {
+		return errors.Wrap(action.ErrActPool, "data of a transfer")
+	}
+	if act.Amount().Sign() == 0 && len(act.Data()) == 0 {
+		return errors.Wrap(action.ErrActPool, "neither amount nor data")
+	}
+	if len(act.Data()) > DataSizeLimit {
+		return errors.Wrap(action.ErrActPool, "oversized data")
+	}
+	if g != nil && act.CallGasLimit() > g.ActionGasLimit {
+		return errors.Wrapf(action.ErrActPool, "call gas limit %d is higher than %d", act.CallGasLimit(),
+			g.ActionGasLimit)
+	}
+	return nil
+}
+
+// validateExecute accepts the execution of the scheduled actions only from the producer of the block, since it charges
+// no gas. The producer isn't known to the action pool, so the action can't be submitted by the users.
+func validateExecute(ctx context.Context) error {
+	actionCtx, ok := protocol.GetActionCtx(ctx)
+	if !ok {
+		return errors.Wrap(ErrNotBlockProducer, "unknown caller")
+	}
+	blkCtx, ok := protocol.GetBlockCtx(ctx)
+	if !ok || blkCtx.Producer == nil {
+		return errors.Wrap(ErrNotBlockProducer, "unknown block producer")
+	}
+	if actionCtx.Caller == nil || actionCtx.Caller.String() != blkCtx.Producer.String() {
+		return errors.Wrapf(ErrNotBlockProducer, "producer %s", blkCtx.Producer.String())
+	}
+	return nil
+}
+
+// validateCommon validates the activation and gas price shared by all the schedule actions, and returns the genesis
+// config if it is available in the context
+func validateCommon(ctx context.Context, gasPrice *big.Int) (*genesis.Genesis, error) {
+	var g *genesis.Genesis
+	if bcCtx, ok := protocol.GetBlockchainCtx(ctx); ok {
+		g = &bcCtx.Genesis
+		// Reject schedule action before it is activated
+		if blkCtx, ok := protocol.GetBlockCtx(ctx); ok {
+			hu := config.NewHeightUpgrade(g)
+			if hu.IsPre(config.Greenland, blkCtx.BlockHeight) {
+				return nil, errors.Wrap(action.ErrActPool, "scheduled actions are not activated yet")
+			}
+		}
+	}
+	// Reject schedule action of negative gas price
+	if gasPrice == nil || gasPrice.Sign() < 0 {
+		return nil, errors.Wrap(action.ErrGasPrice, "negative value")
+	}
+	return g, nil
+}
+
+// executeGasLimit returns the gas budget of the scheduled actions executed by a block, which is the gas limit of a
+// single action, so that they cost a block no more than any other action
+func executeGasLimit(ctx context.Context) uint64 {
+	if bcCtx, ok := protocol.GetBlockchainCtx(ctx); ok {
+		return bcCtx.Genesis.ActionGasLimit
+	}
+	return config.Default.Genesis.ActionGasLimit
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol/staking/stakingpb"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// VoteBucket is an amount of tokens staked by an owner for a duration, whose votes go to a candidate
+type VoteBucket struct {
+	Index          uint64
+	Candidate      string
+	Owner          string
+	StakedAmount   *big.Int
+	StakedDuration uint32 // in days
+	CreateTime     time.Time
+	StakeStartTime time.Time
+	// UnstakeStartTime is zero until the bucket is unstaked
+	UnstakeStartTime time.Time
+	AutoStake        bool
+}
+
+// Serialize serializes the bucket into bytes
+func (vb VoteBucket) Serialize() ([]byte, error) {
+	pb, err := vb.toProto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// Deserialize deserializes bytes into the bucket
+func (vb *VoteBucket) Deserialize(data []byte) error {
+	pb := stakingpb.Bucket{}
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	return vb.fromProto(&pb)
+}
+
+// IsUnstaked returns true if the bucket has been unstaked, in which case it no longer votes
+func (vb *VoteBucket) IsUnstaked() bool {
+	return !vb.UnstakeStartTime.IsZero()
+}
+
+// StakeEndTime returns the time when the staked duration ends if the duration decays
+func (vb *VoteBucket) StakeEndTime() time.Time {
+	return vb.StakeStartTime.Add(time.Duration(vb.StakedDuration) * 24 * time.Hour)
+}
+
+func (vb *VoteBucket) toProto() (*stakingpb.Bucket, error) {
+	createTime, err := ptypes.TimestampProto(vb.CreateTime)
+	if err != nil {
+		return nil, err
+	}
+	stakeStartTime, err := ptypes.TimestampProto(vb.StakeStartTime)
+	if err != nil {
+		return nil, err
+	}
+	pb := &stakingpb.Bucket{
+		Index:          vb.Index,
+		CandidateName:  vb.Candidate,
+		Owner:          vb.Owner,
+		StakedAmount:   vb.StakedAmount.String(),
+		StakedDuration: vb.StakedDuration,
+		CreateTime:     createTime,
+		StakeStartTime: stakeStartTime,
+		AutoStake:      vb.AutoStake,
+	}
+	if vb.IsUnstaked() {
+		if pb.UnstakeStartTime, err = ptypes.TimestampProto(vb.UnstakeStartTime); err != nil {
+			return nil, err
+		}
+	}
+	return pb, nil
+}
+
+func (vb *VoteBucket) fromProto(pb *stakingpb.Bucket) error {
+	amount, ok := new(big.Int).SetString(pb.GetStakedAmount(), 10)
+	if !ok {
+		return errors.Errorf("invalid staked amount %s", pb.GetStakedAmount())
+	}
+	createTime, err := timeFromProto(pb.GetCreateTime())
+	if err != nil {
+		return err
+	}
+	stakeStartTime, err := timeFromProto(pb.GetStakeStartTime())
+	if err != nil {
+		return err
+	}
+	unstakeStartTime, err := timeFromProto(pb.GetUnstakeStartTime())
+	if err != nil {
+		return err
+	}
+	*vb = VoteBucket{
+		Index:            pb.GetIndex(),
+		Candidate:        pb.GetCandidateName(),
+		Owner:            pb.GetOwner(),
+		StakedAmount:     amount,
+		StakedDuration:   pb.GetStakedDuration(),
+		CreateTime:       createTime,
+		StakeStartTime:   stakeStartTime,
+		UnstakeStartTime: unstakeStartTime,
+		AutoStake:        pb.GetAutoStake(),
+	}
+	return nil
+}
+
+// timeFromProto converts a proto timestamp into time, treating a missing timestamp as the zero time
+func timeFromProto(ts *timestamp.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
+
+// bucketIndices is a list of bucket indices, used to look up the buckets of a voter or a candidate
+type bucketIndices []uint64
+
+// Serialize serializes the bucket indices into bytes
+func (bis bucketIndices) Serialize() ([]byte, error) {
+	return proto.Marshal(&stakingpb.BucketIndices{Indices: bis})
+}
+
+// Deserialize deserializes bytes into the bucket indices
+func (bis *bucketIndices) Deserialize(data []byte) error {
+	pb := stakingpb.BucketIndices{}
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	*bis = pb.GetIndices()
+	return nil
+}
+
+func (bis bucketIndices) add(index uint64) bucketIndices {
+	return append(bis, index)
+}
+
+func (bis bucketIndices) remove(index uint64) bucketIndices {
+	res := make(bucketIndices, 0, len(bis))
+	for _, i := range bis {
+		if i != index {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+// totalBucketCount is the number of buckets ever created, which is also the index of the next bucket
+type totalBucketCount uint64
+
+// Serialize serializes the bucket count into bytes
+func (tc totalBucketCount) Serialize() ([]byte, error) {
+	return proto.Marshal(&stakingpb.TotalBucketCount{Count: uint64(tc)})
+}
+
+// Deserialize deserializes bytes into the bucket count
+func (tc *totalBucketCount) Deserialize(data []byte) error {
+	pb := stakingpb.TotalBucketCount{}
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	*tc = totalBucketCount(pb.GetCount())
+	return nil
+}
+
+func bucketKey(index uint64) []byte {
+	return append([]byte{bucketKeyPrefix}, byteutil.Uint64ToBytesBigEndian(index)...)
+}
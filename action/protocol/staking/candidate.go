@@ -0,0 +1,90 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/action/protocol/staking/stakingpb"
+)
+
+// Candidate is a delegate candidate registered on the staking protocol
+type Candidate struct {
+	Name               string
+	Owner              string
+	Operator           string
+	Reward             string
+	SelfStakeBucketIdx uint64
+}
+
+// Serialize serializes the candidate into bytes
+func (c Candidate) Serialize() ([]byte, error) {
+	return proto.Marshal(c.toProto(nil))
+}
+
+// Deserialize deserializes bytes into the candidate
+func (c *Candidate) Deserialize(data []byte) error {
+	pb := stakingpb.Candidate{}
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	*c = Candidate{
+		Name:               pb.GetName(),
+		Owner:              pb.GetOwner(),
+		Operator:           pb.GetOperator(),
+		Reward:             pb.GetReward(),
+		SelfStakeBucketIdx: pb.GetSelfStakeBucketIdx(),
+	}
+	return nil
+}
+
+// toProto converts the candidate into proto, with the total staked amount if it is given
+func (c *Candidate) toProto(totalStaked *big.Int) *stakingpb.Candidate {
+	pb := &stakingpb.Candidate{
+		Name:               c.Name,
+		Owner:              c.Owner,
+		Operator:           c.Operator,
+		Reward:             c.Reward,
+		SelfStakeBucketIdx: c.SelfStakeBucketIdx,
+	}
+	if totalStaked != nil {
+		pb.TotalStakedAmount = totalStaked.String()
+	}
+	return pb
+}
+
+// candidateNames is the list of names of all the registered candidates
+type candidateNames []string
+
+// Serialize serializes the candidate names into bytes
+func (cn candidateNames) Serialize() ([]byte, error) {
+	return proto.Marshal(&stakingpb.CandidateNames{Names: cn})
+}
+
+// Deserialize deserializes bytes into the candidate names
+func (cn *candidateNames) Deserialize(data []byte) error {
+	pb := stakingpb.CandidateNames{}
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return err
+	}
+	*cn = pb.GetNames()
+	return nil
+}
+
+func candidateKey(name string) []byte {
+	return append([]byte{candidateKeyPrefix}, []byte(name)...)
+}
+
+func candidateIndexKey(name string) []byte {
+	return append([]byte{candidateIndexKeyPrefix}, []byte(name)...)
+}
+
+func voterIndexKey(owner []byte) []byte {
+	return append([]byte{voterIndexKeyPrefix}, owner...)
+}
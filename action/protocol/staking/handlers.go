@@ -0,0 +1,371 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+func (p *Protocol) handleCreateStake(ctx context.Context, act *action.CreateStake, sm protocol.StateManager) error {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	if _, err := p.Candidate(sm, act.Candidate()); err != nil {
+		return err
+	}
+	_, err := p.createBucket(sm, &VoteBucket{
+		Candidate:      act.Candidate(),
+		Owner:          actionCtx.Caller.String(),
+		StakedAmount:   act.Amount(),
+		StakedDuration: act.Duration(),
+		CreateTime:     blkCtx.BlockTimeStamp,
+		StakeStartTime: blkCtx.BlockTimeStamp,
+		AutoStake:      act.AutoStake(),
+	})
+	return err
+}
+
+func (p *Protocol) handleUnstake(ctx context.Context, act *action.Unstake, sm protocol.StateManager) error {
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	bucket, err := p.ownedBucket(ctx, sm, act.BucketIndex())
+	if err != nil {
+		return err
+	}
+	if err := p.notSelfStake(sm, bucket); err != nil {
+		return err
+	}
+	if bucket.AutoStake {
+		return errors.Wrapf(ErrBucketLocked, "auto-staked bucket %d", bucket.Index)
+	}
+	if blkCtx.BlockTimeStamp.Before(bucket.StakeEndTime()) {
+		return errors.Wrapf(ErrBucketLocked, "bucket %d is staked until %s", bucket.Index, bucket.StakeEndTime())
+	}
+	bucket.UnstakeStartTime = blkCtx.BlockTimeStamp
+	return p.putState(sm, bucketKey(bucket.Index), bucket)
+}
+
+func (p *Protocol) handleWithdrawStake(
+	ctx context.Context,
+	act *action.WithdrawStake,
+	sm protocol.StateManager,
+) error {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+	bucket, err := p.Bucket(sm, act.BucketIndex())
+	if err != nil {
+		return err
+	}
+	if bucket.Owner != actionCtx.Caller.String() {
+		return errors.Wrapf(ErrNotBucketOwner, "bucket %d is owned by %s", bucket.Index, bucket.Owner)
+	}
+	if !bucket.IsUnstaked() {
+		return errors.Wrapf(ErrBucketNotUnstaked, "bucket %d", bucket.Index)
+	}
+	if err := p.notSelfStake(sm, bucket); err != nil {
+		return err
+	}
+	readyTime := bucket.UnstakeStartTime.Add(bcCtx.Genesis.WithdrawWaitingPeriod)
+	if blkCtx.BlockTimeStamp.Before(readyTime) {
+		return errors.Wrapf(ErrWithdrawNotReady, "bucket %d can be withdrawn after %s", bucket.Index, readyTime)
+	}
+	if err := p.removeBucket(sm, bucket); err != nil {
+		return err
+	}
+	return p.unlockTokens(sm, actionCtx.Caller, bucket.StakedAmount)
+}
+
+func (p *Protocol) handleDepositToStake(
+	ctx context.Context,
+	act *action.DepositToStake,
+	sm protocol.StateManager,
+) error {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	bucket, err := p.ownedBucket(ctx, sm, act.BucketIndex())
+	if err != nil {
+		return err
+	}
+	bucket.StakedAmount = new(big.Int).Add(bucket.StakedAmount, act.Amount())
+	if err := p.putState(sm, bucketKey(bucket.Index), bucket); err != nil {
+		return err
+	}
+	return p.lockTokens(sm, actionCtx.Caller, act.Amount())
+}
+
+func (p *Protocol) handleRestake(ctx context.Context, act *action.Restake, sm protocol.StateManager) error {
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	bucket, err := p.ownedBucket(ctx, sm, act.BucketIndex())
+	if err != nil {
+		return err
+	}
+	if act.Duration() < bucket.StakedDuration {
+		return errors.Wrapf(
+			ErrInvalidDuration,
+			"new duration %d is shorter than the current duration %d",
+			act.Duration(),
+			bucket.StakedDuration,
+		)
+	}
+	bucket.StakedDuration = act.Duration()
+	bucket.StakeStartTime = blkCtx.BlockTimeStamp
+	bucket.AutoStake = act.AutoStake()
+	return p.putState(sm, bucketKey(bucket.Index), bucket)
+}
+
+func (p *Protocol) handleChangeCandidate(
+	ctx context.Context,
+	act *action.ChangeCandidate,
+	sm protocol.StateManager,
+) error {
+	bucket, err := p.ownedBucket(ctx, sm, act.BucketIndex())
+	if err != nil {
+		return err
+	}
+	if err := p.notSelfStake(sm, bucket); err != nil {
+		return err
+	}
+	if _, err := p.Candidate(sm, act.Candidate()); err != nil {
+		return err
+	}
+	if err := p.updateIndex(sm, candidateIndexKey(bucket.Candidate), bucket.Index, false); err != nil {
+		return err
+	}
+	if err := p.updateIndex(sm, candidateIndexKey(act.Candidate()), bucket.Index, true); err != nil {
+		return err
+	}
+	bucket.Candidate = act.Candidate()
+	return p.putState(sm, bucketKey(bucket.Index), bucket)
+}
+
+func (p *Protocol) handleTransferStake(
+	ctx context.Context,
+	act *action.TransferStake,
+	sm protocol.StateManager,
+) error {
+	bucket, err := p.ownedBucket(ctx, sm, act.BucketIndex())
+	if err != nil {
+		return err
+	}
+	if err := p.notSelfStake(sm, bucket); err != nil {
+		return err
+	}
+	owner, err := address.FromString(bucket.Owner)
+	if err != nil {
+		return err
+	}
+	voter, err := address.FromString(act.VoterAddress())
+	if err != nil {
+		return err
+	}
+	if err := p.updateIndex(sm, voterIndexKey(owner.Bytes()), bucket.Index, false); err != nil {
+		return err
+	}
+	if err := p.updateIndex(sm, voterIndexKey(voter.Bytes()), bucket.Index, true); err != nil {
+		return err
+	}
+	bucket.Owner = voter.String()
+	return p.putState(sm, bucketKey(bucket.Index), bucket)
+}
+
+func (p *Protocol) handleCandidateRegister(
+	ctx context.Context,
+	act *action.CandidateRegister,
+	sm protocol.StateManager,
+) error {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	candidates, err := p.Candidates(sm)
+	if err != nil {
+		return err
+	}
+	for _, c := range candidates {
+		if c.Name == act.Name() {
+			return errors.Wrapf(ErrCandidateExist, "name %s has been registered", act.Name())
+		}
+		if c.Owner == actionCtx.Caller.String() {
+			return errors.Wrapf(ErrCandidateExist, "%s has registered candidate %s", c.Owner, c.Name)
+		}
+	}
+	// the name of an unregistered candidate is kept until all the buckets voting for it are moved or withdrawn, so
+	// that a new candidate of the same name doesn't take over their votes
+	indices, err := p.bucketIndices(sm, candidateIndexKey(act.Name()))
+	if err != nil {
+		return err
+	}
+	if len(indices) > 0 {
+		return errors.Wrapf(ErrCandidateExist, "name %s is still voted by %d buckets", act.Name(), len(indices))
+	}
+	// the self-stake bucket is created before the candidate, so that the candidate refers to its index
+	index, err := p.createBucket(sm, &VoteBucket{
+		Candidate:      act.Name(),
+		Owner:          actionCtx.Caller.String(),
+		StakedAmount:   act.Amount(),
+		StakedDuration: act.Duration(),
+		CreateTime:     blkCtx.BlockTimeStamp,
+		StakeStartTime: blkCtx.BlockTimeStamp,
+		AutoStake:      act.AutoStake(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := p.putState(sm, candidateKey(act.Name()), &Candidate{
+		Name:               act.Name(),
+		Owner:              actionCtx.Caller.String(),
+		Operator:           act.OperatorAddress(),
+		Reward:             act.RewardAddress(),
+		SelfStakeBucketIdx: index,
+	}); err != nil {
+		return err
+	}
+	names, err := p.candidateNames(sm)
+	if err != nil {
+		return err
+	}
+	return p.putState(sm, []byte{candidateNamesKey}, append(names, act.Name()))
+}
+
+// handleCandidateUnregister removes the candidate owned by the caller. Its self-stake bucket is released, and can be
+// unstaked and withdrawn as the other buckets, while the buckets voting for the candidate no longer count until they
+// are moved to another candidate.
+func (p *Protocol) handleCandidateUnregister(
+	ctx context.Context,
+	act *action.CandidateUnregister,
+	sm protocol.StateManager,
+) error {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	names, err := p.candidateNames(sm)
+	if err != nil {
+		return err
+	}
+	for i, name := range names {
+		c, err := p.Candidate(sm, name)
+		if err != nil {
+			return err
+		}
+		if c.Owner != actionCtx.Caller.String() {
+			continue
+		}
+		if err := p.deleteState(sm, candidateKey(name)); err != nil {
+			return err
+		}
+		rest := append(append(candidateNames{}, names[:i]...), names[i+1:]...)
+		if len(rest) == 0 {
+			return p.deleteState(sm, []byte{candidateNamesKey})
+		}
+		return p.putState(sm, []byte{candidateNamesKey}, rest)
+	}
+	return errors.Wrapf(ErrCandidateNotExist, "%s has no registered candidate", actionCtx.Caller.String())
+}
+
+// ownedBucket returns the bucket of the given index if it is owned by the caller and still staked
+func (p *Protocol) ownedBucket(ctx context.Context, sm protocol.StateManager, index uint64) (*VoteBucket, error) {
+	actionCtx := protocol.MustGetActionCtx(ctx)
+	bucket, err := p.Bucket(sm, index)
+	if err != nil {
+		return nil, err
+	}
+	if bucket.Owner != actionCtx.Caller.String() {
+		return nil, errors.Wrapf(ErrNotBucketOwner, "bucket %d is owned by %s", index, bucket.Owner)
+	}
+	if bucket.IsUnstaked() {
+		return nil, errors.Wrapf(ErrBucketUnstaked, "bucket %d", index)
+	}
+	return bucket, nil
+}
+
+// notSelfStake returns an error if the bucket is the self-stake bucket of its candidate, which has to stay with the
+// candidate until the candidate is unregistered
+func (p *Protocol) notSelfStake(sm protocol.StateManager, bucket *VoteBucket) error {
+	c, err := p.Candidate(sm, bucket.Candidate)
+	if err != nil {
+		if errors.Cause(err) == ErrCandidateNotExist {
+			return nil
+		}
+		return err
+	}
+	if c.SelfStakeBucketIdx == bucket.Index {
+		return errors.Wrapf(ErrSelfStakeBucket, "bucket %d of candidate %s", bucket.Index, c.Name)
+	}
+	return nil
+}
+
+// createBucket assigns the next index to the bucket, stores it with its indices, and locks the staked tokens of
+// the owner
+func (p *Protocol) createBucket(sm protocol.StateManager, bucket *VoteBucket) (uint64, error) {
+	var count totalBucketCount
+	if err := p.state(sm, []byte{totalBucketCountKey}, &count); err != nil &&
+		errors.Cause(err) != state.ErrStateNotExist {
+		return 0, err
+	}
+	owner, err := address.FromString(bucket.Owner)
+	if err != nil {
+		return 0, err
+	}
+	bucket.Index = uint64(count)
+	if err := p.putState(sm, bucketKey(bucket.Index), bucket); err != nil {
+		return 0, err
+	}
+	if err := p.putState(sm, []byte{totalBucketCountKey}, count+1); err != nil {
+		return 0, err
+	}
+	if err := p.updateIndex(sm, voterIndexKey(owner.Bytes()), bucket.Index, true); err != nil {
+		return 0, err
+	}
+	if err := p.updateIndex(sm, candidateIndexKey(bucket.Candidate), bucket.Index, true); err != nil {
+		return 0, err
+	}
+	return bucket.Index, p.lockTokens(sm, owner, bucket.StakedAmount)
+}
+
+// removeBucket deletes the bucket and its indices
+func (p *Protocol) removeBucket(sm protocol.StateManager, bucket *VoteBucket) error {
+	owner, err := address.FromString(bucket.Owner)
+	if err != nil {
+		return err
+	}
+	if err := p.updateIndex(sm, voterIndexKey(owner.Bytes()), bucket.Index, false); err != nil {
+		return err
+	}
+	if err := p.updateIndex(sm, candidateIndexKey(bucket.Candidate), bucket.Index, false); err != nil {
+		return err
+	}
+	return p.deleteState(sm, bucketKey(bucket.Index))
+}
+
+func (p *Protocol) updateIndex(sm protocol.StateManager, key []byte, index uint64, add bool) error {
+	indices, err := p.bucketIndices(sm, key)
+	if err != nil {
+		return err
+	}
+	if add {
+		indices = indices.add(index)
+	} else {
+		indices = indices.remove(index)
+	}
+	if len(indices) == 0 {
+		return p.deleteState(sm, key)
+	}
+	return p.putState(sm, key, indices)
+}
+
+// lockTokens moves the staked tokens from the owner to the protocol
+func (p *Protocol) lockTokens(sm protocol.StateManager, owner address.Address, amount *big.Int) error {
+	return accountutil.MoveTokens(sm, owner, p.addr, amount)
+}
+
+// unlockTokens returns the staked tokens from the protocol to the owner
+func (p *Protocol) unlockTokens(sm protocol.StateManager, owner address.Address, amount *big.Int) error {
+	return accountutil.MoveTokens(sm, p.addr, owner, amount)
+}
@@ -0,0 +1,454 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/staking/stakingpb"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+const (
+	// TODO: it works only for one instance per protocol definition now
+	protocolID = "staking"
+)
+
+// key prefixes of the staking states, which are further prefixed by the protocol key prefix
+const (
+	bucketKeyPrefix byte = iota
+	voterIndexKeyPrefix
+	candidateIndexKeyPrefix
+	candidateKeyPrefix
+	candidateNamesKey
+	totalBucketCountKey
+)
+
+var (
+	// ErrBucketNotExist indicates that the bucket doesn't exist
+	ErrBucketNotExist = errors.New("bucket doesn't exist")
+	// ErrNotBucketOwner indicates that the caller isn't the owner of the bucket
+	ErrNotBucketOwner = errors.New("caller isn't the owner of the bucket")
+	// ErrBucketUnstaked indicates that the bucket has been unstaked
+	ErrBucketUnstaked = errors.New("bucket has been unstaked")
+	// ErrBucketNotUnstaked indicates that the bucket hasn't been unstaked yet
+	ErrBucketNotUnstaked = errors.New("bucket hasn't been unstaked")
+	// ErrBucketLocked indicates that the staked duration of the bucket hasn't ended yet
+	ErrBucketLocked = errors.New("bucket is still locked")
+	// ErrWithdrawNotReady indicates that the withdraw waiting period hasn't passed yet
+	ErrWithdrawNotReady = errors.New("bucket isn't ready to withdraw")
+	// ErrInvalidDuration indicates that the new staked duration is shorter than the current one
+	ErrInvalidDuration = errors.New("invalid staked duration")
+	// ErrCandidateNotExist indicates that the candidate doesn't exist
+	ErrCandidateNotExist = errors.New("candidate doesn't exist")
+	// ErrCandidateExist indicates that the candidate name or owner has already been registered
+	ErrCandidateExist = errors.New("candidate already exists")
+	// ErrSelfStakeBucket indicates that the bucket is the self-stake bucket of its candidate, which cannot be
+	// unstaked, withdrawn, transferred or moved to another candidate until the candidate is unregistered
+	ErrSelfStakeBucket = errors.New("bucket is the self-stake bucket of the candidate")
+)
+
+// DepositGas deposits gas to some pool
+type DepositGas func(ctx context.Context, sm protocol.StateManager, amount *big.Int) error
+
+// Protocol defines the protocol of native staking. It allows users to stake tokens into buckets voting for the
+// registered candidates, and the staked tokens are kept by the protocol until the buckets are withdrawn.
+type Protocol struct {
+	keyPrefix  []byte
+	addr       address.Address
+	depositGas DepositGas
+}
+
+// NewProtocol instantiates the protocol of native staking
+func NewProtocol(depositGas DepositGas) *Protocol {
+	h := hash.Hash160b([]byte(protocolID))
+	addr, err := address.FromBytes(h[:])
+	if err != nil {
+		log.L().Panic("Error when constructing the address of staking protocol", zap.Error(err))
+	}
+	return &Protocol{
+		keyPrefix:  h[:],
+		addr:       addr,
+		depositGas: depositGas,
+	}
+}
+
+// FindProtocol finds the registered protocol from registry
+func FindProtocol(registry *protocol.Registry) *Protocol {
+	if registry == nil {
+		return nil
+	}
+	p, ok := registry.Find(protocolID)
+	if !ok {
+		return nil
+	}
+	sp, ok := p.(*Protocol)
+	if !ok {
+		log.S().Panic("fail to cast staking protocol")
+	}
+	return sp
+}
+
+// Handle handles the actions on the staking protocol
+func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+	var (
+		amount *big.Int
+		handle func() error
+	)
+	switch act := act.(type) {
+	case *action.CreateStake:
+		amount = act.Amount()
+		handle = func() error { return p.handleCreateStake(ctx, act, sm) }
+	case *action.Unstake:
+		handle = func() error { return p.handleUnstake(ctx, act, sm) }
+	case *action.WithdrawStake:
+		handle = func() error { return p.handleWithdrawStake(ctx, act, sm) }
+	case *action.DepositToStake:
+		amount = act.Amount()
+		handle = func() error { return p.handleDepositToStake(ctx, act, sm) }
+	case *action.Restake:
+		handle = func() error { return p.handleRestake(ctx, act, sm) }
+	case *action.ChangeCandidate:
+		handle = func() error { return p.handleChangeCandidate(ctx, act, sm) }
+	case *action.TransferStake:
+		handle = func() error { return p.handleTransferStake(ctx, act, sm) }
+	case *action.CandidateRegister:
+		amount = act.Amount()
+		handle = func() error { return p.handleCandidateRegister(ctx, act, sm) }
+	case *action.CandidateUnregister:
+		handle = func() error { return p.handleCandidateUnregister(ctx, act, sm) }
+	default:
+		return nil, nil
+	}
+	return p.settleAction(ctx, sm, amount, handle)
+}
+
+// Validate validates the actions on the staking protocol
+func (p *Protocol) Validate(ctx context.Context, act action.Action) error {
+	switch act := act.(type) {
+	case *action.CreateStake:
+		if err := p.validateCreateStake(ctx, act); err != nil {
+			return errors.Wrap(err, "error when validating create stake action")
+		}
+	case *action.Unstake:
+		if err := p.validateReclaim(ctx, act.GasPrice(), act.Payload()); err != nil {
+			return errors.Wrap(err, "error when validating unstake action")
+		}
+	case *action.WithdrawStake:
+		if err := p.validateReclaim(ctx, act.GasPrice(), act.Payload()); err != nil {
+			return errors.Wrap(err, "error when validating withdraw stake action")
+		}
+	case *action.DepositToStake:
+		if err := p.validateDepositToStake(ctx, act); err != nil {
+			return errors.Wrap(err, "error when validating deposit to stake action")
+		}
+	case *action.Restake:
+		if err := p.validateRestake(ctx, act); err != nil {
+			return errors.Wrap(err, "error when validating restake action")
+		}
+	case *action.ChangeCandidate:
+		if err := p.validateChangeCandidate(ctx, act); err != nil {
+			return errors.Wrap(err, "error when validating change candidate action")
+		}
+	case *action.TransferStake:
+		if err := p.validateTransferStake(ctx, act); err != nil {
+			return errors.Wrap(err, "error when validating transfer stake action")
+		}
+	case *action.CandidateRegister:
+		if err := p.validateCandidateRegister(ctx, act); err != nil {
+			return errors.Wrap(err, "error when validating candidate register action")
+		}
+	case *action.CandidateUnregister:
+		if err := p.validateReclaim(ctx, act.GasPrice(), act.Payload()); err != nil {
+			return errors.Wrap(err, "error when validating candidate unregister action")
+		}
+	}
+	return nil
+}
+
+// ReadState read the state on blockchain via protocol
+func (p *Protocol) ReadState(
+	ctx context.Context,
+	sm protocol.StateManager,
+	method []byte,
+	args ...[]byte,
+) ([]byte, error) {
+	switch string(method) {
+	case "BucketsByVoter":
+		if len(args) != 1 {
+			return nil, errors.Errorf("invalid number of arguments %d", len(args))
+		}
+		voter, err := address.FromString(string(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		buckets, err := p.BucketsByVoter(sm, voter)
+		if err != nil {
+			return nil, err
+		}
+		return marshalBuckets(buckets)
+	case "BucketsByCandidate":
+		if len(args) != 1 {
+			return nil, errors.Errorf("invalid number of arguments %d", len(args))
+		}
+		buckets, err := p.BucketsByCandidate(sm, string(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		return marshalBuckets(buckets)
+	case "BucketByIndex":
+		if len(args) != 1 {
+			return nil, errors.Errorf("invalid number of arguments %d", len(args))
+		}
+		index, err := strconv.ParseUint(string(args[0]), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		bucket, err := p.Bucket(sm, index)
+		if err != nil {
+			return nil, err
+		}
+		pb, err := bucket.toProto()
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(pb)
+	case "Candidates":
+		candidates, err := p.Candidates(sm)
+		if err != nil {
+			return nil, err
+		}
+		pbs := make([]*stakingpb.Candidate, 0, len(candidates))
+		for _, c := range candidates {
+			pb, err := p.candidateProto(sm, c)
+			if err != nil {
+				return nil, err
+			}
+			pbs = append(pbs, pb)
+		}
+		return proto.Marshal(&stakingpb.CandidateList{Candidates: pbs})
+	case "CandidateByName":
+		if len(args) != 1 {
+			return nil, errors.Errorf("invalid number of arguments %d", len(args))
+		}
+		c, err := p.Candidate(sm, string(args[0]))
+		if err != nil {
+			return nil, err
+		}
+		pb, err := p.candidateProto(sm, c)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(pb)
+	default:
+		return nil, errors.New("corresponding method isn't found")
+	}
+}
+
+// Register registers the protocol with a unique ID
+func (p *Protocol) Register(r *protocol.Registry) error {
+	return r.Register(protocolID, p)
+}
+
+// ForceRegister registers the protocol with a unique ID and force replacing the previous protocol if it exists
+func (p *Protocol) ForceRegister(r *protocol.Registry) error {
+	return r.ForceRegister(protocolID, p)
+}
+
+// Bucket returns the bucket of the given index
+func (p *Protocol) Bucket(sm protocol.StateManager, index uint64) (*VoteBucket, error) {
+	bucket := VoteBucket{}
+	if err := p.state(sm, bucketKey(index), &bucket); err != nil {
+		if errors.Cause(err) == state.ErrStateNotExist {
+			return nil, errors.Wrapf(ErrBucketNotExist, "bucket index %d", index)
+		}
+		return nil, errors.Wrapf(err, "failed to load bucket %d", index)
+	}
+	return &bucket, nil
+}
+
+// BucketsByVoter returns the buckets owned by the voter, including the unstaked ones which aren't withdrawn yet
+func (p *Protocol) BucketsByVoter(sm protocol.StateManager, voter address.Address) ([]*VoteBucket, error) {
+	indices, err := p.bucketIndices(sm, voterIndexKey(voter.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	return p.buckets(sm, indices)
+}
+
+// BucketsByCandidate returns the buckets voting for the candidate, including the unstaked ones which aren't
+// withdrawn yet
+func (p *Protocol) BucketsByCandidate(sm protocol.StateManager, name string) ([]*VoteBucket, error) {
+	indices, err := p.bucketIndices(sm, candidateIndexKey(name))
+	if err != nil {
+		return nil, err
+	}
+	return p.buckets(sm, indices)
+}
+
+// ActiveBuckets returns the buckets whose votes count, i.e., the ones voting for a registered candidate and not
+// unstaked
+func (p *Protocol) ActiveBuckets(sm protocol.StateManager) ([]*VoteBucket, error) {
+	names, err := p.candidateNames(sm)
+	if err != nil {
+		return nil, err
+	}
+	var active []*VoteBucket
+	for _, name := range names {
+		buckets, err := p.BucketsByCandidate(sm, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, bucket := range buckets {
+			if !bucket.IsUnstaked() {
+				active = append(active, bucket)
+			}
+		}
+	}
+	return active, nil
+}
+
+// Candidate returns the registered candidate of the given name
+func (p *Protocol) Candidate(sm protocol.StateManager, name string) (*Candidate, error) {
+	c := Candidate{}
+	if err := p.state(sm, candidateKey(name), &c); err != nil {
+		if errors.Cause(err) == state.ErrStateNotExist {
+			return nil, errors.Wrapf(ErrCandidateNotExist, "candidate %s", name)
+		}
+		return nil, errors.Wrapf(err, "failed to load candidate %s", name)
+	}
+	return &c, nil
+}
+
+// Candidates returns all the registered candidates in the order of registration
+func (p *Protocol) Candidates(sm protocol.StateManager) ([]*Candidate, error) {
+	names, err := p.candidateNames(sm)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]*Candidate, 0, len(names))
+	for _, name := range names {
+		c, err := p.Candidate(sm, name)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates, nil
+}
+
+func (p *Protocol) candidateProto(sm protocol.StateManager, c *Candidate) (*stakingpb.Candidate, error) {
+	buckets, err := p.BucketsByCandidate(sm, c.Name)
+	if err != nil {
+		return nil, err
+	}
+	total := big.NewInt(0)
+	for _, bucket := range buckets {
+		if !bucket.IsUnstaked() {
+			total.Add(total, bucket.StakedAmount)
+		}
+	}
+	return c.toProto(total), nil
+}
+
+func (p *Protocol) buckets(sm protocol.StateManager, indices bucketIndices) ([]*VoteBucket, error) {
+	buckets := make([]*VoteBucket, 0, len(indices))
+	for _, index := range indices {
+		bucket, err := p.Bucket(sm, index)
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets, nil
+}
+
+func (p *Protocol) bucketIndices(sm protocol.StateManager, key []byte) (bucketIndices, error) {
+	indices := bucketIndices{}
+	if err := p.state(sm, key, &indices); err != nil && errors.Cause(err) != state.ErrStateNotExist {
+		return nil, err
+	}
+	return indices, nil
+}
+
+func (p *Protocol) candidateNames(sm protocol.StateManager) (candidateNames, error) {
+	names := candidateNames{}
+	if err := p.state(sm, []byte{candidateNamesKey}, &names); err != nil &&
+		errors.Cause(err) != state.ErrStateNotExist {
+		return nil, err
+	}
+	return names, nil
+}
+
+func (p *Protocol) state(sm protocol.StateManager, key []byte, value interface{}) error {
+	keyHash := hash.Hash160b(append(p.keyPrefix, key...))
+	return sm.State(keyHash, value)
+}
+
+func (p *Protocol) putState(sm protocol.StateManager, key []byte, value interface{}) error {
+	keyHash := hash.Hash160b(append(p.keyPrefix, key...))
+	return sm.PutState(keyHash, value)
+}
+
+func (p *Protocol) deleteState(sm protocol.StateManager, key []byte) error {
+	keyHash := hash.Hash160b(append(p.keyPrefix, key...))
+	return sm.DelState(keyHash)
+}
+
+// settleAction charges the gas of the action and bumps the nonce of the caller. If handling the action violates the
+// staking rules, the changes made by it are reverted and the receipt fails, but the gas is charged nonetheless.
+func (p *Protocol) settleAction(
+	ctx context.Context,
+	sm protocol.StateManager,
+	amount *big.Int,
+	handle func() error,
+) (*action.Receipt, error) {
+	return accountutil.SettleAction(ctx, sm, p.addr, amount, p.depositGas, handle, isRuleViolation)
+}
+
+// isRuleViolation returns true if the error is caused by the action violating the staking rules, rather than by
+// failing to access the states
+func isRuleViolation(err error) bool {
+	switch errors.Cause(err) {
+	case ErrBucketNotExist,
+		ErrNotBucketOwner,
+		ErrBucketUnstaked,
+		ErrBucketNotUnstaked,
+		ErrBucketLocked,
+		ErrWithdrawNotReady,
+		ErrInvalidDuration,
+		ErrCandidateNotExist,
+		ErrCandidateExist,
+		ErrSelfStakeBucket:
+		return true
+	}
+	return false
+}
+
+func marshalBuckets(buckets []*VoteBucket) ([]byte, error) {
+	pbs := make([]*stakingpb.Bucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		pb, err := bucket.toProto()
+		if err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+	return proto.Marshal(&stakingpb.BucketList{Buckets: pbs})
+}
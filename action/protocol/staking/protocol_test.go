@@ -0,0 +1,427 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/staking/stakingpb"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
+	"github.com/iotexproject/iotex-core/testutil"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+)
+
+func newMockStateManager(ctrl *gomock.Controller) protocol.StateManager {
+	sm := mock_chainmanager.NewMockStateManager(ctrl)
+	cb := db.NewCachedBatch()
+	sm.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160, s interface{}) error {
+			val, err := cb.Get("state", addrHash[:])
+			if err != nil {
+				return state.ErrStateNotExist
+			}
+			return state.Deserialize(s, val)
+		}).AnyTimes()
+	sm.EXPECT().PutState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160, s interface{}) error {
+			ss, err := state.Serialize(s)
+			if err != nil {
+				return err
+			}
+			cb.Put("state", addrHash[:], ss, "failed to put state")
+			return nil
+		}).AnyTimes()
+	sm.EXPECT().DelState(gomock.Any()).DoAndReturn(
+		func(addrHash hash.Hash160) error {
+			cb.Delete("state", addrHash[:], "failed to delete state")
+			return nil
+		}).AnyTimes()
+	sm.EXPECT().Snapshot().DoAndReturn(cb.Snapshot).AnyTimes()
+	sm.EXPECT().Revert(gomock.Any()).DoAndReturn(cb.Revert).AnyTimes()
+	return sm
+}
+
+func TestProtocol_Handle(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm := newMockStateManager(ctrl)
+	gasFees := big.NewInt(0)
+	p := NewProtocol(func(ctx context.Context, sm protocol.StateManager, amount *big.Int) error {
+		actionCtx := protocol.MustGetActionCtx(ctx)
+		acc, err := accountutil.LoadAccount(sm, hash.BytesToHash160(actionCtx.Caller.Bytes()))
+		if err != nil {
+			return err
+		}
+		if err := acc.SubBalance(amount); err != nil {
+			return err
+		}
+		gasFees.Add(gasFees, amount)
+		return accountutil.StoreAccount(sm, actionCtx.Caller.String(), acc)
+	})
+
+	cand, voter, other := identityset.Address(28), identityset.Address(29), identityset.Address(30)
+	for _, addr := range []address.Address{cand, voter, other} {
+		require.NoError(accountutil.StoreAccount(sm, addr.String(), &state.Account{
+			Balance:      big.NewInt(1000000),
+			VotingWeight: big.NewInt(0),
+		}))
+	}
+	balance := func(addr address.Address) *big.Int {
+		acc, err := accountutil.LoadAccount(sm, hash.BytesToHash160(addr.Bytes()))
+		require.NoError(err)
+		return acc.Balance
+	}
+
+	g := config.Default.Genesis
+	now := time.Unix(1580000000, 0).UTC()
+	nonces := make(map[string]uint64)
+	handle := func(caller address.Address, act interface {
+		action.Action
+		IntrinsicGas() (uint64, error)
+		Serialize() []byte
+	}, blkTime time.Time) (*action.Receipt, error) {
+		gas, err := act.IntrinsicGas()
+		require.NoError(err)
+		nonces[caller.String()]++
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       caller,
+			ActionHash:   hash.Hash256b(act.Serialize()),
+			GasPrice:     big.NewInt(1),
+			IntrinsicGas: gas,
+			Nonce:        nonces[caller.String()],
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight:    g.GreenlandBlockHeight,
+			BlockTimeStamp: blkTime,
+			Producer:       identityset.Address(27),
+			GasLimit:       testutil.TestGasLimit,
+		})
+		ctx = protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{Genesis: g})
+		return p.Handle(ctx, act, sm)
+	}
+	requireStatus := func(status iotextypes.ReceiptStatus, receipt *action.Receipt, err error) {
+		require.NoError(err)
+		require.Equal(uint64(status), receipt.Status)
+	}
+
+	// register a candidate with a self-stake bucket
+	register, err := action.NewCandidateRegister(1, "alice", cand.String(), cand.String(), big.NewInt(100), 7,
+		true, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err := handle(cand, register, now)
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+	require.Equal(p.addr.String(), receipt.ContractAddress)
+	gas, err := register.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(big.NewInt(1000000-100-int64(gas)), balance(cand))
+	require.Equal(big.NewInt(100), balance(p.addr))
+	c, err := p.Candidate(sm, "alice")
+	require.NoError(err)
+	require.Equal(&Candidate{
+		Name:               "alice",
+		Owner:              cand.String(),
+		Operator:           cand.String(),
+		Reward:             cand.String(),
+		SelfStakeBucketIdx: 0,
+	}, c)
+
+	// the name and the owner can only be registered once
+	for _, name := range []string{"alice", "bob"} {
+		register, err := action.NewCandidateRegister(2, name, cand.String(), cand.String(), big.NewInt(100), 7,
+			true, nil, 100000, big.NewInt(1))
+		require.NoError(err)
+		receipt, err := handle(cand, register, now)
+		requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+	}
+	candidates, err := p.Candidates(sm)
+	require.NoError(err)
+	require.Len(candidates, 1)
+
+	// stake to a candidate which doesn't exist fails, but still charges gas and bumps the nonce
+	create, err := action.NewCreateStake(1, "bob", big.NewInt(50), 1, false, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(voter, create, now)
+	requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+	require.Equal(big.NewInt(1000000-int64(gas)), balance(voter))
+	acc, err := accountutil.LoadAccount(sm, hash.BytesToHash160(voter.Bytes()))
+	require.NoError(err)
+	require.Equal(uint64(1), acc.Nonce)
+
+	create, err = action.NewCreateStake(2, "alice", big.NewInt(50), 1, false, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(voter, create, now)
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+	require.Equal(big.NewInt(150), balance(p.addr))
+	buckets, err := p.BucketsByVoter(sm, voter)
+	require.NoError(err)
+	require.Equal([]*VoteBucket{{
+		Index:          1,
+		Candidate:      "alice",
+		Owner:          voter.String(),
+		StakedAmount:   big.NewInt(50),
+		StakedDuration: 1,
+		CreateTime:     now,
+		StakeStartTime: now,
+	}}, buckets)
+
+	// not enough balance fails the action
+	create, err = action.NewCreateStake(3, "alice", big.NewInt(5000000), 1, false, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	_, err = handle(voter, create, now)
+	require.Equal(state.ErrNotEnoughBalance, errors.Cause(err))
+	nonces[voter.String()]--
+
+	// only the owner can unstake the bucket, after its staked duration ends
+	unstake, err := action.NewUnstake(1, 1, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(other, unstake, now.Add(48*time.Hour))
+	requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+	receipt, err = handle(voter, unstake, now.Add(time.Hour))
+	requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+	unstakeTime := now.Add(24 * time.Hour)
+	receipt, err = handle(voter, unstake, unstakeTime)
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+	bucket, err := p.Bucket(sm, 1)
+	require.NoError(err)
+	require.True(bucket.IsUnstaked())
+	require.Equal(unstakeTime, bucket.UnstakeStartTime)
+	active, err := p.ActiveBuckets(sm)
+	require.NoError(err)
+	require.Len(active, 1)
+	require.Equal(uint64(0), active[0].Index)
+
+	// auto-staked bucket cannot be unstaked
+	unstake, err = action.NewUnstake(3, 0, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(cand, unstake, now.Add(365*24*time.Hour))
+	requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+
+	// unstaked bucket cannot be changed
+	deposit, err := action.NewDepositToStake(5, 1, big.NewInt(10), nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(voter, deposit, unstakeTime)
+	requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+
+	// withdraw after the waiting period returns the staked tokens
+	balanceBefore := balance(voter)
+	withdraw, err := action.NewWithdrawStake(6, 1, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(voter, withdraw, unstakeTime.Add(time.Hour))
+	requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+	receipt, err = handle(voter, withdraw, unstakeTime.Add(g.WithdrawWaitingPeriod))
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+	require.Equal(new(big.Int).Sub(new(big.Int).Add(balanceBefore, big.NewInt(50)), big.NewInt(2*int64(gas))),
+		balance(voter))
+	_, err = p.Bucket(sm, 1)
+	require.Equal(ErrBucketNotExist, errors.Cause(err))
+	buckets, err = p.BucketsByVoter(sm, voter)
+	require.NoError(err)
+	require.Len(buckets, 0)
+
+	// deposit and restake the self-stake bucket
+	deposit, err = action.NewDepositToStake(3, 0, big.NewInt(10), nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(cand, deposit, now)
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+	restake, err := action.NewRestake(4, 0, 3, false, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(cand, restake, now)
+	requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+	restake, err = action.NewRestake(5, 0, 14, false, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	restakeTime := now.Add(time.Hour)
+	receipt, err = handle(cand, restake, restakeTime)
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+
+	register, err = action.NewCandidateRegister(2, "bob", other.String(), other.String(), big.NewInt(100), 7,
+		false, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(other, register, now)
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+
+	// the self-stake bucket cannot be unstaked, transferred or moved to another candidate
+	unstake, err = action.NewUnstake(6, 0, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(cand, unstake, restakeTime.Add(15*24*time.Hour))
+	requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+	transfer, err := action.NewTransferStake(7, other.String(), 0, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(cand, transfer, now)
+	requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+	change, err := action.NewChangeCandidate(8, "bob", 0, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(cand, change, now)
+	requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+	bucket, err = p.Bucket(sm, 0)
+	require.NoError(err)
+	require.Equal(&VoteBucket{
+		Index:          0,
+		Candidate:      "alice",
+		Owner:          cand.String(),
+		StakedAmount:   big.NewInt(110),
+		StakedDuration: 14,
+		CreateTime:     now,
+		StakeStartTime: restakeTime,
+	}, bucket)
+
+	// other buckets can be moved to another candidate and transferred
+	create, err = action.NewCreateStake(7, "alice", big.NewInt(20), 1, false, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(voter, create, now)
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+	change, err = action.NewChangeCandidate(8, "bob", 3, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(voter, change, now)
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+	transfer, err = action.NewTransferStake(9, other.String(), 3, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(voter, transfer, now)
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+	buckets, err = p.BucketsByCandidate(sm, "alice")
+	require.NoError(err)
+	require.Len(buckets, 1)
+	buckets, err = p.BucketsByCandidate(sm, "bob")
+	require.NoError(err)
+	require.Len(buckets, 2)
+	buckets, err = p.BucketsByVoter(sm, voter)
+	require.NoError(err)
+	require.Len(buckets, 0)
+	buckets, err = p.BucketsByVoter(sm, other)
+	require.NoError(err)
+	require.Len(buckets, 2)
+
+	// unregistering the candidate releases the self-stake bucket
+	unregister, err := action.NewCandidateUnregister(10, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(voter, unregister, now)
+	requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+	receipt, err = handle(cand, unregister, now)
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+	_, err = p.Candidate(sm, "alice")
+	require.Equal(ErrCandidateNotExist, errors.Cause(err))
+	candidates, err = p.Candidates(sm)
+	require.NoError(err)
+	require.Len(candidates, 1)
+	require.Equal("bob", candidates[0].Name)
+	receipt, err = handle(cand, unregister, now)
+	requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+
+	// the name is kept while the released bucket still votes for it
+	register, err = action.NewCandidateRegister(11, "alice", voter.String(), voter.String(), big.NewInt(100), 7,
+		false, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(voter, register, now)
+	requireStatus(iotextypes.ReceiptStatus_Failure, receipt, err)
+
+	unstakeTime = restakeTime.Add(15 * 24 * time.Hour)
+	receipt, err = handle(cand, unstake, unstakeTime)
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+	candBalance := balance(cand)
+	withdraw, err = action.NewWithdrawStake(12, 0, nil, 100000, big.NewInt(1))
+	require.NoError(err)
+	receipt, err = handle(cand, withdraw, unstakeTime.Add(g.WithdrawWaitingPeriod))
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+	fee := new(big.Int).SetUint64(receipt.GasConsumed)
+	require.Equal(new(big.Int).Sub(new(big.Int).Add(candBalance, big.NewInt(110)), fee), balance(cand))
+	_, err = p.Bucket(sm, 0)
+	require.Equal(ErrBucketNotExist, errors.Cause(err))
+
+	receipt, err = handle(voter, register, now)
+	requireStatus(iotextypes.ReceiptStatus_Success, receipt, err)
+
+	// all the gas is deposited
+	require.Equal(big.NewInt(3000000-100-100-20), new(big.Int).Add(gasFees,
+		new(big.Int).Add(balance(cand), new(big.Int).Add(balance(voter), balance(other)))))
+	require.Equal(big.NewInt(220), balance(p.addr))
+}
+
+func TestProtocol_ReadState(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sm := newMockStateManager(ctrl)
+	p := NewProtocol(nil)
+	cand, voter := identityset.Address(28), identityset.Address(29)
+	now := time.Unix(1580000000, 0).UTC()
+	_, err := p.createBucket(sm, &VoteBucket{
+		Candidate:      "alice",
+		Owner:          voter.String(),
+		StakedAmount:   big.NewInt(0),
+		StakedDuration: 7,
+		CreateTime:     now,
+		StakeStartTime: now,
+	})
+	require.NoError(err)
+	require.NoError(p.putState(sm, candidateKey("alice"), &Candidate{
+		Name:     "alice",
+		Owner:    cand.String(),
+		Operator: cand.String(),
+		Reward:   cand.String(),
+	}))
+	require.NoError(p.putState(sm, []byte{candidateNamesKey}, candidateNames{"alice"}))
+
+	ctx := context.Background()
+	for _, test := range []struct {
+		method string
+		arg    string
+	}{
+		{"BucketsByVoter", voter.String()},
+		{"BucketsByCandidate", "alice"},
+	} {
+		data, err := p.ReadState(ctx, sm, []byte(test.method), []byte(test.arg))
+		require.NoError(err)
+		list := stakingpb.BucketList{}
+		require.NoError(proto.Unmarshal(data, &list))
+		require.Len(list.Buckets, 1)
+		require.Equal("alice", list.Buckets[0].CandidateName)
+		require.Equal(voter.String(), list.Buckets[0].Owner)
+		require.Nil(list.Buckets[0].UnstakeStartTime)
+	}
+
+	data, err := p.ReadState(ctx, sm, []byte("BucketByIndex"), []byte("0"))
+	require.NoError(err)
+	pb := stakingpb.Bucket{}
+	require.NoError(proto.Unmarshal(data, &pb))
+	require.Equal(uint32(7), pb.StakedDuration)
+	_, err = p.ReadState(ctx, sm, []byte("BucketByIndex"), []byte("1"))
+	require.Equal(ErrBucketNotExist, errors.Cause(err))
+
+	data, err = p.ReadState(ctx, sm, []byte("Candidates"))
+	require.NoError(err)
+	list := stakingpb.CandidateList{}
+	require.NoError(proto.Unmarshal(data, &list))
+	require.Len(list.Candidates, 1)
+	require.Equal("alice", list.Candidates[0].Name)
+	require.Equal("0", list.Candidates[0].TotalStakedAmount)
+
+	_, err = p.ReadState(ctx, sm, []byte("CandidateByName"), []byte("bob"))
+	require.Equal(ErrCandidateNotExist, errors.Cause(err))
+	_, err = p.ReadState(ctx, sm, []byte("BucketsByVoter"))
+	require.Error(err)
+	_, err = p.ReadState(ctx, sm, []byte("Unknown"))
+	require.Error(err)
+}
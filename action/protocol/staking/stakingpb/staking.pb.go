@@ -0,0 +1,445 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: staking.proto
+
+package stakingpb
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Bucket struct {
+	Index                uint64               `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	CandidateName        string               `protobuf:"bytes,2,opt,name=candidateName,proto3" json:"candidateName,omitempty"`
+	Owner                string               `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	StakedAmount         string               `protobuf:"bytes,4,opt,name=stakedAmount,proto3" json:"stakedAmount,omitempty"`
+	StakedDuration       uint32               `protobuf:"varint,5,opt,name=stakedDuration,proto3" json:"stakedDuration,omitempty"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	StakeStartTime       *timestamp.Timestamp `protobuf:"bytes,7,opt,name=stakeStartTime,proto3" json:"stakeStartTime,omitempty"`
+	UnstakeStartTime     *timestamp.Timestamp `protobuf:"bytes,8,opt,name=unstakeStartTime,proto3" json:"unstakeStartTime,omitempty"`
+	AutoStake            bool                 `protobuf:"varint,9,opt,name=autoStake,proto3" json:"autoStake,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Bucket) Reset()         { *m = Bucket{} }
+func (m *Bucket) String() string { return proto.CompactTextString(m) }
+func (*Bucket) ProtoMessage()    {}
+func (*Bucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{0}
+}
+
+func (m *Bucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Bucket.Unmarshal(m, b)
+}
+func (m *Bucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Bucket.Marshal(b, m, deterministic)
+}
+func (m *Bucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bucket.Merge(m, src)
+}
+func (m *Bucket) XXX_Size() int {
+	return xxx_messageInfo_Bucket.Size(m)
+}
+func (m *Bucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bucket proto.InternalMessageInfo
+
+func (m *Bucket) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Bucket) GetCandidateName() string {
+	if m != nil {
+		return m.CandidateName
+	}
+	return ""
+}
+
+func (m *Bucket) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Bucket) GetStakedAmount() string {
+	if m != nil {
+		return m.StakedAmount
+	}
+	return ""
+}
+
+func (m *Bucket) GetStakedDuration() uint32 {
+	if m != nil {
+		return m.StakedDuration
+	}
+	return 0
+}
+
+func (m *Bucket) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *Bucket) GetStakeStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StakeStartTime
+	}
+	return nil
+}
+
+func (m *Bucket) GetUnstakeStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UnstakeStartTime
+	}
+	return nil
+}
+
+func (m *Bucket) GetAutoStake() bool {
+	if m != nil {
+		return m.AutoStake
+	}
+	return false
+}
+
+type BucketList struct {
+	Buckets              []*Bucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *BucketList) Reset()         { *m = BucketList{} }
+func (m *BucketList) String() string { return proto.CompactTextString(m) }
+func (*BucketList) ProtoMessage()    {}
+func (*BucketList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{1}
+}
+
+func (m *BucketList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketList.Unmarshal(m, b)
+}
+func (m *BucketList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketList.Marshal(b, m, deterministic)
+}
+func (m *BucketList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketList.Merge(m, src)
+}
+func (m *BucketList) XXX_Size() int {
+	return xxx_messageInfo_BucketList.Size(m)
+}
+func (m *BucketList) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketList proto.InternalMessageInfo
+
+func (m *BucketList) GetBuckets() []*Bucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type BucketIndices struct {
+	Indices              []uint64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketIndices) Reset()         { *m = BucketIndices{} }
+func (m *BucketIndices) String() string { return proto.CompactTextString(m) }
+func (*BucketIndices) ProtoMessage()    {}
+func (*BucketIndices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{2}
+}
+
+func (m *BucketIndices) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketIndices.Unmarshal(m, b)
+}
+func (m *BucketIndices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketIndices.Marshal(b, m, deterministic)
+}
+func (m *BucketIndices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketIndices.Merge(m, src)
+}
+func (m *BucketIndices) XXX_Size() int {
+	return xxx_messageInfo_BucketIndices.Size(m)
+}
+func (m *BucketIndices) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketIndices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketIndices proto.InternalMessageInfo
+
+func (m *BucketIndices) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+type Candidate struct {
+	Name               string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner              string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Operator           string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Reward             string `protobuf:"bytes,4,opt,name=reward,proto3" json:"reward,omitempty"`
+	SelfStakeBucketIdx uint64 `protobuf:"varint,5,opt,name=selfStakeBucketIdx,proto3" json:"selfStakeBucketIdx,omitempty"`
+	// totalStakedAmount is the amount of the active buckets voting for the candidate, which is only filled when read
+	TotalStakedAmount    string   `protobuf:"bytes,6,opt,name=totalStakedAmount,proto3" json:"totalStakedAmount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Candidate) Reset()         { *m = Candidate{} }
+func (m *Candidate) String() string { return proto.CompactTextString(m) }
+func (*Candidate) ProtoMessage()    {}
+func (*Candidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{3}
+}
+
+func (m *Candidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Candidate.Unmarshal(m, b)
+}
+func (m *Candidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Candidate.Marshal(b, m, deterministic)
+}
+func (m *Candidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candidate.Merge(m, src)
+}
+func (m *Candidate) XXX_Size() int {
+	return xxx_messageInfo_Candidate.Size(m)
+}
+func (m *Candidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candidate proto.InternalMessageInfo
+
+func (m *Candidate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Candidate) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Candidate) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *Candidate) GetReward() string {
+	if m != nil {
+		return m.Reward
+	}
+	return ""
+}
+
+func (m *Candidate) GetSelfStakeBucketIdx() uint64 {
+	if m != nil {
+		return m.SelfStakeBucketIdx
+	}
+	return 0
+}
+
+func (m *Candidate) GetTotalStakedAmount() string {
+	if m != nil {
+		return m.TotalStakedAmount
+	}
+	return ""
+}
+
+type CandidateList struct {
+	Candidates           []*Candidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CandidateList) Reset()         { *m = CandidateList{} }
+func (m *CandidateList) String() string { return proto.CompactTextString(m) }
+func (*CandidateList) ProtoMessage()    {}
+func (*CandidateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{4}
+}
+
+func (m *CandidateList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateList.Unmarshal(m, b)
+}
+func (m *CandidateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateList.Marshal(b, m, deterministic)
+}
+func (m *CandidateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateList.Merge(m, src)
+}
+func (m *CandidateList) XXX_Size() int {
+	return xxx_messageInfo_CandidateList.Size(m)
+}
+func (m *CandidateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateList proto.InternalMessageInfo
+
+func (m *CandidateList) GetCandidates() []*Candidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+type CandidateNames struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CandidateNames) Reset()         { *m = CandidateNames{} }
+func (m *CandidateNames) String() string { return proto.CompactTextString(m) }
+func (*CandidateNames) ProtoMessage()    {}
+func (*CandidateNames) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{5}
+}
+
+func (m *CandidateNames) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateNames.Unmarshal(m, b)
+}
+func (m *CandidateNames) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateNames.Marshal(b, m, deterministic)
+}
+func (m *CandidateNames) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateNames.Merge(m, src)
+}
+func (m *CandidateNames) XXX_Size() int {
+	return xxx_messageInfo_CandidateNames.Size(m)
+}
+func (m *CandidateNames) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateNames.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateNames proto.InternalMessageInfo
+
+func (m *CandidateNames) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type TotalBucketCount struct {
+	Count                uint64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TotalBucketCount) Reset()         { *m = TotalBucketCount{} }
+func (m *TotalBucketCount) String() string { return proto.CompactTextString(m) }
+func (*TotalBucketCount) ProtoMessage()    {}
+func (*TotalBucketCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{6}
+}
+
+func (m *TotalBucketCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TotalBucketCount.Unmarshal(m, b)
+}
+func (m *TotalBucketCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TotalBucketCount.Marshal(b, m, deterministic)
+}
+func (m *TotalBucketCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalBucketCount.Merge(m, src)
+}
+func (m *TotalBucketCount) XXX_Size() int {
+	return xxx_messageInfo_TotalBucketCount.Size(m)
+}
+func (m *TotalBucketCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalBucketCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TotalBucketCount proto.InternalMessageInfo
+
+func (m *TotalBucketCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Bucket)(nil), "stakingpb.Bucket")
+	proto.RegisterType((*BucketList)(nil), "stakingpb.BucketList")
+	proto.RegisterType((*BucketIndices)(nil), "stakingpb.BucketIndices")
+	proto.RegisterType((*Candidate)(nil), "stakingpb.Candidate")
+	proto.RegisterType((*CandidateList)(nil), "stakingpb.CandidateList")
+	proto.RegisterType((*CandidateNames)(nil), "stakingpb.CandidateNames")
+	proto.RegisterType((*TotalBucketCount)(nil), "stakingpb.TotalBucketCount")
+}
+
+func init() { proto.RegisterFile("staking.proto", fileDescriptor_289e7c8aea278311) }
+
+var fileDescriptor_289e7c8aea278311 = []byte{
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xe1, 0x6a, 0xdb, 0x30,
+	0x10, 0x46, 0x8d, 0xe3, 0xc4, 0xd7, 0xb9, 0xb4, 0x47, 0x18, 0x22, 0x0c, 0x66, 0xcc, 0x28, 0x1e,
+	0x1b, 0x2e, 0x74, 0xfb, 0xb3, 0xfd, 0x5b, 0xb3, 0x0d, 0x06, 0x63, 0x3f, 0x94, 0xbc, 0x80, 0x62,
+	0xab, 0x41, 0x34, 0x96, 0x8c, 0x2d, 0xd3, 0x3e, 0xc4, 0xde, 0x6b, 0xaf, 0x35, 0x2c, 0xd9, 0x9e,
+	0x93, 0x0e, 0xf2, 0xcf, 0xdf, 0xdd, 0xf7, 0x9d, 0xef, 0xee, 0x3b, 0x41, 0x58, 0x1b, 0xfe, 0x20,
+	0xd5, 0x2e, 0x2d, 0x2b, 0x6d, 0x34, 0x06, 0x1d, 0x2c, 0xb7, 0xcb, 0xd7, 0x3b, 0xad, 0x77, 0x7b,
+	0x71, 0x63, 0x13, 0xdb, 0xe6, 0xfe, 0xc6, 0xc8, 0x42, 0xd4, 0x86, 0x17, 0xa5, 0xe3, 0xc6, 0xbf,
+	0x27, 0xe0, 0xdf, 0x35, 0xd9, 0x83, 0x30, 0xb8, 0x80, 0xa9, 0x54, 0xb9, 0x78, 0xa2, 0x24, 0x22,
+	0x89, 0xc7, 0x1c, 0xc0, 0x37, 0x10, 0x66, 0x5c, 0xe5, 0x32, 0xe7, 0x46, 0xfc, 0xe2, 0x85, 0xa0,
+	0x67, 0x11, 0x49, 0x02, 0x76, 0x18, 0x6c, 0xb5, 0xfa, 0x51, 0x89, 0x8a, 0x4e, 0x6c, 0xd6, 0x01,
+	0x8c, 0xe1, 0x45, 0xdb, 0x8a, 0xc8, 0xbf, 0x14, 0xba, 0x51, 0x86, 0x7a, 0x36, 0x79, 0x10, 0xc3,
+	0x6b, 0xb8, 0x70, 0xf8, 0x6b, 0x53, 0x71, 0x23, 0xb5, 0xa2, 0xd3, 0x88, 0x24, 0x21, 0x3b, 0x8a,
+	0xe2, 0x67, 0x80, 0xac, 0x12, 0xdc, 0x88, 0x8d, 0x2c, 0x04, 0xf5, 0x23, 0x92, 0x9c, 0xdf, 0x2e,
+	0x53, 0x37, 0x5e, 0xda, 0x8f, 0x97, 0x6e, 0xfa, 0xf1, 0xd8, 0x88, 0x8d, 0x77, 0xdd, 0x3f, 0xd6,
+	0x86, 0x57, 0xc6, 0xea, 0x67, 0x27, 0xf5, 0x47, 0x0a, 0xfc, 0x0e, 0x97, 0x8d, 0x3a, 0xaa, 0x32,
+	0x3f, 0x59, 0xe5, 0x99, 0x06, 0x5f, 0x41, 0xc0, 0x1b, 0xa3, 0xd7, 0x6d, 0x94, 0x06, 0x11, 0x49,
+	0xe6, 0xec, 0x5f, 0x20, 0xfe, 0x04, 0xe0, 0xdc, 0xf8, 0x29, 0x6b, 0x83, 0xef, 0x60, 0xb6, 0xb5,
+	0xa8, 0xa6, 0x24, 0x9a, 0x24, 0xe7, 0xb7, 0x57, 0xe9, 0x60, 0x6d, 0xea, 0x78, 0xac, 0x67, 0xc4,
+	0x6f, 0x21, 0x74, 0xa1, 0x1f, 0x2a, 0x97, 0x99, 0xa8, 0x91, 0xc2, 0x4c, 0xba, 0x4f, 0xab, 0xf6,
+	0x58, 0x0f, 0xe3, 0x3f, 0x04, 0x82, 0x55, 0xef, 0x1f, 0x22, 0x78, 0xaa, 0x35, 0x96, 0x58, 0x77,
+	0x3c, 0x75, 0xe0, 0xe7, 0xd9, 0xd8, 0xcf, 0x25, 0xcc, 0x75, 0x29, 0x2a, 0x6e, 0x74, 0x6f, 0xf4,
+	0x80, 0xf1, 0x25, 0xf8, 0x95, 0x78, 0xe4, 0x55, 0xde, 0xb9, 0xdc, 0x21, 0x4c, 0x01, 0x6b, 0xb1,
+	0xbf, 0xb7, 0xe3, 0x75, 0xfd, 0xe5, 0x4f, 0xd6, 0x63, 0x8f, 0xfd, 0x27, 0x83, 0xef, 0xe1, 0xca,
+	0x68, 0xc3, 0xf7, 0xeb, 0xf1, 0xe1, 0xf8, 0xb6, 0xe4, 0xf3, 0x44, 0xfc, 0x0d, 0xc2, 0x61, 0x10,
+	0xbb, 0xb2, 0x8f, 0x00, 0xc3, 0x65, 0xf6, 0x5b, 0x5b, 0x8c, 0xb6, 0x36, 0xb0, 0xd9, 0x88, 0x17,
+	0x5f, 0xc3, 0xc5, 0x6a, 0x7c, 0xcf, 0x75, 0xbb, 0x80, 0x76, 0x11, 0xae, 0x44, 0xc0, 0x1c, 0x88,
+	0x13, 0xb8, 0xdc, 0xb4, 0x3d, 0xb8, 0x76, 0x57, 0xf6, 0x80, 0x17, 0x30, 0xcd, 0x6c, 0x93, 0xdd,
+	0xb3, 0xb1, 0x60, 0xeb, 0xdb, 0x63, 0xf8, 0xf0, 0x77, 0x00, 0x52, 0x09, 0xea, 0xd4, 0x9b, 0x03,
+	0x00, 0x00,
+}
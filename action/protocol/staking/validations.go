@@ -0,0 +1,163 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+)
+
+const (
+	// MaxCandidateNameLength is the max length of a candidate name, which fits in the 12-byte name of a delegate
+	MaxCandidateNameLength = 12
+	// PayloadSizeLimit is the max size of the payload of a staking action
+	PayloadSizeLimit = 32 * 1024
+)
+
+// ErrInvalidCandidateName indicates that the candidate name isn't 1 to 12 lowercase letters and digits
+var ErrInvalidCandidateName = errors.New("invalid candidate name")
+
+func (p *Protocol) validateCreateStake(ctx context.Context, act *action.CreateStake) error {
+	g, err := p.validateCommon(ctx, act.GasPrice(), act.Payload())
+	if err != nil {
+		return err
+	}
+	if err := validateCandidateName(act.Candidate()); err != nil {
+		return err
+	}
+	if err := validateAmount(act.Amount(), minStakeAmount(g)); err != nil {
+		return err
+	}
+	return validateDuration(act.Duration(), g)
+}
+
+func (p *Protocol) validateReclaim(ctx context.Context, gasPrice *big.Int, payload []byte) error {
+	_, err := p.validateCommon(ctx, gasPrice, payload)
+	return err
+}
+
+func (p *Protocol) validateDepositToStake(ctx context.Context, act *action.DepositToStake) error {
+	if _, err := p.validateCommon(ctx, act.GasPrice(), act.Payload()); err != nil {
+		return err
+	}
+	return validateAmount(act.Amount(), big.NewInt(1))
+}
+
+func (p *Protocol) validateRestake(ctx context.Context, act *action.Restake) error {
+	g, err := p.validateCommon(ctx, act.GasPrice(), act.Payload())
+	if err != nil {
+		return err
+	}
+	return validateDuration(act.Duration(), g)
+}
+
+func (p *Protocol) validateChangeCandidate(ctx context.Context, act *action.ChangeCandidate) error {
+	if _, err := p.validateCommon(ctx, act.GasPrice(), act.Payload()); err != nil {
+		return err
+	}
+	return validateCandidateName(act.Candidate())
+}
+
+func (p *Protocol) validateTransferStake(ctx context.Context, act *action.TransferStake) error {
+	if _, err := p.validateCommon(ctx, act.GasPrice(), act.Payload()); err != nil {
+		return err
+	}
+	if _, err := address.FromString(act.VoterAddress()); err != nil {
+		return errors.Wrapf(err, "error when validating voter's address %s", act.VoterAddress())
+	}
+	return nil
+}
+
+func (p *Protocol) validateCandidateRegister(ctx context.Context, act *action.CandidateRegister) error {
+	g, err := p.validateCommon(ctx, act.GasPrice(), act.Payload())
+	if err != nil {
+		return err
+	}
+	if err := validateCandidateName(act.Name()); err != nil {
+		return err
+	}
+	if _, err := address.FromString(act.OperatorAddress()); err != nil {
+		return errors.Wrapf(err, "error when validating operator's address %s", act.OperatorAddress())
+	}
+	if _, err := address.FromString(act.RewardAddress()); err != nil {
+		return errors.Wrapf(err, "error when validating reward address %s", act.RewardAddress())
+	}
+	minSelfStake := minStakeAmount(g)
+	if g != nil {
+		minSelfStake = g.RegistrationMinSelfStake()
+	}
+	if err := validateAmount(act.Amount(), minSelfStake); err != nil {
+		return err
+	}
+	return validateDuration(act.Duration(), g)
+}
+
+// validateCommon validates the activation, gas price and payload shared by all the staking actions, and returns
+// the genesis config if it is available in the context
+func (p *Protocol) validateCommon(ctx context.Context, gasPrice *big.Int, payload []byte) (*genesis.Genesis, error) {
+	var g *genesis.Genesis
+	if bcCtx, ok := protocol.GetBlockchainCtx(ctx); ok {
+		g = &bcCtx.Genesis
+		// Reject staking action before it is activated
+		if blkCtx, ok := protocol.GetBlockCtx(ctx); ok {
+			hu := config.NewHeightUpgrade(g)
+			if hu.IsPre(config.Greenland, blkCtx.BlockHeight) {
+				return nil, errors.Wrap(action.ErrActPool, "native staking is not activated yet")
+			}
+		}
+	}
+	// Reject staking action of negative gas price
+	if gasPrice == nil || gasPrice.Sign() < 0 {
+		return nil, errors.Wrap(action.ErrGasPrice, "negative value")
+	}
+	// Reject oversized payload
+	if len(payload) > PayloadSizeLimit {
+		return nil, errors.Wrap(action.ErrActPool, "oversized data")
+	}
+	return g, nil
+}
+
+func validateCandidateName(name string) error {
+	if len(name) == 0 || len(name) > MaxCandidateNameLength {
+		return errors.Wrapf(ErrInvalidCandidateName, "name %s", name)
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z') && !(c >= '0' && c <= '9') {
+			return errors.Wrapf(ErrInvalidCandidateName, "name %s", name)
+		}
+	}
+	return nil
+}
+
+func validateAmount(amount *big.Int, min *big.Int) error {
+	if amount == nil || amount.Cmp(min) < 0 {
+		return errors.Wrapf(action.ErrBalance, "staked amount %s is less than %s", amount, min)
+	}
+	return nil
+}
+
+func validateDuration(duration uint32, g *genesis.Genesis) error {
+	if g != nil && duration > g.MaxStakeDuration {
+		return errors.Wrapf(ErrInvalidDuration, "duration %d is longer than %d days", duration, g.MaxStakeDuration)
+	}
+	return nil
+}
+
+func minStakeAmount(g *genesis.Genesis) *big.Int {
+	if g == nil {
+		return big.NewInt(1)
+	}
+	return g.MinStakeAmount()
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestProtocol_Validate(t *testing.T) {
+	require := require.New(t)
+
+	p := NewProtocol(nil)
+	g := config.Default.Genesis
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Genesis: g})
+	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: g.GreenlandBlockHeight})
+	addr := identityset.Address(28).String()
+	minStake := g.MinStakeAmount()
+	minSelfStake := g.RegistrationMinSelfStake()
+
+	newCreate := func(name string, amount *big.Int, duration uint32, payload []byte) action.Action {
+		act, err := action.NewCreateStake(1, name, amount, duration, false, payload, 100000, big.NewInt(1))
+		require.NoError(err)
+		return act
+	}
+	newRegister := func(name, operator string, amount *big.Int) action.Action {
+		act, err := action.NewCandidateRegister(1, name, operator, addr, amount, 7, false, nil, 100000,
+			big.NewInt(1))
+		require.NoError(err)
+		return act
+	}
+	newTransfer := func(voter string) action.Action {
+		act, err := action.NewTransferStake(1, voter, 0, nil, 100000, big.NewInt(1))
+		require.NoError(err)
+		return act
+	}
+	newDeposit := func(amount *big.Int) action.Action {
+		act, err := action.NewDepositToStake(1, 0, amount, nil, 100000, big.NewInt(1))
+		require.NoError(err)
+		return act
+	}
+	// the cause of an invalid address varies with how it is malformed
+	errInvalidAddr := errors.New("invalid address")
+	negativeGas, err := action.NewUnstake(1, 0, nil, 100000, big.NewInt(-1))
+	require.NoError(err)
+
+	tests := []struct {
+		act action.Action
+		err error
+	}{
+		{newCreate("robotbp00001", minStake, 7, nil), nil},
+		{newCreate("", minStake, 7, nil), ErrInvalidCandidateName},
+		{newCreate("robotbp000001", minStake, 7, nil), ErrInvalidCandidateName},
+		{newCreate("Robot", minStake, 7, nil), ErrInvalidCandidateName},
+		{newCreate("robot", new(big.Int).Sub(minStake, big.NewInt(1)), 7, nil), action.ErrBalance},
+		{newCreate("robot", minStake, g.MaxStakeDuration+1, nil), ErrInvalidDuration},
+		{newCreate("robot", minStake, 7, []byte(strings.Repeat("a", PayloadSizeLimit+1))), action.ErrActPool},
+		{newRegister("robot", addr, minSelfStake), nil},
+		{newRegister("robot", addr, minStake), action.ErrBalance},
+		{newRegister("robot", "io1invalid", minSelfStake), errInvalidAddr},
+		{newTransfer(addr), nil},
+		{newTransfer("io1invalid"), errInvalidAddr},
+		{newDeposit(big.NewInt(1)), nil},
+		{newDeposit(big.NewInt(0)), action.ErrBalance},
+		{negativeGas, action.ErrGasPrice},
+	}
+	for _, test := range tests {
+		err := p.Validate(ctx, test.act)
+		switch test.err {
+		case nil:
+			require.NoError(err)
+		case errInvalidAddr:
+			require.Error(err)
+		default:
+			require.Equal(test.err, errors.Cause(err))
+		}
+	}
+
+	// staking actions are rejected before the Greenland height
+	preCtx := protocol.WithBlockCtx(ctx, protocol.BlockCtx{BlockHeight: g.GreenlandBlockHeight - 1})
+	require.Equal(action.ErrActPool, errors.Cause(p.Validate(preCtx, newCreate("robot", minStake, 7, nil))))
+}
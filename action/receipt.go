@@ -22,6 +22,11 @@ type Receipt struct {
 	GasConsumed     uint64
 	ContractAddress string
 	Logs            []*Log
+	// ExecutionRevertMsg is the revert reason decoded by the node running the execution. It is not part of the
+	// consensus, as it is left out of the hash of the receipt, so a receipt served by another node may carry any.
+	ExecutionRevertMsg string
+	// GasPayer is the address of the sponsor who paid the gas, which is empty if the sender paid it
+	GasPayer string
 }
 
 // Log stores an evm contract event
@@ -47,6 +52,8 @@ func (receipt *Receipt) ConvertToReceiptPb() *iotextypes.Receipt {
 	for _, log := range receipt.Logs {
 		r.Logs = append(r.Logs, log.ConvertToLogPb())
 	}
+	r.ExecutionRevertMsg = receipt.ExecutionRevertMsg
+	r.GasPayer = receipt.GasPayer
 	return r
 }
 
@@ -63,6 +70,8 @@ func (receipt *Receipt) ConvertFromReceiptPb(pbReceipt *iotextypes.Receipt) {
 		receipt.Logs[i] = &Log{}
 		receipt.Logs[i].ConvertFromLogPb(log)
 	}
+	receipt.ExecutionRevertMsg = pbReceipt.GetExecutionRevertMsg()
+	receipt.GasPayer = pbReceipt.GetGasPayer()
 }
 
 // Serialize returns a serialized byte stream for the Receipt
@@ -80,9 +89,12 @@ func (receipt *Receipt) Deserialize(buf []byte) error {
 	return nil
 }
 
-// Hash returns the hash of receipt
+// Hash returns the hash of receipt. The revert reason is node-local and excluded, so it does not change the receipt root
+// of a block.
 func (receipt *Receipt) Hash() hash.Hash256 {
-	data, err := receipt.Serialize()
+	pbReceipt := receipt.ConvertToReceiptPb()
+	pbReceipt.ExecutionRevertMsg = ""
+	data, err := proto.Marshal(pbReceipt)
 	if err != nil {
 		log.L().Panic("Error when serializing a receipt")
 	}
@@ -12,6 +12,8 @@ import (
 
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
 )
 
 func TestConvert(t *testing.T) {
@@ -23,7 +25,14 @@ func TestConvert(t *testing.T) {
 		hash.Hash256b([]byte("Aleutian")),
 	}
 	log := &Log{"1", topics, []byte("cd07d8a74179e032f030d9244"), 1, hash.ZeroHash256, 1, true}
-	receipt := &Receipt{1, 1, hash.ZeroHash256, 1, "test", []*Log{log}}
+	receipt := &Receipt{
+		Status:          1,
+		BlockHeight:     1,
+		ActionHash:      hash.ZeroHash256,
+		GasConsumed:     1,
+		ContractAddress: "test",
+		Logs:            []*Log{log},
+	}
 
 	typeReipt := receipt.ConvertToReceiptPb()
 	require.NotNil(typeReipt)
@@ -48,7 +57,12 @@ func TestConvert(t *testing.T) {
 }
 func TestSerDer(t *testing.T) {
 	require := require.New(t)
-	receipt := &Receipt{1, 1, hash.ZeroHash256, 1, "", nil}
+	receipt := &Receipt{
+		Status:      1,
+		BlockHeight: 1,
+		ActionHash:  hash.ZeroHash256,
+		GasConsumed: 1,
+	}
 	ser, err := receipt.Serialize()
 	require.NoError(err)
 
@@ -63,6 +77,56 @@ func TestSerDer(t *testing.T) {
 	hash := receipt.Hash()
 	require.Equal("9b1d77d8b8902e8d4e662e7cd07d8a74179e032f030d92441ca7fba1ca68e0f4", hex.EncodeToString(hash[:]))
 }
+
+func TestReceiptRevertMsg(t *testing.T) {
+	require := require.New(t)
+	receipt := &Receipt{
+		Status:      106,
+		BlockHeight: 1,
+		ActionHash:  hash.ZeroHash256,
+		GasConsumed: 1,
+		Logs:        []*Log{},
+	}
+	h := receipt.Hash()
+
+	receipt.ExecutionRevertMsg = "not enough balance"
+	pbReceipt := receipt.ConvertToReceiptPb()
+	require.Equal(receipt.ExecutionRevertMsg, pbReceipt.ExecutionRevertMsg)
+	// the revert reason does not change the hash of the receipt
+	require.Equal(h, receipt.Hash())
+
+	ser, err := receipt.Serialize()
+	require.NoError(err)
+	receipt2 := &Receipt{}
+	require.NoError(receipt2.Deserialize(ser))
+	require.Equal(receipt, receipt2)
+}
+
+func TestReceiptGasPayer(t *testing.T) {
+	require := require.New(t)
+	receipt := &Receipt{
+		Status:             0,
+		BlockHeight:        1,
+		ActionHash:         hash.ZeroHash256,
+		GasConsumed:        1,
+		Logs:               []*Log{},
+		ExecutionRevertMsg: "not enough balance",
+	}
+	h := receipt.Hash()
+
+	receipt.GasPayer = identityset.Address(28).String()
+	pbReceipt := receipt.ConvertToReceiptPb()
+	require.Equal(receipt.GasPayer, pbReceipt.GasPayer)
+	require.Equal(receipt.ExecutionRevertMsg, pbReceipt.ExecutionRevertMsg)
+	require.NotEqual(h, receipt.Hash())
+
+	ser, err := receipt.Serialize()
+	require.NoError(err)
+	receipt2 := &Receipt{}
+	require.NoError(receipt2.Deserialize(ser))
+	require.Equal(receipt, receipt2)
+}
+
 func TestConvertLog(t *testing.T) {
 	require := require.New(t)
 
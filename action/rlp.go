@@ -0,0 +1,259 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+// ErrRLPRecipient indicates that an action encoded in RLP is a transfer to a contract, or an execution of an account
+// without code
+var ErrRLPRecipient = errors.New("type of action encoded in RLP doesn't match the code of its recipient")
+
+// DecodeRawTransaction decodes a signed Ethereum transaction encoded in RLP, as sent by web3 wallets, into an action
+// sealed in RLP encoding. The transaction must be replay-protected by EIP-155. A transaction without recipient, or to a
+// recipient which is a contract as told by isContract, is converted to an execution, and the others to transfers with
+// the data as payload, the same way rlpTransaction converts them back.
+func DecodeRawTransaction(raw []byte, isContract func(string) (bool, error)) (SealedEnvelope, error) {
+	tx := &types.Transaction{}
+	if err := rlp.DecodeBytes(raw, tx); err != nil {
+		return SealedEnvelope{}, errors.Wrap(err, "failed to decode RLP transaction")
+	}
+	if !tx.Protected() {
+		return SealedEnvelope{}, errors.Wrap(ErrAction, "RLP transaction is not replay-protected by EIP-155")
+	}
+	id := tx.ChainId()
+	if !id.IsUint64() || id.Uint64() == 0 || id.Uint64() > math.MaxUint32 {
+		return SealedEnvelope{}, errors.Errorf("invalid chain ID %s", id)
+	}
+	chainID := uint32(id.Uint64())
+	bd := &EnvelopeBuilder{}
+	bd.SetVersion(version.ProtocolVersion).
+		SetNonce(tx.Nonce()).
+		SetGasLimit(tx.Gas()).
+		SetGasPrice(tx.GasPrice()).
+		SetChainID(chainID)
+	if tx.To() == nil {
+		exec, err := NewExecution(EmptyAddress, tx.Nonce(), tx.Value(), tx.Gas(), tx.GasPrice(), tx.Data())
+		if err != nil {
+			return SealedEnvelope{}, err
+		}
+		bd.SetAction(exec)
+	} else {
+		recipient, err := address.FromBytes(tx.To().Bytes())
+		if err != nil {
+			return SealedEnvelope{}, err
+		}
+		contract, err := isContract(recipient.String())
+		if err != nil {
+			return SealedEnvelope{}, errors.Wrapf(err, "failed to check whether %s is a contract", recipient.String())
+		}
+		if contract {
+			exec, err := NewExecution(recipient.String(), tx.Nonce(), tx.Value(), tx.Gas(), tx.GasPrice(), tx.Data())
+			if err != nil {
+				return SealedEnvelope{}, err
+			}
+			bd.SetAction(exec)
+		} else {
+			tsf, err := NewTransfer(tx.Nonce(), tx.Value(), recipient.String(), tx.Data(), tx.Gas(), tx.GasPrice())
+			if err != nil {
+				return SealedEnvelope{}, err
+			}
+			bd.SetAction(tsf)
+		}
+	}
+	elp := bd.Build()
+
+	// recover the public key of the sender from the signature in [R || S || V] format where V is 0 or 1
+	signer := rlpSigner(chainID)
+	v, r, s := tx.RawSignatureValues()
+	recID := new(big.Int).Sub(v, new(big.Int).Add(new(big.Int).Mul(id, big.NewInt(2)), big.NewInt(35)))
+	if !recID.IsUint64() || recID.Uint64() > 1 || !ethcrypto.ValidateSignatureValues(byte(recID.Uint64()), r, s, true) {
+		return SealedEnvelope{}, errors.Wrap(ErrAction, "invalid signature of RLP transaction")
+	}
+	sig := make([]byte, SignatureLength)
+	copy(sig[32-len(r.Bytes()):32], r.Bytes())
+	copy(sig[64-len(s.Bytes()):64], s.Bytes())
+	sig[64] = byte(recID.Uint64())
+	h := signer.Hash(tx)
+	pubBytes, err := ethcrypto.Ecrecover(h[:], sig)
+	if err != nil {
+		return SealedEnvelope{}, errors.Wrap(err, "failed to recover public key of RLP transaction")
+	}
+	pubKey, err := crypto.BytesToPublicKey(pubBytes)
+	if err != nil {
+		return SealedEnvelope{}, err
+	}
+	sealed := SealedEnvelope{
+		Envelope:  elp,
+		srcPubkey: pubKey,
+		signature: sig,
+		encoding:  iotextypes.Encoding_ETHEREUM_RLP,
+	}
+	sealed.payload.SetEnvelopeContext(sealed)
+	return sealed, nil
+}
+
+// VerifyRLPRecipient verifies that an action encoded in RLP to a recipient which is a contract, as told by isContract, is
+// an execution, and a transfer otherwise. A transfer and an execution convert to the same Ethereum transaction, so the
+// signature of the sender does not bind the type of the action, which is bound to the code of the recipient instead,
+// the same way DecodeRawTransaction converts the transaction.
+func VerifyRLPRecipient(sealed SealedEnvelope, isContract func(string) (bool, error)) error {
+	if sealed.Encoding() != iotextypes.Encoding_ETHEREUM_RLP {
+		return nil
+	}
+	var (
+		recipient string
+		execution bool
+	)
+	switch act := sealed.Action().(type) {
+	case *Transfer:
+		recipient = act.Recipient()
+	case *Execution:
+		if act.Contract() == EmptyAddress {
+			return nil
+		}
+		recipient, execution = act.Contract(), true
+	default:
+		// the other actions cannot be encoded in RLP
+		return nil
+	}
+	contract, err := isContract(recipient)
+	if err != nil {
+		return errors.Wrapf(err, "failed to check whether %s is a contract", recipient)
+	}
+	if contract != execution {
+		return errors.Wrapf(ErrRLPRecipient, "%T to %s", sealed.Action(), recipient)
+	}
+	return nil
+}
+
+// SignRLP signs the transfer or execution with the key of its sender over the Ethereum transaction it converts to, in
+// the same way as a web3 wallet does
+func SignRLP(act Envelope, sk crypto.PrivateKey) (SealedEnvelope, error) {
+	sealed := SealedEnvelope{Envelope: act, encoding: iotextypes.Encoding_ETHEREUM_RLP}
+	sealed.srcPubkey = sk.PublicKey()
+	hash, err := rlpSigningHash(act)
+	if err != nil {
+		return sealed, err
+	}
+	sig, err := sk.Sign(hash[:])
+	if err != nil {
+		return sealed, errors.Wrapf(ErrAction, "failed to sign RLP signing hash = %x", hash)
+	}
+	sealed.signature = sig
+	sealed.payload.SetEnvelopeContext(sealed)
+	return sealed, nil
+}
+
+// rlpTransaction converts the envelope of a transfer or an execution to the unsigned Ethereum transaction, with the
+// payload of a transfer as data
+func rlpTransaction(elp Envelope) (*types.Transaction, error) {
+	switch act := elp.Action().(type) {
+	case *Transfer:
+		to, err := address.FromString(act.Recipient())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewTransaction(
+			elp.Nonce(), common.BytesToAddress(to.Bytes()), act.Amount(), elp.GasLimit(), elp.GasPrice(), act.Payload(),
+		), nil
+	case *Execution:
+		if act.Contract() == EmptyAddress {
+			return types.NewContractCreation(elp.Nonce(), act.Amount(), elp.GasLimit(), elp.GasPrice(), act.Data()), nil
+		}
+		to, err := address.FromString(act.Contract())
+		if err != nil {
+			return nil, err
+		}
+		return types.NewTransaction(
+			elp.Nonce(), common.BytesToAddress(to.Bytes()), act.Amount(), elp.GasLimit(), elp.GasPrice(), act.Data(),
+		), nil
+	default:
+		return nil, errors.Wrapf(ErrAction, "action %T cannot be encoded in RLP", act)
+	}
+}
+
+// rlpSigner returns the EIP-155 signer of the chain
+func rlpSigner(chainID uint32) types.Signer {
+	return types.NewEIP155Signer(big.NewInt(int64(chainID)))
+}
+
+// rlpSigningHash returns the hash signed by the sender of an action encoded in RLP
+func rlpSigningHash(elp Envelope) (hash.Hash256, error) {
+	tx, err := rlpTransaction(elp)
+	if err != nil {
+		return hash.ZeroHash256, err
+	}
+	h := rlpSigner(elp.ChainID()).Hash(tx)
+	return hash.BytesToHash256(h[:]), nil
+}
+
+// rlpHash returns the hash of the signed Ethereum transaction of an action encoded in RLP
+func rlpHash(sealed SealedEnvelope) (hash.Hash256, error) {
+	tx, err := rlpTransaction(sealed.Envelope)
+	if err != nil {
+		return hash.ZeroHash256, err
+	}
+	if len(sealed.signature) != SignatureLength {
+		return hash.ZeroHash256, errors.New("incorrect length of signature")
+	}
+	sig := make([]byte, SignatureLength)
+	copy(sig, sealed.signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	if tx, err = tx.WithSignature(rlpSigner(sealed.ChainID()), sig); err != nil {
+		return hash.ZeroHash256, err
+	}
+	h := tx.Hash()
+	return hash.BytesToHash256(h[:]), nil
+}
+
+func verifyRLPSender(sealed SealedEnvelope) error {
+	if sealed.IsMultisig() || sealed.IsSponsored() {
+		return errors.Wrap(ErrAction, "action encoded in RLP can be neither multisig nor sponsored")
+	}
+	if sealed.ChainID() == 0 {
+		return errors.Wrap(ErrAction, "action encoded in RLP is not replay-protected by EIP-155")
+	}
+	if _, err := rlpHash(sealed); err != nil {
+		return errors.Wrapf(ErrAction, "failed to hash action encoded in RLP: %v", err)
+	}
+	hash, err := rlpSigningHash(sealed.Envelope)
+	if err != nil {
+		return err
+	}
+	if len(sealed.Signature()) != SignatureLength {
+		return errors.New("incorrect length of signature")
+	}
+	if sealed.SrcPubkey() == nil {
+		return errors.New("empty public key")
+	}
+	if sealed.SrcPubkey().Verify(hash[:], sealed.Signature()) {
+		return nil
+	}
+	return errors.Wrapf(
+		ErrAction,
+		"failed to verify RLP signing hash = %x and signature = %x",
+		hash,
+		sealed.Signature(),
+	)
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestDecodeRawTransaction(t *testing.T) {
+	require := require.New(t)
+	sk, err := ethcrypto.ToECDSA(identityset.PrivateKey(27).Bytes())
+	require.NoError(err)
+	to := common.BytesToAddress(identityset.Address(28).Bytes())
+	contract := common.BytesToAddress(identityset.Address(29).Bytes())
+	isContract := func(addr string) (bool, error) {
+		return addr == identityset.Address(29).String(), nil
+	}
+
+	for _, test := range []struct {
+		tx       *types.Transaction
+		signer   types.Signer
+		transfer bool
+	}{
+		{types.NewTransaction(1, to, big.NewInt(10), 21000, big.NewInt(100), nil), types.NewEIP155Signer(big.NewInt(4689)), true},
+		{types.NewTransaction(2, to, big.NewInt(0), 50000, big.NewInt(100), []byte{1, 2}), types.NewEIP155Signer(big.NewInt(1)), true},
+		{types.NewTransaction(3, contract, big.NewInt(0), 50000, big.NewInt(100), []byte{1, 2}), types.NewEIP155Signer(big.NewInt(1)), false},
+		{types.NewTransaction(4, contract, big.NewInt(10), 50000, big.NewInt(100), nil), types.NewEIP155Signer(big.NewInt(1)), false},
+		{types.NewContractCreation(5, big.NewInt(0), 90000, big.NewInt(100), []byte{3, 4}), types.NewEIP155Signer(big.NewInt(1)), false},
+	} {
+		tx, err := types.SignTx(test.tx, test.signer, sk)
+		require.NoError(err)
+		raw, err := rlp.EncodeToBytes(tx)
+		require.NoError(err)
+		selp, err := DecodeRawTransaction(raw, isContract)
+		require.NoError(err)
+		require.Equal(iotextypes.Encoding_ETHEREUM_RLP, selp.Encoding())
+		require.Equal(identityset.PrivateKey(27).PublicKey().Hash(), selp.SrcPubkey().Hash())
+		require.Equal(tx.Nonce(), selp.Nonce())
+		require.Equal(tx.Gas(), selp.GasLimit())
+		require.NoError(Verify(selp))
+		h := selp.Hash()
+		require.Equal(tx.Hash().Bytes(), h[:])
+		switch act := selp.Action().(type) {
+		case *Transfer:
+			require.True(test.transfer)
+			require.Equal(identityset.Address(28).String(), act.Recipient())
+			require.Equal(tx.Value(), act.Amount())
+			require.True(bytes.Equal(tx.Data(), act.Payload()))
+		case *Execution:
+			require.False(test.transfer)
+			require.True(bytes.Equal(tx.Data(), act.Data()))
+			if tx.To() == nil {
+				require.Equal(EmptyAddress, act.Contract())
+			} else {
+				require.Equal(identityset.Address(29).String(), act.Contract())
+			}
+		default:
+			require.FailNow("unexpected action")
+		}
+
+		// the encoding survives the serialization
+		buf, err := proto.Marshal(selp.Proto())
+		require.NoError(err)
+		pb := &iotextypes.Action{}
+		require.NoError(proto.Unmarshal(buf, pb))
+		var loaded SealedEnvelope
+		require.NoError(loaded.LoadProto(pb))
+		require.Equal(h, loaded.Hash())
+		require.NoError(Verify(loaded))
+
+		// the signature is not valid over the IoTeX hash
+		pb.Encoding = iotextypes.Encoding_IOTEX_PROTOBUF
+		require.NoError(loaded.LoadProto(pb))
+		require.Error(Verify(loaded))
+		// nor over another transaction
+		pb.Encoding = iotextypes.Encoding_ETHEREUM_RLP
+		pb.Core.Nonce++
+		require.NoError(loaded.LoadProto(pb))
+		require.Error(Verify(loaded))
+	}
+
+	// a transaction without replay protection is rejected
+	tx, err := types.SignTx(types.NewTransaction(1, to, big.NewInt(10), 21000, big.NewInt(100), nil), types.HomesteadSigner{}, sk)
+	require.NoError(err)
+	raw, err := rlp.EncodeToBytes(tx)
+	require.NoError(err)
+	_, err = DecodeRawTransaction(raw, isContract)
+	require.Equal(ErrAction, errors.Cause(err))
+
+	_, err = DecodeRawTransaction([]byte{1, 2, 3}, isContract)
+	require.Error(err)
+}
+
+func TestSignRLP(t *testing.T) {
+	require := require.New(t)
+	tsf, err := NewTransfer(1, big.NewInt(10), identityset.Address(28).String(), nil, 21000, big.NewInt(100))
+	require.NoError(err)
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetNonce(1).SetGasLimit(21000).SetGasPrice(big.NewInt(100)).SetChainID(4689).SetAction(tsf).Build()
+	selp, err := SignRLP(elp, identityset.PrivateKey(27))
+	require.NoError(err)
+	require.NoError(Verify(selp))
+
+	// an action without chain ID is not replay-protected
+	selp, err = SignRLP(bd.SetChainID(0).Build(), identityset.PrivateKey(27))
+	require.NoError(err)
+	require.Equal(ErrAction, errors.Cause(Verify(selp)))
+
+	// only transfers and executions can be encoded in RLP
+	unstake, err := NewUnstake(1, 0, nil, 21000, big.NewInt(100))
+	require.NoError(err)
+	_, err = SignRLP(bd.SetAction(unstake).Build(), identityset.PrivateKey(27))
+	require.Error(err)
+
+	// an action encoded in RLP which cannot be hashed as an Ethereum transaction is invalid, instead of falling back
+	// to the hash of its protobuf
+	selp, err = SignRLP(bd.SetChainID(4689).SetAction(tsf).Build(), identityset.PrivateKey(27))
+	require.NoError(err)
+	pb := selp.Proto()
+	pb.Signature = pb.Signature[:SignatureLength-1]
+	var loaded SealedEnvelope
+	require.Error(loaded.LoadProto(pb))
+	unhashable := selp
+	unhashable.signature = pb.Signature
+	require.Equal(hash.ZeroHash256, unhashable.Hash())
+	require.Error(Verify(unhashable))
+	pb = (&SealedEnvelope{
+		Envelope:  bd.SetAction(unstake).Build(),
+		srcPubkey: selp.SrcPubkey(),
+		signature: selp.Signature(),
+		encoding:  iotextypes.Encoding_ETHEREUM_RLP,
+	}).Proto()
+	require.Equal(ErrAction, errors.Cause(loaded.LoadProto(pb)))
+}
+
+func TestVerifyRLPRecipient(t *testing.T) {
+	require := require.New(t)
+	recipient := identityset.Address(28).String()
+	tsf, err := NewTransfer(1, big.NewInt(10), recipient, []byte{1}, 100000, big.NewInt(100))
+	require.NoError(err)
+	exec, err := NewExecution(recipient, 1, big.NewInt(10), 100000, big.NewInt(100), []byte{1})
+	require.NoError(err)
+	bd := &EnvelopeBuilder{}
+	selp, err := SignRLP(bd.SetNonce(1).SetGasLimit(100000).SetGasPrice(big.NewInt(100)).SetChainID(4689).
+		SetAction(exec).Build(), identityset.PrivateKey(27))
+	require.NoError(err)
+
+	// the transaction signed for an execution is as well signed for the transfer swapped in
+	swapped := SealedEnvelope{
+		Envelope:  bd.SetAction(tsf).Build(),
+		srcPubkey: selp.SrcPubkey(),
+		signature: selp.Signature(),
+		encoding:  iotextypes.Encoding_ETHEREUM_RLP,
+	}
+	require.NoError(Verify(swapped))
+	require.Equal(selp.Hash(), swapped.Hash())
+
+	contract := func(string) (bool, error) { return true, nil }
+	account := func(string) (bool, error) { return false, nil }
+	require.NoError(VerifyRLPRecipient(selp, contract))
+	require.Equal(ErrRLPRecipient, errors.Cause(VerifyRLPRecipient(selp, account)))
+	require.NoError(VerifyRLPRecipient(swapped, account))
+	require.Equal(ErrRLPRecipient, errors.Cause(VerifyRLPRecipient(swapped, contract)))
+	require.Error(VerifyRLPRecipient(selp, func(string) (bool, error) { return false, errors.New("error") }))
+
+	// deployments and actions not encoded in RLP have no recipient to check
+	deploy, err := NewExecution(EmptyAddress, 1, big.NewInt(10), 100000, big.NewInt(100), []byte{1})
+	require.NoError(err)
+	selp, err = SignRLP(bd.SetAction(deploy).Build(), identityset.PrivateKey(27))
+	require.NoError(err)
+	require.NoError(VerifyRLPRecipient(selp, contract))
+	selp, err = Sign(bd.SetAction(tsf).Build(), identityset.PrivateKey(27))
+	require.NoError(err)
+	require.NoError(VerifyRLPRecipient(selp, contract))
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+const (
+	// ScheduleActionBaseIntrinsicGas represents the base intrinsic gas for scheduling an action, to which the gas limit
+	// of the scheduled contract call is added
+	ScheduleActionBaseIntrinsicGas = uint64(10000)
+	// CancelScheduledActionIntrinsicGas represents the intrinsic gas for cancelling a scheduled action
+	CancelScheduledActionIntrinsicGas = uint64(10000)
+)
+
+type (
+	// ScheduleAction defines the action of scheduling a transfer, or a contract call if the gas limit of the call is
+	// set, at a future height. The amount is escrowed and the gas of the call is paid when it is scheduled.
+	ScheduleAction struct {
+		AbstractAction
+
+		targetHeight uint64
+		recipient    string
+		amount       *big.Int
+		data         []byte
+		callGasLimit uint64
+	}
+
+	// CancelScheduledAction defines the action of cancelling a scheduled action before it is executed
+	CancelScheduledAction struct {
+		AbstractAction
+
+		id uint64
+	}
+
+	// ExecuteScheduledActions is the system action executing the scheduled actions due at the height
+	ExecuteScheduledActions struct {
+		AbstractAction
+
+		height uint64
+	}
+)
+
+// NewScheduleAction returns a ScheduleAction instance
+func NewScheduleAction(
+	nonce uint64,
+	targetHeight uint64,
+	recipient string,
+	amount *big.Int,
+	data []byte,
+	callGasLimit uint64,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*ScheduleAction, error) {
+	return &ScheduleAction{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		targetHeight: targetHeight,
+		recipient:    recipient,
+		amount:       amount,
+		data:         data,
+		callGasLimit: callGasLimit,
+	}, nil
+}
+
+// TargetHeight returns the height at which the action is executed
+func (sa *ScheduleAction) TargetHeight() uint64 { return sa.targetHeight }
+
+// Recipient returns the recipient of the transfer, or the contract to call
+func (sa *ScheduleAction) Recipient() string { return sa.recipient }
+
+// Amount returns the amount
+func (sa *ScheduleAction) Amount() *big.Int { return sa.amount }
+
+// Data returns the data of the contract call
+func (sa *ScheduleAction) Data() []byte { return sa.data }
+
+// CallGasLimit returns the gas limit of the contract call, which is zero for a transfer
+func (sa *ScheduleAction) CallGasLimit() uint64 { return sa.callGasLimit }
+
+// IsCall returns true if the scheduled action is a contract call rather than a transfer
+func (sa *ScheduleAction) IsCall() bool { return sa.callGasLimit > 0 }
+
+// Serialize returns a raw byte stream of the ScheduleAction
+func (sa *ScheduleAction) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(sa.Proto()))
+}
+
+// Proto converts ScheduleAction to protobuf's ScheduleAction
+func (sa *ScheduleAction) Proto() *iotextypes.ScheduleAction {
+	act := &iotextypes.ScheduleAction{
+		TargetHeight: sa.targetHeight,
+		Recipient:    sa.recipient,
+		Data:         sa.data,
+		CallGasLimit: sa.callGasLimit,
+	}
+	if sa.amount != nil {
+		act.Amount = sa.amount.String()
+	}
+	return act
+}
+
+// LoadProto converts a protobuf's ScheduleAction to ScheduleAction
+func (sa *ScheduleAction) LoadProto(pbAct *iotextypes.ScheduleAction) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if sa == nil {
+		return errors.New("nil action to load proto")
+	}
+	*sa = ScheduleAction{}
+	amount, ok := new(big.Int).SetString(pbAct.GetAmount(), 10)
+	if !ok {
+		return errors.Errorf("invalid amount %s", pbAct.GetAmount())
+	}
+	sa.targetHeight = pbAct.GetTargetHeight()
+	sa.recipient = pbAct.GetRecipient()
+	sa.amount = amount
+	sa.data = pbAct.GetData()
+	sa.callGasLimit = pbAct.GetCallGasLimit()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a ScheduleAction, which includes the gas limit of the scheduled call
+func (sa *ScheduleAction) IntrinsicGas() (uint64, error) {
+	dataSize := uint64(len(sa.data))
+	if (math.MaxUint64-ScheduleActionBaseIntrinsicGas)/TransferPayloadGas < dataSize {
+		return 0, ErrOutOfGas
+	}
+	gas := ScheduleActionBaseIntrinsicGas + dataSize*TransferPayloadGas
+	if math.MaxUint64-gas < sa.callGasLimit {
+		return 0, ErrOutOfGas
+	}
+	return gas + sa.callGasLimit, nil
+}
+
+// Cost returns the total cost of a ScheduleAction
+func (sa *ScheduleAction) Cost() (*big.Int, error) {
+	intrinsicGas, err := sa.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the ScheduleAction")
+	}
+	fee := big.NewInt(0).Mul(sa.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return fee.Add(fee, sa.amount), nil
+}
+
+// NewCancelScheduledAction returns a CancelScheduledAction instance
+func NewCancelScheduledAction(
+	nonce uint64,
+	id uint64,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*CancelScheduledAction, error) {
+	return &CancelScheduledAction{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		id: id,
+	}, nil
+}
+
+// ID returns the id of the scheduled action to cancel
+func (ca *CancelScheduledAction) ID() uint64 { return ca.id }
+
+// Serialize returns a raw byte stream of the CancelScheduledAction
+func (ca *CancelScheduledAction) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(ca.Proto()))
+}
+
+// Proto converts CancelScheduledAction to protobuf's CancelScheduledAction
+func (ca *CancelScheduledAction) Proto() *iotextypes.CancelScheduledAction {
+	return &iotextypes.CancelScheduledAction{Id: ca.id}
+}
+
+// LoadProto converts a protobuf's CancelScheduledAction to CancelScheduledAction
+func (ca *CancelScheduledAction) LoadProto(pbAct *iotextypes.CancelScheduledAction) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if ca == nil {
+		return errors.New("nil action to load proto")
+	}
+	*ca = CancelScheduledAction{id: pbAct.GetId()}
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a CancelScheduledAction
+func (ca *CancelScheduledAction) IntrinsicGas() (uint64, error) {
+	return CancelScheduledActionIntrinsicGas, nil
+}
+
+// Cost returns the total cost of a CancelScheduledAction
+func (ca *CancelScheduledAction) Cost() (*big.Int, error) {
+	return big.NewInt(0).Mul(ca.GasPrice(), big.NewInt(0).SetUint64(CancelScheduledActionIntrinsicGas)), nil
+}
+
+// NewExecuteScheduledActions returns an ExecuteScheduledActions instance
+func NewExecuteScheduledActions(height uint64) *ExecuteScheduledActions {
+	return &ExecuteScheduledActions{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			gasPrice: big.NewInt(0),
+		},
+		height: height,
+	}
+}
+
+// Height returns the height of the block executing the scheduled actions
+func (ea *ExecuteScheduledActions) Height() uint64 { return ea.height }
+
+// Serialize returns a raw byte stream of the ExecuteScheduledActions
+func (ea *ExecuteScheduledActions) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(ea.Proto()))
+}
+
+// Proto converts ExecuteScheduledActions to protobuf's ExecuteScheduledActions
+func (ea *ExecuteScheduledActions) Proto() *iotextypes.ExecuteScheduledActions {
+	return &iotextypes.ExecuteScheduledActions{Height: ea.height}
+}
+
+// LoadProto converts a protobuf's ExecuteScheduledActions to ExecuteScheduledActions
+func (ea *ExecuteScheduledActions) LoadProto(pbAct *iotextypes.ExecuteScheduledActions) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if ea == nil {
+		return errors.New("nil action to load proto")
+	}
+	*ea = ExecuteScheduledActions{height: pbAct.GetHeight()}
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of an ExecuteScheduledActions, which is 0 as the scheduled actions have
+// been paid for
+func (*ExecuteScheduledActions) IntrinsicGas() (uint64, error) {
+	return 0, nil
+}
+
+// Cost returns the total cost of an ExecuteScheduledActions
+func (*ExecuteScheduledActions) Cost() (*big.Int, error) {
+	return big.NewInt(0), nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestScheduleAction(t *testing.T) {
+	require := require.New(t)
+	senderKey := identityset.PrivateKey(27)
+	contract := identityset.Address(28).String()
+
+	sa, err := NewScheduleAction(1, 100, contract, big.NewInt(10), []byte{1, 2}, 50000, 100000, big.NewInt(10))
+	require.NoError(err)
+	require.True(sa.IsCall())
+	gas, err := sa.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(ScheduleActionBaseIntrinsicGas+2*TransferPayloadGas+50000, gas)
+	cs, err := sa.Cost()
+	require.NoError(err)
+	require.Equal(new(big.Int).SetUint64(10*gas+10), cs)
+
+	// the scheduled action survives the round trip through the action proto
+	selp, err := Sign((&EnvelopeBuilder{}).SetNonce(1).
+		SetGasLimit(100000).
+		SetGasPrice(big.NewInt(10)).
+		SetAction(sa).Build(), senderKey)
+	require.NoError(err)
+	selp2 := SealedEnvelope{}
+	require.NoError(selp2.LoadProto(selp.Proto()))
+	require.Equal(selp.Hash(), selp2.Hash())
+	sa2, ok := selp2.Action().(*ScheduleAction)
+	require.True(ok)
+	require.Equal(uint64(100), sa2.TargetHeight())
+	require.Equal(contract, sa2.Recipient())
+	require.Equal(big.NewInt(10), sa2.Amount())
+	require.Equal([]byte{1, 2}, sa2.Data())
+	require.Equal(uint64(50000), sa2.CallGasLimit())
+
+	// an invalid amount fails to load
+	pb := sa.Proto()
+	pb.Amount = "ten"
+	require.Error(sa2.LoadProto(pb))
+
+	ca, err := NewCancelScheduledAction(2, 7, 100000, big.NewInt(10))
+	require.NoError(err)
+	cs, err = ca.Cost()
+	require.NoError(err)
+	require.Equal(new(big.Int).SetUint64(10*CancelScheduledActionIntrinsicGas), cs)
+	selp, err = Sign((&EnvelopeBuilder{}).SetNonce(2).
+		SetGasLimit(100000).
+		SetGasPrice(big.NewInt(10)).
+		SetAction(ca).Build(), senderKey)
+	require.NoError(err)
+	require.NoError(selp2.LoadProto(selp.Proto()))
+	ca2, ok := selp2.Action().(*CancelScheduledAction)
+	require.True(ok)
+	require.Equal(uint64(7), ca2.ID())
+
+	selp, err = Sign((&EnvelopeBuilder{}).SetGasPrice(big.NewInt(0)).
+		SetAction(NewExecuteScheduledActions(100)).Build(), senderKey)
+	require.NoError(err)
+	require.NoError(selp2.LoadProto(selp.Proto()))
+	ea, ok := selp2.Action().(*ExecuteScheduledActions)
+	require.True(ok)
+	require.Equal(uint64(100), ea.Height())
+	gas, err = ea.IntrinsicGas()
+	require.NoError(err)
+	require.Zero(gas)
+}
@@ -17,13 +17,34 @@ type SealedEnvelope struct {
 
 	srcPubkey crypto.PublicKey
 	signature []byte
+	// cosignatures replace the signature for an account under a multisig policy
+	cosignatures []Cosignature
+	// sponsorPubkey and sponsorSignature belong to the account paying the gas of a sponsored action
+	sponsorPubkey    crypto.PublicKey
+	sponsorSignature []byte
+	// sponsorCosignatures replace the signature of the sponsor under a multisig policy
+	sponsorCosignatures []Cosignature
+	// encoding is what the signature is computed over
+	encoding iotextypes.Encoding
 }
 
-// Hash returns the hash value of SealedEnvelope.
+// Hash returns the hash value of SealedEnvelope. It is the hash of the Ethereum transaction if the action is encoded
+// in RLP, so that web3 tools find the action by the hash they compute, and the zero hash if the action cannot be
+// converted to one, which LoadProto and Verify reject.
 func (sealed *SealedEnvelope) Hash() hash.Hash256 {
+	if sealed.encoding == iotextypes.Encoding_ETHEREUM_RLP {
+		h, err := rlpHash(*sealed)
+		if err != nil {
+			return hash.ZeroHash256
+		}
+		return h
+	}
 	return hash.Hash256b(byteutil.Must(proto.Marshal(sealed.Proto())))
 }
 
+// Encoding returns the encoding the signature of the action is computed over
+func (sealed *SealedEnvelope) Encoding() iotextypes.Encoding { return sealed.encoding }
+
 // SrcPubkey returns the source public key
 func (sealed *SealedEnvelope) SrcPubkey() crypto.PublicKey { return sealed.srcPubkey }
 
@@ -34,13 +55,59 @@ func (sealed *SealedEnvelope) Signature() []byte {
 	return sig
 }
 
+// Cosignatures returns the cosignatures of a multisig account
+func (sealed *SealedEnvelope) Cosignatures() []Cosignature {
+	cosigs := make([]Cosignature, len(sealed.cosignatures))
+	copy(cosigs, sealed.cosignatures)
+	return cosigs
+}
+
+// IsMultisig returns true if the action is signed by the keys of a multisig account instead of its own key
+func (sealed *SealedEnvelope) IsMultisig() bool { return len(sealed.cosignatures) > 0 }
+
+// SponsorPubkey returns the public key of the sponsor, which is nil if the action is not sponsored
+func (sealed *SealedEnvelope) SponsorPubkey() crypto.PublicKey { return sealed.sponsorPubkey }
+
+// SponsorSignature returns the signature of the sponsor
+func (sealed *SealedEnvelope) SponsorSignature() []byte {
+	sig := make([]byte, len(sealed.sponsorSignature))
+	copy(sig, sealed.sponsorSignature)
+	return sig
+}
+
+// SponsorCosignatures returns the cosignatures of a multisig sponsor
+func (sealed *SealedEnvelope) SponsorCosignatures() []Cosignature {
+	cosigs := make([]Cosignature, len(sealed.sponsorCosignatures))
+	copy(cosigs, sealed.sponsorCosignatures)
+	return cosigs
+}
+
+// IsMultisigSponsored returns true if the action is sponsored by a multisig account, signed by its keys instead of
+// its own key
+func (sealed *SealedEnvelope) IsMultisigSponsored() bool { return len(sealed.sponsorCosignatures) > 0 }
+
+// IsSponsored returns true if the gas of the action is paid by a sponsor instead of its sender
+func (sealed *SealedEnvelope) IsSponsored() bool { return sealed.sponsorPubkey != nil }
+
 // Proto converts it to it's proto scheme.
 func (sealed *SealedEnvelope) Proto() *iotextypes.Action {
-	return &iotextypes.Action{
+	act := &iotextypes.Action{
 		Core:         sealed.Envelope.Proto(),
 		SenderPubKey: sealed.srcPubkey.Bytes(),
 		Signature:    sealed.signature,
+		Encoding:     sealed.encoding,
+	}
+	for _, cosig := range sealed.cosignatures {
+		act.Cosignatures = append(act.Cosignatures, cosig.Proto())
 	}
+	if sealed.IsSponsored() {
+		act.SponsorPubKey = sealed.sponsorPubkey.Bytes()
+		act.SponsorSignature = sealed.sponsorSignature
+		for _, cosig := range sealed.sponsorCosignatures {
+			act.SponsorCosignatures = append(act.SponsorCosignatures, cosig.Proto())
+		}
+	}
+	return act
 }
 
 // LoadProto loads from proto scheme.
@@ -60,9 +127,37 @@ func (sealed *SealedEnvelope) LoadProto(pbAct *iotextypes.Action) error {
 	sealed.srcPubkey = srcPub
 	sealed.signature = make([]byte, len(pbAct.GetSignature()))
 	copy(sealed.signature, pbAct.GetSignature())
+	sealed.encoding = pbAct.GetEncoding()
+	for _, pbCosig := range pbAct.GetCosignatures() {
+		cosig := Cosignature{}
+		if err := cosig.LoadProto(pbCosig); err != nil {
+			return err
+		}
+		sealed.cosignatures = append(sealed.cosignatures, cosig)
+	}
+	if len(pbAct.GetSponsorPubKey()) > 0 {
+		if sealed.sponsorPubkey, err = crypto.BytesToPublicKey(pbAct.GetSponsorPubKey()); err != nil {
+			return err
+		}
+		sealed.sponsorSignature = make([]byte, len(pbAct.GetSponsorSignature()))
+		copy(sealed.sponsorSignature, pbAct.GetSponsorSignature())
+		for _, pbCosig := range pbAct.GetSponsorCosignatures() {
+			cosig := Cosignature{}
+			if err := cosig.LoadProto(pbCosig); err != nil {
+				return err
+			}
+			sealed.sponsorCosignatures = append(sealed.sponsorCosignatures, cosig)
+		}
+	}
 	if err := sealed.Envelope.LoadProto(pbAct.GetCore()); err != nil {
 		return err
 	}
+	// an action encoded in RLP has no hash other than the one of its Ethereum transaction
+	if sealed.encoding == iotextypes.Encoding_ETHEREUM_RLP {
+		if _, err := rlpHash(*sealed); err != nil {
+			return err
+		}
+	}
 
 	sealed.payload.SetEnvelopeContext(*sealed)
 	return nil
@@ -0,0 +1,143 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+)
+
+const (
+	// sponsorHashPrefix separates the hash signed by a sponsor from the hash of the envelope signed by a sender
+	sponsorHashPrefix = "sponsor"
+	// sponsoredHashPrefix separates the hash signed by the sender of a sponsored action from the hash of the envelope
+	sponsoredHashPrefix = "sponsored"
+)
+
+// SenderHash returns the hash signed by the sender of an action, which is the hash of the envelope if the action isn't
+// sponsored. Otherwise it binds the envelope to the sponsor, so the sponsor can neither be stripped off to charge the
+// gas to the sender, nor be replaced by another one.
+func SenderHash(elp Envelope, sponsor address.Address) hash.Hash256 {
+	h := elp.Hash()
+	if sponsor == nil {
+		return h
+	}
+	data := append([]byte(sponsoredHashPrefix), h[:]...)
+	return hash.Hash256b(append(data, sponsor.Bytes()...))
+}
+
+// SponsorHash returns the hash signed by the sponsor of an action. It binds the envelope to its sender, so the signature
+// of a sponsor can neither sponsor the same envelope sent by another account, nor pass as an action sent by the sponsor.
+func SponsorHash(elp Envelope, sender crypto.PublicKey) hash.Hash256 {
+	h := elp.Hash()
+	data := append([]byte(sponsorHashPrefix), h[:]...)
+	return hash.Hash256b(append(data, sender.Hash()...))
+}
+
+// SignSponsored signs the action with the key of its sender for the given sponsor, who pays the gas of the action by
+// signing it with Sponsor
+func SignSponsored(act Envelope, sk crypto.PrivateKey, sponsor address.Address) (SealedEnvelope, error) {
+	sealed := SealedEnvelope{Envelope: act}
+	if sponsor == nil {
+		return sealed, errors.New("empty address of sponsor")
+	}
+	sealed.srcPubkey = sk.PublicKey()
+	hash := SenderHash(act, sponsor)
+	sig, err := sk.Sign(hash[:])
+	if err != nil {
+		return sealed, errors.Wrapf(ErrAction, "failed to sign sponsored action hash = %x", hash)
+	}
+	sealed.signature = sig
+	sealed.payload.SetEnvelopeContext(sealed)
+	return sealed, nil
+}
+
+// Sponsor signs the action sealed by its sender with the key of the sponsor, who pays the gas of the action instead.
+// The action must have been signed for the sponsor, with SignSponsored or the cosignatures of a multisig account.
+func Sponsor(sealed SealedEnvelope, sk crypto.PrivateKey) (SealedEnvelope, error) {
+	if sealed.SrcPubkey() == nil {
+		return sealed, errors.New("empty public key of sender")
+	}
+	sponsored := sealed
+	sponsored.sponsorPubkey = sk.PublicKey()
+	if err := verifySender(sponsored); err != nil {
+		return sealed, errors.Wrap(err, "action isn't signed for the sponsor")
+	}
+	hash := SponsorHash(sealed.Envelope, sealed.SrcPubkey())
+	sig, err := sk.Sign(hash[:])
+	if err != nil {
+		return sealed, errors.Wrapf(ErrAction, "failed to sign sponsor hash = %x", hash)
+	}
+	sponsored.sponsorSignature = sig
+	sponsored.sponsorCosignatures = nil
+	sponsored.payload.SetEnvelopeContext(sponsored)
+	return sponsored, nil
+}
+
+// GasFee returns the most gas fee the action can be charged, which is reserved from the balance of whom pays the gas
+func (sealed *SealedEnvelope) GasFee() (*big.Int, error) {
+	gas := sealed.GasLimit()
+	// the actions other than execution are charged their intrinsic gas only
+	if _, ok := sealed.Action().(*Execution); !ok {
+		var err error
+		if gas, err = sealed.IntrinsicGas(); err != nil {
+			return nil, err
+		}
+	}
+	return big.NewInt(0).Mul(sealed.GasPrice(), big.NewInt(0).SetUint64(gas)), nil
+}
+
+// SenderCost returns the cost of the action charged to its sender, which excludes the gas fee if it is sponsored
+func (sealed *SealedEnvelope) SenderCost() (*big.Int, error) {
+	cost, err := sealed.Cost()
+	if err != nil || !sealed.IsSponsored() {
+		return cost, err
+	}
+	fee, err := sealed.GasFee()
+	if err != nil {
+		return nil, err
+	}
+	if cost.Cmp(fee) < 0 {
+		return big.NewInt(0), nil
+	}
+	return cost.Sub(cost, fee), nil
+}
+
+// sponsorAddress returns the address of the sponsor of the action, which is nil if the action isn't sponsored
+func sponsorAddress(sealed SealedEnvelope) (address.Address, error) {
+	if !sealed.IsSponsored() {
+		return nil, nil
+	}
+	sponsor, err := address.FromBytes(sealed.sponsorPubkey.Hash())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key of sponsor")
+	}
+	return sponsor, nil
+}
+
+func verifySponsor(sealed SealedEnvelope) error {
+	if sealed.IsMultisigSponsored() {
+		return verifySponsorCosignatures(sealed)
+	}
+	if len(sealed.sponsorSignature) != SignatureLength {
+		return errors.New("incorrect length of sponsor signature")
+	}
+	hash := SponsorHash(sealed.Envelope, sealed.SrcPubkey())
+	if sealed.sponsorPubkey.Verify(hash[:], sealed.sponsorSignature) {
+		return nil
+	}
+	return errors.Wrapf(
+		ErrAction,
+		"failed to verify sponsor hash = %x and signature = %x",
+		hash,
+		sealed.sponsorSignature,
+	)
+}
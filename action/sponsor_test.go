@@ -0,0 +1,211 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestSponsor(t *testing.T) {
+	require := require.New(t)
+	senderKey, sponsorKey := identityset.PrivateKey(27), identityset.PrivateKey(28)
+
+	tsf, err := NewTransfer(1, big.NewInt(100), identityset.Address(29).String(), nil, 100000, big.NewInt(10))
+	require.NoError(err)
+	elp := (&EnvelopeBuilder{}).SetNonce(1).
+		SetGasLimit(100000).
+		SetGasPrice(big.NewInt(10)).
+		SetAction(tsf).Build()
+	selp, err := Sign(elp, senderKey)
+	require.NoError(err)
+	require.False(selp.IsSponsored())
+	cost, err := selp.SenderCost()
+	require.NoError(err)
+	require.Equal(big.NewInt(10*int64(TransferBaseIntrinsicGas)+100), cost)
+
+	// the action must be signed for the sponsor
+	_, err = Sponsor(selp, sponsorKey)
+	require.Error(err)
+	selp, err = SignSponsored(elp, senderKey, identityset.Address(30))
+	require.NoError(err)
+	_, err = Sponsor(selp, sponsorKey)
+	require.Error(err)
+	selp, err = SignSponsored(elp, senderKey, identityset.Address(28))
+	require.NoError(err)
+	require.Error(Verify(selp))
+
+	sponsored, err := Sponsor(selp, sponsorKey)
+	require.NoError(err)
+	require.True(sponsored.IsSponsored())
+	require.Equal(sponsorKey.PublicKey(), sponsored.SponsorPubkey())
+	require.NoError(Verify(sponsored))
+	require.NotEqual(selp.Hash(), sponsored.Hash())
+	// the sender is charged the amount only
+	fee, err := sponsored.GasFee()
+	require.NoError(err)
+	require.Equal(big.NewInt(10*int64(TransferBaseIntrinsicGas)), fee)
+	cost, err = sponsored.SenderCost()
+	require.NoError(err)
+	require.Equal(big.NewInt(100), cost)
+
+	// the sponsor survives the round trip through the action proto
+	selp2 := SealedEnvelope{}
+	require.NoError(selp2.LoadProto(sponsored.Proto()))
+	require.Equal(sponsored.Hash(), selp2.Hash())
+	require.Equal(sponsorKey.PublicKey(), selp2.SponsorPubkey())
+	require.Equal(sponsored.SponsorSignature(), selp2.SponsorSignature())
+	require.NoError(Verify(selp2))
+
+	// the signature of the sponsor covers the sender
+	forged := AssembleSealedEnvelope(elp, identityset.PrivateKey(30).PublicKey(), selp.Signature())
+	forged.sponsorPubkey = sponsored.sponsorPubkey
+	forged.sponsorSignature = sponsored.sponsorSignature
+	require.Error(verifySponsor(forged))
+	// and cannot pass as the signature of the sponsor sending the action
+	require.Error(Verify(AssembleSealedEnvelope(elp, sponsorKey.PublicKey(), sponsored.SponsorSignature())))
+	// the sponsor can be neither stripped off nor replaced
+	stripped := AssembleSealedEnvelope(elp, senderKey.PublicKey(), sponsored.Signature())
+	require.Error(Verify(stripped))
+	replaced, err := Sponsor(stripped, identityset.PrivateKey(30))
+	require.Error(err)
+	replaced = sponsored
+	replaced.sponsorPubkey = identityset.PrivateKey(30).PublicKey()
+	hash := SponsorHash(elp, senderKey.PublicKey())
+	replaced.sponsorSignature, err = identityset.PrivateKey(30).Sign(hash[:])
+	require.NoError(err)
+	require.NoError(verifySponsor(replaced))
+	require.Error(Verify(replaced))
+	selp2.sponsorSignature = selp2.sponsorSignature[1:]
+	require.Error(Verify(selp2))
+
+	// so are the cosignatures of a multisig account
+	account := identityset.PrivateKey(31).PublicKey()
+	cosig, err := Cosign(elp, account, identityset.Address(28), identityset.PrivateKey(32))
+	require.NoError(err)
+	multisig := AssembleMultisigEnvelope(elp, account, []Cosignature{cosig})
+	require.Error(Verify(multisig))
+	sponsored, err = Sponsor(multisig, sponsorKey)
+	require.NoError(err)
+	require.NoError(Verify(sponsored))
+
+	// an execution reserves the whole gas limit
+	exec, err := NewExecution(identityset.Address(29).String(), 2, big.NewInt(100), 100000, big.NewInt(10), nil)
+	require.NoError(err)
+	selp, err = SignSponsored((&EnvelopeBuilder{}).SetNonce(2).
+		SetGasLimit(100000).
+		SetGasPrice(big.NewInt(10)).
+		SetAction(exec).Build(), senderKey, identityset.Address(28))
+	require.NoError(err)
+	sponsored, err = Sponsor(selp, sponsorKey)
+	require.NoError(err)
+	fee, err = sponsored.GasFee()
+	require.NoError(err)
+	require.Equal(big.NewInt(1000000), fee)
+	cost, err = sponsored.SenderCost()
+	require.NoError(err)
+	require.Equal(big.NewInt(100), cost)
+}
+
+func TestMultisigSponsor(t *testing.T) {
+	require := require.New(t)
+	senderKey, account := identityset.PrivateKey(27), identityset.PrivateKey(31)
+	sk1, sk2 := identityset.PrivateKey(32), identityset.PrivateKey(33)
+	policy := &MultisigPolicy{
+		Keys: []WeightedKey{
+			{PubKey: sk1.PublicKey(), Weight: 1},
+			{PubKey: sk2.PublicKey(), Weight: 1},
+		},
+		Threshold: 2,
+	}
+
+	tsf, err := NewTransfer(1, big.NewInt(100), identityset.Address(29).String(), nil, 100000, big.NewInt(10))
+	require.NoError(err)
+	elp := (&EnvelopeBuilder{}).SetNonce(1).
+		SetGasLimit(100000).
+		SetGasPrice(big.NewInt(10)).
+		SetAction(tsf).Build()
+	selp, err := SignSponsored(elp, senderKey, identityset.Address(31))
+	require.NoError(err)
+	cosig1, err := CosignSponsor(selp, account.PublicKey(), sk1)
+	require.NoError(err)
+	cosig2, err := CosignSponsor(selp, account.PublicKey(), sk2)
+	require.NoError(err)
+
+	// the cosignatures of the sponsor are put in the order of their keys
+	sponsored, err := AssembleMultisigSponsor(selp, account.PublicKey(), []Cosignature{cosig2, cosig1})
+	require.NoError(err)
+	require.True(sponsored.IsSponsored())
+	require.True(sponsored.IsMultisigSponsored())
+	require.Len(sponsored.SponsorSignature(), 0)
+	require.NoError(Verify(sponsored))
+	require.NoError(VerifySponsorPolicy(sponsored, policy))
+	// and survive the round trip through the action proto
+	selp2 := SealedEnvelope{}
+	require.NoError(selp2.LoadProto(sponsored.Proto()))
+	require.Equal(sponsored.Hash(), selp2.Hash())
+	require.Equal(sponsored.SponsorCosignatures(), selp2.SponsorCosignatures())
+	require.NoError(Verify(selp2))
+	// but only in that order
+	pb := sponsored.Proto()
+	pb.SponsorCosignatures[0], pb.SponsorCosignatures[1] = pb.SponsorCosignatures[1], pb.SponsorCosignatures[0]
+	require.NoError(selp2.LoadProto(pb))
+	require.Equal(ErrAction, errors.Cause(Verify(selp2)))
+	// nor with the signature of the sponsor
+	hash := SponsorHash(elp, senderKey.PublicKey())
+	pb = sponsored.Proto()
+	pb.SponsorSignature, err = account.Sign(hash[:])
+	require.NoError(err)
+	require.NoError(selp2.LoadProto(pb))
+	require.Equal(ErrAction, errors.Cause(Verify(selp2)))
+
+	// the action must be signed for the multisig sponsor
+	other, err := SignSponsored(elp, senderKey, identityset.Address(28))
+	require.NoError(err)
+	_, err = AssembleMultisigSponsor(other, account.PublicKey(), []Cosignature{cosig1, cosig2})
+	require.Error(err)
+	// and the cosignatures are bound to the sponsoring account
+	other, err = AssembleMultisigSponsor(
+		mustSignSponsored(t, elp, senderKey, identityset.Address(30)),
+		identityset.PrivateKey(30).PublicKey(),
+		[]Cosignature{cosig1, cosig2},
+	)
+	require.NoError(err)
+	require.Equal(ErrAction, errors.Cause(Verify(other)))
+
+	// the cosignatures below the threshold are rejected
+	below, err := AssembleMultisigSponsor(selp, account.PublicKey(), []Cosignature{cosig1})
+	require.NoError(err)
+	require.NoError(Verify(below))
+	require.Equal(ErrMultisigThreshold, errors.Cause(VerifySponsorPolicy(below, policy)))
+	// the own key of a multisig sponsor can't sponsor alone
+	single, err := Sponsor(selp, account)
+	require.NoError(err)
+	require.False(single.IsMultisigSponsored())
+	require.NoError(Verify(single))
+	require.NoError(VerifySponsorPolicy(single, nil))
+	require.Equal(ErrAction, errors.Cause(VerifySponsorPolicy(single, policy)))
+	// a sponsor without multisig policy can't sponsor with cosignatures
+	require.Equal(ErrAction, errors.Cause(VerifySponsorPolicy(sponsored, nil)))
+	// the policy of the sponsor is irrelevant to an action not sponsored
+	unsponsored, err := Sign(elp, senderKey)
+	require.NoError(err)
+	require.NoError(VerifySponsorPolicy(unsponsored, policy))
+}
+
+func mustSignSponsored(t *testing.T, elp Envelope, sk crypto.PrivateKey, sponsor address.Address) SealedEnvelope {
+	selp, err := SignSponsored(elp, sk, sponsor)
+	require.NoError(t, err)
+	return selp
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+// DepositToStake defines the action of adding an amount to a bucket
+type DepositToStake struct {
+	AbstractAction
+
+	bucketIndex uint64
+	amount      *big.Int
+	payload     []byte
+}
+
+// NewDepositToStake returns a DepositToStake instance
+func NewDepositToStake(
+	nonce uint64,
+	bucketIndex uint64,
+	amount *big.Int,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*DepositToStake, error) {
+	return &DepositToStake{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		bucketIndex: bucketIndex,
+		amount:      amount,
+		payload:     payload,
+	}, nil
+}
+
+// BucketIndex returns the index of the bucket
+func (ds *DepositToStake) BucketIndex() uint64 { return ds.bucketIndex }
+
+// Amount returns the amount to add
+func (ds *DepositToStake) Amount() *big.Int { return ds.amount }
+
+// Payload returns the payload bytes
+func (ds *DepositToStake) Payload() []byte { return ds.payload }
+
+// Serialize returns a raw byte stream of the DepositToStake
+func (ds *DepositToStake) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(ds.Proto()))
+}
+
+// Proto converts DepositToStake to protobuf's StakeAddDeposit
+func (ds *DepositToStake) Proto() *iotextypes.StakeAddDeposit {
+	act := &iotextypes.StakeAddDeposit{
+		BucketIndex: ds.bucketIndex,
+		Payload:     ds.payload,
+	}
+	if ds.amount != nil {
+		act.Amount = ds.amount.String()
+	}
+	return act
+}
+
+// LoadProto converts a protobuf's StakeAddDeposit to DepositToStake
+func (ds *DepositToStake) LoadProto(pbAct *iotextypes.StakeAddDeposit) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if ds == nil {
+		return errors.New("nil action to load proto")
+	}
+	*ds = DepositToStake{}
+	amount, ok := new(big.Int).SetString(pbAct.GetAmount(), 10)
+	if !ok {
+		return errors.Errorf("invalid amount %s", pbAct.GetAmount())
+	}
+	ds.bucketIndex = pbAct.GetBucketIndex()
+	ds.amount = amount
+	ds.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a DepositToStake
+func (ds *DepositToStake) IntrinsicGas() (uint64, error) {
+	return stakeIntrinsicGas(ds.payload)
+}
+
+// Cost returns the total cost of a DepositToStake
+func (ds *DepositToStake) Cost() (*big.Int, error) {
+	return stakeCost(ds.payload, ds.GasPrice(), ds.amount)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+// ChangeCandidate defines the action of moving the votes of a bucket to another candidate
+type ChangeCandidate struct {
+	AbstractAction
+
+	bucketIndex uint64
+	candName    string
+	payload     []byte
+}
+
+// NewChangeCandidate returns a ChangeCandidate instance
+func NewChangeCandidate(
+	nonce uint64,
+	candidateName string,
+	bucketIndex uint64,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*ChangeCandidate, error) {
+	return &ChangeCandidate{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		bucketIndex: bucketIndex,
+		candName:    candidateName,
+		payload:     payload,
+	}, nil
+}
+
+// BucketIndex returns the index of the bucket
+func (cc *ChangeCandidate) BucketIndex() uint64 { return cc.bucketIndex }
+
+// Candidate returns the name of the new candidate
+func (cc *ChangeCandidate) Candidate() string { return cc.candName }
+
+// Payload returns the payload bytes
+func (cc *ChangeCandidate) Payload() []byte { return cc.payload }
+
+// Serialize returns a raw byte stream of the ChangeCandidate
+func (cc *ChangeCandidate) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(cc.Proto()))
+}
+
+// Proto converts ChangeCandidate to protobuf's StakeChangeCandidate
+func (cc *ChangeCandidate) Proto() *iotextypes.StakeChangeCandidate {
+	return &iotextypes.StakeChangeCandidate{
+		BucketIndex:   cc.bucketIndex,
+		CandidateName: cc.candName,
+		Payload:       cc.payload,
+	}
+}
+
+// LoadProto converts a protobuf's StakeChangeCandidate to ChangeCandidate
+func (cc *ChangeCandidate) LoadProto(pbAct *iotextypes.StakeChangeCandidate) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if cc == nil {
+		return errors.New("nil action to load proto")
+	}
+	*cc = ChangeCandidate{}
+	cc.bucketIndex = pbAct.GetBucketIndex()
+	cc.candName = pbAct.GetCandidateName()
+	cc.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a ChangeCandidate
+func (cc *ChangeCandidate) IntrinsicGas() (uint64, error) {
+	return stakeIntrinsicGas(cc.payload)
+}
+
+// Cost returns the total cost of a ChangeCandidate
+func (cc *ChangeCandidate) Cost() (*big.Int, error) {
+	return stakeCost(cc.payload, cc.GasPrice(), nil)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+const (
+	// StakeBaseIntrinsicGas represents the base intrinsic gas for the staking actions
+	StakeBaseIntrinsicGas = uint64(10000)
+	// StakePayloadGas represents the staking action payload gas per uint
+	StakePayloadGas = uint64(100)
+)
+
+// CreateStake defines the action of creating a bucket voting for a candidate
+type CreateStake struct {
+	AbstractAction
+
+	candName  string
+	amount    *big.Int
+	duration  uint32
+	autoStake bool
+	payload   []byte
+}
+
+// NewCreateStake returns a CreateStake instance
+func NewCreateStake(
+	nonce uint64,
+	candidateName string,
+	amount *big.Int,
+	duration uint32,
+	autoStake bool,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*CreateStake, error) {
+	return &CreateStake{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		candName:  candidateName,
+		amount:    amount,
+		duration:  duration,
+		autoStake: autoStake,
+		payload:   payload,
+	}, nil
+}
+
+// Amount returns the staked amount
+func (cs *CreateStake) Amount() *big.Int { return cs.amount }
+
+// Candidate returns the name of the candidate to vote for
+func (cs *CreateStake) Candidate() string { return cs.candName }
+
+// Duration returns the staked duration in days
+func (cs *CreateStake) Duration() uint32 { return cs.duration }
+
+// AutoStake returns whether the staked duration is kept from decaying
+func (cs *CreateStake) AutoStake() bool { return cs.autoStake }
+
+// Payload returns the payload bytes
+func (cs *CreateStake) Payload() []byte { return cs.payload }
+
+// Serialize returns a raw byte stream of the CreateStake
+func (cs *CreateStake) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(cs.Proto()))
+}
+
+// Proto converts CreateStake to protobuf's StakeCreate
+func (cs *CreateStake) Proto() *iotextypes.StakeCreate {
+	act := &iotextypes.StakeCreate{
+		CandidateName:  cs.candName,
+		StakedDuration: cs.duration,
+		AutoStake:      cs.autoStake,
+		Payload:        cs.payload,
+	}
+	if cs.amount != nil {
+		act.StakedAmount = cs.amount.String()
+	}
+	return act
+}
+
+// LoadProto converts a protobuf's StakeCreate to CreateStake
+func (cs *CreateStake) LoadProto(pbAct *iotextypes.StakeCreate) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if cs == nil {
+		return errors.New("nil action to load proto")
+	}
+	*cs = CreateStake{}
+	amount, ok := new(big.Int).SetString(pbAct.GetStakedAmount(), 10)
+	if !ok {
+		return errors.Errorf("invalid amount %s", pbAct.GetStakedAmount())
+	}
+	cs.candName = pbAct.GetCandidateName()
+	cs.amount = amount
+	cs.duration = pbAct.GetStakedDuration()
+	cs.autoStake = pbAct.GetAutoStake()
+	cs.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a CreateStake
+func (cs *CreateStake) IntrinsicGas() (uint64, error) {
+	return stakeIntrinsicGas(cs.payload)
+}
+
+// Cost returns the total cost of a CreateStake
+func (cs *CreateStake) Cost() (*big.Int, error) {
+	return stakeCost(cs.payload, cs.GasPrice(), cs.amount)
+}
+
+func stakeIntrinsicGas(payload []byte) (uint64, error) {
+	payloadSize := uint64(len(payload))
+	if (math.MaxUint64-StakeBaseIntrinsicGas)/StakePayloadGas < payloadSize {
+		return 0, ErrOutOfGas
+	}
+	return StakeBaseIntrinsicGas + payloadSize*StakePayloadGas, nil
+}
+
+// stakeCost returns the gas fee of the staking action plus the amount it stakes
+func stakeCost(payload []byte, gasPrice, amount *big.Int) (*big.Int, error) {
+	intrinsicGas, err := stakeIntrinsicGas(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the staking action")
+	}
+	fee := big.NewInt(0).Mul(gasPrice, big.NewInt(0).SetUint64(intrinsicGas))
+	if amount != nil {
+		fee.Add(fee, amount)
+	}
+	return fee, nil
+}
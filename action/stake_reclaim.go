@@ -0,0 +1,110 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+type (
+	// reclaimStake is the common part of unstaking and withdrawing a bucket
+	reclaimStake struct {
+		AbstractAction
+
+		bucketIndex uint64
+		payload     []byte
+	}
+
+	// Unstake defines the action of unstaking a bucket, which stops voting and starts the withdraw waiting period
+	Unstake struct {
+		reclaimStake
+	}
+
+	// WithdrawStake defines the action of withdrawing the staked amount of an unstaked bucket
+	WithdrawStake struct {
+		reclaimStake
+	}
+)
+
+// NewUnstake returns an Unstake instance
+func NewUnstake(nonce uint64, bucketIndex uint64, payload []byte, gasLimit uint64, gasPrice *big.Int) (*Unstake, error) {
+	return &Unstake{newReclaimStake(nonce, bucketIndex, payload, gasLimit, gasPrice)}, nil
+}
+
+// NewWithdrawStake returns a WithdrawStake instance
+func NewWithdrawStake(
+	nonce uint64,
+	bucketIndex uint64,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*WithdrawStake, error) {
+	return &WithdrawStake{newReclaimStake(nonce, bucketIndex, payload, gasLimit, gasPrice)}, nil
+}
+
+func newReclaimStake(nonce uint64, bucketIndex uint64, payload []byte, gasLimit uint64, gasPrice *big.Int) reclaimStake {
+	return reclaimStake{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		bucketIndex: bucketIndex,
+		payload:     payload,
+	}
+}
+
+// BucketIndex returns the index of the bucket
+func (rs *reclaimStake) BucketIndex() uint64 { return rs.bucketIndex }
+
+// Payload returns the payload bytes
+func (rs *reclaimStake) Payload() []byte { return rs.payload }
+
+// Serialize returns a raw byte stream of the action
+func (rs *reclaimStake) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(rs.Proto()))
+}
+
+// Proto converts the action to protobuf's StakeReclaim
+func (rs *reclaimStake) Proto() *iotextypes.StakeReclaim {
+	return &iotextypes.StakeReclaim{
+		BucketIndex: rs.bucketIndex,
+		Payload:     rs.payload,
+	}
+}
+
+// LoadProto converts a protobuf's StakeReclaim to the action
+func (rs *reclaimStake) LoadProto(pbAct *iotextypes.StakeReclaim) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if rs == nil {
+		return errors.New("nil action to load proto")
+	}
+	*rs = reclaimStake{}
+	rs.bucketIndex = pbAct.GetBucketIndex()
+	rs.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of the action
+func (rs *reclaimStake) IntrinsicGas() (uint64, error) {
+	return stakeIntrinsicGas(rs.payload)
+}
+
+// Cost returns the total cost of the action
+func (rs *reclaimStake) Cost() (*big.Int, error) {
+	return stakeCost(rs.payload, rs.GasPrice(), nil)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+// Restake defines the action of renewing the staked duration of a bucket
+type Restake struct {
+	AbstractAction
+
+	bucketIndex uint64
+	duration    uint32
+	autoStake   bool
+	payload     []byte
+}
+
+// NewRestake returns a Restake instance
+func NewRestake(
+	nonce uint64,
+	bucketIndex uint64,
+	duration uint32,
+	autoStake bool,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*Restake, error) {
+	return &Restake{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		bucketIndex: bucketIndex,
+		duration:    duration,
+		autoStake:   autoStake,
+		payload:     payload,
+	}, nil
+}
+
+// BucketIndex returns the index of the bucket
+func (rs *Restake) BucketIndex() uint64 { return rs.bucketIndex }
+
+// Duration returns the new staked duration in days
+func (rs *Restake) Duration() uint32 { return rs.duration }
+
+// AutoStake returns whether the staked duration is kept from decaying
+func (rs *Restake) AutoStake() bool { return rs.autoStake }
+
+// Payload returns the payload bytes
+func (rs *Restake) Payload() []byte { return rs.payload }
+
+// Serialize returns a raw byte stream of the Restake
+func (rs *Restake) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(rs.Proto()))
+}
+
+// Proto converts Restake to protobuf's StakeRestake
+func (rs *Restake) Proto() *iotextypes.StakeRestake {
+	return &iotextypes.StakeRestake{
+		BucketIndex:    rs.bucketIndex,
+		StakedDuration: rs.duration,
+		AutoStake:      rs.autoStake,
+		Payload:        rs.payload,
+	}
+}
+
+// LoadProto converts a protobuf's StakeRestake to Restake
+func (rs *Restake) LoadProto(pbAct *iotextypes.StakeRestake) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if rs == nil {
+		return errors.New("nil action to load proto")
+	}
+	*rs = Restake{}
+	rs.bucketIndex = pbAct.GetBucketIndex()
+	rs.duration = pbAct.GetStakedDuration()
+	rs.autoStake = pbAct.GetAutoStake()
+	rs.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a Restake
+func (rs *Restake) IntrinsicGas() (uint64, error) {
+	return stakeIntrinsicGas(rs.payload)
+}
+
+// Cost returns the total cost of a Restake
+func (rs *Restake) Cost() (*big.Int, error) {
+	return stakeCost(rs.payload, rs.GasPrice(), nil)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestStakeActions(t *testing.T) {
+	require := require.New(t)
+
+	payload := []byte("payload")
+	gasPrice := big.NewInt(10)
+	voter := identityset.Address(28).String()
+	create, err := NewCreateStake(1, "robotbp00001", big.NewInt(100), 7, true, payload, 100000, gasPrice)
+	require.NoError(err)
+	unstake, err := NewUnstake(2, 3, payload, 100000, gasPrice)
+	require.NoError(err)
+	withdraw, err := NewWithdrawStake(3, 3, payload, 100000, gasPrice)
+	require.NoError(err)
+	deposit, err := NewDepositToStake(4, 3, big.NewInt(20), payload, 100000, gasPrice)
+	require.NoError(err)
+	restake, err := NewRestake(5, 3, 14, false, payload, 100000, gasPrice)
+	require.NoError(err)
+	change, err := NewChangeCandidate(6, "robotbp00002", 3, payload, 100000, gasPrice)
+	require.NoError(err)
+	transfer, err := NewTransferStake(7, voter, 3, payload, 100000, gasPrice)
+	require.NoError(err)
+	register, err := NewCandidateRegister(8, "robotbp00003", voter, voter, big.NewInt(300), 91, true, payload,
+		100000, gasPrice)
+	require.NoError(err)
+	unregister, err := NewCandidateUnregister(9, payload, 100000, gasPrice)
+	require.NoError(err)
+
+	gas := StakeBaseIntrinsicGas + uint64(len(payload))*StakePayloadGas
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gas))
+	tests := []struct {
+		act  actionPayload
+		cost *big.Int
+	}{
+		{create, new(big.Int).Add(fee, big.NewInt(100))},
+		{unstake, fee},
+		{withdraw, fee},
+		{deposit, new(big.Int).Add(fee, big.NewInt(20))},
+		{restake, fee},
+		{change, fee},
+		{transfer, fee},
+		{register, new(big.Int).Add(fee, big.NewInt(300))},
+		{unregister, fee},
+	}
+	for i, test := range tests {
+		intrinsicGas, err := test.act.IntrinsicGas()
+		require.NoError(err)
+		require.Equal(gas, intrinsicGas)
+		cost, err := test.act.Cost()
+		require.NoError(err)
+		require.Equal(test.cost, cost)
+
+		// the action survives the round trip through the action proto
+		elp := (&EnvelopeBuilder{}).SetNonce(uint64(i + 1)).
+			SetGasLimit(100000).
+			SetGasPrice(gasPrice).
+			SetAction(test.act).Build()
+		selp, err := Sign(elp, identityset.PrivateKey(27))
+		require.NoError(err)
+		selp2 := SealedEnvelope{}
+		require.NoError(selp2.LoadProto(selp.Proto()))
+		require.Equal(selp.Hash(), selp2.Hash())
+		require.NoError(Verify(selp2))
+		require.IsType(test.act, selp2.Action())
+	}
+
+	loadAction := func(act actionPayload) Action {
+		elp := (&EnvelopeBuilder{}).SetGasPrice(gasPrice).SetAction(act).Build()
+		var elp2 Envelope
+		require.NoError(elp2.LoadProto(elp.Proto()))
+		return elp2.Action()
+	}
+	register2, ok := loadAction(register).(*CandidateRegister)
+	require.True(ok)
+	require.Equal("robotbp00003", register2.Name())
+	require.Equal(voter, register2.OperatorAddress())
+	require.Equal(voter, register2.RewardAddress())
+	require.Equal(big.NewInt(300), register2.Amount())
+	require.Equal(uint32(91), register2.Duration())
+	require.True(register2.AutoStake())
+	require.Equal(payload, register2.Payload())
+
+	unstake2, ok := loadAction(unstake).(*Unstake)
+	require.True(ok)
+	require.Equal(uint64(3), unstake2.BucketIndex())
+	require.Equal(payload, unstake2.Payload())
+
+	transfer2, ok := loadAction(transfer).(*TransferStake)
+	require.True(ok)
+	require.Equal(uint64(3), transfer2.BucketIndex())
+	require.Equal(voter, transfer2.VoterAddress())
+
+	unregister2, ok := loadAction(unregister).(*CandidateUnregister)
+	require.True(ok)
+	require.Equal(payload, unregister2.Payload())
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+// TransferStake defines the action of transferring the ownership of a bucket to another voter
+type TransferStake struct {
+	AbstractAction
+
+	bucketIndex uint64
+	voter       string
+	payload     []byte
+}
+
+// NewTransferStake returns a TransferStake instance
+func NewTransferStake(
+	nonce uint64,
+	voterAddress string,
+	bucketIndex uint64,
+	payload []byte,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*TransferStake, error) {
+	return &TransferStake{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		bucketIndex: bucketIndex,
+		voter:       voterAddress,
+		payload:     payload,
+	}, nil
+}
+
+// BucketIndex returns the index of the bucket
+func (ts *TransferStake) BucketIndex() uint64 { return ts.bucketIndex }
+
+// VoterAddress returns the address of the new owner
+func (ts *TransferStake) VoterAddress() string { return ts.voter }
+
+// Payload returns the payload bytes
+func (ts *TransferStake) Payload() []byte { return ts.payload }
+
+// Serialize returns a raw byte stream of the TransferStake
+func (ts *TransferStake) Serialize() []byte {
+	return byteutil.Must(proto.Marshal(ts.Proto()))
+}
+
+// Proto converts TransferStake to protobuf's StakeTransferOwnership
+func (ts *TransferStake) Proto() *iotextypes.StakeTransferOwnership {
+	return &iotextypes.StakeTransferOwnership{
+		BucketIndex:  ts.bucketIndex,
+		VoterAddress: ts.voter,
+		Payload:      ts.payload,
+	}
+}
+
+// LoadProto converts a protobuf's StakeTransferOwnership to TransferStake
+func (ts *TransferStake) LoadProto(pbAct *iotextypes.StakeTransferOwnership) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if ts == nil {
+		return errors.New("nil action to load proto")
+	}
+	*ts = TransferStake{}
+	ts.bucketIndex = pbAct.GetBucketIndex()
+	ts.voter = pbAct.GetVoterAddress()
+	ts.payload = pbAct.GetPayload()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a TransferStake
+func (ts *TransferStake) IntrinsicGas() (uint64, error) {
+	return stakeIntrinsicGas(ts.payload)
+}
+
+// Cost returns the total cost of a TransferStake
+func (ts *TransferStake) Cost() (*big.Int, error) {
+	return stakeCost(ts.payload, ts.GasPrice(), nil)
+}
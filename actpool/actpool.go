@@ -7,7 +7,9 @@
 package actpool
 
 import (
+	"container/heap"
 	"context"
+	"math/big"
 	"sort"
 	"strings"
 	"sync"
@@ -23,7 +25,9 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/routine"
 )
 
 var (
@@ -31,14 +35,25 @@ var (
 		Name: "iotex_actpool_rejection_metrics",
 		Help: "actpool metrics.",
 	}, []string{"type"})
+	replacementMtc = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "iotex_actpool_replacement_metrics",
+		Help: "Number of pending actions replaced by ones with higher gas price.",
+	})
+	evictionMtc = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "iotex_actpool_eviction_metrics",
+		Help: "Number of actions evicted from the full actpool.",
+	}, []string{"type"})
 )
 
 func init() {
 	prometheus.MustRegister(actpoolMtc)
+	prometheus.MustRegister(replacementMtc)
+	prometheus.MustRegister(evictionMtc)
 }
 
 // ActPool is the interface of actpool
 type ActPool interface {
+	lifecycle.StartStopper
 	// Reset resets actpool state
 	Reset()
 	// PendingActionMap returns an action map with all accepted actions
@@ -60,6 +75,11 @@ type ActPool interface {
 	// GetGasCapacity returns the act pool gas capacity
 	GetGasCapacity() uint64
 
+	// AddSubscriber adds a subscriber to the changes of the actions in pool
+	AddSubscriber(PendingActionSubscriber) error
+	// RemoveSubscriber removes a subscriber
+	RemoveSubscriber(PendingActionSubscriber) error
+
 	AddActionEnvelopeValidators(...protocol.ActionEnvelopeValidator)
 }
 
@@ -89,11 +109,16 @@ type actPool struct {
 	accountActs               map[string]ActQueue
 	accountDesActs            map[string]map[hash.Hash256]action.SealedEnvelope
 	allActions                map[hash.Hash256]action.SealedEnvelope
+	sponsoredGas              map[string]*big.Int
 	gasInPool                 uint64
+	priceIndex                *priceIndex
 	actionEnvelopeValidators  []protocol.ActionEnvelopeValidator
 	timerFactory              *prometheustimer.TimerFactory
 	enableExperimentalActions bool
 	senderBlackList           map[string]bool
+	journal                   *actJournal
+	rejournalTask             *routine.RecurringTask
+	subscribers               []PendingActionSubscriber
 }
 
 // NewActPool constructs a new actpool
@@ -114,6 +139,12 @@ func NewActPool(bc blockchain.Blockchain, cfg config.ActPool, opts ...Option) (A
 		accountActs:     make(map[string]ActQueue),
 		accountDesActs:  make(map[string]map[hash.Hash256]action.SealedEnvelope),
 		allActions:      make(map[hash.Hash256]action.SealedEnvelope),
+		sponsoredGas:    make(map[string]*big.Int),
+		priceIndex:      newPriceIndex(),
+	}
+	if cfg.JournalPath != "" {
+		ap.journal = newActJournal(cfg.JournalPath)
+		ap.rejournalTask = routine.NewRecurringTask(ap.rejournal, cfg.JournalCompactInterval)
 	}
 	for _, opt := range opts {
 		if err := opt(ap); err != nil {
@@ -133,6 +164,39 @@ func NewActPool(bc blockchain.Blockchain, cfg config.ActPool, opts ...Option) (A
 	return ap, nil
 }
 
+// Start replays the actions in journal, and starts compacting the journal periodically. The context should carry the
+// blockchain context, which is required to add actions.
+func (ap *actPool) Start(ctx context.Context) error {
+	if ap.journal == nil {
+		return nil
+	}
+	if err := ap.journal.load(func(act action.SealedEnvelope) error {
+		return ap.Add(ctx, act)
+	}); err != nil {
+		return errors.Wrap(err, "failed to load journal")
+	}
+	if err := ap.compactJournal(); err != nil {
+		return err
+	}
+	return ap.rejournalTask.Start(ctx)
+}
+
+// Stop stops compacting the journal, and closes it
+func (ap *actPool) Stop(ctx context.Context) error {
+	if ap.journal == nil {
+		return nil
+	}
+	if err := ap.rejournalTask.Stop(ctx); err != nil {
+		return err
+	}
+	if err := ap.compactJournal(); err != nil {
+		return err
+	}
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+	return ap.journal.close()
+}
+
 func (ap *actPool) AddActionEnvelopeValidators(fs ...protocol.ActionEnvelopeValidator) {
 	ap.actionEnvelopeValidators = append(ap.actionEnvelopeValidators, fs...)
 }
@@ -183,17 +247,20 @@ func (ap *actPool) Add(ctx context.Context, act action.SealedEnvelope) error {
 		actpoolMtc.WithLabelValues("blacklisted").Inc()
 		return errors.Wrap(action.ErrAddress, "action source address is blacklisted")
 	}
-	// Reject action if pool space is full
-	if uint64(len(ap.allActions)) >= ap.cfg.MaxNumActsPerPool {
-		actpoolMtc.WithLabelValues("overMaxNumActsPerPool").Inc()
-		return errors.Wrap(action.ErrActPool, "insufficient space for action")
-	}
 	intrinsicGas, err := act.IntrinsicGas()
 	if err != nil {
 		actpoolMtc.WithLabelValues("failedGetIntrinsicGas").Inc()
 		return errors.Wrap(err, "failed to get action's intrinsic gas")
 	}
-	if ap.gasInPool+intrinsicGas > ap.cfg.MaxGasLimitPerPool {
+	// Reject action if pool space is full, unless it evicts the lower-priced ones. An action replacing a pending one
+	// only needs the room beyond the replaced one.
+	evictees, ok := ap.evictees(srcAddr.String(), act, intrinsicGas)
+	if !ok {
+		_, replacing := ap.replaced(srcAddr.String(), act)
+		if !replacing && uint64(len(ap.allActions)) >= ap.cfg.MaxNumActsPerPool {
+			actpoolMtc.WithLabelValues("overMaxNumActsPerPool").Inc()
+			return errors.Wrap(action.ErrActPool, "insufficient space for action")
+		}
 		actpoolMtc.WithLabelValues("overMaxGasLimitPerPool").Inc()
 		return errors.Wrap(action.ErrActPool, "insufficient gas space for action")
 	}
@@ -219,10 +286,27 @@ func (ap *actPool) Add(ctx context.Context, act action.SealedEnvelope) error {
 		return err
 	}
 
+	// Reject action if it's invalid in the next block
+	tipHeight := ap.bc.TipHeight()
+	validateCtx := protocol.WithBlockchainCtx(
+		context.Background(),
+		protocol.BlockchainCtx{
+			Registry: bcCtx.Registry,
+			Genesis:  ap.bc.Genesis(),
+			Tip:      protocol.TipInfo{Height: tipHeight},
+		},
+	)
+	validateCtx = protocol.WithBlockCtx(
+		validateCtx,
+		protocol.BlockCtx{
+			BlockHeight: tipHeight + 1,
+			GasLimit:    ap.bc.Genesis().BlockGasLimit,
+		},
+	)
 	// envelope validation
 	for _, validator := range ap.actionEnvelopeValidators {
 		ctx := protocol.WithActionCtx(
-			context.Background(),
+			validateCtx,
 			protocol.ActionCtx{
 				Caller: caller,
 			},
@@ -232,10 +316,9 @@ func (ap *actPool) Add(ctx context.Context, act action.SealedEnvelope) error {
 			return errors.Wrapf(err, "reject invalid action: %x", hash)
 		}
 	}
-	// Reject action if it's invalid
 	for _, validator := range bcCtx.Registry.All() {
 		ctx := protocol.WithActionCtx(
-			context.Background(),
+			validateCtx,
 			protocol.ActionCtx{
 				Caller: caller,
 			},
@@ -245,7 +328,16 @@ func (ap *actPool) Add(ctx context.Context, act action.SealedEnvelope) error {
 			return errors.Wrapf(err, "reject invalid action: %x", hash)
 		}
 	}
-	return ap.enqueueAction(caller.String(), act, hash, act.Nonce())
+	if err := ap.enqueueAction(caller.String(), act, hash, act.Nonce(), evictees); err != nil {
+		return err
+	}
+	if ap.journal != nil && ap.journal.writer != nil {
+		if err := ap.journal.insert(act); err != nil {
+			log.L().Warn("Failed to write action into journal.", log.Hex("hash", hash[:]), zap.Error(err))
+		}
+	}
+	ap.notify(ActionAccepted, act)
+	return nil
 }
 
 // GetPendingNonce returns pending nonce in pool or confirmed nonce given an account address
@@ -320,10 +412,16 @@ func (ap *actPool) GetGasCapacity() uint64 {
 	return ap.cfg.MaxGasLimitPerPool
 }
 
-//======================================
+// ======================================
 // private functions
-//======================================
-func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHash hash.Hash256, actNonce uint64) error {
+// ======================================
+func (ap *actPool) enqueueAction(
+	sender string,
+	act action.SealedEnvelope,
+	actHash hash.Hash256,
+	actNonce uint64,
+	evictees []action.SealedEnvelope,
+) error {
 	confirmedNonce, err := ap.bc.Factory().Nonce(sender)
 	if err != nil {
 		actpoolMtc.WithLabelValues("failedToGetNonce").Inc()
@@ -332,7 +430,7 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHa
 
 	queue := ap.accountActs[sender]
 	if queue == nil {
-		queue = NewActQueue(ap, sender, WithTimeOut(ap.cfg.ActionExpiry))
+		queue = NewActQueue(ap, sender, WithTimeOut(ap.cfg.ActionExpiry), WithPriceBump(ap.cfg.ReplacementPriceBump))
 		ap.accountActs[sender] = queue
 
 		// Initialize pending nonce for new account
@@ -346,10 +444,9 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHa
 		}
 		queue.SetPendingBalance(balance)
 	}
-	if queue.Overlaps(act) {
+	if old, exist := queue.Get(actNonce); exist {
 		// Nonce already exists
-		actpoolMtc.WithLabelValues("nonceUsed").Inc()
-		return errors.Wrapf(action.ErrNonce, "duplicate nonce for action %x", actHash)
+		return ap.replaceAction(sender, queue, old, act, actHash, evictees)
 	}
 
 	if actNonce-confirmedNonce-1 >= ap.cfg.MaxNumActsPerAcct {
@@ -362,11 +459,16 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHa
 		return errors.Wrapf(action.ErrNonce, "nonce too large ,actNonce : %x", actNonce)
 	}
 
-	cost, err := act.Cost()
+	cost, err := act.SenderCost()
 	if err != nil {
 		actpoolMtc.WithLabelValues("failedToGetCost").Inc()
 		return errors.Wrapf(err, "failed to get cost of action %x", actHash)
 	}
+	if act.IsSponsored() && !ap.enoughSponsorBalance(act, false) {
+		// Balance of sponsor is insufficient
+		actpoolMtc.WithLabelValues("insufficientSponsorBalance").Inc()
+		return errors.Wrapf(action.ErrBalance, "insufficient balance of sponsor for action %x", actHash)
+	}
 	if queue.PendingBalance().Cmp(cost) < 0 {
 		// Pending balance is insufficient
 		actpoolMtc.WithLabelValues("insufficientBalance").Inc()
@@ -380,10 +482,175 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHa
 		)
 	}
 
+	ap.evict(evictees, act)
 	if err := queue.Put(act); err != nil {
 		actpoolMtc.WithLabelValues("failedPutActQueue").Inc()
 		return errors.Wrapf(err, "cannot put action %x into ActQueue", actHash)
 	}
+	ap.addAction(sender, act, actHash)
+	// If the pending nonce equals this nonce, update queue
+	nonce := queue.PendingNonce()
+	if actNonce == nonce {
+		ap.updateAccount(sender)
+	}
+	ap.updatePriceIndex(sender)
+	return nil
+}
+
+// replaced returns the pending action of the sender with the same nonce as the action, if any
+func (ap *actPool) replaced(sender string, act action.SealedEnvelope) (action.SealedEnvelope, bool) {
+	queue, ok := ap.accountActs[sender]
+	if !ok {
+		return action.SealedEnvelope{}, false
+	}
+	return queue.Get(act.Nonce())
+}
+
+// evictees returns the tail actions of other accounts to evict in order, so that the action fits into the pool. The
+// actions of the accounts holding more than the account slots are evicted first, and the others are evicted only if
+// their gas prices are lower than the one of the action. It returns false if not enough actions could be evicted.
+func (ap *actPool) evictees(sender string, act action.SealedEnvelope, intrinsicGas uint64) ([]action.SealedEnvelope, bool) {
+	var (
+		numActs = uint64(len(ap.allActions)) + 1
+		gas     = ap.gasInPool + intrinsicGas
+	)
+	if old, ok := ap.replaced(sender, act); ok {
+		oldGas, _ := old.IntrinsicGas()
+		numActs--
+		gas -= oldGas
+	}
+	if numActs <= ap.cfg.MaxNumActsPerPool && gas <= ap.cfg.MaxGasLimitPerPool {
+		return nil, true
+	}
+	// an account holding as many actions as the account slots cannot evict the actions of others
+	if queue, ok := ap.accountActs[sender]; ok && ap.cfg.AccountSlots > 0 && uint64(queue.Len()) >= ap.cfg.AccountSlots {
+		return nil, false
+	}
+	var (
+		evictees   []action.SealedEnvelope
+		cands      = ap.priceIndex.candidates()
+		acctActs   = make(map[string][]action.SealedEnvelope)
+		numEvicted = make(map[string]int)
+	)
+	for numActs > ap.cfg.MaxNumActsPerPool || gas > ap.cfg.MaxGasLimitPerPool {
+		if cands.Len() == 0 {
+			return nil, false
+		}
+		cand := heap.Pop(&cands).(*tailItem)
+		if cand.sender == sender {
+			continue
+		}
+		if !cand.overSlots && cand.act.GasPrice().Cmp(act.GasPrice()) >= 0 {
+			return nil, false
+		}
+		evictees = append(evictees, cand.act)
+		numActs--
+		candGas, _ := cand.act.IntrinsicGas()
+		gas -= candGas
+		// the action before the evicted one becomes the tail of the account
+		acts, ok := acctActs[cand.sender]
+		if !ok {
+			acts = ap.accountActs[cand.sender].AllActs()
+			acctActs[cand.sender] = acts
+		}
+		numEvicted[cand.sender]++
+		if remaining := len(acts) - numEvicted[cand.sender]; remaining > 0 {
+			heap.Push(&cands, &tailItem{
+				sender:    cand.sender,
+				act:       acts[remaining-1],
+				overSlots: ap.overSlots(uint64(remaining)),
+			})
+		}
+	}
+	return evictees, true
+}
+
+// evict removes the tail actions from pool to make room for the action
+func (ap *actPool) evict(evictees []action.SealedEnvelope, act action.SealedEnvelope) {
+	for _, evictee := range evictees {
+		sender, _ := address.FromBytes(evictee.SrcPubkey().Hash())
+		queue, ok := ap.accountActs[sender.String()]
+		if !ok {
+			continue
+		}
+		overSlots := ap.overSlots(uint64(queue.Len()))
+		if _, ok := queue.PopTail(); !ok {
+			continue
+		}
+		ap.removeInvalidActs([]action.SealedEnvelope{evictee})
+		ap.notify(ActionDropped, evictee)
+		if queue.Empty() {
+			delete(ap.accountActs, sender.String())
+		}
+		ap.updatePriceIndex(sender.String())
+		if overSlots {
+			evictionMtc.WithLabelValues("overAccountSlots").Inc()
+		} else {
+			evictionMtc.WithLabelValues("underpriced").Inc()
+		}
+		evicteeHash := evictee.Hash()
+		actHash := act.Hash()
+		log.L().Debug("Evicted action from full pool.",
+			log.Hex("hash", evicteeHash[:]),
+			log.Hex("by", actHash[:]),
+			zap.String("gasPrice", evictee.GasPrice().String()),
+			zap.Bool("overAccountSlots", overSlots))
+	}
+}
+
+func (ap *actPool) overSlots(numActs uint64) bool {
+	return ap.cfg.AccountSlots > 0 && numActs > ap.cfg.AccountSlots
+}
+
+// updatePriceIndex updates the tail action of the account in the price index
+func (ap *actPool) updatePriceIndex(sender string) {
+	queue, ok := ap.accountActs[sender]
+	if !ok {
+		ap.priceIndex.Remove(sender)
+		return
+	}
+	tail, ok := queue.Tail()
+	if !ok {
+		ap.priceIndex.Remove(sender)
+		return
+	}
+	ap.priceIndex.Update(sender, tail, ap.overSlots(uint64(queue.Len())))
+}
+
+// replaceAction replaces the pending action of the sender with the same nonce, if the new one pays a high enough gas
+// price and the pending balance can afford it, and evicts the actions of others to make room for its extra gas
+func (ap *actPool) replaceAction(
+	sender string,
+	queue ActQueue,
+	old action.SealedEnvelope,
+	act action.SealedEnvelope,
+	actHash hash.Hash256,
+	evictees []action.SealedEnvelope,
+) error {
+	if err := queue.Put(act); err != nil {
+		actpoolMtc.WithLabelValues("nonceUsed").Inc()
+		return errors.Wrapf(err, "cannot replace action with action %x", actHash)
+	}
+	ap.evict(evictees, act)
+	oldHash := old.Hash()
+	delete(ap.allActions, oldHash)
+	oldGas, _ := old.IntrinsicGas()
+	ap.gasInPool -= oldGas
+	ap.deleteAccountDestinationActions(old)
+	ap.addAction(sender, act, actHash)
+	ap.notifyEvent(PendingActionEvent{Type: ActionReplaced, Action: old, ReplacedBy: actHash})
+	ap.updateAccount(sender)
+	replacementMtc.Inc()
+	log.L().Debug("Replaced pending action.",
+		log.Hex("oldHash", oldHash[:]),
+		log.Hex("hash", actHash[:]),
+		zap.Uint64("nonce", act.Nonce()),
+		zap.String("gasPrice", act.GasPrice().String()))
+	return nil
+}
+
+// addAction adds the action accepted by the sender's queue into the pool
+func (ap *actPool) addAction(sender string, act action.SealedEnvelope, actHash hash.Hash256) {
 	ap.allActions[actHash] = act
 
 	//add actions to destination map
@@ -398,12 +665,6 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, actHa
 
 	intrinsicGas, _ := act.IntrinsicGas()
 	ap.gasInPool += intrinsicGas
-	// If the pending nonce equals this nonce, update queue
-	nonce := queue.PendingNonce()
-	if actNonce == nonce {
-		ap.updateAccount(sender)
-	}
-	return nil
 }
 
 // removeConfirmedActs removes processed (committed to block) actions from pool
@@ -424,6 +685,7 @@ func (ap *actPool) removeConfirmedActs() {
 		if queue.Empty() {
 			delete(ap.accountActs, from)
 		}
+		ap.updatePriceIndex(from)
 	}
 }
 
@@ -455,14 +717,42 @@ func (ap *actPool) deleteAccountDestinationActions(acts ...action.SealedEnvelope
 // updateAccount updates queue's status and remove invalidated actions from pool if necessary
 func (ap *actPool) updateAccount(sender string) {
 	queue := ap.accountActs[sender]
+	expired := queue.CleanTimeout()
+	if len(expired) > 0 {
+		ap.removeInvalidActs(expired)
+		ap.notify(ActionExpired, expired...)
+	}
 	acts := queue.UpdateQueue(queue.PendingNonce())
 	if len(acts) > 0 {
 		ap.removeInvalidActs(acts)
+		ap.notify(ActionDropped, acts...)
 	}
 	// Delete the queue entry if it becomes empty
 	if queue.Empty() {
 		delete(ap.accountActs, sender)
 	}
+	ap.updatePriceIndex(sender)
+}
+
+func (ap *actPool) rejournal() {
+	if err := ap.compactJournal(); err != nil {
+		log.L().Error("Error when compacting journal.", zap.Error(err))
+	}
+}
+
+// compactJournal rewrites the journal with the actions currently in pool
+func (ap *actPool) compactJournal() error {
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	acts := make([]action.SealedEnvelope, 0, len(ap.allActions))
+	for _, queue := range ap.accountActs {
+		acts = append(acts, queue.AllActs()...)
+	}
+	if err := ap.journal.rotate(acts); err != nil {
+		return errors.Wrap(err, "failed to compact journal")
+	}
+	return nil
 }
 
 func (ap *actPool) reset() {
@@ -471,6 +761,8 @@ func (ap *actPool) reset() {
 
 	// Remove confirmed actions in actpool
 	ap.removeConfirmedActs()
+	// The gas fee of sponsored actions is reserved again while updating the pending actions of each account
+	ap.sponsoredGas = make(map[string]*big.Int)
 	for from, queue := range ap.accountActs {
 		// Reset pending balance for each account
 		balance, err := ap.bc.Factory().Balance(from)
@@ -491,3 +783,56 @@ func (ap *actPool) reset() {
 		ap.updateAccount(from)
 	}
 }
+
+// enoughSponsorBalance checks whether the sponsor of the action can pay its gas fee on top of the gas fee reserved for
+// the other pending actions it sponsors, and reserves the gas fee if reserve is true
+func (ap *actPool) enoughSponsorBalance(act action.SealedEnvelope, reserve bool) bool {
+	sponsor, err := address.FromBytes(act.SponsorPubkey().Hash())
+	if err != nil {
+		return false
+	}
+	fee, err := act.GasFee()
+	if err != nil {
+		return false
+	}
+	// the pending actions of the sponsor itself come first
+	var balance *big.Int
+	if queue, ok := ap.accountActs[sponsor.String()]; ok {
+		balance = queue.PendingBalance()
+	} else if balance, err = ap.bc.Factory().Balance(sponsor.String()); err != nil {
+		return false
+	}
+	required := big.NewInt(0).Add(fee, ap.reservedGas(sponsor.String()))
+	if balance.Cmp(required) < 0 {
+		return false
+	}
+	if reserve {
+		ap.sponsoredGas[sponsor.String()] = required
+	}
+	return true
+}
+
+// releaseSponsoredGas releases the gas fee reserved for the pending sponsored action
+func (ap *actPool) releaseSponsoredGas(act action.SealedEnvelope) {
+	sponsor, err := address.FromBytes(act.SponsorPubkey().Hash())
+	if err != nil {
+		return
+	}
+	fee, err := act.GasFee()
+	if err != nil {
+		return
+	}
+	reserved := big.NewInt(0).Sub(ap.reservedGas(sponsor.String()), fee)
+	if reserved.Sign() <= 0 {
+		delete(ap.sponsoredGas, sponsor.String())
+		return
+	}
+	ap.sponsoredGas[sponsor.String()] = reserved
+}
+
+func (ap *actPool) reservedGas(sponsor string) *big.Int {
+	if reserved, ok := ap.sponsoredGas[sponsor]; ok {
+		return reserved
+	}
+	return big.NewInt(0)
+}
@@ -1020,6 +1020,180 @@ func TestActPool_GetActionByHash(t *testing.T) {
 	require.Equal(tsf2, act)
 }
 
+func TestActPool_ReplaceAction(t *testing.T) {
+	require := require.New(t)
+	cfg := config.Default
+	sender := identityset.Address(34).String()
+	priKey := identityset.PrivateKey(34)
+	cfg.Genesis.InitBalanceMap[sender] = "10000000"
+	registry := protocol.NewRegistry()
+	bc := blockchain.NewBlockchain(
+		cfg,
+		nil,
+		blockchain.InMemStateFactoryOption(),
+		blockchain.InMemDaoOption(),
+		blockchain.RegistryOption(registry),
+	)
+	acc := account.NewProtocol(rewarding.DepositGas)
+	require.NoError(acc.Register(registry))
+	require.NoError(bc.Start(context.Background()))
+	defer func() {
+		require.NoError(bc.Stop(context.Background()))
+	}()
+	// Create actpool
+	apConfig := getActPoolCfg()
+	apConfig.ReplacementPriceBump = 10
+	Ap, err := NewActPool(bc, apConfig, EnableExperimentalActions())
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Registry: registry})
+
+	tsf1, err := testutil.SignedTransfer(addr1, priKey, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(100))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr1, priKey, uint64(2), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(100))
+	require.NoError(err)
+	tsf4, err := testutil.SignedTransfer(addr1, priKey, uint64(4), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(100))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf1))
+	require.NoError(ap.Add(ctx, tsf2))
+	require.NoError(ap.Add(ctx, tsf4))
+	gasInPool := ap.GetGasSize()
+
+	// the gas price is not high enough
+	underpriced, err := testutil.SignedTransfer(addr2, priKey, uint64(1), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(105))
+	require.NoError(err)
+	require.Equal(action.ErrNonce, errors.Cause(ap.Add(ctx, underpriced)))
+
+	// the pending action is replaced
+	replace1, err := testutil.SignedTransfer(addr2, priKey, uint64(1), big.NewInt(20), []byte{}, uint64(10000), big.NewInt(110))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, replace1))
+	_, err = ap.GetActionByHash(tsf1.Hash())
+	require.Equal(action.ErrNotFound, errors.Cause(err))
+	act, err := ap.GetActionByHash(replace1.Hash())
+	require.NoError(err)
+	require.Equal(replace1, act)
+	require.Equal(uint64(3), ap.GetSize())
+	require.Equal(gasInPool, ap.GetGasSize())
+	require.Equal([]action.SealedEnvelope{tsf2, tsf4}, ap.GetUnconfirmedActs(addr1))
+	require.Equal([]action.SealedEnvelope{replace1}, ap.GetUnconfirmedActs(addr2))
+	pNonce, err := ap.getPendingNonce(sender)
+	require.NoError(err)
+	require.Equal(uint64(3), pNonce)
+	pBalance, err := ap.getPendingBalance(sender)
+	require.NoError(err)
+	require.Equal(big.NewInt(10000000-1100020-1000010).String(), pBalance.String())
+
+	// the pending balance cannot afford the replacement
+	overBalance, err := testutil.SignedTransfer(addr1, priKey, uint64(2), big.NewInt(8000000), []byte{}, uint64(10000), big.NewInt(110))
+	require.NoError(err)
+	require.Equal(action.ErrBalance, errors.Cause(ap.Add(ctx, overBalance)))
+	pBalance, err = ap.getPendingBalance(sender)
+	require.NoError(err)
+	require.Equal(big.NewInt(10000000-1100020-1000010).String(), pBalance.String())
+
+	// the action not yet pending is replaced as well
+	replace4, err := testutil.SignedTransfer(addr1, priKey, uint64(4), big.NewInt(10), []byte{}, uint64(10000), big.NewInt(200))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, replace4))
+	require.Equal([]action.SealedEnvelope{tsf2, replace4}, ap.GetUnconfirmedActs(addr1))
+	pendingActs := ap.PendingActionMap()[sender]
+	require.Equal([]action.SealedEnvelope{replace1, tsf2}, pendingActs)
+
+	// the replacement needing more gas than the pool has room for is rejected
+	ap.cfg.MaxGasLimitPerPool = ap.GetGasSize()
+	overGas, err := testutil.SignedTransfer(addr1, priKey, uint64(4), big.NewInt(10), []byte{1}, uint64(20000), big.NewInt(300))
+	require.NoError(err)
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(ctx, overGas)))
+	require.Equal([]action.SealedEnvelope{tsf2, replace4}, ap.GetUnconfirmedActs(addr1))
+	require.Equal(gasInPool, ap.GetGasSize())
+}
+
+func TestActPool_EvictActs(t *testing.T) {
+	require := require.New(t)
+	cfg := config.Default
+	addrs := make([]string, 4)
+	for i := range addrs {
+		addrs[i] = identityset.Address(24 + i).String()
+		cfg.Genesis.InitBalanceMap[addrs[i]] = "100000000"
+	}
+	registry := protocol.NewRegistry()
+	bc := blockchain.NewBlockchain(
+		cfg,
+		nil,
+		blockchain.InMemStateFactoryOption(),
+		blockchain.InMemDaoOption(),
+		blockchain.RegistryOption(registry),
+	)
+	acc := account.NewProtocol(rewarding.DepositGas)
+	require.NoError(acc.Register(registry))
+	require.NoError(bc.Start(context.Background()))
+	defer func() {
+		require.NoError(bc.Stop(context.Background()))
+	}()
+	// Create actpool
+	apConfig := getActPoolCfg()
+	apConfig.MaxNumActsPerPool = 4
+	apConfig.MaxNumActsPerAcct = 4
+	apConfig.AccountSlots = 2
+	Ap, err := NewActPool(bc, apConfig, EnableExperimentalActions())
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Registry: registry})
+	transfer := func(i int, nonce uint64, gasPrice int64) action.SealedEnvelope {
+		tsf, err := testutil.SignedTransfer(addr1, identityset.PrivateKey(24+i), nonce, big.NewInt(10), []byte{}, uint64(10000), big.NewInt(gasPrice))
+		require.NoError(err)
+		return tsf
+	}
+
+	tsf01 := transfer(0, 1, 10)
+	tsf02 := transfer(0, 2, 10)
+	tsf03 := transfer(0, 3, 10)
+	tsf11 := transfer(1, 1, 20)
+	for _, tsf := range []action.SealedEnvelope{tsf01, tsf02, tsf03, tsf11} {
+		require.NoError(ap.Add(ctx, tsf))
+	}
+	require.Equal(uint64(4), ap.GetSize())
+
+	// the account beyond its slots is evicted first regardless of the gas price
+	tsf21 := transfer(2, 1, 5)
+	require.NoError(ap.Add(ctx, tsf21))
+	require.Equal(uint64(4), ap.GetSize())
+	_, err = ap.GetActionByHash(tsf03.Hash())
+	require.Equal(action.ErrNotFound, errors.Cause(err))
+	require.Equal([]action.SealedEnvelope{tsf01, tsf02}, ap.GetUnconfirmedActs(addrs[0]))
+	pNonce, err := ap.getPendingNonce(addrs[0])
+	require.NoError(err)
+	require.Equal(uint64(3), pNonce)
+
+	// the action cannot evict the ones with higher or equal gas price
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(ctx, transfer(3, 1, 5))))
+	// the tail action with the lowest gas price is evicted
+	tsf31 := transfer(3, 1, 15)
+	require.NoError(ap.Add(ctx, tsf31))
+	require.Equal(uint64(4), ap.GetSize())
+	require.Equal(0, len(ap.GetUnconfirmedActs(addrs[2])))
+	require.Equal(3, ap.priceIndex.Len())
+	// the account holding as many actions as its slots cannot evict others
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(ctx, transfer(0, 3, 100))))
+	// the action replacing a pending one does not need room
+	tsf12 := transfer(1, 1, 30)
+	require.NoError(ap.Add(ctx, tsf12))
+	require.Equal(uint64(4), ap.GetSize())
+	require.Equal(uint64(40000), ap.GetGasSize())
+	// unless it needs more gas than the pool has room for, which evicts the lower-priced ones
+	ap.cfg.MaxGasLimitPerPool = 40000
+	tsf13, err := testutil.SignedTransfer(addr1, identityset.PrivateKey(25), 1, big.NewInt(10), []byte{1}, uint64(20000), big.NewInt(40))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf13))
+	require.Equal(uint64(3), ap.GetSize())
+	require.Equal(uint64(30100), ap.GetGasSize())
+	require.Equal([]action.SealedEnvelope{tsf01}, ap.GetUnconfirmedActs(addrs[0]))
+	require.Equal([]action.SealedEnvelope{tsf13}, ap.GetUnconfirmedActs(addrs[1]))
+}
+
 func TestActPool_GetCapacity(t *testing.T) {
 	require := require.New(t)
 	bc := blockchain.NewBlockchain(config.Default, nil, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
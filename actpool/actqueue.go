@@ -52,7 +52,11 @@ func (h *noncePriorityQueue) Pop() interface{} {
 type ActQueue interface {
 	Overlaps(action.SealedEnvelope) bool
 	Put(action.SealedEnvelope) error
+	Get(uint64) (action.SealedEnvelope, bool)
+	Tail() (action.SealedEnvelope, bool)
+	PopTail() (action.SealedEnvelope, bool)
 	FilterNonce(uint64) []action.SealedEnvelope
+	CleanTimeout() []action.SealedEnvelope
 	UpdateQueue(uint64) []action.SealedEnvelope
 	SetPendingNonce(uint64)
 	PendingNonce() uint64
@@ -78,6 +82,8 @@ type actQueue struct {
 	pendingBalance *big.Int
 	clock          clock.Clock
 	ttl            time.Duration
+	// Percentage by which the gas price of an action must exceed the one it replaces
+	priceBump uint64
 }
 
 // ActQueueOption is the option for actQueue.
@@ -109,17 +115,105 @@ func (q *actQueue) Overlaps(act action.SealedEnvelope) bool {
 	return exist
 }
 
-// Put inserts a new action into the map, also updating the queue's nonce index
+// Put inserts a new action into the map, also updating the queue's nonce index. If an action with the same nonce
+// exists, it is replaced only if the new gas price is high enough and the pending balance can afford the new one.
 func (q *actQueue) Put(act action.SealedEnvelope) error {
 	nonce := act.Nonce()
-	if _, exist := q.items[nonce]; exist {
-		return errors.Wrapf(action.ErrNonce, "duplicate nonce")
+	if old, exist := q.items[nonce]; exist {
+		return q.replace(old, act)
 	}
 	heap.Push(&q.index, nonceWithTTL{nonce: nonce, deadline: q.clock.Now().Add(q.ttl)})
 	q.items[nonce] = act
 	return nil
 }
 
+// Get returns the action with the given nonce in the queue
+func (q *actQueue) Get(nonce uint64) (action.SealedEnvelope, bool) {
+	act, exist := q.items[nonce]
+	return act, exist
+}
+
+// Tail returns the action with the highest nonce in the queue
+func (q *actQueue) Tail() (action.SealedEnvelope, bool) {
+	i := q.tailIndex()
+	if i < 0 {
+		return action.SealedEnvelope{}, false
+	}
+	return q.items[q.index[i].nonce], true
+}
+
+// PopTail removes the action with the highest nonce from the queue, and restores the pending nonce and balance if
+// the action is pending
+func (q *actQueue) PopTail() (action.SealedEnvelope, bool) {
+	i := q.tailIndex()
+	if i < 0 {
+		return action.SealedEnvelope{}, false
+	}
+	nonce := heap.Remove(&q.index, i).(nonceWithTTL).nonce
+	act := q.items[nonce]
+	delete(q.items, nonce)
+	if nonce < q.pendingNonce {
+		cost, _ := act.SenderCost()
+		q.pendingBalance.Add(q.pendingBalance, cost)
+		if act.IsSponsored() {
+			q.ap.releaseSponsoredGas(act)
+		}
+		q.pendingNonce = nonce
+	}
+	return act, true
+}
+
+func (q *actQueue) tailIndex() int {
+	tail := -1
+	for i := range q.index {
+		if tail < 0 || q.index[i].nonce > q.index[tail].nonce {
+			tail = i
+		}
+	}
+	return tail
+}
+
+func (q *actQueue) replace(old action.SealedEnvelope, act action.SealedEnvelope) error {
+	minPrice := new(big.Int).Mul(old.GasPrice(), big.NewInt(int64(100+q.priceBump)))
+	minPrice.Div(minPrice, big.NewInt(100))
+	if act.GasPrice().Cmp(old.GasPrice()) <= 0 || act.GasPrice().Cmp(minPrice) < 0 {
+		return errors.Wrapf(
+			action.ErrNonce,
+			"duplicate nonce, gas price %s is not enough to replace the action with gas price %s",
+			act.GasPrice(),
+			old.GasPrice(),
+		)
+	}
+	nonce := act.Nonce()
+	if nonce >= q.pendingNonce {
+		if !q.enoughBalance(act, false) {
+			return errors.Wrapf(action.ErrBalance, "insufficient balance to replace the action with nonce %d", nonce)
+		}
+	} else {
+		// the cost of the pending action has been deducted from the pending balance
+		oldCost, _ := old.SenderCost()
+		q.pendingBalance.Add(q.pendingBalance, oldCost)
+		if old.IsSponsored() {
+			q.ap.releaseSponsoredGas(old)
+		}
+		if !q.enoughBalance(act, true) {
+			q.pendingBalance.Sub(q.pendingBalance, oldCost)
+			if old.IsSponsored() {
+				q.ap.enoughSponsorBalance(old, true)
+			}
+			return errors.Wrapf(action.ErrBalance, "insufficient balance to replace the action with nonce %d", nonce)
+		}
+	}
+	for i := range q.index {
+		if q.index[i].nonce == nonce {
+			q.index[i].deadline = q.clock.Now().Add(q.ttl)
+			break
+		}
+	}
+	q.items[nonce] = act
+	return nil
+}
+
 // FilterNonce removes all actions from the map with a nonce lower than the given threshold
 func (q *actQueue) FilterNonce(threshold uint64) []action.SealedEnvelope {
 	var removed []action.SealedEnvelope
@@ -132,16 +226,27 @@ func (q *actQueue) FilterNonce(threshold uint64) []action.SealedEnvelope {
 	return removed
 }
 
+// CleanTimeout removes all the actions staying in the queue longer than the ttl
+func (q *actQueue) CleanTimeout() []action.SealedEnvelope {
+	if q.ttl == 0 {
+		return []action.SealedEnvelope{}
+	}
+	return q.cleanTimeout()
+}
+
 func (q *actQueue) cleanTimeout() []action.SealedEnvelope {
 	removedFromQueue := make([]action.SealedEnvelope, 0)
-	for i := 0; i < len(q.index); i++ {
+	for i := 0; i < len(q.index); {
 		if q.clock.Now().After(q.index[i].deadline) {
 			// remove
 			removedFromQueue = append(removedFromQueue, q.items[q.index[i].nonce])
 			delete(q.items, q.index[i].nonce)
 			q.index = append(q.index[:i], q.index[i+1:]...)
+			continue
 		}
+		i++
 	}
+	heap.Init(&q.index)
 	return removedFromQueue
 }
 
@@ -272,12 +377,16 @@ func (q *actQueue) removeActs(idx int) []action.SealedEnvelope {
 	return removedFromQueue
 }
 
-// enoughBalance helps check whether queue's pending balance is sufficient for the given action
+// enoughBalance helps check whether queue's pending balance is sufficient for the given action, whose gas fee is
+// reserved from the balance of its sponsor instead if it is sponsored
 func (q *actQueue) enoughBalance(act action.SealedEnvelope, updateBalance bool) bool {
-	cost, _ := act.Cost()
+	cost, _ := act.SenderCost()
 	if q.pendingBalance.Cmp(cost) < 0 {
 		return false
 	}
+	if act.IsSponsored() && !q.ap.enoughSponsorBalance(act, updateBalance) {
+		return false
+	}
 
 	if updateBalance {
 		q.pendingBalance.Sub(q.pendingBalance, cost)
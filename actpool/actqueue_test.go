@@ -14,11 +14,13 @@ import (
 
 	"github.com/facebookgo/clock"
 	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
 	"github.com/iotexproject/iotex-core/test/mock/mock_factory"
 	"github.com/iotexproject/iotex-core/testutil"
@@ -75,6 +77,74 @@ func TestActQueuePut(t *testing.T) {
 	require.Error(q.Put(tsf3))
 }
 
+func TestActQueueReplace(t *testing.T) {
+	require := require.New(t)
+	q := NewActQueue(nil, "", WithPriceBump(10)).(*actQueue)
+	q.pendingBalance = big.NewInt(100000)
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(0), big.NewInt(1))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 2, big.NewInt(100), nil, uint64(0), big.NewInt(1))
+	require.NoError(err)
+	require.NoError(q.Put(tsf1))
+	require.NoError(q.Put(tsf2))
+	q.UpdateQueue(q.pendingNonce)
+	require.Equal(uint64(3), q.pendingNonce)
+	require.Equal(big.NewInt(79800), q.pendingBalance)
+
+	// the gas price must be raised by the price bump
+	tsf3, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(200), nil, uint64(0), big.NewInt(1))
+	require.NoError(err)
+	require.Equal(action.ErrNonce, errors.Cause(q.Put(tsf3)))
+	// the pending balance must afford the replacement
+	tsf4, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(70000), nil, uint64(0), big.NewInt(2))
+	require.NoError(err)
+	require.Equal(action.ErrBalance, errors.Cause(q.Put(tsf4)))
+	require.Equal(big.NewInt(79800), q.pendingBalance)
+	tsf5, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(0), big.NewInt(2))
+	require.NoError(err)
+	require.NoError(q.Put(tsf5))
+	require.Equal(tsf5, q.items[1])
+	require.Equal(2, q.index.Len())
+	require.Equal(big.NewInt(69800), q.pendingBalance)
+}
+
+func TestActQueuePopTail(t *testing.T) {
+	require := require.New(t)
+	q := NewActQueue(nil, "").(*actQueue)
+	q.pendingBalance = big.NewInt(100000)
+	_, ok := q.Tail()
+	require.False(ok)
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(0), big.NewInt(1))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 2, big.NewInt(100), nil, uint64(0), big.NewInt(1))
+	require.NoError(err)
+	tsf4, err := testutil.SignedTransfer(addr2, priKey1, 4, big.NewInt(100), nil, uint64(0), big.NewInt(1))
+	require.NoError(err)
+	require.NoError(q.Put(tsf2))
+	require.NoError(q.Put(tsf4))
+	require.NoError(q.Put(tsf1))
+	q.UpdateQueue(q.pendingNonce)
+	require.Equal(uint64(3), q.pendingNonce)
+	require.Equal(big.NewInt(79800), q.pendingBalance)
+
+	tail, ok := q.Tail()
+	require.True(ok)
+	require.Equal(tsf4, tail)
+	tail, ok = q.PopTail()
+	require.True(ok)
+	require.Equal(tsf4, tail)
+	require.Equal(uint64(3), q.pendingNonce)
+	require.Equal(big.NewInt(79800), q.pendingBalance)
+	// the pending nonce and balance are restored when a pending action is removed
+	tail, ok = q.PopTail()
+	require.True(ok)
+	require.Equal(tsf2, tail)
+	require.Equal(uint64(2), q.pendingNonce)
+	require.Equal(big.NewInt(89900), q.pendingBalance)
+	require.Equal(1, q.Len())
+	require.Equal(uint64(1), q.index[0].nonce)
+}
+
 func TestActQueueFilterNonce(t *testing.T) {
 	require := require.New(t)
 	q := NewActQueue(nil, "").(*actQueue)
@@ -212,3 +282,47 @@ func TestActQueueTimeOutAction(t *testing.T) {
 	q.(*actQueue).cleanTimeout()
 	assert.Equal(t, 1, q.Len())
 }
+
+func TestActQueueSponsoredBalance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	require := require.New(t)
+	cfg := config.Default
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	sf := mock_factory.NewMockFactory(ctrl)
+	sf.EXPECT().Balance(addr3).Return(big.NewInt(25000), nil).AnyTimes()
+	bc.EXPECT().Factory().Return(sf).AnyTimes()
+	ap, err := NewActPool(bc, cfg.ActPool, EnableExperimentalActions())
+	require.NoError(err)
+	q := NewActQueue(ap.(*actPool), addr1).(*actQueue)
+	q.pendingNonce = 1
+	q.pendingBalance = big.NewInt(100)
+	sponsored := func(nonce uint64) action.SealedEnvelope {
+		tsf, err := testutil.SignedTransfer(addr2, priKey1, nonce, big.NewInt(50), nil, uint64(100000), big.NewInt(1))
+		require.NoError(err)
+		selp, err := action.SignSponsored(tsf.Envelope, priKey1, identityset.Address(30))
+		require.NoError(err)
+		selp, err = action.Sponsor(selp, priKey3)
+		require.NoError(err)
+		return selp
+	}
+	tsf1, tsf2, tsf3 := sponsored(1), sponsored(2), sponsored(3)
+	// the sender pays the amount only, while the sponsor reserves the gas fee
+	require.NoError(q.Put(tsf1))
+	require.NoError(q.Put(tsf2))
+	require.Empty(q.UpdateQueue(1))
+	require.Equal(uint64(3), q.pendingNonce)
+	require.Equal(big.NewInt(0).String(), q.pendingBalance.String())
+	require.Equal(big.NewInt(20000).String(), ap.(*actPool).reservedGas(addr3).String())
+
+	// the sponsor cannot afford the gas fee of the third action
+	q.pendingBalance = big.NewInt(100)
+	require.False(q.enoughBalance(tsf3, false))
+
+	// popping the pending action releases the reserved gas fee
+	act, ok := q.PopTail()
+	require.True(ok)
+	require.Equal(tsf2, act)
+	require.Equal(big.NewInt(10000).String(), ap.(*actPool).reservedGas(addr3).String())
+	require.True(q.enoughBalance(tsf3, false))
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// maxJournalRecordSize is the maximum size of an action in journal, beyond which the journal is regarded as corrupted
+const maxJournalRecordSize = 4 * 1024 * 1024
+
+// actJournal is a file appending the actions accepted by the actpool, each of which is encoded as the size of the
+// action proto followed by the proto bytes
+type actJournal struct {
+	path   string
+	writer *os.File
+}
+
+func newActJournal(path string) *actJournal {
+	return &actJournal{path: path}
+}
+
+// load replays the actions in journal through the add function. A truncated or corrupted tail, e.g., written when the
+// node crashed, is skipped.
+func (j *actJournal) load(add func(action.SealedEnvelope) error) error {
+	f, err := os.Open(j.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to open journal %s", j.path)
+	}
+	defer f.Close()
+
+	var (
+		reader  = bufio.NewReader(f)
+		total   int
+		dropped int
+	)
+	for {
+		act, err := readJournalRecord(reader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.L().Warn("Skip the corrupted tail of journal.", zap.String("path", j.path), zap.Error(err))
+			break
+		}
+		total++
+		if err := add(act); err != nil {
+			log.L().Debug("Failed to add action from journal.", zap.Error(err))
+			dropped++
+		}
+	}
+	log.L().Info("Loaded actions from journal.",
+		zap.String("path", j.path),
+		zap.Int("total", total),
+		zap.Int("dropped", dropped))
+	return nil
+}
+
+// insert appends the action to journal
+func (j *actJournal) insert(act action.SealedEnvelope) error {
+	if j.writer == nil {
+		return errors.New("journal is not open")
+	}
+	return writeJournalRecord(j.writer, act)
+}
+
+// rotate rewrites the journal with the given actions, and opens it for appending
+func (j *actJournal) rotate(acts []action.SealedEnvelope) error {
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return errors.Wrap(err, "failed to close journal")
+		}
+		j.writer = nil
+	}
+	tmpPath := j.path + ".new"
+	f, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create journal %s", tmpPath)
+	}
+	writer := bufio.NewWriter(f)
+	for _, act := range acts {
+		if err := writeJournalRecord(writer, act); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		f.Close()
+		return errors.Wrap(err, "failed to flush journal")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "failed to close journal")
+	}
+	if err := os.Rename(tmpPath, j.path); err != nil {
+		return errors.Wrap(err, "failed to replace journal")
+	}
+	j.writer, err = os.OpenFile(j.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open journal %s", j.path)
+	}
+	return nil
+}
+
+// close closes the journal
+func (j *actJournal) close() error {
+	if j.writer == nil {
+		return nil
+	}
+	err := j.writer.Close()
+	j.writer = nil
+	return err
+}
+
+func writeJournalRecord(w io.Writer, act action.SealedEnvelope) error {
+	data, err := proto.Marshal(act.Proto())
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize action")
+	}
+	record := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(record, uint32(len(data)))
+	copy(record[4:], data)
+	if _, err := w.Write(record); err != nil {
+		return errors.Wrap(err, "failed to write journal")
+	}
+	return nil
+}
+
+func readJournalRecord(r io.Reader) (action.SealedEnvelope, error) {
+	var (
+		act  action.SealedEnvelope
+		size uint32
+	)
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return act, err
+	}
+	if size > maxJournalRecordSize {
+		return act, errors.Errorf("action size %d exceeds the limit", size)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return act, err
+	}
+	pb := &iotextypes.Action{}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return act, errors.Wrap(err, "failed to deserialize action")
+	}
+	if err := act.LoadProto(pb); err != nil {
+		return act, errors.Wrap(err, "failed to load action")
+	}
+	return act, nil
+}
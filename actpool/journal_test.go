@@ -0,0 +1,143 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"context"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestActJournal(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "journal")
+	require.NoError(err)
+	defer testutil.CleanupPath(t, dir)
+	path := filepath.Join(dir, "actpool.journal")
+	j := newActJournal(path)
+
+	load := func() []action.SealedEnvelope {
+		var acts []action.SealedEnvelope
+		require.NoError(j.load(func(act action.SealedEnvelope) error {
+			acts = append(acts, act)
+			return nil
+		}))
+		return acts
+	}
+	// the journal not existing is loaded as empty
+	require.Equal(0, len(load()))
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 2, big.NewInt(100), []byte{1, 2, 3}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr1, priKey2, 1, big.NewInt(100), nil, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	require.Error(j.insert(tsf1))
+
+	require.NoError(j.rotate([]action.SealedEnvelope{tsf1}))
+	require.NoError(j.insert(tsf2))
+	require.NoError(j.insert(tsf3))
+	require.Equal([]action.SealedEnvelope{tsf1, tsf2, tsf3}, load())
+
+	// compacting the journal drops the actions not in pool
+	require.NoError(j.rotate([]action.SealedEnvelope{tsf3}))
+	require.Equal([]action.SealedEnvelope{tsf3}, load())
+	require.NoError(j.insert(tsf1))
+	require.NoError(j.close())
+	require.Equal([]action.SealedEnvelope{tsf3, tsf1}, load())
+
+	// the truncated tail is skipped
+	info, err := os.Stat(path)
+	require.NoError(err)
+	require.NoError(os.Truncate(path, info.Size()-3))
+	require.Equal([]action.SealedEnvelope{tsf3}, load())
+}
+
+func TestActPool_Journal(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "journal")
+	require.NoError(err)
+	defer testutil.CleanupPath(t, dir)
+
+	cfg := config.Default
+	cfg.Genesis.InitBalanceMap[addr1] = "100"
+	cfg.Genesis.InitBalanceMap[addr2] = "100"
+	registry := protocol.NewRegistry()
+	bc := blockchain.NewBlockchain(
+		cfg,
+		nil,
+		blockchain.InMemStateFactoryOption(),
+		blockchain.InMemDaoOption(),
+		blockchain.RegistryOption(registry),
+	)
+	acc := account.NewProtocol(rewarding.DepositGas)
+	require.NoError(acc.Register(registry))
+	require.NoError(bc.Start(context.Background()))
+	defer func() {
+		require.NoError(bc.Stop(context.Background()))
+	}()
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Registry: registry})
+	apConfig := getActPoolCfg()
+	apConfig.JournalPath = filepath.Join(dir, "actpool.journal")
+	apConfig.JournalCompactInterval = time.Hour
+
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(10), nil, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 2, big.NewInt(10), nil, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr1, priKey2, 1, big.NewInt(10), nil, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	ap, err := NewActPool(bc, apConfig)
+	require.NoError(err)
+	require.NoError(ap.Start(ctx))
+	require.NoError(ap.Add(ctx, tsf1))
+	require.NoError(ap.Add(ctx, tsf2))
+	require.NoError(ap.Add(ctx, tsf3))
+	// the actions added are replayed after restart
+	ap2, err := NewActPool(bc, apConfig)
+	require.NoError(err)
+	require.NoError(ap2.Start(ctx))
+	require.Equal(uint64(3), ap2.GetSize())
+	require.Equal([]action.SealedEnvelope{tsf1, tsf2, tsf3}, ap2.GetUnconfirmedActs(addr1))
+	require.NoError(ap2.Stop(ctx))
+	require.NoError(ap.Stop(ctx))
+
+	// the actions no longer valid are dropped, and compacted out of the journal
+	tsf4, err := testutil.SignedTransfer(addr2, priKey1, 2, big.NewInt(1000), nil, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	j := newActJournal(apConfig.JournalPath)
+	require.NoError(j.rotate([]action.SealedEnvelope{tsf1, tsf4, tsf3}))
+	require.NoError(j.close())
+	ap3, err := NewActPool(bc, apConfig)
+	require.NoError(err)
+	require.NoError(ap3.Start(ctx))
+	require.Equal(uint64(2), ap3.GetSize())
+	_, err = ap3.GetActionByHash(tsf4.Hash())
+	require.Equal(action.ErrNotFound, errors.Cause(err))
+	require.NoError(ap3.Stop(ctx))
+	var acts []action.SealedEnvelope
+	require.NoError(j.load(func(act action.SealedEnvelope) error {
+		acts = append(acts, act)
+		return nil
+	}))
+	require.Equal(2, len(acts))
+}
@@ -29,3 +29,12 @@ func WithTimeOut(ttl time.Duration) interface{ ActQueueOption } {
 }
 
 func (o *ttlOption) SetActQueueOption(aq *actQueue) { aq.ttl = o.ttl }
+
+type priceBumpOption struct{ bump uint64 }
+
+// WithPriceBump returns an option to set the percentage by which the gas price of a replacement action must exceed.
+func WithPriceBump(bump uint64) interface{ ActQueueOption } {
+	return &priceBumpOption{bump}
+}
+
+func (o *priceBumpOption) SetActQueueOption(aq *actQueue) { aq.priceBump = o.bump }
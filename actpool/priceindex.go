@@ -0,0 +1,108 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"container/heap"
+
+	"github.com/iotexproject/iotex-core/action"
+)
+
+type tailItem struct {
+	sender string
+	act    action.SealedEnvelope
+	// overSlots is true if the account holds more actions than the guaranteed account slots
+	overSlots bool
+	index     int
+}
+
+// tailQueue is a min-heap of the tail actions, in which the ones of the accounts over their slots come first, and the
+// others are ordered by gas price
+type tailQueue []*tailItem
+
+func (h tailQueue) Len() int { return len(h) }
+func (h tailQueue) Less(i, j int) bool {
+	if h[i].overSlots != h[j].overSlots {
+		return h[i].overSlots
+	}
+	return h[i].act.GasPrice().Cmp(h[j].act.GasPrice()) < 0
+}
+func (h tailQueue) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *tailQueue) Push(x interface{}) {
+	in, ok := x.(*tailItem)
+	if !ok {
+		return
+	}
+	in.index = len(*h)
+	*h = append(*h, in)
+}
+
+func (h *tailQueue) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*h = old[0 : n-1]
+	return x
+}
+
+// priceIndex is the global priority index of the actions in pool. Only the tail action of each account, i.e., the one
+// with the highest nonce, is indexed, since evicting any other one would leave a nonce gap in the account.
+type priceIndex struct {
+	items map[string]*tailItem
+	queue tailQueue
+}
+
+func newPriceIndex() *priceIndex {
+	return &priceIndex{
+		items: make(map[string]*tailItem),
+		queue: tailQueue{},
+	}
+}
+
+// Update sets the tail action of the account
+func (pi *priceIndex) Update(sender string, tail action.SealedEnvelope, overSlots bool) {
+	if item, ok := pi.items[sender]; ok {
+		item.act = tail
+		item.overSlots = overSlots
+		heap.Fix(&pi.queue, item.index)
+		return
+	}
+	item := &tailItem{sender: sender, act: tail, overSlots: overSlots}
+	heap.Push(&pi.queue, item)
+	pi.items[sender] = item
+}
+
+// Remove removes the account from the index
+func (pi *priceIndex) Remove(sender string) {
+	item, ok := pi.items[sender]
+	if !ok {
+		return
+	}
+	heap.Remove(&pi.queue, item.index)
+	delete(pi.items, sender)
+}
+
+// Len returns the number of accounts in the index
+func (pi *priceIndex) Len() int {
+	return pi.queue.Len()
+}
+
+// candidates returns a copy of the heap, which could be popped without changing the index
+func (pi *priceIndex) candidates() tailQueue {
+	cands := make(tailQueue, len(pi.queue))
+	for i, item := range pi.queue {
+		c := *item
+		cands[i] = &c
+	}
+	return cands
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"container/heap"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestPriceIndex(t *testing.T) {
+	require := require.New(t)
+	pi := newPriceIndex()
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(0), big.NewInt(30))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey2, 1, big.NewInt(100), nil, uint64(0), big.NewInt(20))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr2, priKey3, 1, big.NewInt(100), nil, uint64(0), big.NewInt(10))
+	require.NoError(err)
+	pi.Update(addr1, tsf1, false)
+	pi.Update(addr2, tsf2, false)
+	pi.Update(addr3, tsf3, false)
+	require.Equal(3, pi.Len())
+	require.Equal(addr3, pi.queue[0].sender)
+
+	// the account over its slots comes first regardless of the gas price
+	pi.Update(addr1, tsf1, true)
+	require.Equal(addr1, pi.queue[0].sender)
+
+	// popping the candidates does not change the index
+	cands := pi.candidates()
+	require.Equal(addr1, heap.Pop(&cands).(*tailItem).sender)
+	require.Equal(addr3, heap.Pop(&cands).(*tailItem).sender)
+	require.Equal(addr2, heap.Pop(&cands).(*tailItem).sender)
+	require.Equal(3, pi.Len())
+	require.Equal(addr1, pi.queue[0].sender)
+
+	pi.Remove(addr1)
+	pi.Remove(addr1)
+	require.Equal(2, pi.Len())
+	require.Equal(addr3, pi.queue[0].sender)
+	tsf4, err := testutil.SignedTransfer(addr2, priKey3, 2, big.NewInt(100), nil, uint64(0), big.NewInt(40))
+	require.NoError(err)
+	pi.Update(addr3, tsf4, false)
+	require.Equal(addr2, pi.queue[0].sender)
+	require.Equal(tsf4, pi.items[addr3].act)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"github.com/iotexproject/go-pkgs/hash"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// PendingActionEventType is the type of the change of an action in pool
+type PendingActionEventType int
+
+const (
+	// ActionAccepted means the action is accepted into pool
+	ActionAccepted PendingActionEventType = iota
+	// ActionReplaced means the action is replaced by another one with the same sender and nonce
+	ActionReplaced
+	// ActionDropped means the action is removed from pool before committed, e.g., evicted or no longer affordable
+	ActionDropped
+	// ActionExpired means the action is removed from pool after staying too long
+	ActionExpired
+)
+
+type (
+	// PendingActionEvent is the change of an action in pool
+	PendingActionEvent struct {
+		Type   PendingActionEventType
+		Action action.SealedEnvelope
+		// ReplacedBy is the hash of the action replacing this one
+		ReplacedBy hash.Hash256
+	}
+
+	// PendingActionSubscriber is notified of the changes of the actions in pool. It is called with the pool locked, so
+	// it should return quickly.
+	PendingActionSubscriber interface {
+		HandlePendingAction(PendingActionEvent) error
+	}
+)
+
+// AddSubscriber adds a subscriber to the changes of the actions in pool
+func (ap *actPool) AddSubscriber(s PendingActionSubscriber) error {
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	for _, sub := range ap.subscribers {
+		if sub == s {
+			return nil
+		}
+	}
+	ap.subscribers = append(ap.subscribers, s)
+	return nil
+}
+
+// RemoveSubscriber removes a subscriber
+func (ap *actPool) RemoveSubscriber(s PendingActionSubscriber) error {
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	for i, sub := range ap.subscribers {
+		if sub == s {
+			ap.subscribers = append(ap.subscribers[:i], ap.subscribers[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (ap *actPool) notify(t PendingActionEventType, acts ...action.SealedEnvelope) {
+	for _, act := range acts {
+		ap.notifyEvent(PendingActionEvent{Type: t, Action: act})
+	}
+}
+
+func (ap *actPool) notifyEvent(evt PendingActionEvent) {
+	for _, s := range ap.subscribers {
+		if err := s.HandlePendingAction(evt); err != nil {
+			log.L().Warn("Failed to notify the change of action.", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+type testSubscriber struct {
+	events []PendingActionEvent
+}
+
+func (s *testSubscriber) HandlePendingAction(evt PendingActionEvent) error {
+	s.events = append(s.events, evt)
+	return nil
+}
+
+func TestActPool_Subscriber(t *testing.T) {
+	require := require.New(t)
+	cfg := config.Default
+	sender := identityset.Address(34).String()
+	priKey := identityset.PrivateKey(34)
+	cfg.Genesis.InitBalanceMap[sender] = "10000000"
+	registry := protocol.NewRegistry()
+	bc := blockchain.NewBlockchain(
+		cfg,
+		nil,
+		blockchain.InMemStateFactoryOption(),
+		blockchain.InMemDaoOption(),
+		blockchain.RegistryOption(registry),
+	)
+	acc := account.NewProtocol(rewarding.DepositGas)
+	require.NoError(acc.Register(registry))
+	require.NoError(bc.Start(context.Background()))
+	defer func() {
+		require.NoError(bc.Stop(context.Background()))
+	}()
+	apConfig := getActPoolCfg()
+	apConfig.ReplacementPriceBump = 10
+	apConfig.ActionExpiry = 200 * time.Millisecond
+	ap, err := NewActPool(bc, apConfig)
+	require.NoError(err)
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{Registry: registry})
+
+	sub := &testSubscriber{}
+	require.NoError(ap.AddSubscriber(sub))
+	require.NoError(ap.AddSubscriber(sub))
+
+	tsf1, err := testutil.SignedTransfer(addr1, priKey, 1, big.NewInt(10), nil, uint64(10000), big.NewInt(100))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr1, priKey, 2, big.NewInt(10), nil, uint64(10000), big.NewInt(100))
+	require.NoError(err)
+	replace1, err := testutil.SignedTransfer(addr1, priKey, 1, big.NewInt(10), nil, uint64(10000), big.NewInt(200))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, tsf1))
+	require.NoError(ap.Add(ctx, tsf2))
+	require.Error(ap.Add(ctx, tsf2))
+	require.NoError(ap.Add(ctx, replace1))
+	require.Equal([]PendingActionEvent{
+		{Type: ActionAccepted, Action: tsf1},
+		{Type: ActionAccepted, Action: tsf2},
+		{Type: ActionReplaced, Action: tsf1, ReplacedBy: replace1.Hash()},
+		{Type: ActionAccepted, Action: replace1},
+	}, sub.events)
+
+	// the actions staying too long are expired
+	sub.events = nil
+	time.Sleep(300 * time.Millisecond)
+	ap.Reset()
+	require.Equal(2, len(sub.events))
+	for _, evt := range sub.events {
+		require.Equal(ActionExpired, evt.Type)
+		require.Equal(hash.ZeroHash256, evt.ReplacedBy)
+	}
+	require.Equal(uint64(0), ap.GetSize())
+
+	// the subscriber removed is no longer notified
+	sub.events = nil
+	require.NoError(ap.RemoveSubscriber(sub))
+	require.NoError(ap.Add(ctx, tsf1))
+	require.Equal(0, len(sub.events))
+}
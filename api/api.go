@@ -15,6 +15,7 @@ import (
 	"strconv"
 	"time"
 
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
@@ -33,6 +34,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/action/protocol/poll"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
 	"github.com/iotexproject/iotex-core/actpool"
@@ -47,6 +49,7 @@ import (
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 	"github.com/iotexproject/iotex-core/pkg/version"
 	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
 )
 
 var (
@@ -98,7 +101,9 @@ type Server struct {
 	cfg               config.Config
 	registry          *protocol.Registry
 	chainListener     Listener
+	pendingListener   *pendingListener
 	grpcserver        *grpc.Server
+	web3Server        *Web3Server
 	hasActionIndex    bool
 	electionCommittee committee.Committee
 }
@@ -138,7 +143,8 @@ func NewServer(
 		cfg:               cfg,
 		registry:          registry,
 		chainListener:     NewChainListener(),
-		gs:                gasstation.NewGasStation(chain, cfg.API),
+		pendingListener:   newPendingListener(),
+		gs:                gasstation.NewGasStation(chain, actPool, cfg.API),
 		electionCommittee: apiCfg.electionCommittee,
 	}
 	if _, ok := cfg.Plugins[config.GatewayPlugin]; ok {
@@ -151,12 +157,26 @@ func NewServer(
 	iotexapi.RegisterAPIServiceServer(svr.grpcserver, svr)
 	grpc_prometheus.Register(svr.grpcserver)
 	reflection.Register(svr.grpcserver)
+	if cfg.API.Web3Port > 0 {
+		web3Server, err := NewWeb3Server(svr)
+		if err != nil {
+			return nil, err
+		}
+		svr.web3Server = web3Server
+	}
 
 	return svr, nil
 }
 
 // GetAccount returns the metadata of an account
 func (api *Server) GetAccount(ctx context.Context, in *iotexapi.GetAccountRequest) (*iotexapi.GetAccountResponse, error) {
+	height, history, err := api.queryHeight(in.Height)
+	if err != nil {
+		return nil, err
+	}
+	if history {
+		return api.getAccountAtHeight(in.Address, height)
+	}
 	state, err := api.bc.Factory().AccountState(in.Address)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
@@ -398,9 +418,26 @@ func (api *Server) ReadContract(ctx context.Context, in *iotexapi.ReadContractRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	nonce, err := api.bc.Factory().Nonce(in.CallerAddress)
+	height, history, err := api.queryHeight(in.Height)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	var (
+		nonce uint64
+		opts  []blockchain.SimulateOption
+	)
+	if history {
+		account, err := api.bc.Factory().AccountStateAtHeight(in.CallerAddress, height)
+		if err != nil {
+			return nil, historyStatusError(err, codes.InvalidArgument)
+		}
+		nonce = account.Nonce
+		opts = append(opts, blockchain.SimulateAtHeightOption(height))
+	} else {
+		nonce, err = api.bc.Factory().Nonce(in.CallerAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 	}
 
 	sc, _ = action.NewExecution(
@@ -417,14 +454,25 @@ func (api *Server) ReadContract(ctx context.Context, in *iotexapi.ReadContractRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	retval, receipt, err := blockchain.SimulateExecution(api.bc, callerAddr, sc)
+	retval, receipt, err := blockchain.SimulateExecution(api.bc, callerAddr, sc, opts...)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, historyStatusError(err, codes.Internal)
 	}
-	return &iotexapi.ReadContractResponse{
+	res := &iotexapi.ReadContractResponse{
 		Data:    hex.EncodeToString(retval),
 		Receipt: receipt.ConvertToReceiptPb(),
-	}, nil
+	}
+	if receipt.Status != uint64(iotextypes.ReceiptStatus_Success) {
+		if reason := evm.RevertReason(retval); reason != "" {
+			// the response is attached for the caller to get the raw revert data
+			st, err := status.New(codes.FailedPrecondition, "execution reverted: "+reason).WithDetails(res)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			return nil, st.Err()
+		}
+	}
+	return res, nil
 }
 
 // ReadState reads state on blockchain
@@ -433,9 +481,18 @@ func (api *Server) ReadState(ctx context.Context, in *iotexapi.ReadStateRequest)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "protocol %s isn't registered", string(in.ProtocolID))
 	}
-	data, err := api.readState(ctx, p, in.MethodName, in.Arguments...)
+	height, history, err := api.queryHeight(in.Height)
 	if err != nil {
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, err
+	}
+	var data []byte
+	if history {
+		data, err = api.readStateAtHeight(ctx, height, p, in.MethodName, in.Arguments...)
+	} else {
+		data, err = api.readState(ctx, p, in.MethodName, in.Arguments...)
+	}
+	if err != nil {
+		return nil, historyStatusError(err, codes.NotFound)
 	}
 	out := iotexapi.ReadStateResponse{
 		Data: data,
@@ -452,6 +509,113 @@ func (api *Server) SuggestGasPrice(ctx context.Context, in *iotexapi.SuggestGasP
 	return &iotexapi.SuggestGasPriceResponse{GasPrice: suggestPrice}, nil
 }
 
+// SuggestGasPrices suggests the gas prices of the slow, standard and fast tiers
+func (api *Server) SuggestGasPrices(ctx context.Context, in *iotexapi.SuggestGasPricesRequest) (*iotexapi.SuggestGasPricesResponse, error) {
+	prices, err := api.gs.SuggestGasPrices()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &iotexapi.SuggestGasPricesResponse{
+		Slow:     prices.Slow,
+		Standard: prices.Standard,
+		Fast:     prices.Fast,
+	}, nil
+}
+
+// GetFeeHistory returns the gas usage and the gas prices of the recent blocks
+func (api *Server) GetFeeHistory(ctx context.Context, in *iotexapi.GetFeeHistoryRequest) (*iotexapi.GetFeeHistoryResponse, error) {
+	newest := in.NewestBlock
+	if newest == 0 {
+		newest = api.bc.TipHeight()
+	}
+	history, err := api.gs.FeeHistory(in.BlockCount, newest, in.RewardPercentiles)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	res := &iotexapi.GetFeeHistoryResponse{
+		OldestBlock:  history.OldestBlock,
+		GasUsedRatio: history.GasUsedRatio,
+	}
+	for _, rewards := range history.Rewards {
+		gasPrices := make([]string, 0, len(rewards))
+		for _, r := range rewards {
+			gasPrices = append(gasPrices, r.String())
+		}
+		res.Rewards = append(res.Rewards, &iotexapi.FeeHistoryRewards{GasPrices: gasPrices})
+	}
+	return res, nil
+}
+
+// SimulateActions runs the actions one after another on top of the tip, optionally after the pending actions of the
+// callers, and returns the receipt and the account changes of each action
+func (api *Server) SimulateActions(ctx context.Context, in *iotexapi.SimulateActionsRequest) (*iotexapi.SimulateActionsResponse, error) {
+	if len(in.Steps) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no action to simulate")
+	}
+	if uint64(len(in.Steps)) > api.cfg.API.RangeQueryLimit {
+		return nil, status.Error(codes.InvalidArgument, "number of actions to simulate exceeds the limit")
+	}
+	var (
+		pending []action.SealedEnvelope
+		nonces  = make(map[string]uint64)
+		steps   = make([]blockchain.SimulationStep, 0, len(in.Steps))
+	)
+	for _, step := range in.Steps {
+		caller, err := address.FromString(step.CallerAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		addr := caller.String()
+		if _, ok := nonces[addr]; !ok {
+			nonce, err := api.bc.Factory().Nonce(addr)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			if in.IncludePending && api.ap != nil {
+				acts, err := api.pendingActions(addr)
+				if err != nil {
+					return nil, status.Error(codes.Internal, err.Error())
+				}
+				pending = append(pending, acts...)
+				nonce += uint64(len(acts))
+			}
+			nonces[addr] = nonce
+		}
+		nonces[addr]++
+		elp, err := simulationEnvelope(step.Action, nonces[addr], api.cfg.Genesis.ActionGasLimit)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		steps = append(steps, blockchain.SimulationStep{Caller: caller, Envelope: elp})
+	}
+
+	results, err := blockchain.SimulateActions(api.bc, pending, steps)
+	if errors.Cause(err) == blockchain.ErrUnsupportedSimulation {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &iotexapi.SimulateActionsResponse{}
+	for _, r := range results {
+		result := &iotexapi.SimulationResult{}
+		if r.Err != nil {
+			result.Error = r.Err.Error()
+		} else {
+			result.Receipt = r.Receipt.ConvertToReceiptPb()
+		}
+		for _, diff := range r.Diffs {
+			result.Diffs = append(result.Diffs, &iotexapi.AccountDiff{
+				Address: diff.Address,
+				Before:  accountStatePb(diff.Before),
+				After:   accountStatePb(diff.After),
+			})
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
 // EstimateGasForAction estimates gas for action
 func (api *Server) EstimateGasForAction(ctx context.Context, in *iotexapi.EstimateGasForActionRequest) (*iotexapi.EstimateGasForActionResponse, error) {
 	estimateGas, err := api.gs.EstimateGasForAction(in.Action)
@@ -653,6 +817,21 @@ func (api *Server) StreamLogs(in *iotexapi.StreamLogsRequest, stream iotexapi.AP
 	}
 }
 
+// StreamPendingActions streams the changes of the actions in actpool that match the filter condition
+func (api *Server) StreamPendingActions(
+	in *iotexapi.StreamPendingActionsRequest,
+	stream iotexapi.APIService_StreamPendingActionsServer,
+) error {
+	filter := NewPendingActionFilter(in.Filter, stream)
+	if err := api.pendingListener.AddResponder(filter); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := filter.Serve(); err != nil {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return nil
+}
+
 // GetVotes gets votes for req
 func (api *Server) GetVotes(
 	ctx context.Context,
@@ -694,6 +873,40 @@ func (api *Server) GetElectionBuckets(
 	return &iotexapi.GetElectionBucketsResponse{Buckets: re}, nil
 }
 
+// TraceTransaction re-executes a committed execution and returns its trace
+func (api *Server) TraceTransaction(ctx context.Context, in *iotexapi.TraceTransactionRequest) (*iotexapi.TraceResponse, error) {
+	actHash, err := hash.HexStringToHash256(in.ActionHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	trace, receipt, err := api.traceAction(ctx, actHash, in.StructLogs)
+	if err != nil {
+		return nil, err
+	}
+	return traceResponse(trace, receipt), nil
+}
+
+// TraceCall simulates an execution on top of the tip and returns its trace
+func (api *Server) TraceCall(ctx context.Context, in *iotexapi.TraceCallRequest) (*iotexapi.TraceResponse, error) {
+	sc := &action.Execution{}
+	if err := sc.LoadProto(in.Execution); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sc, _ = action.NewExecution(
+		sc.Contract(),
+		0,
+		sc.Amount(),
+		api.cfg.Genesis.BlockGasLimit,
+		big.NewInt(0),
+		sc.Data(),
+	)
+	trace, receipt, err := api.traceCall(ctx, in.CallerAddress, sc, in.StructLogs)
+	if err != nil {
+		return nil, err
+	}
+	return traceResponse(trace, receipt), nil
+}
+
 // GetReceiptByActionHash returns receipt by action hash
 func (api *Server) GetReceiptByActionHash(h hash.Hash256) (*action.Receipt, error) {
 	if !api.hasActionIndex || api.indexer == nil {
@@ -717,6 +930,197 @@ func (api *Server) GetActionByActionHash(h hash.Hash256) (action.SealedEnvelope,
 	return selp, err
 }
 
+// traceAction re-executes a committed execution on top of the states of its parent block, and returns the trace of it.
+// As the states of the parent block have been overwritten once the block is committed, even if it is the tip block, it
+// requires the history of states to be kept by the state factory, i.e., chain.enableHistoryStateDB to be turned on.
+func (api *Server) traceAction(ctx context.Context, actHash hash.Hash256, structLogs bool) (*evm.ExecutionTrace, *action.Receipt, error) {
+	if !api.hasActionIndex || api.indexer == nil {
+		return nil, nil, status.Error(codes.NotFound, blockindex.ErrActionIndexNA.Error())
+	}
+	selp, _, height, err := api.getActionByActionHash(actHash)
+	if err != nil {
+		return nil, nil, status.Error(codes.NotFound, err.Error())
+	}
+	if _, ok := selp.Action().(*action.Execution); !ok {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "action %x is not an execution", actHash)
+	}
+	blk, err := api.dao.GetBlockByHeight(height)
+	if err != nil {
+		return nil, nil, status.Error(codes.NotFound, err.Error())
+	}
+	tracer := evm.NewCallTracer(structLogs)
+	receipt, err := blockchain.TraceAction(api.bc, blk, actHash, tracer)
+	if err != nil {
+		if errors.Cause(err) == factory.ErrNoArchiveData || errors.Cause(err) == factory.ErrNotSupported {
+			return nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return tracer.Result(), receipt, nil
+}
+
+// traceCall simulates an execution on top of the tip, and returns the trace of it
+func (api *Server) traceCall(ctx context.Context, callerAddr string, sc *action.Execution, structLogs bool) (*evm.ExecutionTrace, *action.Receipt, error) {
+	caller, err := address.FromString(callerAddr)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tracer := evm.NewCallTracer(structLogs)
+	_, receipt, err := blockchain.SimulateExecution(api.bc, caller, sc, blockchain.SimulateTracerOption(tracer))
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return tracer.Result(), receipt, nil
+}
+
+func traceResponse(trace *evm.ExecutionTrace, receipt *action.Receipt) *iotexapi.TraceResponse {
+	pb := &iotexapi.ExecutionTrace{Call: callFramePb(trace.Call)}
+	for _, l := range trace.StructLogs {
+		lpb := &iotexapi.StructLog{
+			Pc:      l.Pc,
+			Op:      l.Op.String(),
+			Gas:     l.Gas,
+			GasCost: l.GasCost,
+			Memory:  l.Memory,
+			Depth:   int32(l.Depth),
+			Refund:  l.RefundCounter,
+		}
+		for _, item := range l.Stack {
+			lpb.Stack = append(lpb.Stack, ethcommon.BigToHash(item).Bytes())
+		}
+		if len(l.Storage) > 0 {
+			lpb.Storage = make(map[string][]byte, len(l.Storage))
+			for k, v := range l.Storage {
+				lpb.Storage[hex.EncodeToString(k[:])] = v.Bytes()
+			}
+		}
+		if l.Err != nil {
+			lpb.Error = l.Err.Error()
+		}
+		pb.StructLogs = append(pb.StructLogs, lpb)
+	}
+	return &iotexapi.TraceResponse{
+		Trace:   pb,
+		Receipt: receipt.ConvertToReceiptPb(),
+	}
+}
+
+func callFramePb(frame *evm.CallFrame) *iotexapi.CallFrame {
+	if frame == nil {
+		return nil
+	}
+	pb := &iotexapi.CallFrame{
+		Type:         frame.Type,
+		From:         frame.From,
+		To:           frame.To,
+		Value:        frame.Value,
+		Gas:          uint64(frame.Gas),
+		GasUsed:      uint64(frame.GasUsed),
+		Input:        frame.Input,
+		Output:       frame.Output,
+		Error:        frame.Error,
+		RevertReason: frame.RevertReason,
+	}
+	for _, call := range frame.Calls {
+		pb.Calls = append(pb.Calls, callFramePb(call))
+	}
+	return pb
+}
+
+// GetStateProof returns the Merkle proof of an account and the storage slots of the contract, along with the header of
+// the block at the height of the proof. The header commits to the state root if its producer keeps a state trie, against
+// which the client verifies the proof without trusting this node, see LoadStateProof.
+func (api *Server) GetStateProof(ctx context.Context, in *iotexapi.GetStateProofRequest) (*iotexapi.GetStateProofResponse, error) {
+	keys := make([]hash.Hash256, len(in.StorageKeys))
+	for i, key := range in.StorageKeys {
+		if len(key) != len(hash.ZeroHash256) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid storage key %x", key)
+		}
+		keys[i] = hash.BytesToHash256(key)
+	}
+	proof, err := api.stateProof(ctx, in.Address, keys, in.Height)
+	if err != nil {
+		return nil, err
+	}
+	header, err := api.bc.BlockHeaderByHeight(proof.Height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	res := &iotexapi.GetStateProofResponse{
+		Header:       header.BlockHeaderProto(),
+		RootHash:     proof.RootHash[:],
+		AccountProof: proof.AccountProof,
+	}
+	if proof.Account != nil {
+		if res.Account, err = proof.Account.Serialize(); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	for _, sp := range proof.StorageProofs {
+		res.StorageProofs = append(res.StorageProofs, &iotexapi.StorageProof{
+			Key:   sp.Key[:],
+			Value: sp.Value,
+			Proof: sp.Proof,
+		})
+	}
+	return res, nil
+}
+
+// LoadStateProof loads the proof and the block header from the response of GetStateProof. The proof is verified against
+// the state root committed in the header by factory.VerifyStateProofAtRoot, once the header itself is verified.
+func LoadStateProof(res *iotexapi.GetStateProofResponse) (*block.Header, *factory.StateProof, error) {
+	header := &block.Header{}
+	if err := header.LoadFromBlockHeaderProto(res.Header); err != nil {
+		return nil, nil, err
+	}
+	proof := &factory.StateProof{
+		Height:       header.Height(),
+		RootHash:     hash.BytesToHash256(res.RootHash),
+		AccountProof: res.AccountProof,
+	}
+	if len(res.Account) > 0 {
+		proof.Account = &state.Account{}
+		if err := proof.Account.Deserialize(res.Account); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, sp := range res.StorageProofs {
+		proof.StorageProofs = append(proof.StorageProofs, &factory.StorageProof{
+			Key:   hash.BytesToHash256(sp.Key),
+			Value: sp.Value,
+			Proof: sp.Proof,
+		})
+	}
+	return header, proof, nil
+}
+
+// stateProof returns the Merkle proof of an account and the storage slots of the contract against the root hash of the
+// states at the end of the block height, 0 meaning the tip. It is not supported by the stateDB factory.
+func (api *Server) stateProof(ctx context.Context, addr string, keys []hash.Hash256, height uint64) (*factory.StateProof, error) {
+	prover, ok := api.bc.Factory().(factory.Prover)
+	if !ok {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			"state factory does not support proofs, which requires chain.enableTrielessStateDB to be turned off",
+		)
+	}
+	ioAddr, err := address.FromString(addr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if height, _, err = api.queryHeight(height); err != nil {
+		return nil, err
+	}
+	if height == 0 {
+		height = api.bc.TipHeight()
+	}
+	proof, err := prover.ProveAtHeight(height, hash.BytesToHash160(ioAddr.Bytes()), keys)
+	if err != nil {
+		return nil, historyStatusError(err, codes.Internal)
+	}
+	return proof, nil
+}
+
 // Start starts the API server
 func (api *Server) Start() error {
 	portStr := ":" + strconv.Itoa(api.cfg.API.Port)
@@ -738,12 +1142,34 @@ func (api *Server) Start() error {
 	if err := api.chainListener.Start(); err != nil {
 		return errors.Wrap(err, "failed to start blockchain listener")
 	}
+	if err := api.ap.AddSubscriber(api.pendingListener); err != nil {
+		return errors.Wrap(err, "failed to subscribe to actpool")
+	}
+	if err := api.pendingListener.Start(); err != nil {
+		return errors.Wrap(err, "failed to start actpool listener")
+	}
+	if api.web3Server != nil {
+		if err := api.web3Server.Start(); err != nil {
+			return errors.Wrap(err, "failed to start web3 server")
+		}
+	}
 	return nil
 }
 
 // Stop stops the API server
 func (api *Server) Stop() error {
 	api.grpcserver.Stop()
+	if api.web3Server != nil {
+		if err := api.web3Server.Stop(); err != nil {
+			return errors.Wrap(err, "failed to stop web3 server")
+		}
+	}
+	if err := api.ap.RemoveSubscriber(api.pendingListener); err != nil {
+		return errors.Wrap(err, "failed to unsubscribe actpool listener")
+	}
+	if err := api.pendingListener.Stop(); err != nil {
+		return errors.Wrap(err, "failed to stop actpool listener")
+	}
 	if err := api.bc.RemoveSubscriber(api.chainListener); err != nil {
 		return errors.Wrap(err, "failed to unsubscribe blockchain listener")
 	}
@@ -751,21 +1177,90 @@ func (api *Server) Stop() error {
 }
 
 func (api *Server) readState(ctx context.Context, p protocol.Protocol, methodName []byte, arguments ...[]byte) ([]byte, error) {
+	ws, err := api.bc.Factory().NewWorkingSet()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return api.readStateInWorkingSet(ctx, api.bc.TipHeight(), ws, p, methodName, arguments...)
+}
+
+// readStateAtHeight reads the state of a protocol on top of the states at the end of a past height
+func (api *Server) readStateAtHeight(
+	ctx context.Context,
+	height uint64,
+	p protocol.Protocol,
+	methodName []byte,
+	arguments ...[]byte,
+) ([]byte, error) {
+	ws, err := api.bc.Factory().NewWorkingSetAtHeight(height + 1)
+	if err != nil {
+		return nil, historyStatusError(err, codes.Internal)
+	}
+	return api.readStateInWorkingSet(ctx, height, ws, p, methodName, arguments...)
+}
+
+func (api *Server) readStateInWorkingSet(
+	ctx context.Context,
+	height uint64,
+	ws factory.WorkingSet,
+	p protocol.Protocol,
+	methodName []byte,
+	arguments ...[]byte,
+) ([]byte, error) {
 	// TODO: need to complete the context
 	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
-		BlockHeight: api.bc.TipHeight(),
+		BlockHeight: height,
 	})
 	ctx = protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{
 		Registry: api.registry,
 	})
-	ws, err := api.bc.Factory().NewWorkingSet()
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
 	// TODO: need to distinguish user error and system error
 	return p.ReadState(ctx, ws, methodName, arguments...)
 }
 
+// queryHeight checks the block height of a request, 0 meaning the tip, and returns whether it is a past height rather
+// than the tip. The tip is only read for a non-zero height, so current state queries do not depend on it.
+func (api *Server) queryHeight(height uint64) (uint64, bool, error) {
+	if height == 0 {
+		return 0, false, nil
+	}
+	tip := api.bc.TipHeight()
+	if height > tip {
+		return 0, false, status.Errorf(codes.InvalidArgument, "block height %d is higher than the tip height %d", height, tip)
+	}
+	return height, height != tip, nil
+}
+
+// getAccountAtHeight returns the metadata of an account at the end of a past height. The pending nonce is the next
+// nonce at that height, and the number of actions is not available.
+func (api *Server) getAccountAtHeight(addr string, height uint64) (*iotexapi.GetAccountResponse, error) {
+	state, err := api.bc.Factory().AccountStateAtHeight(addr, height)
+	if err != nil {
+		return nil, historyStatusError(err, codes.NotFound)
+	}
+	return &iotexapi.GetAccountResponse{AccountMeta: &iotextypes.AccountMeta{
+		Address:      addr,
+		Balance:      state.Balance.String(),
+		Nonce:        state.Nonce,
+		PendingNonce: state.Nonce + 1,
+	}}, nil
+}
+
+// historyStatusError converts an error of querying history states into a status error, in which a pruned height is
+// out of range and a height without history states is a failed precondition
+func historyStatusError(err error, code codes.Code) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch errors.Cause(err) {
+	case factory.ErrHistoryPruned:
+		code = codes.OutOfRange
+	case factory.ErrNoArchiveData, factory.ErrNotSupported:
+		code = codes.FailedPrecondition
+	}
+	return status.Error(code, err.Error())
+}
+
 func (api *Server) getActionsFromIndex(totalActions, start, count uint64) (*iotexapi.GetActionsResponse, error) {
 	var actionInfo []*iotexapi.ActionInfo
 	hashes, err := api.indexer.GetActionHashFromIndex(start, count)
@@ -1266,7 +1761,6 @@ func (api *Server) getLogsInBlock(filter *LogFilter, start, count uint64) ([]*io
 	return logs, nil
 }
 
-// TODO: Since GasConsumed on the receipt may not be enough for the gas limit, we use binary search for the gas estimate. Need a better way to address it later.
 func (api *Server) estimateActionGasConsumptionForExecution(exec *iotextypes.Execution, sender string) (*iotexapi.EstimateActionGasConsumptionResponse, error) {
 	sc := &action.Execution{}
 	if err := sc.LoadProto(exec); err != nil {
@@ -1292,35 +1786,14 @@ func (api *Server) estimateActionGasConsumptionForExecution(exec *iotextypes.Exe
 		sc.Data(),
 	)
 
-	_, receipt, err := blockchain.SimulateExecution(api.bc, callerAddr, sc)
+	estimatedGas, err := api.gs.EstimateExecutionGas(callerAddr, sc)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	if receipt.Status != uint64(iotextypes.ReceiptStatus_Success) {
-		return nil, status.Error(codes.Internal, "execution simulation gets failure status")
-	}
-	estimatedGas := receipt.GasConsumed
-	enough, err := api.isGasLimitEnough(callerAddr, sc, nonce, estimatedGas)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	if !enough {
-		low, high := estimatedGas, api.cfg.Genesis.BlockGasLimit
-		estimatedGas = high
-		for low <= high {
-			mid := (low + high) / 2
-			enough, err = api.isGasLimitEnough(callerAddr, sc, nonce, mid)
-			if err != nil {
-				return nil, status.Error(codes.Internal, err.Error())
-			}
-			if enough {
-				estimatedGas = mid
-				break
-			}
-			low = mid + 1
+		if errors.Cause(err) == gasstation.ErrExecutionFailed {
+			// the execution reverts or fails even with the max gas limit, and the error carries the revert reason
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
 		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
-
 	return &iotexapi.EstimateActionGasConsumptionResponse{
 		Gas: estimatedGas,
 	}, nil
@@ -1333,23 +1806,64 @@ func (api *Server) estimateActionGasConsumptionForTransfer(transfer *iotextypes.
 	}, nil
 }
 
-func (api *Server) isGasLimitEnough(
-	caller address.Address,
-	sc *action.Execution,
-	nonce uint64,
-	gasLimit uint64,
-) (bool, error) {
-	sc, _ = action.NewExecution(
-		sc.Contract(),
-		nonce,
-		sc.Amount(),
-		gasLimit,
-		big.NewInt(0),
-		sc.Data(),
-	)
-	_, receipt, err := blockchain.SimulateExecution(api.bc, caller, sc)
+// pendingActions returns the pending actions sent by the address in the actpool, sorted by nonce
+func (api *Server) pendingActions(addr string) ([]action.SealedEnvelope, error) {
+	pendingNonce, err := api.ap.GetPendingNonce(addr)
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+	var acts []action.SealedEnvelope
+	for _, selp := range api.ap.GetUnconfirmedActs(addr) {
+		sender, err := address.FromBytes(selp.SrcPubkey().Hash())
+		if err != nil {
+			return nil, err
+		}
+		if sender.String() == addr && selp.Nonce() < pendingNonce {
+			acts = append(acts, selp)
+		}
+	}
+	return acts, nil
+}
+
+// simulationEnvelope builds the action to simulate with the nonce, and the default gas limit if not set
+func simulationEnvelope(core *iotextypes.ActionCore, nonce uint64, gasLimit uint64) (action.Envelope, error) {
+	var elp action.Envelope
+	if err := elp.LoadProto(core); err != nil {
+		return elp, err
+	}
+	if elp.GasLimit() > 0 {
+		gasLimit = elp.GasLimit()
+	}
+	bd := &action.EnvelopeBuilder{}
+	bd.SetNonce(nonce).SetGasLimit(gasLimit).SetGasPrice(elp.GasPrice())
+	switch act := elp.Action().(type) {
+	case *action.Transfer:
+		tsf, err := action.NewTransfer(nonce, act.Amount(), act.Recipient(), act.Payload(), gasLimit, elp.GasPrice())
+		if err != nil {
+			return elp, err
+		}
+		bd.SetAction(tsf)
+	case *action.Execution:
+		exec, err := action.NewExecution(act.Contract(), nonce, act.Amount(), gasLimit, elp.GasPrice(), act.Data())
+		if err != nil {
+			return elp, err
+		}
+		bd.SetAction(exec)
+	default:
+		return elp, errors.Wrapf(blockchain.ErrUnsupportedSimulation, "action %T", act)
+	}
+	return bd.Build(), nil
+}
+
+func accountStatePb(account *state.Account) *iotexapi.AccountState {
+	pb := &iotexapi.AccountState{
+		Nonce:       account.Nonce,
+		Balance:     "0",
+		StorageRoot: account.Root[:],
+		CodeHash:    account.CodeHash,
+	}
+	if account.Balance != nil {
+		pb.Balance = account.Balance.String()
 	}
-	return receipt.Status == uint64(iotextypes.ReceiptStatus_Success), nil
+	return pb
 }
@@ -51,6 +51,8 @@ import (
 	"github.com/iotexproject/iotex-election/test/mock/mock_committee"
 	"github.com/iotexproject/iotex-proto/golang/iotexapi"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const lld = "lifeLongDelegates"
@@ -1083,6 +1085,41 @@ func TestServer_ReadContract(t *testing.T) {
 		require.NoError(err)
 		require.Equal(test.retValue, res.Data)
 	}
+
+	// deploy a contract which always reverts with Error("not enough balance")
+	var (
+		// copy the runtime code into memory and return it
+		initCode = "6070600c6000396070" + "6000f3"
+		// copy the revert data into memory and revert with it
+		runtimeCode = "6064600c6000396064" + "6000fd"
+		revertData  = "08c379a0" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000012" +
+			"6e6f7420656e6f7567682062616c616e63650000000000000000000000000000"
+	)
+	code, err := hex.DecodeString(initCode + runtimeCode + revertData)
+	require.NoError(err)
+	nonce, err := svr.bc.Factory().Nonce(identityset.Address(27).String())
+	require.NoError(err)
+	deploy, err := testutil.SignedExecution(action.EmptyAddress, identityset.PrivateKey(27), nonce+1,
+		big.NewInt(0), testutil.TestGasLimit*10, big.NewInt(testutil.TestGasPriceInt64), code)
+	require.NoError(err)
+	blk, err := svr.bc.MintNewBlock(
+		map[string][]action.SealedEnvelope{identityset.Address(27).String(): {deploy}},
+		testutil.TimestampNow(),
+	)
+	require.NoError(err)
+	require.NoError(svr.bc.CommitBlock(blk))
+	receipt, err := svr.GetReceiptByActionHash(deploy.Hash())
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+
+	_, err = svr.ReadContract(context.Background(), &iotexapi.ReadContractRequest{
+		Execution:     &iotextypes.Execution{Contract: receipt.ContractAddress, Amount: "0"},
+		CallerAddress: identityset.Address(30).String(),
+	})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	require.Contains(err.Error(), "execution reverted: not enough balance")
 }
 
 func TestServer_SuggestGasPrice(t *testing.T) {
@@ -1099,6 +1136,174 @@ func TestServer_SuggestGasPrice(t *testing.T) {
 	}
 }
 
+func TestServer_SuggestGasPrices(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	for _, test := range suggestGasPriceTests {
+		cfg.API.GasStation.DefaultGas = test.defaultGasPrice
+		svr, err := createServer(cfg, false)
+		require.NoError(err)
+		res, err := svr.SuggestGasPrices(context.Background(), &iotexapi.SuggestGasPricesRequest{})
+		require.NoError(err)
+		require.Equal(test.suggestedGasPrice, res.Standard)
+		require.True(res.Slow <= res.Standard && res.Standard <= res.Fast)
+	}
+}
+
+func TestServer_GetFeeHistory(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+	res, err := svr.GetFeeHistory(context.Background(), &iotexapi.GetFeeHistoryRequest{
+		BlockCount:        10,
+		RewardPercentiles: []float64{50},
+	})
+	require.NoError(err)
+	require.Equal(uint64(1), res.OldestBlock)
+	require.Equal(4, len(res.GasUsedRatio))
+	require.Equal(4, len(res.Rewards))
+	for _, r := range res.Rewards {
+		require.Equal(1, len(r.GasPrices))
+	}
+
+	res, err = svr.GetFeeHistory(context.Background(), &iotexapi.GetFeeHistoryRequest{BlockCount: 2, NewestBlock: 3})
+	require.NoError(err)
+	require.Equal(uint64(2), res.OldestBlock)
+	require.Equal(2, len(res.GasUsedRatio))
+	require.Empty(res.Rewards)
+
+	_, err = svr.GetFeeHistory(context.Background(), &iotexapi.GetFeeHistoryRequest{BlockCount: 1, NewestBlock: 5})
+	require.Error(err)
+}
+
+func TestServer_SimulateActions(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	svr, err := createServer(cfg, true)
+	require.NoError(err)
+
+	transfer := func(recipient string, amount int64) *iotextypes.ActionCore {
+		return &iotextypes.ActionCore{
+			Action: &iotextypes.ActionCore_Transfer{
+				Transfer: &iotextypes.Transfer{Amount: strconv.FormatInt(amount, 10), Recipient: recipient},
+			},
+		}
+	}
+	addr27 := identityset.Address(27).String()
+	addr28 := identityset.Address(28).String()
+	addr29 := identityset.Address(29).String()
+	steps := []*iotexapi.SimulationStep{
+		{CallerAddress: addr27, Action: transfer(addr28, 10)},
+		{CallerAddress: addr28, Action: transfer(addr29, 5)},
+		{
+			CallerAddress: addr27,
+			Action: &iotextypes.ActionCore{
+				Action: &iotextypes.ActionCore_Execution{
+					Execution: &iotextypes.Execution{Amount: "0", Data: []byte{0x60, 0x01, 0x60, 0x00, 0x55, 0x00}},
+				},
+			},
+		},
+		{CallerAddress: addr29, Action: transfer(addr28, 1000000000)},
+		{CallerAddress: addr27, Action: transfer(addr28, 1)},
+	}
+	nonce, err := svr.bc.Factory().Nonce(addr27)
+	require.NoError(err)
+
+	res, err := svr.SimulateActions(context.Background(), &iotexapi.SimulateActionsRequest{Steps: steps})
+	require.NoError(err)
+	// the simulation stops at the failed transfer
+	require.Equal(4, len(res.Results))
+	for _, r := range res.Results[:3] {
+		require.Empty(r.Error)
+		require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Receipt.Status)
+	}
+	require.NotEmpty(res.Results[3].Error)
+	require.Nil(res.Results[3].Receipt)
+
+	diffs := res.Results[0].Diffs
+	require.Equal(2, len(diffs))
+	require.Equal(addr27, diffs[0].Address)
+	require.Equal(nonce, diffs[0].Before.Nonce)
+	require.Equal(nonce+1, diffs[0].After.Nonce)
+	require.Equal(addr28, diffs[1].Address)
+	before, _ := new(big.Int).SetString(diffs[1].Before.Balance, 10)
+	after, _ := new(big.Int).SetString(diffs[1].After.Balance, 10)
+	require.Equal(big.NewInt(10), after.Sub(after, before))
+	// the second transfer sees the balance received in the first one
+	require.Equal(diffs[1].After.Balance, res.Results[1].Diffs[0].Before.Balance)
+	// the deployment creates the contract
+	receipt := res.Results[2].Receipt
+	require.NotEmpty(receipt.ContractAddress)
+	diffs = res.Results[2].Diffs
+	require.Equal(2, len(diffs))
+	require.Equal(nonce+2, diffs[0].After.Nonce)
+	require.Equal(receipt.ContractAddress, diffs[1].Address)
+	require.NotEqual(diffs[1].Before.StorageRoot, diffs[1].After.StorageRoot)
+
+	// the pending actions of the caller in the actpool run first
+	res, err = svr.SimulateActions(context.Background(), &iotexapi.SimulateActionsRequest{
+		Steps:          steps[:1],
+		IncludePending: true,
+	})
+	require.NoError(err)
+	require.Equal(1, len(res.Results))
+	require.Equal(nonce+4, res.Results[0].Diffs[0].Before.Nonce)
+	require.Equal(nonce+5, res.Results[0].Diffs[0].After.Nonce)
+
+	// the deployment forwarding the amount to addr29 in the EVM reports the change of the recipient too
+	forward := append([]byte{0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x34, 0x73}, identityset.Address(29).Bytes()...)
+	forward = append(forward, 0x5a, 0xf1, 0x00)
+	res, err = svr.SimulateActions(context.Background(), &iotexapi.SimulateActionsRequest{
+		Steps: []*iotexapi.SimulationStep{{
+			CallerAddress: addr27,
+			Action: &iotextypes.ActionCore{
+				Action: &iotextypes.ActionCore_Execution{
+					Execution: &iotextypes.Execution{Amount: "7", Data: forward},
+				},
+			},
+		}},
+	})
+	require.NoError(err)
+	require.Equal(1, len(res.Results))
+	require.Empty(res.Results[0].Error)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), res.Results[0].Receipt.Status)
+	diffs = res.Results[0].Diffs
+	require.Equal(addr27, diffs[0].Address)
+	var forwarded *iotexapi.AccountDiff
+	for _, diff := range diffs {
+		if diff.Address == addr29 {
+			forwarded = diff
+		}
+	}
+	require.NotNil(forwarded)
+	before, _ = new(big.Int).SetString(forwarded.Before.Balance, 10)
+	after, _ = new(big.Int).SetString(forwarded.After.Balance, 10)
+	require.Equal(big.NewInt(7), after.Sub(after, before))
+
+	_, err = svr.SimulateActions(context.Background(), &iotexapi.SimulateActionsRequest{})
+	require.Error(err)
+	_, err = svr.SimulateActions(context.Background(), &iotexapi.SimulateActionsRequest{
+		Steps: []*iotexapi.SimulationStep{{CallerAddress: "invalid", Action: transfer(addr28, 1)}},
+	})
+	require.Error(err)
+	_, err = svr.SimulateActions(context.Background(), &iotexapi.SimulateActionsRequest{
+		Steps: []*iotexapi.SimulationStep{{
+			CallerAddress: addr27,
+			Action: &iotextypes.ActionCore{
+				Action: &iotextypes.ActionCore_DepositToRewardingFund{
+					DepositToRewardingFund: &iotextypes.DepositToRewardingFund{Amount: "1"},
+				},
+			},
+		}},
+	})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	require.Contains(err.Error(), blockchain.ErrUnsupportedSimulation.Error())
+}
+
 func TestServer_EstimateGasForAction(t *testing.T) {
 	require := require.New(t)
 	cfg := newConfig()
@@ -1124,6 +1329,7 @@ func TestServer_EstimateGasForAction(t *testing.T) {
 func TestServer_EstimateActionGasConsumption(t *testing.T) {
 	require := require.New(t)
 	cfg := newConfig()
+	cfg.Genesis.BeringBlockHeight = 0
 	svr, err := createServer(cfg, false)
 	require.NoError(err)
 
@@ -1143,6 +1349,22 @@ func TestServer_EstimateActionGasConsumption(t *testing.T) {
 	require.NoError(err)
 	require.Equal(uint64(286579), res.Gas)
 
+	// a reverting execution fails the precondition with the revert reason
+	byteCodes, err = hex.DecodeString("6064600c60003960646000fd" +
+		"08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"626f6f6d00000000000000000000000000000000000000000000000000000000")
+	require.NoError(err)
+	execution, err = action.NewExecution("", 1, big.NewInt(0), 0, big.NewInt(0), byteCodes)
+	require.NoError(err)
+	request.Action = &iotexapi.EstimateActionGasConsumptionRequest_Execution{
+		Execution: execution.Proto(),
+	}
+	_, err = svr.EstimateActionGasConsumption(context.Background(), request)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	require.Contains(err.Error(), "execution reverted: boom")
+
 	// test for transfer
 	tran, err := action.NewTransfer(0, big.NewInt(0), "", []byte("123"), 0, big.NewInt(0))
 	require.NoError(err)
@@ -1584,6 +1806,214 @@ func TestServer_GetLogs(t *testing.T) {
 	}
 }
 
+func TestServer_TraceAction(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	// history states are not kept
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+	_, _, err = svr.traceAction(context.Background(), executionHash3, false)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	require.Contains(err.Error(), "chain.enableHistoryStateDB")
+
+	cfg.Chain.EnableHistoryStateDB = true
+	svr, err = createServer(cfg, false)
+	require.NoError(err)
+	for _, actHash := range []hash.Hash256{executionHash1, executionHash3} {
+		trace, receipt, err := svr.traceAction(context.Background(), actHash, true)
+		require.NoError(err)
+		require.Equal(actHash, receipt.ActionHash)
+		stored, err := svr.GetReceiptByActionHash(actHash)
+		require.NoError(err)
+		require.Equal(stored.Status, receipt.Status)
+		require.Equal(stored.GasConsumed, receipt.GasConsumed)
+		require.NotNil(trace.Call)
+		require.Equal(identityset.Address(31).String(), trace.Call.To)
+		require.Empty(trace.Call.Calls)
+	}
+	// not an execution
+	_, _, err = svr.traceAction(context.Background(), transferHash1, false)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	// unknown action
+	_, _, err = svr.traceAction(context.Background(), hash.ZeroHash256, false)
+	require.Equal(codes.NotFound, status.Code(err))
+
+	exec, err := action.NewExecution(identityset.Address(31).String(), 0, big.NewInt(1),
+		cfg.Genesis.BlockGasLimit, big.NewInt(0), []byte{1})
+	require.NoError(err)
+	trace, receipt, err := svr.traceCall(context.Background(), identityset.Address(30).String(), exec, false)
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+	require.Equal(identityset.Address(30).String(), trace.Call.From)
+	require.Equal("1", trace.Call.Value)
+	require.Empty(trace.StructLogs)
+
+	// the tracing over gRPC
+	res, err := svr.TraceTransaction(context.Background(), &iotexapi.TraceTransactionRequest{
+		ActionHash: hex.EncodeToString(executionHash3[:]),
+		StructLogs: true,
+	})
+	require.NoError(err)
+	require.Equal(hex.EncodeToString(executionHash3[:]), hex.EncodeToString(res.Receipt.ActHash))
+	require.Equal(identityset.Address(31).String(), res.Trace.Call.To)
+	_, err = svr.TraceTransaction(context.Background(), &iotexapi.TraceTransactionRequest{ActionHash: "0x"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	res, err = svr.TraceCall(context.Background(), &iotexapi.TraceCallRequest{
+		Execution:     exec.Proto(),
+		CallerAddress: identityset.Address(30).String(),
+	})
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), res.Receipt.Status)
+	require.Equal(identityset.Address(30).String(), res.Trace.Call.From)
+	require.Equal("1", res.Trace.Call.Value)
+	require.Empty(res.Trace.StructLogs)
+}
+
+func TestServer_HistoryState(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	cfg.Consensus.Scheme = config.RollDPoSScheme
+	addr := identityset.Address(30).String()
+
+	// history states are not kept
+	svr, err := createServer(cfg, true)
+	require.NoError(err)
+	_, err = svr.GetAccount(context.Background(), &iotexapi.GetAccountRequest{Address: addr, Height: 2})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+
+	cfg.Chain.EnableHistoryStateDB = true
+	cfg.DB.HistoryStateRetention = 2
+	svr, err = createServer(cfg, true)
+	require.NoError(err)
+	for _, test := range []struct {
+		height       uint64
+		balance      string
+		nonce        uint64
+		pendingNonce uint64
+		available    string
+	}{
+		{2, "5", 6, 7, "199999968000000000000000000"},
+		{3, "5", 6, 7, "199999952000000000000000000"},
+		{4, "3", 8, 9, "199999936000000000000000000"},
+	} {
+		res, err := svr.GetAccount(context.Background(), &iotexapi.GetAccountRequest{Address: addr, Height: test.height})
+		require.NoError(err)
+		require.Equal(test.balance, res.AccountMeta.Balance)
+		require.Equal(test.nonce, res.AccountMeta.Nonce)
+		require.Equal(test.pendingNonce, res.AccountMeta.PendingNonce)
+
+		out, err := svr.ReadState(context.Background(), &iotexapi.ReadStateRequest{
+			ProtocolID: []byte("rewarding"),
+			MethodName: []byte("AvailableBalance"),
+			Height:     test.height,
+		})
+		require.NoError(err)
+		require.Equal(test.available, string(out.Data))
+
+		exec, err := action.NewExecution(identityset.Address(31).String(), 0, big.NewInt(1), 0, big.NewInt(0), []byte{1})
+		require.NoError(err)
+		contract, err := svr.ReadContract(context.Background(), &iotexapi.ReadContractRequest{
+			Execution:     exec.Proto(),
+			CallerAddress: addr,
+			Height:        test.height,
+		})
+		require.NoError(err)
+		require.Equal(uint64(iotextypes.ReceiptStatus_Success), contract.Receipt.Status)
+	}
+
+	// beyond the retention window
+	_, err = svr.GetAccount(context.Background(), &iotexapi.GetAccountRequest{Address: addr, Height: 1})
+	require.Equal(codes.OutOfRange, status.Code(err))
+	_, err = svr.ReadState(context.Background(), &iotexapi.ReadStateRequest{
+		ProtocolID: []byte("rewarding"),
+		MethodName: []byte("AvailableBalance"),
+		Height:     1,
+	})
+	require.Equal(codes.OutOfRange, status.Code(err))
+	// beyond the tip
+	_, err = svr.GetAccount(context.Background(), &iotexapi.GetAccountRequest{Address: addr, Height: 5})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	// the tip
+	res, err := svr.GetAccount(context.Background(), &iotexapi.GetAccountRequest{Address: addr, Height: 4})
+	require.NoError(err)
+	tip, err := svr.GetAccount(context.Background(), &iotexapi.GetAccountRequest{Address: addr})
+	require.NoError(err)
+	require.Equal(tip.AccountMeta, res.AccountMeta)
+}
+
+func TestServer_GetStateProof(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	cfg.Chain.EnableHistoryStateDB = true
+	cfg.Genesis.HawaiiBlockHeight = 0
+	addr := identityset.Address(30)
+	addrHash := hash.BytesToHash160(addr.Bytes())
+	slot := hash.Hash256b([]byte("slot"))
+
+	svr, err := createServer(cfg, true)
+	require.NoError(err)
+	for _, test := range []struct {
+		height  uint64
+		proved  uint64
+		balance string
+	}{
+		{1, 1, "10"},
+		{0, 4, "3"},
+	} {
+		res, err := svr.GetStateProof(context.Background(), &iotexapi.GetStateProofRequest{
+			Address:     addr.String(),
+			StorageKeys: [][]byte{slot[:]},
+			Height:      test.height,
+		})
+		require.NoError(err)
+		header, proof, err := LoadStateProof(res)
+		require.NoError(err)
+		require.Equal(test.proved, header.Height())
+		require.Equal(test.proved, proof.Height)
+		root, err := svr.bc.Factory().RootHashByHeight(test.proved)
+		require.NoError(err)
+		require.Equal(root, proof.RootHash)
+		require.Equal(root, header.StateRoot())
+		blkHash, err := svr.bc.BlockDAO().GetBlockHash(test.proved)
+		require.NoError(err)
+		require.Equal(blkHash, header.HashBlock())
+		require.Equal(test.balance, proof.Account.Balance.String())
+		require.Equal(1, len(proof.StorageProofs))
+		require.Nil(proof.StorageProofs[0].Value)
+		require.NoError(factory.VerifyStateProofAtRoot(header.Height(), header.StateRoot(), addrHash, proof))
+
+		// a proof against another root is rejected by the header
+		proof.RootHash = hash.Hash256b(proof.RootHash[:])
+		require.Error(factory.VerifyStateProofAtRoot(header.Height(), header.StateRoot(), addrHash, proof))
+		proof.RootHash = root
+		proof.Account.Balance = big.NewInt(1000)
+		require.Error(factory.VerifyStateProofAtRoot(header.Height(), header.StateRoot(), addrHash, proof))
+	}
+
+	_, err = svr.GetStateProof(context.Background(), &iotexapi.GetStateProofRequest{Address: "invalid"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = svr.GetStateProof(context.Background(), &iotexapi.GetStateProofRequest{Address: addr.String(), Height: 5})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = svr.GetStateProof(context.Background(), &iotexapi.GetStateProofRequest{
+		Address:     addr.String(),
+		StorageKeys: [][]byte{{1, 2}},
+	})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// the stateDB factory does not support proofs
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	sdb, err := factory.NewStateDB(cfg, factory.InMemStateDBOption())
+	require.NoError(err)
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	bc.EXPECT().Factory().Return(sdb).Times(1)
+	svr.bc = bc
+	_, err = svr.GetStateProof(context.Background(), &iotexapi.GetStateProofRequest{Address: addr.String()})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	require.Contains(err.Error(), "chain.enableTrielessStateDB")
+}
+
 func addTestingBlocks(bc blockchain.Blockchain) error {
 	addr0 := identityset.Address(27).String()
 	priKey0 := identityset.PrivateKey(27)
@@ -1805,7 +2235,7 @@ func setupChain(cfg config.Config) (blockchain.Blockchain, blockdao.BlockDAO, bl
 	if err := p.Register(registry); err != nil {
 		return nil, nil, nil, nil, err
 	}
-	bc.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(bc.Factory().Nonce))
+	bc.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(bc.Factory().Nonce, protocol.WithChainID(bc.ChainID())))
 
 	return bc, dao, indexer, registry, nil
 }
@@ -1816,7 +2246,7 @@ func setupActPool(bc blockchain.Blockchain, cfg config.ActPool) (actpool.ActPool
 		return nil, err
 	}
 
-	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc.Factory().Nonce))
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc.Factory().Nonce, protocol.WithChainID(bc.ChainID())))
 
 	return ap, nil
 }
@@ -1880,7 +2310,7 @@ func createServer(cfg config.Config, needActPool bool) (*Server, error) {
 		indexer:        indexer,
 		ap:             ap,
 		cfg:            cfg,
-		gs:             gasstation.NewGasStation(bc, cfg.API),
+		gs:             gasstation.NewGasStation(bc, ap, cfg.API),
 		registry:       registry,
 		hasActionIndex: true,
 	}
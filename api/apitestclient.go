@@ -54,6 +54,14 @@ type ServiceClient interface {
 	StreamBlocks(ctx context.Context, in *iotexapi.StreamBlocksRequest, opts ...grpc.CallOption) (iotexapi.APIService_StreamBlocksClient, error)
 	// get filtered logs in stream
 	StreamLogs(ctx context.Context, in *iotexapi.StreamLogsRequest, opts ...grpc.CallOption) (iotexapi.APIService_StreamLogsClient, error)
+	// suggest gas prices by percentiles
+	SuggestGasPrices(ctx context.Context, in *iotexapi.SuggestGasPricesRequest, opts ...grpc.CallOption) (*iotexapi.SuggestGasPricesResponse, error)
+	// get gas price history of recent blocks
+	GetFeeHistory(ctx context.Context, in *iotexapi.GetFeeHistoryRequest, opts ...grpc.CallOption) (*iotexapi.GetFeeHistoryResponse, error)
+	// simulate a sequence of actions
+	SimulateActions(ctx context.Context, in *iotexapi.SimulateActionsRequest, opts ...grpc.CallOption) (*iotexapi.SimulateActionsResponse, error)
+	// get filtered pending actions in stream
+	StreamPendingActions(ctx context.Context, in *iotexapi.StreamPendingActionsRequest, opts ...grpc.CallOption) (iotexapi.APIService_StreamPendingActionsClient, error)
 	// get native election buckets
 	GetElectionBuckets(ctx context.Context, in *iotexapi.GetElectionBucketsRequest, opts ...grpc.CallOption) (*iotexapi.GetElectionBucketsResponse, error)
 }
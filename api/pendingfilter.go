@@ -0,0 +1,172 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"encoding/hex"
+	"reflect"
+	"strings"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// errPendingSubscriberBehind is the error that the subscriber does not take the changes of the pending actions in time
+var errPendingSubscriberBehind = errors.New("subscriber falls behind the pending actions")
+
+// PendingActionFilter streams the changes of the actions in actpool matching the filter. The changes are queued
+// without blocking the pending listener, and sent by the goroutine serving the stream, so that a subscriber not taking
+// them in time is dropped once its queue is full instead of holding up the others.
+type PendingActionFilter struct {
+	stream    iotexapi.APIService_StreamPendingActionsServer
+	responses chan *iotexapi.StreamPendingActionsResponse
+	errChan   chan error
+	done      chan struct{}
+	*iotexapi.PendingActionFilter
+}
+
+// NewPendingActionFilter returns a new pending action filter
+func NewPendingActionFilter(
+	in *iotexapi.PendingActionFilter,
+	stream iotexapi.APIService_StreamPendingActionsServer,
+) *PendingActionFilter {
+	if in == nil {
+		in = &iotexapi.PendingActionFilter{}
+	}
+	return &PendingActionFilter{
+		stream:              stream,
+		responses:           make(chan *iotexapi.StreamPendingActionsResponse, pendingEventBufferSize),
+		errChan:             make(chan error, 1),
+		done:                make(chan struct{}),
+		PendingActionFilter: in,
+	}
+}
+
+// RespondPending queues the change of the action if it matches the filter. It returns an error to be dropped by the
+// pending listener if the stream has ended or the queue is full.
+func (f *PendingActionFilter) RespondPending(evt actpool.PendingActionEvent) error {
+	select {
+	case <-f.done:
+		return errors.New("pending action stream has ended")
+	default:
+	}
+	sender, err := address.FromBytes(evt.Action.SrcPubkey().Hash())
+	if err != nil {
+		return nil
+	}
+	if !f.Match(sender.String(), evt.Action) {
+		return nil
+	}
+	actHash := evt.Action.Hash()
+	res := &iotexapi.StreamPendingActionsResponse{
+		Event:   pendingActionEvent(evt.Type),
+		ActHash: hex.EncodeToString(actHash[:]),
+		Sender:  sender.String(),
+		Action:  evt.Action.Proto(),
+	}
+	if evt.ReplacedBy != hash.ZeroHash256 {
+		res.ReplacedBy = hex.EncodeToString(evt.ReplacedBy[:])
+	}
+	select {
+	case f.responses <- res:
+		return nil
+	default:
+		f.exit(errPendingSubscriberBehind)
+		log.L().Info("Drop the subscriber of the pending actions falling behind.", log.Hex("hash", actHash[:]))
+		return errPendingSubscriberBehind
+	}
+}
+
+// Exit ends the stream
+func (f *PendingActionFilter) Exit() {
+	f.exit(nil)
+}
+
+// Serve sends the queued changes to the stream until the stream fails, the client goes away or the filter exits
+func (f *PendingActionFilter) Serve() error {
+	defer close(f.done)
+	for {
+		select {
+		case err := <-f.errChan:
+			return err
+		case <-f.stream.Context().Done():
+			return f.stream.Context().Err()
+		case res := <-f.responses:
+			if err := f.stream.Send(res); err != nil {
+				log.L().Info("error streaming the pending action", zap.String("hash", res.ActHash), zap.Error(err))
+				return err
+			}
+		}
+	}
+}
+
+// exit passes the error ending the stream to the goroutine serving it, which only takes the first one
+func (f *PendingActionFilter) exit(err error) {
+	select {
+	case f.errChan <- err:
+	default:
+	}
+}
+
+// Match returns whether the action matches the filter
+func (f *PendingActionFilter) Match(sender string, selp action.SealedEnvelope) bool {
+	if len(f.Senders) > 0 && !containsString(f.Senders, sender) {
+		return false
+	}
+	if len(f.Recipients) > 0 {
+		recipient, ok := selp.Destination()
+		if !ok || !containsString(f.Recipients, recipient) {
+			return false
+		}
+	}
+	if len(f.Types) > 0 && !containsString(f.Types, pendingActionType(selp)) {
+		return false
+	}
+	return true
+}
+
+// pendingActionType returns the type of the action, i.e., the name of the action in the oneof of ActionCore, or the
+// name of the action type if it is not defined in ActionCore
+func pendingActionType(selp action.SealedEnvelope) string {
+	act := selp.Proto().GetCore().GetAction()
+	if act == nil {
+		if selp.Action() == nil {
+			return ""
+		}
+		return reflect.TypeOf(selp.Action()).Elem().Name()
+	}
+	return strings.TrimPrefix(reflect.TypeOf(act).Elem().Name(), "ActionCore_")
+}
+
+func pendingActionEvent(t actpool.PendingActionEventType) iotexapi.PendingActionEvent {
+	switch t {
+	case actpool.ActionReplaced:
+		return iotexapi.PendingActionEvent_REPLACED
+	case actpool.ActionDropped:
+		return iotexapi.PendingActionEvent_DROPPED
+	case actpool.ActionExpired:
+		return iotexapi.PendingActionEvent_EXPIRED
+	default:
+		return iotexapi.PendingActionEvent_ACCEPTED
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if strings.EqualFold(e, s) {
+			return true
+		}
+	}
+	return false
+}
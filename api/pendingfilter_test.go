@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+type testPendingStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *iotexapi.StreamPendingActionsResponse
+	err  error
+}
+
+func (s *testPendingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testPendingStream) Send(res *iotexapi.StreamPendingActionsResponse) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sent <- res
+	return nil
+}
+
+func TestPendingActionFilter_Match(t *testing.T) {
+	require := require.New(t)
+
+	sender := identityset.Address(27).String()
+	recipient := identityset.Address(28).String()
+	tsf, err := testutil.SignedTransfer(recipient, identityset.PrivateKey(27), 1,
+		big.NewInt(10), nil, testutil.TestGasLimit, big.NewInt(testutil.TestGasPriceInt64))
+	require.NoError(err)
+	exec, err := testutil.SignedExecution(recipient, identityset.PrivateKey(27), 2,
+		big.NewInt(0), testutil.TestGasLimit, big.NewInt(testutil.TestGasPriceInt64), nil)
+	require.NoError(err)
+
+	tests := []struct {
+		filter *iotexapi.PendingActionFilter
+		match  [2]bool
+	}{
+		{nil, [2]bool{true, true}},
+		{&iotexapi.PendingActionFilter{Senders: []string{sender}}, [2]bool{true, true}},
+		{&iotexapi.PendingActionFilter{Senders: []string{recipient}}, [2]bool{false, false}},
+		{&iotexapi.PendingActionFilter{Recipients: []string{recipient}}, [2]bool{true, true}},
+		{&iotexapi.PendingActionFilter{Recipients: []string{sender}}, [2]bool{false, false}},
+		{&iotexapi.PendingActionFilter{Types: []string{"transfer"}}, [2]bool{true, false}},
+		{&iotexapi.PendingActionFilter{Types: []string{"Transfer", "Execution"}}, [2]bool{true, true}},
+		{&iotexapi.PendingActionFilter{Senders: []string{sender}, Types: []string{"execution"}}, [2]bool{false, true}},
+	}
+	for _, test := range tests {
+		f := NewPendingActionFilter(test.filter, nil)
+		require.Equal(test.match[0], f.Match(sender, tsf))
+		require.Equal(test.match[1], f.Match(sender, exec))
+	}
+}
+
+func TestPendingActionFilter_RespondPending(t *testing.T) {
+	require := require.New(t)
+
+	tsf1, err := testutil.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), 1,
+		big.NewInt(10), nil, testutil.TestGasLimit, big.NewInt(testutil.TestGasPriceInt64))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(identityset.Address(29).String(), identityset.PrivateKey(27), 1,
+		big.NewInt(10), nil, testutil.TestGasLimit, big.NewInt(2*testutil.TestGasPriceInt64))
+	require.NoError(err)
+	hash1, hash2 := tsf1.Hash(), tsf2.Hash()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &testPendingStream{ctx: ctx, sent: make(chan *iotexapi.StreamPendingActionsResponse)}
+	f := NewPendingActionFilter(&iotexapi.PendingActionFilter{
+		Recipients: []string{identityset.Address(28).String()},
+	}, stream)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- f.Serve()
+	}()
+
+	require.NoError(f.RespondPending(actpool.PendingActionEvent{Type: actpool.ActionAccepted, Action: tsf1}))
+	require.NoError(f.RespondPending(actpool.PendingActionEvent{Type: actpool.ActionAccepted, Action: tsf2}))
+	require.NoError(f.RespondPending(actpool.PendingActionEvent{
+		Type:       actpool.ActionReplaced,
+		Action:     tsf1,
+		ReplacedBy: hash2,
+	}))
+	res := <-stream.sent
+	require.Equal(iotexapi.PendingActionEvent_ACCEPTED, res.Event)
+	require.Equal(hex.EncodeToString(hash1[:]), res.ActHash)
+	require.Equal(identityset.Address(27).String(), res.Sender)
+	require.Equal("", res.ReplacedBy)
+	res = <-stream.sent
+	require.Equal(iotexapi.PendingActionEvent_REPLACED, res.Event)
+	require.Equal(hex.EncodeToString(hash2[:]), res.ReplacedBy)
+
+	// the error of the stream ends the serving, after which the changes are not taken
+	stream.err = errors.New("stream is closed")
+	require.NoError(f.RespondPending(actpool.PendingActionEvent{Type: actpool.ActionExpired, Action: tsf1}))
+	require.Equal(stream.err, <-errChan)
+	require.Error(f.RespondPending(actpool.PendingActionEvent{Type: actpool.ActionExpired, Action: tsf1}))
+
+	// exit ends the serving
+	f = NewPendingActionFilter(nil, stream)
+	go func() {
+		errChan <- f.Serve()
+	}()
+	f.Exit()
+	f.Exit()
+	require.NoError(<-errChan)
+}
+
+func TestPendingActionFilter_SubscriberBehind(t *testing.T) {
+	require := require.New(t)
+
+	tsf, err := testutil.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), 1,
+		big.NewInt(10), nil, testutil.TestGasLimit, big.NewInt(testutil.TestGasPriceInt64))
+	require.NoError(err)
+	// the stream is not served, and the responder never blocks
+	stream := &testPendingStream{
+		ctx:  context.Background(),
+		sent: make(chan *iotexapi.StreamPendingActionsResponse, pendingEventBufferSize),
+	}
+	f := NewPendingActionFilter(nil, stream)
+	for i := 0; i < pendingEventBufferSize; i++ {
+		require.NoError(f.RespondPending(actpool.PendingActionEvent{Type: actpool.ActionAccepted, Action: tsf}))
+	}
+	require.Equal(
+		errPendingSubscriberBehind,
+		f.RespondPending(actpool.PendingActionEvent{Type: actpool.ActionAccepted, Action: tsf}),
+	)
+	require.Equal(errPendingSubscriberBehind, f.Serve())
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// pendingEventBufferSize is the number of the changes of pending actions buffered before passed to responders
+const pendingEventBufferSize = 1024
+
+type (
+	// PendingResponder responds to the changes of the actions in actpool
+	PendingResponder interface {
+		RespondPending(actpool.PendingActionEvent) error
+		Exit()
+	}
+
+	// pendingListener passes the changes of the actions in actpool to all responders
+	pendingListener struct {
+		events     chan actpool.PendingActionEvent
+		cancelChan chan struct{}
+		streamMap  sync.Map // all registered <PendingResponder, struct{}>
+	}
+)
+
+func newPendingListener() *pendingListener {
+	return &pendingListener{
+		events:     make(chan actpool.PendingActionEvent, pendingEventBufferSize),
+		cancelChan: make(chan struct{}),
+	}
+}
+
+// Start starts the pendingListener
+func (pl *pendingListener) Start() error {
+	go func() {
+		for {
+			select {
+			case <-pl.cancelChan:
+				// notify all responders to exit
+				pl.streamMap.Range(func(key, _ interface{}) bool {
+					r, ok := key.(PendingResponder)
+					if !ok {
+						log.S().Panic("streamMap stores a key which is not a PendingResponder")
+					}
+					r.Exit()
+					pl.streamMap.Delete(key)
+					return true
+				})
+				return
+			case evt := <-pl.events:
+				// pass the event to every responder
+				pl.streamMap.Range(func(key, _ interface{}) bool {
+					r, ok := key.(PendingResponder)
+					if !ok {
+						log.S().Panic("streamMap stores a key which is not a PendingResponder")
+					}
+					if err := r.RespondPending(evt); err != nil {
+						pl.streamMap.Delete(key)
+					}
+					return true
+				})
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops the pendingListener
+func (pl *pendingListener) Stop() error {
+	close(pl.cancelChan)
+	return nil
+}
+
+// HandlePendingAction handles the change of an action in actpool. It never blocks the actpool, and the change is
+// dropped if the responders fall behind.
+func (pl *pendingListener) HandlePendingAction(evt actpool.PendingActionEvent) error {
+	select {
+	case pl.events <- evt:
+		return nil
+	default:
+		return errors.New("pending action listener is full")
+	}
+}
+
+// AddResponder adds a new responder
+func (pl *pendingListener) AddResponder(r PendingResponder) error {
+	_, loaded := pl.streamMap.LoadOrStore(r, struct{}{})
+	if loaded {
+		return errors.New("Responder already added")
+	}
+	return nil
+}
@@ -0,0 +1,681 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/third_party/go-ethereum/rpc"
+)
+
+// ErrWeb3BlockNumber indicates the block number given to a web3 method is not supported
+var ErrWeb3BlockNumber = errors.New("only the latest block is supported")
+
+// web3RevertErrorCode is the JSON-RPC error code of a reverted call, as returned by Ethereum clients
+const web3RevertErrorCode = 3
+
+// web3RevertError is the error of a reverted call, which carries the data returned by the reverted contract
+type web3RevertError struct {
+	reason string
+	data   []byte
+}
+
+func (e *web3RevertError) Error() string {
+	if e.reason == "" {
+		return "execution reverted"
+	}
+	return "execution reverted: " + e.reason
+}
+
+// ErrorCode returns the JSON-RPC error code
+func (e *web3RevertError) ErrorCode() int { return web3RevertErrorCode }
+
+// ErrorData returns the data returned by the reverted contract, written into the data field of the JSON-RPC error
+func (e *web3RevertError) ErrorData() interface{} { return hexutil.Encode(e.data) }
+
+// Web3Server serves the Ethereum-compatible JSON-RPC API on top of the api server, over both HTTP and WebSocket
+type Web3Server struct {
+	svr        *Server
+	rpcServer  *rpc.Server
+	httpServer *http.Server
+}
+
+// NewWeb3Server creates a new web3 server
+func NewWeb3Server(svr *Server) (*Web3Server, error) {
+	rpcServer := rpc.NewServer()
+	if err := rpcServer.RegisterName("eth", &ethService{svr: svr}); err != nil {
+		return nil, errors.Wrap(err, "failed to register eth service")
+	}
+	if err := rpcServer.RegisterName("net", &netService{svr: svr}); err != nil {
+		return nil, errors.Wrap(err, "failed to register net service")
+	}
+	if err := rpcServer.RegisterName("debug", &debugService{svr: svr}); err != nil {
+		return nil, errors.Wrap(err, "failed to register debug service")
+	}
+	if err := rpcServer.RegisterName("iotex", &iotexService{svr: svr}); err != nil {
+		return nil, errors.Wrap(err, "failed to register iotex service")
+	}
+	return &Web3Server{
+		svr:       svr,
+		rpcServer: rpcServer,
+	}, nil
+}
+
+// ServeHTTP serves a JSON-RPC request, upgrading it to a WebSocket connection if asked to
+func (s *Web3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		s.rpcServer.WebsocketHandler([]string{"*"}).ServeHTTP(w, r)
+		return
+	}
+	s.rpcServer.ServeHTTP(w, r)
+}
+
+// Start starts the web3 server
+func (s *Web3Server) Start() error {
+	portStr := ":" + strconv.Itoa(s.svr.cfg.API.Web3Port)
+	lis, err := net.Listen("tcp", portStr)
+	if err != nil {
+		log.L().Error("Web3 server failed to listen.", zap.Error(err))
+		return errors.Wrap(err, "web3 server failed to listen")
+	}
+	log.L().Info("Web3 server is listening.", zap.String("addr", lis.Addr().String()))
+
+	s.httpServer = rpc.NewHTTPServer([]string{"*"}, []string{"*"}, rpc.DefaultHTTPTimeouts, s)
+	go func() {
+		if err := s.httpServer.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.L().Error("Node failed to serve web3.", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+// Stop stops the web3 server
+func (s *Web3Server) Stop() error {
+	s.rpcServer.Stop()
+	if s.httpServer == nil {
+		return nil
+	}
+	return s.httpServer.Close()
+}
+
+// netService implements the net namespace
+type netService struct {
+	svr *Server
+}
+
+// Version returns the chain ID as the network ID
+func (n *netService) Version() string {
+	return strconv.FormatUint(uint64(n.svr.bc.ChainID()), 10)
+}
+
+// debugService implements the tracing methods of the debug namespace
+type debugService struct {
+	svr *Server
+}
+
+// TraceTransaction re-executes a committed execution and returns its trace
+func (d *debugService) TraceTransaction(ctx context.Context, h common.Hash, cfg *Web3TraceConfig) (*evm.ExecutionTrace, error) {
+	trace, _, err := d.svr.traceAction(ctx, hash.BytesToHash256(h.Bytes()), cfg.structLogs())
+	return trace, err
+}
+
+// TraceCall executes a contract call on top of the latest block without creating a transaction, and returns its trace
+func (d *debugService) TraceCall(ctx context.Context, args Web3CallArgs, blkNum rpc.BlockNumber, cfg *Web3TraceConfig) (*evm.ExecutionTrace, error) {
+	if err := (&ethService{svr: d.svr}).checkBlockNumber(blkNum); err != nil {
+		return nil, err
+	}
+	caller, err := args.caller()
+	if err != nil {
+		return nil, err
+	}
+	exec, err := args.execution(d.svr.cfg.Genesis.BlockGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	trace, _, err := d.svr.traceCall(ctx, caller, exec, cfg.structLogs())
+	return trace, err
+}
+
+// iotexService implements the iotex namespace, which serves the methods without an Ethereum-compatible counterpart
+type iotexService struct {
+	svr *Server
+}
+
+// SendRawAction submits a signed action, i.e., the serialized iotextypes.Action protobuf, which serves the actions
+// without an Ethereum transaction counterpart
+func (i *iotexService) SendRawAction(ctx context.Context, raw hexutil.Bytes) (common.Hash, error) {
+	act := &iotextypes.Action{}
+	if err := proto.Unmarshal(raw, act); err != nil {
+		return common.Hash{}, errors.Wrap(err, "failed to unmarshal raw action")
+	}
+	res, err := i.svr.SendAction(ctx, &iotexapi.SendActionRequest{Action: act})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.HexToHash(res.ActionHash), nil
+}
+
+// ethService implements the eth namespace
+type ethService struct {
+	svr *Server
+}
+
+// SendRawTransaction submits a signed Ethereum transaction encoded in RLP, which is converted to a transfer or an
+// execution, and returns the hash of the transaction
+func (e *ethService) SendRawTransaction(ctx context.Context, raw hexutil.Bytes) (common.Hash, error) {
+	selp, err := action.DecodeRawTransaction(raw, func(addr string) (bool, error) {
+		account, err := e.svr.bc.Factory().AccountState(addr)
+		if err != nil {
+			return false, err
+		}
+		return account.IsContract(), nil
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	res, err := e.svr.SendAction(ctx, &iotexapi.SendActionRequest{Action: selp.Proto()})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.HexToHash(res.ActionHash), nil
+}
+
+// ChainId returns the chain ID
+func (e *ethService) ChainId() hexutil.Uint64 {
+	return hexutil.Uint64(e.svr.bc.ChainID())
+}
+
+// BlockNumber returns the tip height
+func (e *ethService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(e.svr.bc.TipHeight())
+}
+
+// GasPrice returns the suggested gas price
+func (e *ethService) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	res, err := e.svr.SuggestGasPrice(ctx, &iotexapi.SuggestGasPriceRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(new(big.Int).SetUint64(res.GasPrice)), nil
+}
+
+// FeeHistory returns the gas usage and the gas prices of the recent blocks, with zero base fees since there is no
+// base fee in IoTeX
+func (e *ethService) FeeHistory(blockCount hexutil.Uint64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*Web3FeeHistory, error) {
+	height, err := e.blockHeight(&lastBlock)
+	if err != nil {
+		return nil, err
+	}
+	history, err := e.svr.gs.FeeHistory(uint64(blockCount), height, rewardPercentiles)
+	if err != nil {
+		return nil, err
+	}
+	res := &Web3FeeHistory{
+		OldestBlock:  (*hexutil.Big)(new(big.Int).SetUint64(history.OldestBlock)),
+		GasUsedRatio: history.GasUsedRatio,
+	}
+	for i := 0; i <= len(history.GasUsedRatio); i++ {
+		res.BaseFee = append(res.BaseFee, (*hexutil.Big)(big.NewInt(0)))
+	}
+	for _, rewards := range history.Rewards {
+		blkRewards := make([]*hexutil.Big, 0, len(rewards))
+		for _, r := range rewards {
+			blkRewards = append(blkRewards, (*hexutil.Big)(r))
+		}
+		res.Reward = append(res.Reward, blkRewards)
+	}
+	return res, nil
+}
+
+// GetBalance returns the balance of an account
+func (e *ethService) GetBalance(ctx context.Context, addr common.Address, blkNum rpc.BlockNumber) (*hexutil.Big, error) {
+	height, err := e.blockHeight(&blkNum)
+	if err != nil {
+		return nil, err
+	}
+	ioAddr, err := ethToIoAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.svr.GetAccount(ctx, &iotexapi.GetAccountRequest{Address: ioAddr, Height: height})
+	if err != nil {
+		return nil, err
+	}
+	balance, ok := new(big.Int).SetString(res.AccountMeta.Balance, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid balance %s", res.AccountMeta.Balance)
+	}
+	return (*hexutil.Big)(balance), nil
+}
+
+// GetTransactionCount returns the pending nonce of an account, or the next nonce at the end of a past block
+func (e *ethService) GetTransactionCount(ctx context.Context, addr common.Address, blkNum rpc.BlockNumber) (hexutil.Uint64, error) {
+	height, err := e.blockHeight(&blkNum)
+	if err != nil {
+		return 0, err
+	}
+	ioAddr, err := ethToIoAddress(addr)
+	if err != nil {
+		return 0, err
+	}
+	res, err := e.svr.GetAccount(ctx, &iotexapi.GetAccountRequest{Address: ioAddr, Height: height})
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(res.AccountMeta.PendingNonce), nil
+}
+
+// Call executes a contract call without creating a transaction
+func (e *ethService) Call(ctx context.Context, args Web3CallArgs, blkNum rpc.BlockNumber) (hexutil.Bytes, error) {
+	height, err := e.blockHeight(&blkNum)
+	if err != nil {
+		return nil, err
+	}
+	caller, err := args.caller()
+	if err != nil {
+		return nil, err
+	}
+	exec, err := args.execution(e.svr.cfg.Genesis.BlockGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.svr.ReadContract(ctx, &iotexapi.ReadContractRequest{
+		Execution:     exec.Proto(),
+		CallerAddress: caller,
+		Height:        height,
+	})
+	if err != nil {
+		// a call reverting with a reason carries the response with the revert data
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			for _, detail := range st.Details() {
+				if res, ok := detail.(*iotexapi.ReadContractResponse); ok {
+					data, _ := hex.DecodeString(res.Data)
+					return nil, &web3RevertError{reason: evm.RevertReason(data), data: data}
+				}
+			}
+		}
+		return nil, err
+	}
+	switch res.Receipt.Status {
+	case uint64(iotextypes.ReceiptStatus_Success):
+		return hex.DecodeString(res.Data)
+	case uint64(iotextypes.ReceiptStatus_ErrExecutionReverted):
+		data, _ := hex.DecodeString(res.Data)
+		return nil, &web3RevertError{data: data}
+	default:
+		return nil, errors.Errorf("execution failed with status %d", res.Receipt.Status)
+	}
+}
+
+// GetTransactionReceipt returns the receipt of an action, or nil if the action is not found
+func (e *ethService) GetTransactionReceipt(ctx context.Context, h common.Hash) (*Web3Receipt, error) {
+	res, err := e.svr.GetReceiptByAction(ctx, &iotexapi.GetReceiptByActionRequest{ActionHash: hex.EncodeToString(h[:])})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	receipt := res.ReceiptInfo.Receipt
+	blkHash, err := hash.HexStringToHash256(res.ReceiptInfo.BlkHash)
+	if err != nil {
+		return nil, err
+	}
+	selp, err := e.svr.GetActionByActionHash(hash.BytesToHash256(h[:]))
+	if err != nil {
+		return nil, err
+	}
+	from := common.BytesToAddress(selp.SrcPubkey().Hash())
+	r := &Web3Receipt{
+		TransactionHash: h,
+		BlockHash:       common.BytesToHash(blkHash[:]),
+		BlockNumber:     hexutil.Uint64(receipt.BlkHeight),
+		From:            from,
+		GasUsed:         hexutil.Uint64(receipt.GasConsumed),
+		Logs:            []*Web3Log{},
+		Status:          web3Status(receipt.Status),
+		RevertReason:    receipt.ExecutionRevertMsg,
+	}
+	var to string
+	switch act := selp.Action().(type) {
+	case *action.Transfer:
+		to = act.Recipient()
+	case *action.Execution:
+		to = act.Contract()
+	}
+	if to != "" {
+		addr, err := ioToEthAddress(to)
+		if err != nil {
+			return nil, err
+		}
+		r.To = &addr
+	}
+	if receipt.ContractAddress != "" {
+		addr, err := ioToEthAddress(receipt.ContractAddress)
+		if err != nil {
+			return nil, err
+		}
+		r.ContractAddress = &addr
+	}
+	if selp.IsSponsored() {
+		payer := common.BytesToAddress(selp.SponsorPubkey().Hash())
+		r.GasPayer = &payer
+	}
+	// locate the receipt in its block for the index and cumulative gas
+	receipts, err := e.svr.dao.GetReceipts(receipt.BlkHeight)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		cumulativeGas uint64
+		found         bool
+	)
+	for i, rec := range receipts {
+		cumulativeGas += rec.GasConsumed
+		if rec.ActionHash == hash.BytesToHash256(h[:]) {
+			r.TransactionIndex = hexutil.Uint(i)
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.Errorf("action %x is not in the receipts of block %d", h, receipt.BlkHeight)
+	}
+	r.CumulativeGasUsed = hexutil.Uint64(cumulativeGas)
+	for _, l := range receipt.Logs {
+		wl, err := newWeb3Log(l, blkHash)
+		if err != nil {
+			return nil, err
+		}
+		wl.TransactionIndex = r.TransactionIndex
+		r.Logs = append(r.Logs, wl)
+	}
+	return r, nil
+}
+
+// GetLogs returns logs matching the filter
+func (e *ethService) GetLogs(ctx context.Context, q Web3FilterQuery) ([]*Web3Log, error) {
+	filter, err := q.logsFilter()
+	if err != nil {
+		return nil, err
+	}
+	req := &iotexapi.GetLogsRequest{Filter: filter}
+	if q.BlockHash != nil {
+		req.Lookup = &iotexapi.GetLogsRequest_ByBlock{
+			ByBlock: &iotexapi.GetLogsByBlock{BlockHash: q.BlockHash.Bytes()},
+		}
+	} else {
+		from, err := e.blockHeight(q.FromBlock)
+		if err != nil {
+			return nil, err
+		}
+		to, err := e.blockHeight(q.ToBlock)
+		if err != nil {
+			return nil, err
+		}
+		if from > to {
+			return nil, errors.Errorf("invalid block range %d to %d", from, to)
+		}
+		count := to - from + 1
+		if count > e.svr.cfg.API.RangeQueryLimit {
+			return nil, errors.Errorf("block range exceeds the limit %d", e.svr.cfg.API.RangeQueryLimit)
+		}
+		req.Lookup = &iotexapi.GetLogsRequest_ByRange{
+			ByRange: &iotexapi.GetLogsByRange{FromBlock: from, Count: count},
+		}
+	}
+	res, err := e.svr.GetLogs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return e.web3Logs(res.Logs)
+}
+
+// EstimateGas estimates the gas needed by a transfer, or by an execution if data is given or the recipient is absent
+func (e *ethService) EstimateGas(ctx context.Context, args Web3CallArgs) (hexutil.Uint64, error) {
+	caller, err := args.caller()
+	if err != nil {
+		return 0, err
+	}
+	req := &iotexapi.EstimateActionGasConsumptionRequest{CallerAddress: caller}
+	if args.To != nil && len(args.data()) == 0 {
+		recipient, err := ethToIoAddress(*args.To)
+		if err != nil {
+			return 0, err
+		}
+		req.Action = &iotexapi.EstimateActionGasConsumptionRequest_Transfer{
+			Transfer: &iotextypes.Transfer{
+				Amount:    args.value().String(),
+				Recipient: recipient,
+			},
+		}
+	} else {
+		exec, err := args.execution(e.svr.cfg.Genesis.BlockGasLimit)
+		if err != nil {
+			return 0, err
+		}
+		req.Action = &iotexapi.EstimateActionGasConsumptionRequest_Execution{
+			Execution: exec.Proto(),
+		}
+	}
+	res, err := e.svr.EstimateActionGasConsumption(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(res.Gas), nil
+}
+
+// GetProof returns the Merkle proof of an account and the storage slots of the contract
+func (e *ethService) GetProof(ctx context.Context, addr common.Address, keys []common.Hash, blkNum rpc.BlockNumber) (*Web3AccountProof, error) {
+	height, err := e.blockHeight(&blkNum)
+	if err != nil {
+		return nil, err
+	}
+	ioAddr, err := ethToIoAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	slots := make([]hash.Hash256, len(keys))
+	for i, k := range keys {
+		slots[i] = hash.BytesToHash256(k.Bytes())
+	}
+	proof, err := e.svr.stateProof(ctx, ioAddr, slots, height)
+	if err != nil {
+		return nil, err
+	}
+	blkHash, err := e.svr.dao.GetBlockHash(proof.Height)
+	if err != nil {
+		return nil, err
+	}
+	res := &Web3AccountProof{
+		Address:      addr,
+		AccountProof: web3Proof(proof.AccountProof),
+		Balance:      (*hexutil.Big)(big.NewInt(0)),
+		StateRoot:    common.BytesToHash(proof.RootHash[:]),
+		BlockNumber:  hexutil.Uint64(proof.Height),
+		BlockHash:    common.BytesToHash(blkHash[:]),
+	}
+	if proof.Account != nil {
+		res.Balance = (*hexutil.Big)(proof.Account.Balance)
+		res.CodeHash = common.BytesToHash(proof.Account.CodeHash)
+		res.Nonce = hexutil.Uint64(proof.Account.Nonce)
+		res.StorageHash = common.BytesToHash(proof.Account.Root[:])
+	}
+	for _, sp := range proof.StorageProofs {
+		res.StorageProof = append(res.StorageProof, &Web3StorageProof{
+			Key:   common.BytesToHash(sp.Key[:]),
+			Value: sp.Value,
+			Proof: web3Proof(sp.Proof),
+		})
+	}
+	return res, nil
+}
+
+// NewHeads subscribes to new block headers, i.e., eth_subscribe("newHeads")
+func (e *ethService) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, sub, err := createWeb3Subscription(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stream := &web3BlockStream{web3Stream: web3Stream{ctx: ctx, notifier: notifier, sub: sub}}
+	go func() {
+		if err := e.svr.StreamBlocks(&iotexapi.StreamBlocksRequest{}, stream); err != nil {
+			log.L().Debug("Web3 newHeads subscription ended.", zap.Error(err))
+		}
+	}()
+	return sub, nil
+}
+
+// Logs subscribes to logs matching the filter, i.e., eth_subscribe("logs")
+func (e *ethService) Logs(ctx context.Context, q Web3FilterQuery) (*rpc.Subscription, error) {
+	filter, err := q.logsFilter()
+	if err != nil {
+		return nil, err
+	}
+	notifier, sub, err := createWeb3Subscription(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stream := &web3LogStream{web3Stream: web3Stream{ctx: ctx, notifier: notifier, sub: sub}, svr: e.svr}
+	go func() {
+		if err := e.svr.StreamLogs(&iotexapi.StreamLogsRequest{Filter: filter}, stream); err != nil {
+			log.L().Debug("Web3 logs subscription ended.", zap.Error(err))
+		}
+	}()
+	return sub, nil
+}
+
+func (e *ethService) checkBlockNumber(blkNum rpc.BlockNumber) error {
+	if blkNum == rpc.LatestBlockNumber || blkNum == rpc.PendingBlockNumber || uint64(blkNum) == e.svr.bc.TipHeight() {
+		return nil
+	}
+	return ErrWeb3BlockNumber
+}
+
+// blockHeight resolves a block number, absent meaning the latest block
+func (e *ethService) blockHeight(blkNum *rpc.BlockNumber) (uint64, error) {
+	tip := e.svr.bc.TipHeight()
+	if blkNum == nil || *blkNum == rpc.LatestBlockNumber || *blkNum == rpc.PendingBlockNumber {
+		return tip, nil
+	}
+	if *blkNum < 0 || uint64(*blkNum) > tip {
+		return 0, errors.Errorf("invalid block number %d", *blkNum)
+	}
+	return uint64(*blkNum), nil
+}
+
+func (e *ethService) web3Logs(logs []*iotextypes.Log) ([]*Web3Log, error) {
+	res := make([]*Web3Log, 0, len(logs))
+	blkHashes := make(map[uint64]hash.Hash256)
+	for _, l := range logs {
+		blkHash, ok := blkHashes[l.BlkHeight]
+		if !ok {
+			var err error
+			if blkHash, err = e.svr.dao.GetBlockHash(l.BlkHeight); err != nil {
+				return nil, err
+			}
+			blkHashes[l.BlkHeight] = blkHash
+		}
+		wl, err := newWeb3Log(l, blkHash)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, wl)
+	}
+	return res, nil
+}
+
+func createWeb3Subscription(ctx context.Context) (*rpc.Notifier, *rpc.Subscription, error) {
+	notifier, ok := rpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, nil, rpc.ErrNotificationsUnsupported
+	}
+	return notifier, notifier.CreateSubscription(), nil
+}
+
+// web3Stream adapts a web3 subscription to a grpc server stream, so that web3 subscriptions are served by the
+// streaming API
+type web3Stream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	notifier *rpc.Notifier
+	sub      *rpc.Subscription
+}
+
+func (s *web3Stream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *web3Stream) notify(data interface{}) error {
+	// the notifier does not fail once the subscription is gone, so check it first
+	select {
+	case err := <-s.sub.Err():
+		if err == nil {
+			err = errors.New("subscription unsubscribed")
+		}
+		return err
+	case <-s.notifier.Closed():
+		return errors.New("connection closed")
+	default:
+	}
+	return s.notifier.Notify(s.sub.ID, data)
+}
+
+type web3BlockStream struct {
+	web3Stream
+}
+
+func (s *web3BlockStream) Send(res *iotexapi.StreamBlocksResponse) error {
+	blk := &block.Block{}
+	if err := blk.ConvertFromBlockPb(res.Block.Block); err != nil {
+		return err
+	}
+	header, err := newWeb3Header(&blk.Header)
+	if err != nil {
+		return err
+	}
+	return s.notify(header)
+}
+
+type web3LogStream struct {
+	web3Stream
+	svr *Server
+}
+
+func (s *web3LogStream) Send(res *iotexapi.StreamLogsResponse) error {
+	blkHash, err := s.svr.dao.GetBlockHash(res.Log.BlkHeight)
+	if err != nil {
+		return err
+	}
+	l, err := newWeb3Log(res.Log, blkHash)
+	if err != nil {
+		return err
+	}
+	return s.notify(l)
+}
@@ -0,0 +1,475 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+	"github.com/iotexproject/iotex-core/third_party/go-ethereum/rpc"
+)
+
+func createWeb3Client(t *testing.T, svr *Server) *rpc.Client {
+	web3, err := NewWeb3Server(svr)
+	require.NoError(t, err)
+	return rpc.DialInProc(web3.rpcServer)
+}
+
+func TestWeb3Server_AddressConversion(t *testing.T) {
+	require := require.New(t)
+
+	ioAddr := identityset.Address(30)
+	ethAddr, err := ioToEthAddress(ioAddr.String())
+	require.NoError(err)
+	require.Equal(ioAddr.Bytes(), ethAddr.Bytes())
+	back, err := ethToIoAddress(ethAddr)
+	require.NoError(err)
+	require.Equal(ioAddr.String(), back)
+
+	_, err = ioToEthAddress("0xabc")
+	require.Error(err)
+
+	require.Equal(hexutil.Uint64(1), web3Status(uint64(iotextypes.ReceiptStatus_Success)))
+	require.Equal(hexutil.Uint64(0), web3Status(uint64(iotextypes.ReceiptStatus_Failure)))
+	require.Equal(hexutil.Uint64(0), web3Status(uint64(iotextypes.ReceiptStatus_ErrExecutionReverted)))
+}
+
+func TestWeb3Server_FilterQuery(t *testing.T) {
+	require := require.New(t)
+
+	topic := "0x" + common.Bytes2Hex(common.LeftPadBytes([]byte{1}, 32))
+	var q Web3FilterQuery
+	require.NoError(json.Unmarshal([]byte(`{
+		"fromBlock": "0x1",
+		"toBlock": "latest",
+		"address": "0x0000000000000000000000000000000000000001",
+		"topics": [null, "`+topic+`", ["`+topic+`", "`+topic+`"]]
+	}`), &q))
+	require.Equal(rpc.BlockNumber(1), *q.FromBlock)
+	require.Equal(rpc.LatestBlockNumber, *q.ToBlock)
+	require.Len(q.Addresses, 1)
+	require.Len(q.Topics, 3)
+	require.Nil(q.Topics[0])
+	require.Len(q.Topics[1], 1)
+	require.Len(q.Topics[2], 2)
+
+	filter, err := q.logsFilter()
+	require.NoError(err)
+	require.Len(filter.Address, 1)
+	require.Len(filter.Topics, 3)
+
+	require.Error(json.Unmarshal([]byte(`{"blockHash": "`+topic+`", "fromBlock": "0x1"}`), &q))
+	require.Error(json.Unmarshal([]byte(`{"topics": ["0x01"]}`), &q))
+}
+
+func TestWeb3Server_Queries(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	svr, err := createServer(cfg, true)
+	require.NoError(err)
+	client := createWeb3Client(t, svr)
+	defer client.Close()
+
+	var height hexutil.Uint64
+	require.NoError(client.Call(&height, "eth_blockNumber"))
+	require.Equal(hexutil.Uint64(4), height)
+
+	var chainID hexutil.Uint64
+	require.NoError(client.Call(&chainID, "eth_chainId"))
+	require.Equal(hexutil.Uint64(svr.bc.ChainID()), chainID)
+
+	addr, err := ioToEthAddress(identityset.Address(30).String())
+	require.NoError(err)
+	var balance hexutil.Big
+	require.NoError(client.Call(&balance, "eth_getBalance", addr, "latest"))
+	require.Equal("3", balance.ToInt().String())
+	require.Error(client.Call(&balance, "eth_getBalance", addr, "0x1"))
+
+	var nonce hexutil.Uint64
+	require.NoError(client.Call(&nonce, "eth_getTransactionCount", addr, "pending"))
+	require.Equal(hexutil.Uint64(9), nonce)
+
+	// transfer receipt
+	var receipt *Web3Receipt
+	require.NoError(client.Call(&receipt, "eth_getTransactionReceipt", common.BytesToHash(transferHash1[:])))
+	require.NotNil(receipt)
+	require.Equal(hexutil.Uint64(1), receipt.Status)
+	require.Equal(hexutil.Uint64(1), receipt.BlockNumber)
+	from, err := ioToEthAddress(identityset.Address(27).String())
+	require.NoError(err)
+	require.Equal(from, receipt.From)
+	require.NotNil(receipt.To)
+	require.Equal(addr, *receipt.To)
+	require.Equal(receipt.GasUsed, receipt.CumulativeGasUsed)
+
+	// unknown action
+	receipt = nil
+	require.NoError(client.Call(&receipt, "eth_getTransactionReceipt", common.Hash{}))
+	require.Nil(receipt)
+
+	// action missing in the receipts of its block
+	svr.dao = &receiptsDroppingDAO{BlockDAO: svr.dao, drop: transferHash1}
+	require.Error(client.Call(&receipt, "eth_getTransactionReceipt", common.BytesToHash(transferHash1[:])))
+	svr.dao = svr.dao.(*receiptsDroppingDAO).BlockDAO
+
+	// logs
+	var logs []*Web3Log
+	require.NoError(client.Call(&logs, "eth_getLogs", map[string]interface{}{"fromBlock": "0x1"}))
+	require.Len(logs, 4)
+	for _, l := range logs {
+		blkHash, err := svr.dao.GetBlockHash(uint64(l.BlockNumber))
+		require.NoError(err)
+		require.Equal(common.BytesToHash(blkHash[:]), l.BlockHash)
+	}
+	require.Error(client.Call(&logs, "eth_getLogs", map[string]interface{}{"fromBlock": "0x5"}))
+
+	// call
+	contract, err := ioToEthAddress(identityset.Address(31).String())
+	require.NoError(err)
+	var ret hexutil.Bytes
+	require.NoError(client.Call(&ret, "eth_call", map[string]interface{}{
+		"from": addr,
+		"to":   contract,
+		"data": hexutil.Bytes{1},
+	}, "latest"))
+	require.Empty(ret)
+
+	// estimate gas
+	var gas hexutil.Uint64
+	require.NoError(client.Call(&gas, "eth_estimateGas", map[string]interface{}{
+		"from":  addr,
+		"to":    contract,
+		"value": (*hexutil.Big)(big.NewInt(1)),
+	}))
+	require.Equal(hexutil.Uint64(10000), gas)
+	require.NoError(client.Call(&gas, "eth_estimateGas", map[string]interface{}{
+		"from": addr,
+		"to":   contract,
+		"data": hexutil.Bytes{1},
+	}))
+	require.True(gas > 10000)
+
+	// fee history
+	var history Web3FeeHistory
+	require.NoError(client.Call(&history, "eth_feeHistory", hexutil.Uint64(2), "latest", []float64{25, 75}))
+	require.Equal(big.NewInt(3), history.OldestBlock.ToInt())
+	require.Len(history.BaseFee, 3)
+	require.Len(history.GasUsedRatio, 2)
+	require.Len(history.Reward, 2)
+	require.Len(history.Reward[0], 2)
+	require.Error(client.Call(&history, "eth_feeHistory", hexutil.Uint64(2), "0x5", nil))
+}
+
+func TestWeb3Server_HistoryState(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	cfg.Chain.EnableHistoryStateDB = true
+
+	svr, err := createServer(cfg, true)
+	require.NoError(err)
+	client := createWeb3Client(t, svr)
+	defer client.Close()
+
+	addr, err := ioToEthAddress(identityset.Address(30).String())
+	require.NoError(err)
+	var balance hexutil.Big
+	require.NoError(client.Call(&balance, "eth_getBalance", addr, "0x1"))
+	require.Equal("10", balance.ToInt().String())
+	require.NoError(client.Call(&balance, "eth_getBalance", addr, "latest"))
+	require.Equal("3", balance.ToInt().String())
+	require.Error(client.Call(&balance, "eth_getBalance", addr, "0x5"))
+
+	var nonce hexutil.Uint64
+	require.NoError(client.Call(&nonce, "eth_getTransactionCount", addr, "0x2"))
+	require.Equal(hexutil.Uint64(7), nonce)
+
+	contract, err := ioToEthAddress(identityset.Address(31).String())
+	require.NoError(err)
+	var ret hexutil.Bytes
+	require.NoError(client.Call(&ret, "eth_call", map[string]interface{}{
+		"from": addr,
+		"to":   contract,
+		"data": hexutil.Bytes{1},
+	}, "0x1"))
+	require.Empty(ret)
+}
+
+func TestWeb3Server_GetProof(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	cfg.Chain.EnableHistoryStateDB = true
+
+	svr, err := createServer(cfg, true)
+	require.NoError(err)
+	client := createWeb3Client(t, svr)
+	defer client.Close()
+
+	addr, err := ioToEthAddress(identityset.Address(30).String())
+	require.NoError(err)
+	slot := common.HexToHash("0x01")
+	var res Web3AccountProof
+	require.NoError(client.Call(&res, "eth_getProof", addr, []common.Hash{slot}, "0x2"))
+	require.Equal(addr, res.Address)
+	require.Equal(hexutil.Uint64(2), res.BlockNumber)
+	blkHash, err := svr.dao.GetBlockHash(2)
+	require.NoError(err)
+	require.Equal(common.BytesToHash(blkHash[:]), res.BlockHash)
+	require.Equal("5", res.Balance.ToInt().String())
+	require.Equal(hexutil.Uint64(6), res.Nonce)
+	proof := make([][]byte, len(res.AccountProof))
+	for i, node := range res.AccountProof {
+		proof[i] = node
+	}
+	data, err := trie.VerifyProof(res.StateRoot.Bytes(), addr.Bytes(), proof, trie.DefaultHashFunc)
+	require.NoError(err)
+	var account state.Account
+	require.NoError(account.Deserialize(data))
+	require.Equal("5", account.Balance.String())
+	require.Equal(1, len(res.StorageProof))
+	require.Equal(slot, res.StorageProof[0].Key)
+	require.Empty(res.StorageProof[0].Value)
+
+	require.Error(client.Call(&res, "eth_getProof", addr, []common.Hash{}, "0x5"))
+}
+
+func TestWeb3Server_SendRawAction(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	cfg.Genesis.HawaiiBlockHeight = 0
+
+	svr, err := createServer(cfg, true)
+	require.NoError(err)
+	broadcastCount := 0
+	svr.broadcastHandler = func(_ context.Context, _ uint32, _ proto.Message) error {
+		broadcastCount++
+		return nil
+	}
+	client := createWeb3Client(t, svr)
+	defer client.Close()
+
+	nonce, err := svr.ap.GetPendingNonce(identityset.Address(27).String())
+	require.NoError(err)
+	selp, err := testutil.SignedTransfer(identityset.Address(29).String(), identityset.PrivateKey(27), nonce,
+		big.NewInt(1), []byte{}, testutil.TestGasLimit, big.NewInt(testutil.TestGasPriceInt64))
+	require.NoError(err)
+	raw, err := proto.Marshal(selp.Proto())
+	require.NoError(err)
+	var h common.Hash
+	// a raw action is not an RLP encoded Ethereum transaction
+	require.Error(client.Call(&h, "eth_sendRawTransaction", hexutil.Bytes(raw)))
+	require.Equal(0, broadcastCount)
+	require.NoError(client.Call(&h, "iotex_sendRawAction", hexutil.Bytes(raw)))
+	selpHash := selp.Hash()
+	require.Equal(common.BytesToHash(selpHash[:]), h)
+	require.Equal(1, broadcastCount)
+
+	require.Error(client.Call(&h, "iotex_sendRawAction", hexutil.Bytes{1, 2, 3}))
+
+	// a transaction signed by a web3 wallet
+	sk, err := ethcrypto.ToECDSA(identityset.PrivateKey(27).Bytes())
+	require.NoError(err)
+	to, err := ioToEthAddress(identityset.Address(29).String())
+	require.NoError(err)
+	tx, err := types.SignTx(
+		types.NewTransaction(nonce+1, to, big.NewInt(1), testutil.TestGasLimit,
+			big.NewInt(testutil.TestGasPriceInt64), nil),
+		types.NewEIP155Signer(big.NewInt(int64(svr.bc.ChainID()))),
+		sk,
+	)
+	require.NoError(err)
+	raw, err = rlp.EncodeToBytes(tx)
+	require.NoError(err)
+	require.NoError(client.Call(&h, "eth_sendRawTransaction", hexutil.Bytes(raw)))
+	require.Equal(tx.Hash(), h)
+	require.Equal(2, broadcastCount)
+	pending, err := svr.ap.GetActionByHash(hash.BytesToHash256(h[:]))
+	require.NoError(err)
+	require.Equal(iotextypes.Encoding_ETHEREUM_RLP, pending.Encoding())
+	require.Equal(identityset.PrivateKey(27).PublicKey().Hash(), pending.SrcPubkey().Hash())
+}
+
+// receiptsDroppingDAO drops the receipt of an action from the receipts of its block
+type receiptsDroppingDAO struct {
+	blockdao.BlockDAO
+	drop hash.Hash256
+}
+
+func (dao *receiptsDroppingDAO) GetReceipts(height uint64) ([]*action.Receipt, error) {
+	receipts, err := dao.BlockDAO.GetReceipts(height)
+	if err != nil {
+		return nil, err
+	}
+	var kept []*action.Receipt
+	for _, r := range receipts {
+		if r.ActionHash != dao.drop {
+			kept = append(kept, r)
+		}
+	}
+	return kept, nil
+}
+
+type web3RevertService struct{}
+
+func (s *web3RevertService) Revert() error {
+	return &web3RevertError{reason: "not owner", data: []byte{8, 195, 121, 160}}
+}
+
+func TestWeb3Server_RevertData(t *testing.T) {
+	require := require.New(t)
+
+	web3, err := NewWeb3Server(nil)
+	require.NoError(err)
+	require.NoError(web3.rpcServer.RegisterName("test", &web3RevertService{}))
+	ts := httptest.NewServer(web3)
+	defer ts.Close()
+
+	type response struct {
+		Error struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+			Data    string `json:"data"`
+		} `json:"error"`
+	}
+	check := func(res response) {
+		require.Equal(3, res.Error.Code)
+		require.Equal("execution reverted: not owner", res.Error.Message)
+		require.Equal("0x08c379a0", res.Error.Data)
+	}
+	req := `{"jsonrpc":"2.0","id":1,"method":"test_revert","params":[]}`
+	httpRes, err := http.Post(ts.URL, "application/json", strings.NewReader(req))
+	require.NoError(err)
+	defer httpRes.Body.Close()
+	var res response
+	require.NoError(json.NewDecoder(httpRes.Body).Decode(&res))
+	check(res)
+
+	// a batch of requests
+	httpRes, err = http.Post(ts.URL, "application/json", strings.NewReader("["+req+","+req+"]"))
+	require.NoError(err)
+	defer httpRes.Body.Close()
+	var batch []response
+	require.NoError(json.NewDecoder(httpRes.Body).Decode(&batch))
+	require.Len(batch, 2)
+	for _, res := range batch {
+		check(res)
+	}
+
+	conn, err := websocket.Dial("ws"+strings.TrimPrefix(ts.URL, "http"), "", ts.URL)
+	require.NoError(err)
+	defer conn.Close()
+	require.NoError(websocket.Message.Send(conn, req))
+	res = response{}
+	require.NoError(websocket.JSON.Receive(conn, &res))
+	check(res)
+}
+
+func TestWeb3Server_Trace(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	cfg.Chain.EnableHistoryStateDB = true
+
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+	client := createWeb3Client(t, svr)
+	defer client.Close()
+
+	var trace *evm.ExecutionTrace
+	require.NoError(client.Call(&trace, "debug_traceTransaction", common.BytesToHash(executionHash3[:])))
+	require.NotNil(trace.Call)
+	require.Equal(identityset.Address(31).String(), trace.Call.To)
+	require.Empty(trace.StructLogs)
+	require.Error(client.Call(&trace, "debug_traceTransaction", common.BytesToHash(transferHash1[:])))
+
+	from, err := ioToEthAddress(identityset.Address(30).String())
+	require.NoError(err)
+	contract, err := ioToEthAddress(identityset.Address(31).String())
+	require.NoError(err)
+	args := map[string]interface{}{
+		"from": from,
+		"to":   contract,
+		"data": hexutil.Bytes{1},
+	}
+	trace = nil
+	require.NoError(client.Call(&trace, "debug_traceCall", args, "latest", &Web3TraceConfig{StructLogs: true}))
+	require.NotNil(trace.Call)
+	require.Equal(identityset.Address(30).String(), trace.Call.From)
+	require.Equal(hexutil.Bytes{1}, trace.Call.Input)
+	require.Error(client.Call(&trace, "debug_traceCall", args, "0x1"))
+}
+
+func TestWeb3Server_Subscribe(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+	svr.chainListener = NewChainListener()
+	require.NoError(svr.chainListener.Start())
+	defer func() { require.NoError(svr.chainListener.Stop()) }()
+	client := createWeb3Client(t, svr)
+	defer client.Close()
+
+	ctx := context.Background()
+	heads := make(chan *Web3Header, 1)
+	headSub, err := client.EthSubscribe(ctx, heads, "newHeads")
+	require.NoError(err)
+	defer headSub.Unsubscribe()
+	logs := make(chan *Web3Log, 4)
+	logSub, err := client.EthSubscribe(ctx, logs, "logs", map[string]interface{}{})
+	require.NoError(err)
+	defer logSub.Unsubscribe()
+
+	blk, err := svr.dao.GetBlockByHeight(4)
+	require.NoError(err)
+	blk.Receipts, err = svr.dao.GetReceipts(4)
+	require.NoError(err)
+	require.NoError(svr.chainListener.HandleBlock(blk))
+
+	select {
+	case head := <-heads:
+		require.Equal(hexutil.Uint64(4), head.Number)
+		blkHash := blk.HashBlock()
+		require.Equal(common.BytesToHash(blkHash[:]), head.Hash)
+	case err := <-headSub.Err():
+		require.NoError(err)
+	case <-time.After(5 * time.Second):
+		require.Fail("timeout waiting for new head")
+	}
+	select {
+	case l := <-logs:
+		require.Equal(hexutil.Uint64(4), l.BlockNumber)
+	case err := <-logSub.Err():
+		require.NoError(err)
+	case <-time.After(5 * time.Second):
+		require.Fail("timeout waiting for log")
+	}
+}
@@ -0,0 +1,344 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/third_party/go-ethereum/rpc"
+)
+
+type (
+	// Web3CallArgs is the transaction object of eth_call and eth_estimateGas
+	Web3CallArgs struct {
+		From     *common.Address `json:"from"`
+		To       *common.Address `json:"to"`
+		Gas      *hexutil.Uint64 `json:"gas"`
+		GasPrice *hexutil.Big    `json:"gasPrice"`
+		Value    *hexutil.Big    `json:"value"`
+		Data     *hexutil.Bytes  `json:"data"`
+		Input    *hexutil.Bytes  `json:"input"`
+	}
+
+	// Web3FilterQuery is the filter object of eth_getLogs and eth_subscribe("logs")
+	Web3FilterQuery struct {
+		BlockHash *common.Hash
+		FromBlock *rpc.BlockNumber
+		ToBlock   *rpc.BlockNumber
+		Addresses []common.Address
+		Topics    [][]common.Hash
+	}
+
+	// Web3Log is the log object of the web3 API
+	Web3Log struct {
+		Address          common.Address `json:"address"`
+		Topics           []common.Hash  `json:"topics"`
+		Data             hexutil.Bytes  `json:"data"`
+		BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+		TransactionHash  common.Hash    `json:"transactionHash"`
+		TransactionIndex hexutil.Uint   `json:"transactionIndex"`
+		BlockHash        common.Hash    `json:"blockHash"`
+		LogIndex         hexutil.Uint   `json:"logIndex"`
+		Removed          bool           `json:"removed"`
+	}
+
+	// Web3Receipt is the receipt object of eth_getTransactionReceipt
+	Web3Receipt struct {
+		TransactionHash   common.Hash     `json:"transactionHash"`
+		TransactionIndex  hexutil.Uint    `json:"transactionIndex"`
+		BlockHash         common.Hash     `json:"blockHash"`
+		BlockNumber       hexutil.Uint64  `json:"blockNumber"`
+		From              common.Address  `json:"from"`
+		To                *common.Address `json:"to"`
+		CumulativeGasUsed hexutil.Uint64  `json:"cumulativeGasUsed"`
+		GasUsed           hexutil.Uint64  `json:"gasUsed"`
+		ContractAddress   *common.Address `json:"contractAddress"`
+		Logs              []*Web3Log      `json:"logs"`
+		LogsBloom         hexutil.Bytes   `json:"logsBloom"`
+		Status            hexutil.Uint64  `json:"status"`
+		RevertReason      string          `json:"revertReason,omitempty"`
+		GasPayer          *common.Address `json:"gasPayer,omitempty"`
+	}
+
+	// Web3Header is the block header object of eth_subscribe("newHeads")
+	Web3Header struct {
+		Number           hexutil.Uint64 `json:"number"`
+		Hash             common.Hash    `json:"hash"`
+		ParentHash       common.Hash    `json:"parentHash"`
+		Miner            common.Address `json:"miner"`
+		TransactionsRoot common.Hash    `json:"transactionsRoot"`
+		ReceiptsRoot     common.Hash    `json:"receiptsRoot"`
+		StateRoot        common.Hash    `json:"stateRoot"`
+		LogsBloom        hexutil.Bytes  `json:"logsBloom"`
+		Timestamp        hexutil.Uint64 `json:"timestamp"`
+	}
+
+	// Web3AccountProof is the result of eth_getProof. The root hash which the proofs are against is returned along with
+	// the block, whose header commits to it if the producer of the block keeps a state trie.
+	Web3AccountProof struct {
+		Address      common.Address      `json:"address"`
+		AccountProof []hexutil.Bytes     `json:"accountProof"`
+		Balance      *hexutil.Big        `json:"balance"`
+		CodeHash     common.Hash         `json:"codeHash"`
+		Nonce        hexutil.Uint64      `json:"nonce"`
+		StorageHash  common.Hash         `json:"storageHash"`
+		StorageProof []*Web3StorageProof `json:"storageProof"`
+		StateRoot    common.Hash         `json:"stateRoot"`
+		BlockNumber  hexutil.Uint64      `json:"blockNumber"`
+		BlockHash    common.Hash         `json:"blockHash"`
+	}
+
+	// Web3StorageProof is the proof of a storage slot in the result of eth_getProof
+	Web3StorageProof struct {
+		Key   common.Hash     `json:"key"`
+		Value hexutil.Bytes   `json:"value"`
+		Proof []hexutil.Bytes `json:"proof"`
+	}
+
+	// Web3TraceConfig is the options of debug_traceTransaction and debug_traceCall
+	Web3TraceConfig struct {
+		// StructLogs asks for the opcode level logs in addition to the call tree
+		StructLogs bool `json:"structLogs"`
+	}
+
+	// Web3FeeHistory is the result of eth_feeHistory
+	Web3FeeHistory struct {
+		OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+		BaseFee      []*hexutil.Big   `json:"baseFeePerGas"`
+		GasUsedRatio []float64        `json:"gasUsedRatio"`
+		Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+	}
+)
+
+// UnmarshalJSON decodes a filter object, in which address could be either a single address or a list of them, and
+// each topic position could be either null, a single topic or a list of alternatives
+func (q *Web3FilterQuery) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		BlockHash *common.Hash     `json:"blockHash"`
+		FromBlock *rpc.BlockNumber `json:"fromBlock"`
+		ToBlock   *rpc.BlockNumber `json:"toBlock"`
+		Address   json.RawMessage  `json:"address"`
+		Topics    []interface{}    `json:"topics"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.BlockHash != nil && (raw.FromBlock != nil || raw.ToBlock != nil) {
+		return errors.New("cannot specify both blockHash and fromBlock/toBlock")
+	}
+	*q = Web3FilterQuery{
+		BlockHash: raw.BlockHash,
+		FromBlock: raw.FromBlock,
+		ToBlock:   raw.ToBlock,
+	}
+	if len(raw.Address) > 0 && string(raw.Address) != "null" {
+		var addrs []common.Address
+		if err := json.Unmarshal(raw.Address, &addrs); err != nil {
+			var addr common.Address
+			if err := json.Unmarshal(raw.Address, &addr); err != nil {
+				return errors.Wrap(err, "invalid address in filter")
+			}
+			addrs = []common.Address{addr}
+		}
+		q.Addresses = addrs
+	}
+	for _, t := range raw.Topics {
+		switch topic := t.(type) {
+		case nil:
+			q.Topics = append(q.Topics, nil)
+		case string:
+			h, err := decodeWeb3Topic(topic)
+			if err != nil {
+				return err
+			}
+			q.Topics = append(q.Topics, []common.Hash{h})
+		case []interface{}:
+			alternatives := make([]common.Hash, 0, len(topic))
+			for _, alt := range topic {
+				str, ok := alt.(string)
+				if !ok {
+					return errors.New("invalid topic in filter")
+				}
+				h, err := decodeWeb3Topic(str)
+				if err != nil {
+					return err
+				}
+				alternatives = append(alternatives, h)
+			}
+			q.Topics = append(q.Topics, alternatives)
+		default:
+			return errors.New("invalid topic in filter")
+		}
+	}
+	return nil
+}
+
+// logsFilter converts the filter object into the api log filter
+func (q *Web3FilterQuery) logsFilter() (*iotexapi.LogsFilter, error) {
+	filter := &iotexapi.LogsFilter{}
+	for _, addr := range q.Addresses {
+		ioAddr, err := ethToIoAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		filter.Address = append(filter.Address, ioAddr)
+	}
+	for _, alternatives := range q.Topics {
+		topics := &iotexapi.Topics{}
+		for _, t := range alternatives {
+			topics.Topic = append(topics.Topic, t.Bytes())
+		}
+		filter.Topics = append(filter.Topics, topics)
+	}
+	return filter, nil
+}
+
+func decodeWeb3Topic(topic string) (common.Hash, error) {
+	b, err := hexutil.Decode(topic)
+	if err != nil || len(b) != common.HashLength {
+		return common.Hash{}, errors.Errorf("invalid topic %s", topic)
+	}
+	return common.BytesToHash(b), nil
+}
+
+// execution converts the call object into an execution. An absent recipient means contract creation.
+func (args *Web3CallArgs) execution(gasLimit uint64) (*action.Execution, error) {
+	contract := action.EmptyAddress
+	if args.To != nil {
+		ioAddr, err := ethToIoAddress(*args.To)
+		if err != nil {
+			return nil, err
+		}
+		contract = ioAddr
+	}
+	if args.Gas != nil && uint64(*args.Gas) > 0 {
+		gasLimit = uint64(*args.Gas)
+	}
+	return action.NewExecution(contract, 0, args.value(), gasLimit, big.NewInt(0), args.data())
+}
+
+// caller returns the io address of the caller, or the zero address if it is not specified
+func (args *Web3CallArgs) caller() (string, error) {
+	if args.From == nil {
+		return address.ZeroAddress, nil
+	}
+	return ethToIoAddress(*args.From)
+}
+
+func (args *Web3CallArgs) value() *big.Int {
+	if args.Value == nil {
+		return big.NewInt(0)
+	}
+	return args.Value.ToInt()
+}
+
+func (args *Web3CallArgs) data() []byte {
+	if args.Input != nil {
+		return *args.Input
+	}
+	if args.Data != nil {
+		return *args.Data
+	}
+	return nil
+}
+
+func (cfg *Web3TraceConfig) structLogs() bool {
+	return cfg != nil && cfg.StructLogs
+}
+
+// ioToEthAddress converts an io1 encoded address into its 0x form
+func ioToEthAddress(addr string) (common.Address, error) {
+	ioAddr, err := address.FromString(addr)
+	if err != nil {
+		return common.Address{}, errors.Wrapf(err, "invalid address %s", addr)
+	}
+	return common.BytesToAddress(ioAddr.Bytes()), nil
+}
+
+// web3Proof converts the serialized nodes of a proof into hex encoded bytes
+func web3Proof(proof [][]byte) []hexutil.Bytes {
+	res := make([]hexutil.Bytes, len(proof))
+	for i, node := range proof {
+		res[i] = node
+	}
+	return res
+}
+
+// ethToIoAddress converts a 0x address into its io1 encoded form
+func ethToIoAddress(addr common.Address) (string, error) {
+	ioAddr, err := address.FromBytes(addr.Bytes())
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid address %s", addr.Hex())
+	}
+	return ioAddr.String(), nil
+}
+
+// web3Status translates a receipt status code into the Ethereum status field, which only distinguishes success (1)
+// from failure (0)
+func web3Status(status uint64) hexutil.Uint64 {
+	if status == uint64(iotextypes.ReceiptStatus_Success) {
+		return 1
+	}
+	return 0
+}
+
+func newWeb3Log(l *iotextypes.Log, blkHash hash.Hash256) (*Web3Log, error) {
+	addr, err := ioToEthAddress(l.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	topics := make([]common.Hash, 0, len(l.Topics))
+	for _, t := range l.Topics {
+		topics = append(topics, common.BytesToHash(t))
+	}
+	return &Web3Log{
+		Address:         addr,
+		Topics:          topics,
+		Data:            l.Data,
+		BlockNumber:     hexutil.Uint64(l.BlkHeight),
+		TransactionHash: common.BytesToHash(l.ActHash),
+		BlockHash:       common.BytesToHash(blkHash[:]),
+		LogIndex:        hexutil.Uint(l.Index),
+	}, nil
+}
+
+func newWeb3Header(header *block.Header) (*Web3Header, error) {
+	h := header.HashBlock()
+	prev := header.PrevHash()
+	txRoot := header.TxRoot()
+	receiptRoot := header.ReceiptRoot()
+	stateRoot := header.StateRoot()
+	miner, err := ioToEthAddress(header.ProducerAddress())
+	if err != nil {
+		return nil, err
+	}
+	res := &Web3Header{
+		Number:           hexutil.Uint64(header.Height()),
+		Hash:             common.BytesToHash(h[:]),
+		ParentHash:       common.BytesToHash(prev[:]),
+		Miner:            miner,
+		TransactionsRoot: common.BytesToHash(txRoot[:]),
+		ReceiptsRoot:     common.BytesToHash(receiptRoot[:]),
+		StateRoot:        common.BytesToHash(stateRoot[:]),
+		Timestamp:        hexutil.Uint64(header.Timestamp().Unix()),
+	}
+	if bloom := header.LogsBloomfilter(); bloom != nil {
+		res.LogsBloom = bloom.Bytes()
+	}
+	return res, nil
+}
@@ -101,6 +101,18 @@ func (b *Block) VerifyDeltaStateDigest(digest hash.Hash256) error {
 	return nil
 }
 
+// VerifyStateRoot verifies the state root in header
+func (b *Block) VerifyStateRoot(root hash.Hash256) error {
+	if b.Header.stateRoot != root {
+		return errors.Errorf(
+			"state root doesn't match, expected = %x, actual = %x",
+			b.Header.stateRoot,
+			root,
+		)
+	}
+	return nil
+}
+
 // VerifyReceiptRoot verifies the receipt root in header
 func (b *Block) VerifyReceiptRoot(root hash.Hash256) error {
 	if b.Header.receiptRoot != root {
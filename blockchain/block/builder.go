@@ -60,6 +60,12 @@ func (b *Builder) SetPrevBlockHash(h hash.Hash256) *Builder {
 	return b
 }
 
+// SetStateRoot sets the root hash of the state trie after running actions included in this building block
+func (b *Builder) SetStateRoot(h hash.Hash256) *Builder {
+	b.blk.Header.stateRoot = h
+	return b
+}
+
 // SetDeltaStateDigest sets the new delta state digest after running actions included in this building block
 func (b *Builder) SetDeltaStateDigest(h hash.Hash256) *Builder {
 	b.blk.Header.deltaStateDigest = h
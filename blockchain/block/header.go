@@ -33,6 +33,7 @@ type Header struct {
 	txRoot           hash.Hash256      // merkle root of all transactions
 	deltaStateDigest hash.Hash256      // digest of state change by this block
 	receiptRoot      hash.Hash256      // root of receipt trie
+	stateRoot        hash.Hash256      // root of state trie after applying this block, zero before the Hawaii height
 	logsBloom        bloom.BloomFilter // bloom filter for all contract events in this block
 	blockSig         []byte            // block signature
 	pubkey           crypto.PublicKey  // block producer's public key
@@ -62,6 +63,9 @@ func (h *Header) PublicKey() crypto.PublicKey { return h.pubkey }
 // ReceiptRoot returns the receipt root after apply this block
 func (h *Header) ReceiptRoot() hash.Hash256 { return h.receiptRoot }
 
+// StateRoot returns the root hash of the state trie after applying this block, which is zero before the Hawaii height
+func (h *Header) StateRoot() hash.Hash256 { return h.stateRoot }
+
 // HashBlock return the hash of this block (actually hash of block header)
 func (h *Header) HashBlock() hash.Hash256 { return h.HashHeader() }
 
@@ -95,6 +99,10 @@ func (h *Header) BlockHeaderCoreProto() *iotextypes.BlockHeaderCore {
 	if h.logsBloom != nil {
 		header.LogsBloom = h.logsBloom.Bytes()
 	}
+	// the header without a state root is serialized as before
+	if h.stateRoot != hash.ZeroHash256 {
+		header.StateRoot = h.stateRoot[:]
+	}
 	return &header
 }
 
@@ -126,6 +134,7 @@ func (h *Header) loadFromBlockHeaderCoreProto(pb *iotextypes.BlockHeaderCore) er
 	copy(h.txRoot[:], pb.GetTxRoot())
 	copy(h.deltaStateDigest[:], pb.GetDeltaStateDigest())
 	copy(h.receiptRoot[:], pb.GetReceiptRoot())
+	copy(h.stateRoot[:], pb.GetStateRoot())
 	if pb.GetLogsBloom() != nil {
 		h.logsBloom, err = bloom.BloomFilterFromBytes(pb.GetLogsBloom(), 2048, 3)
 	}
@@ -187,5 +196,6 @@ func (h *Header) HeaderLogger(l *zap.Logger) *zap.Logger {
 		log.Hex("txRoot", h.txRoot[:]),
 		log.Hex("receiptRoot", h.receiptRoot[:]),
 		log.Hex("deltaStateDigest", h.deltaStateDigest[:]),
+		log.Hex("stateRoot", h.stateRoot[:]),
 	)
 }
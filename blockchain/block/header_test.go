@@ -60,6 +60,24 @@ func TestSerDesHeadrer(t *testing.T) {
 	require.NotNil(header.BlockHeaderCoreProto())
 	require.Equal("io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms", header.ProducerAddress())
 }
+
+func TestHeaderStateRoot(t *testing.T) {
+	require := require.New(t)
+	h := getHeader()
+	require.Equal(hash.ZeroHash256, h.StateRoot())
+	blkHash := h.HashBlock()
+
+	// the state root is committed in the hash of the block
+	h.stateRoot = hash.Hash256b([]byte("root"))
+	require.NotEqual(blkHash, h.HashBlock())
+	ser, err := h.Serialize()
+	require.NoError(err)
+	header := &Header{}
+	require.NoError(header.Deserialize(ser))
+	require.Equal(h.StateRoot(), header.StateRoot())
+	require.Equal(h.HashBlock(), header.HashBlock())
+}
+
 func getHeader() *Header {
 	ti, err := time.Parse("2006-Jan-02", "2019-Feb-03")
 	if err != nil {
@@ -25,6 +25,18 @@ func calculateTxRoot(acts []action.SealedEnvelope) hash.Hash256 {
 	return crypto.NewMerkleTree(h).HashTree()
 }
 
+// CalculateReceiptRoot returns the merkle root of the receipts
+func CalculateReceiptRoot(receipts []*action.Receipt) hash.Hash256 {
+	if len(receipts) == 0 {
+		return hash.ZeroHash256
+	}
+	h := make([]hash.Hash256, 0, len(receipts))
+	for _, receipt := range receipts {
+		h = append(h, receipt.Hash())
+	}
+	return crypto.NewMerkleTree(h).HashTree()
+}
+
 // calculateTransferAmount returns the calculated transfer amount
 func calculateTransferAmount(acts []action.SealedEnvelope) *big.Int {
 	transferAmount := big.NewInt(0)
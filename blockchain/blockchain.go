@@ -13,6 +13,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/facebookgo/clock"
 	"github.com/iotexproject/go-pkgs/bloom"
 	"github.com/iotexproject/go-pkgs/hash"
@@ -33,7 +34,6 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain/blockdao"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/config"
-	"github.com/iotexproject/iotex-core/crypto"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
@@ -113,14 +113,68 @@ type Blockchain interface {
 	RemoveSubscriber(BlockCreationSubscriber) error
 }
 
+type (
+	simulateConfig struct {
+		tracer   vm.Tracer
+		atHeight bool
+		height   uint64
+	}
+
+	// SimulateOption sets an option of execution simulation
+	SimulateOption func(*simulateConfig)
+)
+
+// SimulateTracerOption traces the simulated execution with the tracer
+func SimulateTracerOption(tracer vm.Tracer) SimulateOption {
+	return func(cfg *simulateConfig) {
+		cfg.tracer = tracer
+	}
+}
+
+// SimulateAtHeightOption simulates the execution on top of the states at the end of the given height
+func SimulateAtHeightOption(height uint64) SimulateOption {
+	return func(cfg *simulateConfig) {
+		cfg.atHeight = true
+		cfg.height = height
+	}
+}
+
 // SimulateExecution simulates a running of smart contract operation, this is done off the network since it does not
 // cause any state change
-func SimulateExecution(bc Blockchain, caller address.Address, ex *action.Execution) ([]byte, *action.Receipt, error) {
+func SimulateExecution(
+	bc Blockchain,
+	caller address.Address,
+	ex *action.Execution,
+	opts ...SimulateOption,
+) ([]byte, *action.Receipt, error) {
+	cfg := simulateConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
 	ctx, err := bc.Context()
 	if err != nil {
 		return nil, nil, err
 	}
 	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+	if cfg.atHeight && cfg.height != bcCtx.Tip.Height {
+		if cfg.height > bcCtx.Tip.Height {
+			return nil, nil, errors.Errorf("invalid height %d, current height is %d", cfg.height, bcCtx.Tip.Height)
+		}
+		blkHash, err := bc.BlockDAO().GetBlockHash(cfg.height)
+		if err != nil {
+			return nil, nil, err
+		}
+		header, err := bc.BlockDAO().Header(blkHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		bcCtx.Tip = protocol.TipInfo{
+			Height:    cfg.height,
+			Hash:      blkHash,
+			Timestamp: header.Timestamp(),
+		}
+		ctx = protocol.WithBlockchainCtx(ctx, bcCtx)
+	}
 	ctx = protocol.WithActionCtx(
 		ctx,
 		protocol.ActionCtx{
@@ -140,6 +194,12 @@ func SimulateExecution(bc Blockchain, caller address.Address, ex *action.Executi
 			Producer:       zeroAddr,
 		},
 	)
+	if cfg.tracer != nil {
+		ctx = evm.WithTracerCtx(ctx, cfg.tracer)
+	}
+	if cfg.atHeight {
+		return bc.Factory().ReadContractAtHeight(ctx, bcCtx.Tip.Height, ex, bc.BlockDAO().GetBlockHash)
+	}
 	ws, err := bc.Factory().NewWorkingSet()
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to obtain working set from state factory")
@@ -153,6 +213,68 @@ func SimulateExecution(bc Blockchain, caller address.Address, ex *action.Executi
 	)
 }
 
+// TraceAction re-executes an action of a committed block on top of the states of its parent block, after replaying
+// the actions before it in the block, and traces the execution with the tracer
+func TraceAction(bc Blockchain, blk *block.Block, actHash hash.Hash256, tracer vm.Tracer) (*action.Receipt, error) {
+	ws, err := bc.Factory().NewWorkingSetAtHeight(blk.Height())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to obtain working set of height %d", blk.Height())
+	}
+	ctx, err := bc.Context()
+	if err != nil {
+		return nil, err
+	}
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+	bcCtx.History = ws.History()
+	bcCtx.Tip = protocol.TipInfo{
+		Height:    blk.Height() - 1,
+		Hash:      blk.PrevHash(),
+		Timestamp: time.Unix(bcCtx.Genesis.Timestamp, 0),
+	}
+	if blk.Height() > 1 {
+		parent, err := bc.BlockHeaderByHeight(blk.Height() - 1)
+		if err != nil {
+			return nil, err
+		}
+		bcCtx.Tip.Timestamp = parent.Timestamp()
+	}
+	if pp := poll.FindProtocol(bcCtx.Registry); pp != nil {
+		if bcCtx.Candidates, err = pp.CandidatesByHeight(blk.Height()); err != nil {
+			return nil, err
+		}
+	}
+	ctx = protocol.WithBlockchainCtx(ctx, bcCtx)
+	producer, err := address.FromBytes(blk.PublicKey().Hash())
+	if err != nil {
+		return nil, err
+	}
+	ctx = protocol.WithBlockCtx(
+		ctx,
+		protocol.BlockCtx{
+			BlockHeight:    blk.Height(),
+			BlockTimeStamp: blk.Timestamp(),
+			Producer:       producer,
+			GasLimit:       bcCtx.Genesis.BlockGasLimit,
+		},
+	)
+	for _, p := range bcCtx.Registry.All() {
+		if pp, ok := p.(protocol.PreStatesCreator); ok {
+			if err := pp.CreatePreStates(ctx, ws); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, selp := range blk.RunnableActions().Actions() {
+		if selp.Hash() == actHash {
+			return ws.RunAction(evm.WithTracerCtx(ctx, tracer), selp)
+		}
+		if _, err := ws.RunAction(ctx, selp); err != nil {
+			return nil, errors.Wrapf(err, "failed to replay action %x", selp.Hash())
+		}
+	}
+	return nil, errors.Errorf("action %x is not in block %d", actHash, blk.Height())
+}
+
 // ProductivityByEpoch returns the map of the number of blocks produced per delegate in an epoch
 func ProductivityByEpoch(bc Blockchain, epochNum uint64) (uint64, map[string]uint64, error) {
 	ctx, err := bc.Context()
@@ -266,7 +388,8 @@ func InMemStateFactoryOption() Option {
 	}
 }
 
-// BoltDBDaoOption sets blockchain's dao with BoltDB from config.Chain.ChainDBPath
+// BoltDBDaoOption sets blockchain's dao with the KV store from config.Chain.ChainDBPath, which is bolt DB unless
+// another backend is set in config.DB
 func BoltDBDaoOption() Option {
 	return func(bc *blockchain, cfg config.Config) error {
 		if bc.dao != nil {
@@ -274,7 +397,7 @@ func BoltDBDaoOption() Option {
 		}
 		cfg.DB.DbPath = cfg.Chain.ChainDBPath // TODO: remove this after moving TrieDBPath from cfg.Chain to cfg.DB
 		bc.dao = blockdao.NewBlockDAO(
-			db.NewBoltDB(cfg.DB),
+			db.NewKVStore(cfg.DB),
 			nil,
 			cfg.Chain.CompressBlock,
 			cfg.DB,
@@ -548,13 +671,18 @@ func (bc *blockchain) MintNewBlock(
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get digest")
 	}
+	root, err := bc.stateRoot(newblockHeight, ws)
+	if err != nil {
+		return nil, err
+	}
 	blk, err := block.NewBuilder(ra).
 		SetHeight(newblockHeight).
 		SetTimestamp(timestamp).
 		SetPrevBlockHash(prevBlkHash).
 		SetDeltaStateDigest(digest).
+		SetStateRoot(root).
 		SetReceipts(rc).
-		SetReceiptRoot(calculateReceiptRoot(rc)).
+		SetReceiptRoot(block.CalculateReceiptRoot(rc)).
 		SetLogsBloom(calculateLogsBloom(bc.config, newblockHeight, rc)).
 		SignAndBuild(sk)
 	if err != nil {
@@ -744,6 +872,10 @@ func (bc *blockchain) validateBlock(blk *block.Block) error {
 	runTimer := bc.timerFactory.NewTimer("runActions")
 	receipts, err := bc.runActions(ctx, blk, ws)
 	runTimer.End()
+	switch errors.Cause(err) {
+	case action.ErrMultisigUnauthorized, action.ErrRLPRecipient:
+		return errors.Wrapf(err, "error when validating block %d", blk.Height())
+	}
 	if err != nil {
 		log.L().Panic("Failed to update state.", zap.Uint64("tipHeight", bc.tipHeight), zap.Error(err))
 	}
@@ -754,8 +886,15 @@ func (bc *blockchain) validateBlock(blk *block.Block) error {
 	if err = blk.VerifyDeltaStateDigest(digest); err != nil {
 		return err
 	}
+	root, err := bc.stateRoot(blk.Height(), ws)
+	if err != nil {
+		return err
+	}
+	if err = blk.VerifyStateRoot(root); err != nil {
+		return err
+	}
 
-	if err = blk.VerifyReceiptRoot(calculateReceiptRoot(receipts)); err != nil {
+	if err = blk.VerifyReceiptRoot(block.CalculateReceiptRoot(receipts)); err != nil {
 		return errors.Wrap(err, "Failed to verify receipt root")
 	}
 
@@ -873,6 +1012,15 @@ func (bc *blockchain) pickAndRunActions(ctx context.Context, actionMap map[strin
 				actionIterator.PopAccount()
 				continue
 			}
+			switch errors.Cause(err) {
+			case action.ErrMultisigUnauthorized, action.ErrRLPRecipient:
+				// the multisig policy of the sender has changed since the action was cosigned, or the code of the
+				// recipient of an action encoded in RLP has changed since it was sent, and the rest actions of the
+				// sender cannot be processed without it
+				log.L().Debug("Skip the action rejected by the states.", zap.Error(err))
+				actionIterator.PopAccount()
+				continue
+			}
 			return nil, nil, errors.Wrapf(err, "Failed to update state changes for selp %x", nextAction.Hash())
 		}
 		if receipt != nil {
@@ -931,16 +1079,24 @@ func (bc *blockchain) emitToSubscribers(blk *block.Block) {
 	}
 }
 
-func calculateReceiptRoot(receipts []*action.Receipt) hash.Hash256 {
-	if len(receipts) == 0 {
-		return hash.ZeroHash256
+// stateRoot returns the state root the block at the height commits to, which is zero before the Hawaii height. After it,
+// the root is required, so a node running the stateDB factory can neither produce nor validate blocks.
+func (bc *blockchain) stateRoot(height uint64, ws factory.WorkingSet) (hash.Hash256, error) {
+	hu := config.NewHeightUpgrade(&bc.config.Genesis)
+	if hu.IsPre(config.Hawaii, height) {
+		return hash.ZeroHash256, nil
 	}
-	h := make([]hash.Hash256, 0, len(receipts))
-	for _, receipt := range receipts {
-		h = append(h, receipt.Hash())
+	root, err := ws.RootHash()
+	if err != nil {
+		return hash.ZeroHash256, errors.Wrap(err, "failed to get state root")
+	}
+	if root == hash.ZeroHash256 {
+		return hash.ZeroHash256, errors.Errorf(
+			"cannot compute the state root of block %d without the state trie, chain.enableTrielessStateDB must be off",
+			height,
+		)
 	}
-	res := crypto.NewMerkleTree(h).HashTree()
-	return res
+	return root, nil
 }
 
 func calculateLogsBloom(cfg config.Config, height uint64, receipts []*action.Receipt) bloom.BloomFilter {
@@ -575,6 +575,66 @@ func TestBlockchain_MintNewBlock_PopAccount(t *testing.T) {
 	require.True(t, whetherInclude)
 }
 
+func TestBlockchain_StateRoot(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	cfg := config.Default
+	cfg.Genesis.EnableGravityChainVoting = false
+	cfg.Genesis.HawaiiBlockHeight = 2
+	registry := protocol.NewRegistry()
+	acc := account.NewProtocol(rewarding.DepositGas)
+	require.NoError(acc.Register(registry))
+	bc := NewBlockchain(cfg, nil, InMemStateFactoryOption(), InMemDaoOption(), RegistryOption(registry))
+	require.NoError(bc.Start(ctx))
+	defer func() {
+		require.NoError(bc.Stop(ctx))
+	}()
+
+	// no state root before the Hawaii height
+	blk, err := bc.MintNewBlock(nil, testutil.TimestampNow())
+	require.NoError(err)
+	require.Equal(hash.ZeroHash256, blk.Header.StateRoot())
+	require.NoError(bc.ValidateBlock(blk))
+	require.NoError(bc.CommitBlock(blk))
+
+	blk, err = bc.MintNewBlock(nil, testutil.TimestampNow())
+	require.NoError(err)
+	root, err := blk.WorkingSet.RootHash()
+	require.NoError(err)
+	require.NotEqual(hash.ZeroHash256, root)
+	require.Equal(root, blk.Header.StateRoot())
+	require.NoError(bc.ValidateBlock(blk))
+
+	// a block committing to another state root, or to none, is rejected
+	for _, r := range []hash.Hash256{hash.ZeroHash256, hash.Hash256b(root[:])} {
+		forged, err := block.NewBuilder(block.NewRunnableActionsBuilder().AddActions(blk.Actions...).Build()).
+			SetHeight(blk.Height()).
+			SetTimestamp(blk.Timestamp()).
+			SetPrevBlockHash(blk.PrevHash()).
+			SetDeltaStateDigest(blk.DeltaStateDigest()).
+			SetStateRoot(r).
+			SetReceiptRoot(blk.ReceiptRoot()).
+			SetLogsBloom(blk.LogsBloomfilter()).
+			SignAndBuild(cfg.ProducerPrivateKey())
+		require.NoError(err)
+		require.Error(bc.ValidateBlock(&forged))
+	}
+
+	// the stateDB factory cannot produce a block after the Hawaii height
+	cfg.Genesis.HawaiiBlockHeight = 1
+	sf, err := factory.NewStateDB(cfg, factory.InMemStateDBOption())
+	require.NoError(err)
+	registry = protocol.NewRegistry()
+	require.NoError(acc.Register(registry))
+	sdb := NewBlockchain(cfg, nil, PrecreatedStateFactoryOption(sf), InMemDaoOption(), RegistryOption(registry))
+	require.NoError(sdb.Start(ctx))
+	defer func() {
+		require.NoError(sdb.Stop(ctx))
+	}()
+	_, err = sdb.MintNewBlock(nil, testutil.TimestampNow())
+	require.Error(err)
+}
+
 type MockSubscriber struct {
 	counter int
 	mu      sync.RWMutex
@@ -831,9 +831,10 @@ func (dao *blockDAO) openDB(idx uint64) (kvstore db.KVStore, index uint64, err e
 	model, _ := getFileNameAndDir(cfg.DbPath)
 	name := model + fmt.Sprintf("-%08d", idx) + ".db"
 
-	// open or create this db file
+	// open or create this db file, of the same backend as the main db
+	cfg.Backend = cfg.KVStoreBackend()
 	cfg.DbPath = path.Dir(cfg.DbPath) + "/" + name
-	kvstore = db.NewBoltDB(cfg)
+	kvstore = db.NewKVStore(cfg)
 	dao.kvstores.Store(idx, kvstore)
 	err = kvstore.Start(context.Background())
 	if err != nil {
@@ -58,11 +58,8 @@ var (
 // Validate validates the given block's content
 func (v *validator) Validate(ctx context.Context, blk *block.Block) error {
 	bcCtx := protocol.MustGetBlockchainCtx(ctx)
-	if err := verifyHeightAndHash(blk, bcCtx.Tip.Height, bcCtx.Tip.Hash); err != nil {
-		return errors.Wrap(err, "failed to verify block's height and hash")
-	}
-	if err := verifySigAndRoot(blk); err != nil {
-		return errors.Wrap(err, "failed to verify block's signature and merkle root")
+	if err := VerifyBlockWithoutState(blk, bcCtx.Tip.Height, bcCtx.Tip.Hash); err != nil {
+		return err
 	}
 
 	if v.sf == nil {
@@ -189,6 +186,55 @@ func (v *validator) validateActions(
 	return nil
 }
 
+// VerifyBlockWithoutState verifies the height, the link to the tip, the signature and the merkle root of the block,
+// none of which needs the states
+func VerifyBlockWithoutState(blk *block.Block, tipHeight uint64, tipHash hash.Hash256) error {
+	if err := verifyHeightAndHash(blk, tipHeight, tipHash); err != nil {
+		return errors.Wrap(err, "failed to verify block's height and hash")
+	}
+	if err := verifySigAndRoot(blk); err != nil {
+		return errors.Wrap(err, "failed to verify block's signature and merkle root")
+	}
+	return nil
+}
+
+// VerifyHeaderWithoutState verifies the height, the link to the tip and the signature of the block, leaving out the
+// body, which is verified against the tx root once it is fetched
+func VerifyHeaderWithoutState(blk *block.Block, tipHeight uint64, tipHash hash.Hash256) error {
+	if err := verifyHeightAndHash(blk, tipHeight, tipHash); err != nil {
+		return errors.Wrap(err, "failed to verify block's height and hash")
+	}
+	if err := VerifyHeaderSignature(blk); err != nil {
+		return errors.Wrap(err, "failed to verify block's signature")
+	}
+	return nil
+}
+
+// VerifyHeaderSignature verifies the signature of the block producer on the header
+func VerifyHeaderSignature(blk *block.Block) error {
+	if blk.Height() > 0 && !blk.VerifySignature() {
+		return errors.Wrapf(
+			ErrInvalidBlock,
+			"failed to verify block's signature with public key: %x",
+			blk.PublicKey())
+	}
+	return nil
+}
+
+// VerifyBlockSignatures verifies the signature and the merkle root of the block, and the signatures of the actions in
+// it, none of which needs the tip or the states
+func VerifyBlockSignatures(blk *block.Block) error {
+	if err := verifySigAndRoot(blk); err != nil {
+		return errors.Wrap(err, "failed to verify block's signature and merkle root")
+	}
+	for _, selp := range blk.Actions {
+		if err := action.Verify(selp); err != nil {
+			return errors.Wrapf(ErrInvalidBlock, "failed to verify action %x: %v", selp.Hash(), err)
+		}
+	}
+	return nil
+}
+
 func verifyHeightAndHash(blk *block.Block, tipHeight uint64, tipHash hash.Hash256) error {
 	if blk == nil {
 		return ErrInvalidBlock
@@ -215,14 +261,9 @@ func verifyHeightAndHash(blk *block.Block, tipHeight uint64, tipHash hash.Hash25
 }
 
 func verifySigAndRoot(blk *block.Block) error {
-	if blk.Height() > 0 {
-		// verify new block's signature is correct
-		if !blk.VerifySignature() {
-			return errors.Wrapf(
-				ErrInvalidBlock,
-				"failed to verify block's signature with public key: %x",
-				blk.PublicKey())
-		}
+	// verify new block's signature is correct
+	if err := VerifyHeaderSignature(blk); err != nil {
+		return err
 	}
 
 	hashExpect := blk.TxRoot()
@@ -98,6 +98,41 @@ func TestSignBlock(t *testing.T) {
 	require.NoError(val.Validate(ctx, &blk))
 }
 
+func TestVerifyBlockSignatures(t *testing.T) {
+	require := require.New(t)
+
+	tsf1, err := testutil.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), 1, big.NewInt(20), []byte{}, 100000, big.NewInt(10))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(identityset.Address(29).String(), identityset.PrivateKey(27), 2, big.NewInt(30), []byte{}, 100000, big.NewInt(10))
+	require.NoError(err)
+	blk, err := block.NewTestingBuilder().
+		SetHeight(3).
+		SetPrevBlockHash(tsf1.Hash()).
+		SetTimeStamp(testutil.TimestampNow()).
+		AddActions(tsf1, tsf2).
+		SignAndBuild(identityset.PrivateKey(27))
+	require.NoError(err)
+	require.NoError(VerifyBlockSignatures(&blk))
+
+	// tampered tx root
+	blk.Actions[0], blk.Actions[1] = blk.Actions[1], blk.Actions[0]
+	require.Equal(ErrInvalidBlock, errors.Cause(VerifyBlockSignatures(&blk)))
+
+	// tampered action signature
+	actPb := tsf2.Proto()
+	actPb.Signature[10]++
+	var tampered action.SealedEnvelope
+	require.NoError(tampered.LoadProto(actPb))
+	blk, err = block.NewTestingBuilder().
+		SetHeight(3).
+		SetPrevBlockHash(tsf1.Hash()).
+		SetTimeStamp(testutil.TimestampNow()).
+		AddActions(tsf1, tampered).
+		SignAndBuild(identityset.PrivateKey(27))
+	require.NoError(err)
+	require.Equal(ErrInvalidBlock, errors.Cause(VerifyBlockSignatures(&blk)))
+}
+
 func TestWrongNonce(t *testing.T) {
 	cfg := config.Default
 
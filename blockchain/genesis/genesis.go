@@ -53,6 +53,9 @@ func defaultConfig() Genesis {
 			BeringBlockHeight:       1512001,
 			CookBlockHeight:         1641601,
 			DardanellesBlockHeight:  1816201,
+			FairbankBlockHeight:     5157001,
+			GreenlandBlockHeight:    5553001,
+			HawaiiBlockHeight:       6544441,
 		},
 		Account: Account{
 			InitBalanceMap: make(map[string]string),
@@ -72,6 +75,12 @@ func defaultConfig() Genesis {
 			NumDelegatesForFoundationBonus: 36,
 			FoundationBonusLastEpoch:       8760,
 		},
+		Staking: Staking{
+			MinStakeAmountStr:           unit.ConvertIotxToRau(100).String(),
+			RegistrationMinSelfStakeStr: unit.ConvertIotxToRau(1200000).String(),
+			WithdrawWaitingPeriod:       3 * 24 * time.Hour,
+			MaxStakeDuration:            1050,
+		},
 	}
 }
 
@@ -100,6 +109,7 @@ type (
 		Account    `ymal:"account"`
 		Poll       `yaml:"poll"`
 		Rewarding  `yaml:"rewarding"`
+		Staking    `yaml:"staking"`
 	}
 	// Blockchain contains blockchain level configs
 	Blockchain struct {
@@ -132,6 +142,14 @@ type (
 		CookBlockHeight uint64 `yaml:"cookHeight"`
 		// DardanellesBlockHeight is the start height of 5s block internal
 		DardanellesBlockHeight uint64 `yaml:"dardanellesHeight"`
+		// FairbankBlockHeight is the start height of accepting batch transfers
+		FairbankBlockHeight uint64 `yaml:"fairbankHeight"`
+		// GreenlandBlockHeight is the start height of the native staking protocol, multisig accounts, scheduled actions and
+		// sponsored actions
+		GreenlandBlockHeight uint64 `yaml:"greenlandHeight"`
+		// HawaiiBlockHeight is the start height of committing the state root in the block header, which requires the
+		// state trie, and of accepting actions encoded in Ethereum RLP
+		HawaiiBlockHeight uint64 `yaml:"hawaiiHeight"`
 	}
 	// Account contains the configs for account protocol
 	Account struct {
@@ -198,6 +216,17 @@ type (
 		// epoch reward
 		ProductivityThreshold uint64 `yaml:"productivityThreshold"`
 	}
+	// Staking contains the configs for native staking protocol
+	Staking struct {
+		// MinStakeAmountStr is the minimal amount of a bucket in decimal string format
+		MinStakeAmountStr string `yaml:"minStakeAmount"`
+		// RegistrationMinSelfStakeStr is the minimal self-staked amount to register a candidate in decimal string format
+		RegistrationMinSelfStakeStr string `yaml:"registrationMinSelfStake"`
+		// WithdrawWaitingPeriod is the period between unstaking a bucket and withdrawing it
+		WithdrawWaitingPeriod time.Duration `yaml:"withdrawWaitingPeriod"`
+		// MaxStakeDuration is the max staked duration of a bucket in days
+		MaxStakeDuration uint32 `yaml:"maxStakeDuration"`
+	}
 )
 
 // New constructs a genesis config. It loads the default values, and could be overwritten by values defined in the yaml
@@ -415,3 +444,21 @@ func (r *Rewarding) FoundationBonus() *big.Int {
 	}
 	return val
 }
+
+// MinStakeAmount returns the minimal amount of a bucket
+func (s *Staking) MinStakeAmount() *big.Int {
+	val, ok := big.NewInt(0).SetString(s.MinStakeAmountStr, 10)
+	if !ok {
+		log.S().Panicf("Error when casting min stake amount string %s into big int", s.MinStakeAmountStr)
+	}
+	return val
+}
+
+// RegistrationMinSelfStake returns the minimal self-staked amount to register a candidate
+func (s *Staking) RegistrationMinSelfStake() *big.Int {
+	val, ok := big.NewInt(0).SetString(s.RegistrationMinSelfStakeStr, 10)
+	if !ok {
+		log.S().Panicf("Error when casting min self-stake string %s into big int", s.RegistrationMinSelfStakeStr)
+	}
+	return val
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+type (
+	// SimulationStep is an unsigned action to simulate on behalf of the caller
+	SimulationStep struct {
+		Caller   address.Address
+		Envelope action.Envelope
+	}
+
+	// SimulationResult is the outcome of a simulated step
+	SimulationResult struct {
+		Receipt *action.Receipt
+		// Diffs are the changes of the accounts touched by the step, starting with the caller, the recipient and the
+		// created contract, followed by the others in the order they are touched, such as the recipients of the
+		// internal transfers in the EVM
+		Diffs []*AccountDiff
+		// Err is the error failing the step, after which the rest steps are not simulated
+		Err error
+	}
+
+	// AccountDiff is the change of an account made by a simulated step
+	AccountDiff struct {
+		Address string
+		Before  *state.Account
+		After   *state.Account
+	}
+
+	// touchRecorder records the accounts put into the working set with their states before the first put, so that
+	// the accounts touched by the protocols internally are reported as well
+	touchRecorder struct {
+		factory.WorkingSet
+		before  map[hash.Hash160]*state.Account
+		touched []hash.Hash160
+	}
+)
+
+// ErrUnsupportedSimulation indicates that the action cannot be simulated
+var ErrUnsupportedSimulation = errors.New("only transfers and executions can be simulated")
+
+// SimulateActions runs the pending actions and then the steps one after another on a throwaway working set on top of
+// the tip, so that a step sees the state changes made by the previous ones. The simulation stops at the first failed
+// step.
+func SimulateActions(bc Blockchain, pending []action.SealedEnvelope, steps []SimulationStep) ([]*SimulationResult, error) {
+	for i, step := range steps {
+		switch act := step.Envelope.Action().(type) {
+		case *action.Transfer, *action.Execution:
+		default:
+			return nil, errors.Wrapf(ErrUnsupportedSimulation, "step %d is %T", i, act)
+		}
+	}
+	ctx, err := bc.Context()
+	if err != nil {
+		return nil, err
+	}
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+	if bcCtx.Registry == nil {
+		return nil, errors.New("no protocol is registered to simulate actions")
+	}
+	zeroAddr, err := address.FromString(address.ZeroAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx = protocol.WithBlockCtx(
+		ctx,
+		protocol.BlockCtx{
+			BlockHeight:    bcCtx.Tip.Height + 1,
+			BlockTimeStamp: time.Time{},
+			GasLimit:       bcCtx.Genesis.BlockGasLimit,
+			Producer:       zeroAddr,
+		},
+	)
+	ws, err := bc.Factory().NewWorkingSet()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain working set from state factory")
+	}
+	for _, selp := range pending {
+		if _, err := ws.RunAction(ctx, selp); err != nil {
+			return nil, errors.Wrapf(err, "failed to run pending action %x", selp.Hash())
+		}
+	}
+
+	results := make([]*SimulationResult, 0, len(steps))
+	for _, step := range steps {
+		res := &SimulationResult{}
+		res.Receipt, res.Diffs, res.Err = simulateStep(ctx, ws, step)
+		results = append(results, res)
+		if res.Err != nil {
+			break
+		}
+	}
+	return results, nil
+}
+
+func simulateStep(
+	ctx context.Context,
+	ws factory.WorkingSet,
+	step SimulationStep,
+) (*action.Receipt, []*AccountDiff, error) {
+	elp := step.Envelope
+	intrinsicGas, err := elp.IntrinsicGas()
+	if err != nil {
+		return nil, nil, err
+	}
+	actHash := elp.Hash()
+	ctx = protocol.WithActionCtx(ctx, protocol.ActionCtx{
+		Caller:       step.Caller,
+		ActionHash:   actHash,
+		GasPrice:     elp.GasPrice(),
+		IntrinsicGas: intrinsicGas,
+		Nonce:        elp.Nonce(),
+	})
+
+	rec := &touchRecorder{
+		WorkingSet: ws,
+		before:     make(map[hash.Hash160]*state.Account),
+	}
+	var receipt *action.Receipt
+	for _, actionHandler := range protocol.MustGetBlockchainCtx(ctx).Registry.All() {
+		if receipt, err = actionHandler.Handle(ctx, elp.Action(), rec); err != nil {
+			return nil, nil, err
+		}
+		if receipt != nil {
+			break
+		}
+	}
+	if receipt == nil {
+		return nil, nil, errors.Errorf("no protocol handles the action %x", actHash)
+	}
+	receipt.ActionHash = actHash
+	for _, l := range receipt.Logs {
+		l.ActionHash = actHash
+	}
+
+	addrs := []string{step.Caller.String()}
+	if dest, ok := elp.Destination(); ok && dest != "" {
+		addrs = append(addrs, dest)
+	}
+	if receipt.ContractAddress != "" {
+		addrs = append(addrs, receipt.ContractAddress)
+	}
+	keys := make([]hash.Hash160, 0, len(addrs)+len(rec.touched))
+	for _, addr := range addrs {
+		a, err := address.FromString(addr)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, hash.BytesToHash160(a.Bytes()))
+	}
+	keys = append(keys, rec.touched...)
+	var (
+		diffs []*AccountDiff
+		seen  = make(map[hash.Hash160]bool)
+	)
+	for _, key := range keys {
+		before, ok := rec.before[key]
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		after, err := accountutil.LoadAccount(ws, key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !accountChanged(before, after) {
+			continue
+		}
+		addr, err := address.FromBytes(key[:])
+		if err != nil {
+			return nil, nil, err
+		}
+		diffs = append(diffs, &AccountDiff{
+			Address: addr.String(),
+			Before:  before,
+			After:   after,
+		})
+	}
+	return receipt, diffs, nil
+}
+
+// PutState loads the account before it is put for the first time
+func (r *touchRecorder) PutState(key hash.Hash160, s interface{}) error {
+	switch s.(type) {
+	case *state.Account, state.Account:
+		if _, ok := r.before[key]; ok {
+			break
+		}
+		account, err := accountutil.LoadAccount(r.WorkingSet, key)
+		if err != nil {
+			return err
+		}
+		r.before[key] = account
+		r.touched = append(r.touched, key)
+	}
+	return r.WorkingSet.PutState(key, s)
+}
+
+func accountChanged(before, after *state.Account) bool {
+	return before.Nonce != after.Nonce ||
+		bigIntOrZero(before.Balance).Cmp(bigIntOrZero(after.Balance)) != 0 ||
+		before.Root != after.Root ||
+		string(before.CodeHash) != string(after.CodeHash)
+}
+
+func bigIntOrZero(i *big.Int) *big.Int {
+	if i == nil {
+		return big.NewInt(0)
+	}
+	return i
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockindex
+
+import (
+	"context"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// TotalActionsAtHeight returns the total number of actions at the end of the given height, which should not be higher
+// than the height of the indexer
+func TotalActionsAtHeight(x Indexer, height uint64) (uint64, error) {
+	tip, err := x.GetBlockchainHeight()
+	if err != nil {
+		return 0, err
+	}
+	if height > tip {
+		return 0, errors.Errorf("indexer is at height %d, lower than %d", tip, height)
+	}
+	total, err := x.GetTotalActions()
+	if err != nil {
+		return 0, err
+	}
+	for h := tip; h > height; h-- {
+		index, err := x.GetBlockIndex(h)
+		if err != nil {
+			return 0, err
+		}
+		total -= uint64(index.NumAction())
+	}
+	return total, nil
+}
+
+// ImportSnapshot initializes an empty index DB with the total number of actions at the end of the block, and indexes
+// the block, so that the indexer starts from the height of the block. The blocks before it are not indexed.
+func ImportSnapshot(ctx context.Context, kv db.KVStore, genesisHash hash.Hash256, blk *block.Block, totalActions uint64) error {
+	if uint64(len(blk.Actions)) > totalActions {
+		return errors.Errorf("total actions %d is less than the actions in block %d", totalActions, blk.Height())
+	}
+	indexer, err := NewIndexer(kv, genesisHash)
+	if err != nil {
+		return err
+	}
+	x := indexer.(*blockIndexer)
+	if err := x.Start(ctx); err != nil {
+		return err
+	}
+	err = x.importSnapshot(blk, totalActions)
+	if stopErr := x.Stop(ctx); err == nil {
+		err = stopErr
+	}
+	return err
+}
+
+func (x *blockIndexer) importSnapshot(blk *block.Block, totalActions uint64) error {
+	if x.tbk.Size() != 1 || x.tac.Size() != 0 {
+		return errors.New("cannot import snapshot into a non-empty index db")
+	}
+	// set the counters as if the blocks before have been indexed
+	b := db.NewBatch()
+	b.Put(string(totalBlocksBucket), db.CountKey, byteutil.Uint64ToBytesBigEndian(blk.Height()), "failed to put total blocks")
+	b.Put(
+		string(totalActionsBucket),
+		db.CountKey,
+		byteutil.Uint64ToBytesBigEndian(totalActions-uint64(len(blk.Actions))),
+		"failed to put total actions",
+	)
+	if err := x.kvstore.WriteBatch(b); err != nil {
+		return err
+	}
+	var err error
+	if x.tbk, err = db.GetCountingIndex(x.kvstore, totalBlocksBucket); err != nil {
+		return err
+	}
+	if x.tac, err = db.GetCountingIndex(x.kvstore, totalActionsBucket); err != nil {
+		return err
+	}
+	if err := x.PutBlock(blk); err != nil {
+		return err
+	}
+	return x.Commit()
+}
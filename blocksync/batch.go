@@ -0,0 +1,176 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/compress"
+)
+
+// blockBatchVersion is the version of the batched block sync response
+const blockBatchVersion uint32 = 1
+
+type (
+	batchRequest struct {
+		interval    syncBlocksInterval
+		headersOnly bool
+		sent        time.Time
+	}
+
+	// batchRequests keeps the block sync requests advertising batched responses sent to each peer until they time
+	// out, so that a batch is only accepted from a peer the blocks in it have been requested from
+	batchRequests struct {
+		mu       sync.Mutex
+		timeout  time.Duration
+		requests map[string][]batchRequest
+		now      func() time.Time
+	}
+)
+
+func newBatchRequests(timeout time.Duration) *batchRequests {
+	return &batchRequests{
+		timeout:  timeout,
+		requests: make(map[string][]batchRequest),
+		now:      time.Now,
+	}
+}
+
+// Sent records the request of the blocks or their headers of the interval sent to the peer
+func (br *batchRequests) Sent(p peerstore.PeerInfo, interval syncBlocksInterval, headersOnly bool) {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+	id := p.ID.Pretty()
+	br.requests[id] = append(br.expire(id), batchRequest{
+		interval:    interval,
+		headersOnly: headersOnly,
+		sent:        br.now(),
+	})
+}
+
+// Pending returns whether any request of the kind of batch sent to the peer has not timed out
+func (br *batchRequests) Pending(p peerstore.PeerInfo, headersOnly bool) bool {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+	for _, req := range br.expire(p.ID.Pretty()) {
+		if req.headersOnly == headersOnly {
+			return true
+		}
+	}
+	return false
+}
+
+// Solicited returns whether all the blocks in the batch are requested from the peer by the requests not timed out
+func (br *batchRequests) Solicited(p peerstore.PeerInfo, headersOnly bool, blks []*block.Block) bool {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+	reqs := br.expire(p.ID.Pretty())
+	for _, blk := range blks {
+		if blk == nil {
+			continue
+		}
+		height := blk.Height()
+		requested := false
+		for _, req := range reqs {
+			if req.headersOnly == headersOnly && req.interval.Start <= height && height <= req.interval.End {
+				requested = true
+				break
+			}
+		}
+		if !requested {
+			return false
+		}
+	}
+	return len(reqs) > 0
+}
+
+// expire drops the requests sent to the peer which have timed out, and returns the rest
+func (br *batchRequests) expire(id string) []batchRequest {
+	now := br.now()
+	reqs := br.requests[id]
+	live := reqs[:0]
+	for _, req := range reqs {
+		if now.Sub(req.sent) <= br.timeout {
+			live = append(live, req)
+		}
+	}
+	if len(live) == 0 {
+		delete(br.requests, id)
+		return nil
+	}
+	br.requests[id] = live
+	return live
+}
+
+// withSyncCapability sets the capability of batched responses in the block sync request. The peers only serving single
+// blocks ignore it, and serve a request of headers only with full blocks.
+func withSyncCapability(sync *iotexrpc.BlockSync, compression bool, headersOnly bool) *iotexrpc.BlockSync {
+	sync.Capability = &iotexrpc.SyncCapability{
+		BatchVersion: blockBatchVersion,
+		Compression:  compression,
+		HeadersOnly:  headersOnly,
+	}
+	return sync
+}
+
+// newBlockBatch packs the blocks into a batched response, which is gzipped if compressed, and marked as headers only
+// if the bodies of the blocks are left out
+func newBlockBatch(blks []*iotextypes.Block, compressed bool, headersOnly bool) (*iotexrpc.BlockBatch, error) {
+	payload, err := proto.Marshal(&iotexrpc.Blocks{Blocks: blks})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal blocks")
+	}
+	if compressed {
+		if payload, err = compress.Compress(payload); err != nil {
+			return nil, errors.Wrap(err, "failed to compress blocks")
+		}
+	}
+	return &iotexrpc.BlockBatch{
+		Version:     blockBatchVersion,
+		Compressed:  compressed,
+		Payload:     payload,
+		HeadersOnly: headersOnly,
+	}, nil
+}
+
+// decodeBlockBatch unpacks the blocks in a batched block sync response, which is rejected if the blocks exceed the
+// max bytes
+func decodeBlockBatch(batch *iotexrpc.BlockBatch, maxBytes uint64) ([]*block.Block, error) {
+	if batch.Version == 0 || batch.Version > blockBatchVersion {
+		return nil, errors.Errorf("unsupported block batch version %d", batch.Version)
+	}
+	payload := batch.Payload
+	if batch.Compressed {
+		var err error
+		if payload, err = compress.DecompressWithLimit(payload, maxBytes); err != nil {
+			return nil, errors.Wrap(err, "failed to decompress blocks")
+		}
+	} else if uint64(len(payload)) > maxBytes {
+		return nil, errors.Errorf("size of blocks %d exceeds the limit %d", len(payload), maxBytes)
+	}
+	blksPb := &iotexrpc.Blocks{}
+	if err := proto.Unmarshal(payload, blksPb); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal blocks")
+	}
+	blks := make([]*block.Block, 0, len(blksPb.Blocks))
+	for _, blkPb := range blksPb.Blocks {
+		blk := &block.Block{}
+		if err := blk.ConvertFromBlockPb(blkPb); err != nil {
+			return nil, err
+		}
+		blks = append(blks, blk)
+	}
+	return blks, nil
+}
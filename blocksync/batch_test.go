@@ -0,0 +1,275 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/p2p"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockdao"
+	"github.com/iotexproject/iotex-core/test/mock/mock_consensus"
+)
+
+func TestSyncCapability(t *testing.T) {
+	require := require.New(t)
+	cfg := config.Default
+	cfg.BlockSync.BatchCompression = true
+	w := newSyncWorker(cfg.Chain.ID, cfg, nil, nil, nil, nil, nil, nil)
+
+	b, err := proto.Marshal(w.syncRequest(syncBlocksInterval{Start: 3, End: 7}))
+	require.NoError(err)
+	sync := &iotexrpc.BlockSync{}
+	require.NoError(proto.Unmarshal(b, sync))
+	require.Equal(uint64(3), sync.Start)
+	require.Equal(uint64(7), sync.End)
+	require.Equal(blockBatchVersion, sync.GetCapability().GetBatchVersion())
+	require.True(sync.GetCapability().GetCompression())
+	require.False(sync.GetCapability().GetHeadersOnly())
+	require.True(w.headerRequest(syncBlocksInterval{Start: 3, End: 7}).GetCapability().GetHeadersOnly())
+
+	// a request without capability only accepts single blocks
+	cfg.BlockSync.BatchMaxBytes = 0
+	w = newSyncWorker(cfg.Chain.ID, cfg, nil, nil, nil, nil, nil, nil)
+	require.Nil(w.syncRequest(syncBlocksInterval{Start: 3, End: 7}).GetCapability())
+}
+
+func TestBlockBatch(t *testing.T) {
+	require := require.New(t)
+	blk1 := makeLightTestBlock(t, 1, hash.ZeroHash256, nil)
+	blk2 := makeLightTestBlock(t, 2, blk1.HashBlock(), nil)
+	blksPb := []*iotextypes.Block{blk1.ConvertToBlockPb(), blk2.ConvertToBlockPb()}
+	size := uint64(proto.Size(&iotexrpc.Blocks{Blocks: blksPb}))
+	for _, compressed := range []bool{false, true} {
+		batch, err := newBlockBatch(blksPb, compressed, false)
+		require.NoError(err)
+		require.Equal(compressed, batch.Compressed)
+		blks, err := decodeBlockBatch(batch, size)
+		require.NoError(err)
+		require.Equal(2, len(blks))
+		require.Equal(blk1.HashBlock(), blks[0].HashBlock())
+		require.Equal(blk2.HashBlock(), blks[1].HashBlock())
+
+		// the blocks over the max bytes are rejected
+		_, err = decodeBlockBatch(batch, size-1)
+		require.Error(err)
+	}
+	_, err := decodeBlockBatch(&iotexrpc.BlockBatch{Version: blockBatchVersion + 1}, size)
+	require.Error(err)
+	_, err = decodeBlockBatch(&iotexrpc.BlockBatch{Version: blockBatchVersion, Compressed: true, Payload: []byte{1}}, size)
+	require.Error(err)
+}
+
+func TestBatchRequests(t *testing.T) {
+	require := require.New(t)
+	br := newBatchRequests(time.Minute)
+	now := time.Now()
+	br.now = func() time.Time { return now }
+	a, b := peerstore.PeerInfo{ID: "a"}, peerstore.PeerInfo{ID: "b"}
+	blk3 := makeLightTestBlock(t, 3, hash.ZeroHash256, nil)
+	blk8 := makeLightTestBlock(t, 8, hash.ZeroHash256, nil)
+
+	require.False(br.Pending(a, false))
+	br.Sent(a, syncBlocksInterval{Start: 1, End: 5}, false)
+	br.Sent(a, syncBlocksInterval{Start: 6, End: 10}, true)
+	require.True(br.Pending(a, false))
+	require.True(br.Pending(a, true))
+	require.False(br.Pending(b, false))
+	require.True(br.Solicited(a, false, []*block.Block{blk3, nil}))
+	require.True(br.Solicited(a, true, []*block.Block{blk8}))
+	// the blocks not requested, or not requested from the peer
+	require.False(br.Solicited(a, false, []*block.Block{blk3, blk8}))
+	require.False(br.Solicited(a, true, []*block.Block{blk3}))
+	require.False(br.Solicited(b, false, []*block.Block{blk3}))
+	require.False(br.Solicited(b, false, nil))
+
+	// the requests time out
+	now = now.Add(time.Minute + time.Second)
+	require.False(br.Pending(a, false))
+	require.False(br.Solicited(a, false, []*block.Block{blk3}))
+	require.Equal(0, len(br.requests))
+}
+
+func TestBlockSyncerProcessSyncRequestBatch(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blks := make(map[uint64]*block.Block)
+	prevHash := hash.ZeroHash256
+	for h := uint64(1); h <= 5; h++ {
+		blks[h] = makeLightTestBlock(t, h, prevHash, nil)
+		prevHash = blks[h].HashBlock()
+	}
+	blkSize := uint64(proto.Size(blks[1].ConvertToBlockPb()))
+	chain := mock_blockchain.NewMockBlockchain(ctrl)
+	dao := mock_blockdao.NewMockBlockDAO(ctrl)
+	dao.EXPECT().GetBlockByHeight(gomock.Any()).DoAndReturn(func(h uint64) (*block.Block, error) {
+		return blks[h], nil
+	}).AnyTimes()
+	chain.EXPECT().BlockDAO().Return(dao).AnyTimes()
+	chain.EXPECT().ChainID().Return(config.Default.Chain.ID).AnyTimes()
+	chain.EXPECT().TipHeight().Return(uint64(5)).AnyTimes()
+
+	var sent []proto.Message
+	cfg := config.Default
+	// two blocks per batch
+	cfg.BlockSync.BatchMaxBytes = 2*blkSize + 1
+	cfg.BlockSync.BatchCompression = true
+	bs, err := NewBlockSyncer(
+		cfg,
+		chain,
+		nil,
+		mock_consensus.NewMockConsensus(ctrl),
+		WithUnicastOutBound(func(_ context.Context, _ peerstore.PeerInfo, msg proto.Message) error {
+			sent = append(sent, msg)
+			return nil
+		}),
+		WithNeighbors(func(_ context.Context) ([]peerstore.PeerInfo, error) { return nil, nil }),
+	)
+	require.NoError(err)
+
+	// an old peer gets single blocks
+	require.NoError(bs.ProcessSyncRequest(context.Background(), peerstore.PeerInfo{}, &iotexrpc.BlockSync{Start: 1, End: 5}))
+	require.Equal(5, len(sent))
+	for _, msg := range sent {
+		_, ok := msg.(*iotextypes.Block)
+		require.True(ok)
+	}
+
+	for _, compression := range []bool{false, true} {
+		sent = nil
+		sync := withSyncCapability(&iotexrpc.BlockSync{Start: 1, End: 5}, compression, false)
+		require.NoError(bs.ProcessSyncRequest(context.Background(), peerstore.PeerInfo{}, sync))
+		require.Equal(3, len(sent))
+		var heights []uint64
+		for _, msg := range sent {
+			batch, ok := msg.(*iotexrpc.BlockBatch)
+			require.True(ok)
+			require.Equal(compression, batch.Compressed)
+			decoded, err := decodeBlockBatch(batch, cfg.BlockSync.BatchMaxReceiveBytes)
+			require.NoError(err)
+			for _, blk := range decoded {
+				heights = append(heights, blk.Height())
+			}
+		}
+		require.Equal([]uint64{1, 2, 3, 4, 5}, heights)
+	}
+
+	// the headers are sent without the bodies
+	sent = nil
+	sync := withSyncCapability(&iotexrpc.BlockSync{Start: 1, End: 5}, false, true)
+	require.NoError(bs.ProcessSyncRequest(context.Background(), peerstore.PeerInfo{}, sync))
+	require.NotEqual(0, len(sent))
+	var headers []*block.Block
+	for _, msg := range sent {
+		batch, ok := msg.(*iotexrpc.BlockBatch)
+		require.True(ok)
+		require.True(batch.HeadersOnly)
+		decoded, err := decodeBlockBatch(batch, cfg.BlockSync.BatchMaxReceiveBytes)
+		require.NoError(err)
+		headers = append(headers, decoded...)
+	}
+	require.Equal(5, len(headers))
+	for _, header := range headers {
+		require.Equal(blks[header.Height()].HashBlock(), header.HashBlock())
+	}
+
+	// the poll results are kept for the light nodes
+	elp := (&action.EnvelopeBuilder{}).SetAction(action.NewPutPollResult(0, 4, state.CandidateList{
+		{Address: identityset.Address(1).String(), Votes: big.NewInt(2), RewardAddress: identityset.Address(1).String()},
+	})).Build()
+	selp, err := action.Sign(elp, identityset.PrivateKey(0))
+	require.NoError(err)
+	blks[3] = makeLightTestBlock(t, 3, blks[2].HashBlock(), nil, selp)
+	sent = nil
+	sync = withSyncCapability(&iotexrpc.BlockSync{Start: 3, End: 3}, false, true)
+	require.NoError(bs.ProcessSyncRequest(context.Background(), peerstore.PeerInfo{}, sync))
+	require.Equal(1, len(sent))
+	decoded, err := decodeBlockBatch(sent[0].(*iotexrpc.BlockBatch), cfg.BlockSync.BatchMaxReceiveBytes)
+	require.NoError(err)
+	require.Equal(1, len(decoded))
+	require.Equal(1, len(decoded[0].Actions))
+	require.Equal(blks[3].TxRoot(), decoded[0].CalculateTxRoot())
+
+	// a block larger than the max bytes is sent alone
+	bs.(*blockSyncer).batchMaxBytes = 1
+	sent = nil
+	sync = withSyncCapability(&iotexrpc.BlockSync{Start: 1, End: 2}, false, false)
+	require.NoError(bs.ProcessSyncRequest(context.Background(), peerstore.PeerInfo{}, sync))
+	require.Equal(2, len(sent))
+
+	// batching is disabled
+	bs.(*blockSyncer).batchMaxBytes = 0
+	sent = nil
+	require.NoError(bs.ProcessSyncRequest(context.Background(), peerstore.PeerInfo{}, sync))
+	require.Equal(2, len(sent))
+	_, ok := sent[0].(*iotextypes.Block)
+	require.True(ok)
+}
+
+func TestBlockSyncerProcessBlockBatch(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	cfg := config.Default
+	lc, err := NewLightChain(cfg.Genesis, db.NewMemKVStore())
+	require.NoError(err)
+	require.NoError(lc.Start(ctx))
+	validator := footerValidatorFunc(func(*block.Block) error { return nil })
+	bs, err := NewLightBlockSyncer(cfg, lc, validator, opts...)
+	require.NoError(err)
+
+	blk1 := makeLightTestBlock(t, 1, cfg.Genesis.Hash(), nil)
+	blk2 := makeLightTestBlock(t, 2, blk1.HashBlock(), nil)
+	blk3 := makeLightTestBlock(t, 3, blk2.HashBlock(), nil)
+	blk4 := makeLightTestBlock(t, 4, blk3.HashBlock(), nil)
+	newBatch := func(blks ...*block.Block) *iotexrpc.BlockBatch {
+		blksPb := make([]*iotextypes.Block, 0, len(blks))
+		for _, blk := range blks {
+			blksPb = append(blksPb, blk.ConvertToBlockPb())
+		}
+		batch, err := newBlockBatch(blksPb, true, false)
+		require.NoError(err)
+		return batch
+	}
+	syncer := bs.(*blockSyncer)
+	a, b := peerstore.PeerInfo{ID: "a"}, peerstore.PeerInfo{ID: "b"}
+	ctxA, ctxB := p2p.WithPeer(ctx, a), p2p.WithPeer(ctx, b)
+	// the batches not requested from the peer are dropped
+	require.NoError(bs.ProcessBlockBatch(ctx, newBatch(blk1)))
+	require.NoError(bs.ProcessBlockBatch(ctxA, newBatch(blk1)))
+	syncer.batchRequests.Sent(a, syncBlocksInterval{Start: 1, End: 3}, false)
+	require.NoError(bs.ProcessBlockBatch(ctxB, newBatch(blk1)))
+	require.NoError(bs.ProcessBlockBatch(ctxA, newBatch(blk4)))
+	require.Equal(uint64(0), syncer.buf.CommitHeight())
+
+	// the gap at height 1 holds the batch in buffer
+	require.NoError(bs.ProcessBlockBatch(ctxA, newBatch(blk2, blk3)))
+	require.Equal(uint64(0), lc.TipHeight())
+	syncer.batchRequests.Sent(a, syncBlocksInterval{Start: 4, End: 4}, false)
+	require.NoError(bs.ProcessBlockBatch(ctxA, newBatch(blk1, blk4)))
+	require.Equal(uint64(4), lc.TipHeight())
+	require.Equal(uint64(4), bs.(*blockSyncer).buf.CommitHeight())
+	require.False(bs.(*blockSyncer).buf.FlushBatch([]*block.Block{blk4}))
+}
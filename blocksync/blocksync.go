@@ -11,16 +11,20 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/consensus"
+	"github.com/iotexproject/iotex-core/p2p"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
 
 type (
@@ -55,6 +59,11 @@ func WithNeighbors(neighborsHandler Neighbors) Option {
 	}
 }
 
+// FooterValidator validates the endorsements in the block footer
+type FooterValidator interface {
+	ValidateBlockFooter(blk *block.Block) error
+}
+
 // BlockSync defines the interface of blocksyncer
 type BlockSync interface {
 	lifecycle.StartStopper
@@ -63,6 +72,7 @@ type BlockSync interface {
 	ProcessSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync *iotexrpc.BlockSync) error
 	ProcessBlock(ctx context.Context, blk *block.Block) error
 	ProcessBlockSync(ctx context.Context, blk *block.Block) error
+	ProcessBlockBatch(ctx context.Context, batch *iotexrpc.BlockBatch) error
 }
 
 // blockSyncer implements BlockSync interface
@@ -73,6 +83,13 @@ type blockSyncer struct {
 	bc               blockchain.Blockchain
 	unicastHandler   UnicastOutbound
 	neighborsHandler Neighbors
+	batchMaxBytes    uint64
+	batchMaxReceive  uint64
+	batchCompression bool
+	batchRequests    *batchRequests
+	scoreboard       *peerScoreboard
+	pipeline         *syncPipeline
+	headers          *headerChain
 }
 
 // NewBlockSyncer returns a new block syncer instance
@@ -91,18 +108,80 @@ func NewBlockSyncer(
 		bufferSize:   cfg.BlockSync.BufferSize,
 		intervalSize: cfg.BlockSync.IntervalSize,
 	}
+	return newBlockSyncer(cfg, chain.ChainID(), chain, buf, opts...)
+}
+
+// NewLightBlockSyncer returns a new block syncer instance in light mode, which appends the headers and footers of the
+// synced blocks to the light chain after validating the endorsements with the footer validator. The blocks are
+// requested with the headers only, and the peers keep the bodies of the blocks carrying poll results, which are
+// verified against the tx roots. The peers only serving single blocks still serve the full blocks.
+func NewLightBlockSyncer(
+	cfg config.Config,
+	lc *LightChain,
+	validator FooterValidator,
+	opts ...Option,
+) (BlockSync, error) {
+	if lc == nil {
+		return nil, errors.New("light chain cannot be nil")
+	}
+	if validator == nil {
+		return nil, errors.New("footer validator cannot be nil")
+	}
+	buf := &blockBuffer{
+		blocks:       make(map[uint64]*block.Block),
+		light:        lc,
+		validator:    validator,
+		bufferSize:   cfg.BlockSync.BufferSize,
+		intervalSize: cfg.BlockSync.IntervalSize,
+	}
+	return newBlockSyncer(cfg, cfg.Chain.ID, nil, buf, opts...)
+}
+
+func newBlockSyncer(
+	cfg config.Config,
+	chainID uint32,
+	chain blockchain.Blockchain,
+	buf *blockBuffer,
+	opts ...Option,
+) (BlockSync, error) {
 	bsCfg := Config{}
 	for _, opt := range opts {
 		if err := opt(&bsCfg); err != nil {
 			return nil, err
 		}
 	}
+	scoreboard := newPeerScoreboard(cfg.BlockSync.PeerRequestTimeout, cfg.BlockSync.PeerBanDuration)
+	buf.invalidBlock = scoreboard.Invalid
+	requests := newBatchRequests(cfg.BlockSync.PeerRequestTimeout)
+	var headers *headerChain
+	// a light node syncs the headers only in the first place
+	if buf.light == nil && cfg.BlockSync.Pipeline && cfg.BlockSync.PipelineHeaderLookahead > 0 {
+		headers = newHeaderChain(buf, cfg.BlockSync.PipelineHeaderLookahead)
+	}
 	bs := &blockSyncer{
 		bc:               chain,
 		buf:              buf,
 		unicastHandler:   bsCfg.unicastHandler,
 		neighborsHandler: bsCfg.neighborsHandler,
-		worker:           newSyncWorker(chain.ChainID(), cfg, bsCfg.unicastHandler, bsCfg.neighborsHandler, buf),
+		batchMaxBytes:    cfg.BlockSync.BatchMaxBytes,
+		batchMaxReceive:  cfg.BlockSync.BatchMaxReceiveBytes,
+		batchCompression: cfg.BlockSync.BatchCompression,
+		batchRequests:    requests,
+		scoreboard:       scoreboard,
+		headers:          headers,
+		worker: newSyncWorker(
+			chainID,
+			cfg,
+			bsCfg.unicastHandler,
+			bsCfg.neighborsHandler,
+			buf,
+			scoreboard,
+			requests,
+			headers,
+		),
+	}
+	if cfg.BlockSync.Pipeline {
+		bs.pipeline = newSyncPipeline(buf, cfg.BlockSync.PipelineWorkers, cfg.BlockSync.PipelineMemoryBudget, scoreboard.Invalid)
 	}
 	return bs, nil
 }
@@ -118,17 +197,39 @@ func (bs *blockSyncer) TargetHeight() uint64 {
 func (bs *blockSyncer) Start(ctx context.Context) error {
 	log.L().Debug("Starting block syncer.")
 	bs.commitHeight = bs.buf.CommitHeight()
+	if bs.pipeline != nil {
+		if err := bs.pipeline.Start(ctx); err != nil {
+			return err
+		}
+	}
 	return bs.worker.Start(ctx)
 }
 
 // Stop stops a block syncer
 func (bs *blockSyncer) Stop(ctx context.Context) error {
 	log.L().Debug("Stopping block syncer.")
-	return bs.worker.Stop(ctx)
+	if err := bs.worker.Stop(ctx); err != nil {
+		return err
+	}
+	if bs.pipeline != nil {
+		return bs.pipeline.Stop(ctx)
+	}
+	return nil
 }
 
 // ProcessBlock processes an incoming latest committed block
-func (bs *blockSyncer) ProcessBlock(_ context.Context, blk *block.Block) error {
+func (bs *blockSyncer) ProcessBlock(ctx context.Context, blk *block.Block) error {
+	bs.delivered(ctx, blk)
+	if _, ok := p2p.GetPeer(ctx); ok && blk != nil && bs.pipeline != nil {
+		// a block sent by a peer is a response to a block sync request
+		bs.submit(blk, bs.processBlock)
+		return nil
+	}
+	bs.processBlock(blk)
+	return nil
+}
+
+func (bs *blockSyncer) processBlock(blk *block.Block) {
 	var needSync bool
 	moved, re := bs.buf.Flush(blk)
 	switch re {
@@ -147,19 +248,111 @@ func (bs *blockSyncer) ProcessBlock(_ context.Context, blk *block.Block) error {
 	if needSync {
 		bs.worker.SetTargetHeight(blk.Height())
 	}
+}
+
+func (bs *blockSyncer) ProcessBlockSync(ctx context.Context, blk *block.Block) error {
+	bs.delivered(ctx, blk)
+	if blk != nil && bs.pipeline != nil {
+		bs.submit(blk, bs.processBlockSync)
+		return nil
+	}
+	bs.processBlockSync(blk)
 	return nil
 }
 
-func (bs *blockSyncer) ProcessBlockSync(_ context.Context, blk *block.Block) error {
+func (bs *blockSyncer) processBlockSync(blk *block.Block) {
 	bs.buf.Flush(blk)
-	if bs.bc.TipHeight() == bs.TargetHeight() {
+	bs.moveTargetHeight()
+}
+
+// moveTargetHeight moves the target height forward by the buffer size once it is reached
+func (bs *blockSyncer) moveTargetHeight() {
+	if bs.buf.tipHeight() == bs.TargetHeight() {
 		bs.worker.SetTargetHeight(bs.TargetHeight() + bs.buf.bufSize())
 	}
+}
+
+// ProcessBlockBatch processes a batched block sync response, which is dropped unless the blocks in it have been
+// requested from the peer sending it
+func (bs *blockSyncer) ProcessBlockBatch(ctx context.Context, batch *iotexrpc.BlockBatch) error {
+	peer, ok := p2p.GetPeer(ctx)
+	if !ok || !bs.batchRequests.Pending(peer, batch.HeadersOnly) {
+		log.L().Debug("Drop the block batch not requested.", zap.Bool("headersOnly", batch.HeadersOnly))
+		return nil
+	}
+	blks, err := decodeBlockBatch(batch, bs.batchMaxReceive)
+	if err != nil {
+		return err
+	}
+	if !bs.batchRequests.Solicited(peer, batch.HeadersOnly, blks) {
+		log.L().Debug(
+			"Drop the block batch not requested from the peer.",
+			zap.String("peerID", peer.ID.Pretty()),
+			zap.Bool("headersOnly", batch.HeadersOnly),
+		)
+		return nil
+	}
+	if batch.HeadersOnly && bs.buf.light == nil {
+		bs.processHeaderBatch(blks)
+		return nil
+	}
+	bs.processBlockBatch(ctx, blks)
 	return nil
 }
 
+// processBlockBatch processes the blocks of a batched block sync response
+func (bs *blockSyncer) processBlockBatch(ctx context.Context, blks []*block.Block) {
+	bs.delivered(ctx, blks...)
+	if bs.pipeline != nil {
+		for _, blk := range blks {
+			if blk != nil {
+				bs.submit(blk, bs.processBlockSync)
+			}
+		}
+		return
+	}
+	bs.buf.FlushBatch(blks)
+	bs.moveTargetHeight()
+}
+
+// processHeaderBatch processes the headers of a batched block sync response, which are only requested in pipelined
+// mode
+func (bs *blockSyncer) processHeaderBatch(blks []*block.Block) {
+	if bs.headers == nil {
+		return
+	}
+	if appended := bs.headers.Append(blks); appended > 0 {
+		log.L().Debug("Append synced headers.", zap.Int("appended", appended), zap.Uint64("tip", bs.headers.Tip()))
+	}
+}
+
+// submit puts the block into the pipeline, unless it does not match the known header at its height
+func (bs *blockSyncer) submit(blk *block.Block, done func(*block.Block)) {
+	if bs.headers != nil && !bs.headers.Match(blk) {
+		return
+	}
+	bs.pipeline.Submit(blk, done)
+}
+
+// delivered records the blocks delivered by the peer sending them, if any
+func (bs *blockSyncer) delivered(ctx context.Context, blks ...*block.Block) {
+	peer, ok := p2p.GetPeer(ctx)
+	if !ok {
+		return
+	}
+	for _, blk := range blks {
+		if blk != nil {
+			bs.scoreboard.Delivered(peer, blk)
+		}
+	}
+}
+
 // ProcessSyncRequest processes a block sync request
 func (bs *blockSyncer) ProcessSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync *iotexrpc.BlockSync) error {
+	if bs.bc == nil {
+		// a light node does not have the bodies to serve
+		return nil
+	}
 	end := bs.bc.TipHeight()
 	switch {
 	case sync.End < end:
@@ -173,12 +366,14 @@ func (bs *blockSyncer) ProcessSyncRequest(ctx context.Context, peer peerstore.Pe
 			zap.Uint64("tipHeight", end),
 		)
 	}
+	if capability := sync.GetCapability(); bs.batchMaxBytes > 0 && capability.GetBatchVersion() >= blockBatchVersion {
+		return bs.sendBlockBatches(peer, sync.Start, end, bs.batchCompression && capability.Compression, capability.HeadersOnly)
+	}
 	for i := sync.Start; i <= end; i++ {
 		blk, err := bs.bc.BlockDAO().GetBlockByHeight(i)
 		if err != nil {
 			return err
 		}
-		// TODO: send back multiple blocks in one shot
 		if err := bs.unicastHandler(context.Background(), peer,
 			blk.ConvertToBlockPb(),
 		); err != nil {
@@ -187,3 +382,68 @@ func (bs *blockSyncer) ProcessSyncRequest(ctx context.Context, peer peerstore.Pe
 	}
 	return nil
 }
+
+// sendBlockBatches sends back the blocks or their headers in batches, each of which is bounded by the max bytes unless
+// it only has one block
+func (bs *blockSyncer) sendBlockBatches(
+	peer peerstore.PeerInfo,
+	start uint64,
+	end uint64,
+	compressed bool,
+	headersOnly bool,
+) error {
+	var (
+		blks []*iotextypes.Block
+		size uint64
+	)
+	for i := start; i <= end; i++ {
+		blk, err := bs.bc.BlockDAO().GetBlockByHeight(i)
+		if err != nil {
+			return err
+		}
+		blkPb := blk.ConvertToBlockPb()
+		// the poll results are kept for the light nodes to verify the endorsements of the next epoch
+		if headersOnly && !hasPollResult(blk) {
+			blkPb.Body = &iotextypes.BlockBody{}
+		}
+		blkSize := uint64(proto.Size(blkPb))
+		if len(blks) > 0 && size+blkSize > bs.batchMaxBytes {
+			if err := bs.sendBlockBatch(peer, blks, compressed, headersOnly); err != nil {
+				return err
+			}
+			blks, size = nil, 0
+		}
+		blks = append(blks, blkPb)
+		size += blkSize
+	}
+	if len(blks) == 0 {
+		return nil
+	}
+	return bs.sendBlockBatch(peer, blks, compressed, headersOnly)
+}
+
+// hasPollResult returns whether the block carries the poll result of the next epoch
+func hasPollResult(blk *block.Block) bool {
+	for _, selp := range blk.Actions {
+		if _, ok := selp.Action().(*action.PutPollResult); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (bs *blockSyncer) sendBlockBatch(
+	peer peerstore.PeerInfo,
+	blks []*iotextypes.Block,
+	compressed bool,
+	headersOnly bool,
+) error {
+	batch, err := newBlockBatch(blks, compressed, headersOnly)
+	if err != nil {
+		return err
+	}
+	if err := bs.unicastHandler(context.Background(), peer, batch); err != nil {
+		log.L().Debug("Failed to response to ProcessSyncRequest.", zap.Error(err))
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ package blocksync
 import (
 	"sync"
 
+	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-election/db"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -38,6 +39,9 @@ type blockBuffer struct {
 	bc           blockchain.Blockchain
 	ap           actpool.ActPool
 	cs           consensus.Consensus
+	light        *LightChain
+	validator    FooterValidator
+	invalidBlock func(*block.Block)
 	bufferSize   uint64
 	intervalSize uint64
 	commitHeight uint64 // last commit block height
@@ -55,23 +59,63 @@ func (b *blockBuffer) Flush(blk *block.Block) (bool, bCheckinResult) {
 	if blk == nil {
 		return false, bCheckinSkipNil
 	}
-	confirmedHeight := b.bc.TipHeight()
-	// check
+	confirmedHeight := b.tipHeight()
+	blkHeight := blk.Height()
+	if re := b.checkin(blk, confirmedHeight); re != bCheckinValid {
+		return false, re
+	}
+	l := log.L().With(
+		zap.Uint64("recvHeight", blkHeight),
+		zap.Uint64("confirmedHeight", confirmedHeight),
+		zap.String("source", "blockBuffer"))
+	heightToSync := b.flush(confirmedHeight, l)
+	return heightToSync > blkHeight, bCheckinValid
+}
+
+// FlushBatch puts the blocks of a batched response into buffer at once, and flushes buffer into blockchain. It
+// returns whether the tip has moved.
+func (b *blockBuffer) FlushBatch(blks []*block.Block) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	confirmedHeight := b.tipHeight()
+	var checkedIn int
+	for _, blk := range blks {
+		if blk == nil {
+			continue
+		}
+		if b.checkin(blk, confirmedHeight) == bCheckinValid {
+			checkedIn++
+		}
+	}
+	if checkedIn == 0 {
+		return false
+	}
+	l := log.L().With(
+		zap.Int("recvBlocks", checkedIn),
+		zap.Uint64("confirmedHeight", confirmedHeight),
+		zap.String("source", "blockBuffer"))
+	return b.flush(confirmedHeight, l) > confirmedHeight+1
+}
+
+// checkin puts the block into buffer if it is within the range to accept
+func (b *blockBuffer) checkin(blk *block.Block, confirmedHeight uint64) bCheckinResult {
 	blkHeight := blk.Height()
 	if blkHeight <= confirmedHeight {
-		return false, bCheckinLower
+		return bCheckinLower
 	}
 	if _, ok := b.blocks[blkHeight]; ok {
-		return false, bCheckinExisting
+		return bCheckinExisting
 	}
 	if blkHeight > confirmedHeight+b.bufferSize {
-		return false, bCheckinHigher
+		return bCheckinHigher
 	}
 	b.blocks[blkHeight] = blk
-	l := log.L().With(
-		zap.Uint64("recvHeight", blkHeight),
-		zap.Uint64("confirmedHeight", confirmedHeight),
-		zap.String("source", "blockBuffer"))
+	return bCheckinValid
+}
+
+// flush commits the consecutive blocks in buffer above the confirmed height, and returns the first height not
+// committed
+func (b *blockBuffer) flush(confirmedHeight uint64, l *zap.Logger) uint64 {
 	var heightToSync uint64
 	for heightToSync = confirmedHeight + 1; heightToSync <= confirmedHeight+b.bufferSize; heightToSync++ {
 		blk, ok := b.blocks[heightToSync]
@@ -79,11 +123,14 @@ func (b *blockBuffer) Flush(blk *block.Block) (bool, bCheckinResult) {
 			break
 		}
 		delete(b.blocks, heightToSync)
-		if err := commitBlock(b.bc, b.ap, b.cs, blk); err != nil && errors.Cause(err) != blockchain.ErrInvalidTipHeight {
+		if err := b.commit(blk); err != nil && errors.Cause(err) != blockchain.ErrInvalidTipHeight {
 			if errors.Cause(err) == poll.ErrProposedDelegatesLength || errors.Cause(err) == poll.ErrDelegatesNotAsExpected || errors.Cause(err) == db.ErrNotExist {
 				l.Debug("Failed to commit the block.", zap.Error(err), zap.Uint64("syncHeight", heightToSync))
 			} else {
 				l.Error("Failed to commit the block.", zap.Error(err), zap.Uint64("syncHeight", heightToSync))
+				if _, ok := err.(*invalidBlockError); ok && b.invalidBlock != nil {
+					b.invalidBlock(blk)
+				}
 			}
 			break
 		}
@@ -100,8 +147,7 @@ func (b *blockBuffer) Flush(blk *block.Block) (bool, bCheckinResult) {
 			}
 		}
 	}
-
-	return heightToSync > blkHeight, bCheckinValid
+	return heightToSync
 }
 
 // GetBlocksIntervalsToSync returns groups of syncBlocksInterval are missing upto targetHeight.
@@ -115,7 +161,7 @@ func (b *blockBuffer) GetBlocksIntervalsToSync(targetHeight uint64) []syncBlocks
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 
-	confirmedHeight := b.bc.TipHeight()
+	confirmedHeight := b.tipHeight()
 	// The sync range shouldn't go beyond tip height + buffer size to avoid being too aggressive
 	if targetHeight > confirmedHeight+b.bufferSize {
 		targetHeight = confirmedHeight + b.bufferSize
@@ -154,6 +200,36 @@ func (b *blockBuffer) GetBlocksIntervalsToSync(targetHeight uint64) []syncBlocks
 	return bi
 }
 
+// holds returns whether the block is in buffer
+func (b *blockBuffer) holds(blk *block.Block) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.blocks[blk.Height()] == blk
+}
+
+// tipHeight returns the height of the tip, which is the last verified header in light mode
+func (b *blockBuffer) tipHeight() uint64 {
+	if b.light != nil {
+		return b.light.TipHeight()
+	}
+	return b.bc.TipHeight()
+}
+
+// tipHash returns the hash of the tip, which is the last verified header in light mode
+func (b *blockBuffer) tipHash() hash.Hash256 {
+	if b.light != nil {
+		return b.light.TipHash()
+	}
+	return b.bc.TipHash()
+}
+
+func (b *blockBuffer) commit(blk *block.Block) error {
+	if b.light != nil {
+		return commitLightBlock(b.light, b.validator, blk)
+	}
+	return commitBlock(b.bc, b.ap, b.cs, blk)
+}
+
 // bufSize return the bufferSize of buffer
 func (b *blockBuffer) bufSize() uint64 {
 	return b.bufferSize
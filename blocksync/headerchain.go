@@ -0,0 +1,130 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// headerChain keeps the headers fetched ahead of the tip in pipelined mode, which are linked to the tip by the
+// previous block hashes and signed by the producers. The bodies of the blocks are downloaded after the headers, so
+// that the known heights can be spread over several peers. The headers are not endorsed until the blocks are
+// committed, so a body not matching its header drops the headers from that height, while the body itself is still
+// validated in full on commit.
+type headerChain struct {
+	mu        sync.Mutex
+	buf       *blockBuffer
+	lookahead uint64
+	base      uint64
+	tip       uint64
+	hashes    map[uint64]hash.Hash256
+}
+
+func newHeaderChain(buf *blockBuffer, lookahead uint64) *headerChain {
+	return &headerChain{
+		buf:       buf,
+		lookahead: lookahead,
+		hashes:    make(map[uint64]hash.Hash256),
+	}
+}
+
+// Tip returns the height of the last known header, which is the tip of the chain if no header is known ahead
+func (hc *headerChain) Tip() uint64 {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	hc.prune()
+	return hc.tip
+}
+
+// NextInterval returns the heights of the headers to fetch towards the target height, up to the lookahead
+func (hc *headerChain) NextInterval(targetHeight uint64) (syncBlocksInterval, bool) {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	hc.prune()
+	end := hc.base + hc.lookahead
+	if targetHeight < end {
+		end = targetHeight
+	}
+	if hc.tip >= end {
+		return syncBlocksInterval{}, false
+	}
+	return syncBlocksInterval{Start: hc.tip + 1, End: end}, true
+}
+
+// Append links the headers of the blocks to the last known header, and returns the number of the headers appended
+func (hc *headerChain) Append(blks []*block.Block) int {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	hc.prune()
+	sort.Slice(blks, func(i, j int) bool { return blks[i].Height() < blks[j].Height() })
+	appended := 0
+	for _, blk := range blks {
+		height := blk.Height()
+		if height != hc.tip+1 || height > hc.base+hc.lookahead {
+			continue
+		}
+		prevHash, ok := hc.hashes[hc.tip]
+		if hc.tip == hc.base {
+			prevHash, ok = hc.buf.tipHash(), true
+		}
+		if !ok || blk.PrevHash() != prevHash {
+			log.L().Debug("Drop the header not linked to the known headers.", zap.Uint64("height", height))
+			break
+		}
+		if !blk.VerifySignature() {
+			log.L().Debug("Drop the header with an invalid signature.", zap.Uint64("height", height))
+			break
+		}
+		hc.hashes[height] = blk.HashBlock()
+		hc.tip = height
+		appended++
+	}
+	return appended
+}
+
+// Match returns whether the block matches the known header at its height, if any. The headers from the height of a
+// mismatched block are dropped, since either the header or the body is forged.
+func (hc *headerChain) Match(blk *block.Block) bool {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	height := blk.Height()
+	expected, ok := hc.hashes[height]
+	if !ok || expected == blk.HashBlock() {
+		return true
+	}
+	log.L().Debug("Drop the headers from the height of a mismatched block.", zap.Uint64("height", height))
+	for h := height; h <= hc.tip; h++ {
+		delete(hc.hashes, h)
+	}
+	hc.tip = height - 1
+	return false
+}
+
+// prune forgets the headers at or below the tip of the chain
+func (hc *headerChain) prune() {
+	base := hc.buf.tipHeight()
+	if base <= hc.base {
+		return
+	}
+	// the headers above a committed block other than the known header are not linked to the chain
+	if tipHash, ok := hc.hashes[base]; base >= hc.tip || !ok || tipHash != hc.buf.tipHash() {
+		hc.hashes = make(map[uint64]hash.Hash256)
+		hc.base, hc.tip = base, base
+		return
+	}
+	for h := hc.base + 1; h <= base; h++ {
+		delete(hc.hashes, h)
+	}
+	hc.base = base
+}
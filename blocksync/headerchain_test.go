@@ -0,0 +1,154 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
+	"github.com/iotexproject/iotex-core/test/mock/mock_consensus"
+)
+
+func makeLightTestChain(t *testing.T, prevHash hash.Hash256, start, end uint64) []*block.Block {
+	var blks []*block.Block
+	for h := start; h <= end; h++ {
+		blk := makeLightTestBlock(t, h, prevHash, nil)
+		blks = append(blks, blk)
+		prevHash = blk.HashBlock()
+	}
+	return blks
+}
+
+func TestHeaderChain(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	cfg := config.Default
+	lc, err := NewLightChain(cfg.Genesis, db.NewMemKVStore())
+	require.NoError(err)
+	require.NoError(lc.Start(ctx))
+	validator := footerValidatorFunc(func(*block.Block) error { return nil })
+	hc := newHeaderChain(&blockBuffer{light: lc}, 4)
+
+	blks := makeLightTestChain(t, cfg.Genesis.Hash(), 1, 6)
+	interval, ok := hc.NextInterval(10)
+	require.True(ok)
+	require.Equal(syncBlocksInterval{Start: 1, End: 4}, interval)
+	interval, ok = hc.NextInterval(2)
+	require.True(ok)
+	require.Equal(syncBlocksInterval{Start: 1, End: 2}, interval)
+
+	// the headers not linked to the tip are dropped
+	require.Equal(0, hc.Append(blks[1:3]))
+	require.Equal(0, hc.Append([]*block.Block{makeLightTestBlock(t, 1, hash.ZeroHash256, nil)}))
+	require.Equal(uint64(0), hc.Tip())
+	// the headers are appended up to the lookahead
+	require.Equal(4, hc.Append([]*block.Block{blks[2], blks[0], blks[1], blks[3], blks[4]}))
+	require.Equal(uint64(4), hc.Tip())
+	_, ok = hc.NextInterval(10)
+	require.False(ok)
+
+	// the bodies are matched against the headers
+	require.True(hc.Match(blks[2]))
+	require.True(hc.Match(blks[5]))
+	require.False(hc.Match(makeLightTestBlock(t, 3, hash.ZeroHash256, nil)))
+	require.Equal(uint64(2), hc.Tip())
+
+	// the headers at or below the tip are forgotten
+	require.NoError(commitLightBlock(lc, validator, blks[0]))
+	require.Equal(uint64(2), hc.Tip())
+	interval, ok = hc.NextInterval(10)
+	require.True(ok)
+	require.Equal(syncBlocksInterval{Start: 3, End: 5}, interval)
+	require.Equal(1, len(hc.hashes))
+	require.NoError(commitLightBlock(lc, validator, blks[1]))
+	require.NoError(commitLightBlock(lc, validator, blks[2]))
+	require.Equal(uint64(3), hc.Tip())
+	require.Equal(0, len(hc.hashes))
+	require.Equal(1, hc.Append(blks[3:4]))
+	require.Equal(uint64(4), hc.Tip())
+}
+
+func TestBlockSyncerHeaderFirst(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	cfg := config.Default
+	cfg.BlockSync.Pipeline = true
+	cfg.BlockSync.PipelineHeaderLookahead = 40
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	chain := mock_blockchain.NewMockBlockchain(ctrl)
+	chain.EXPECT().ChainID().Return(cfg.Chain.ID).AnyTimes()
+	chain.EXPECT().TipHeight().Return(uint64(0)).AnyTimes()
+	chain.EXPECT().TipHash().Return(cfg.Genesis.Hash()).AnyTimes()
+	peers := []peerstore.PeerInfo{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+	var (
+		headerRequests []*iotexrpc.BlockSync
+		bodyRequests   = make(map[string][]*iotexrpc.BlockSync)
+	)
+	bs, err := NewBlockSyncer(
+		cfg,
+		chain,
+		nil,
+		mock_consensus.NewMockConsensus(ctrl),
+		WithUnicastOutBound(func(_ context.Context, p peerstore.PeerInfo, msg proto.Message) error {
+			sync := msg.(*iotexrpc.BlockSync)
+			if sync.GetCapability().GetHeadersOnly() {
+				headerRequests = append(headerRequests, sync)
+			} else {
+				bodyRequests[p.ID.Pretty()] = append(bodyRequests[p.ID.Pretty()], sync)
+			}
+			return nil
+		}),
+		WithNeighbors(func(_ context.Context) ([]peerstore.PeerInfo, error) { return peers, nil }),
+	)
+	require.NoError(err)
+	syncer := bs.(*blockSyncer)
+	syncer.worker.SetTargetHeight(100)
+
+	// the headers are requested up to the lookahead, and the bodies are requested as before until any is known
+	syncer.worker.Sync()
+	require.Equal(1, len(headerRequests))
+	require.Equal(uint64(1), headerRequests[0].Start)
+	require.Equal(uint64(40), headerRequests[0].End)
+	require.Equal(len(peers), len(bodyRequests))
+
+	// the bodies of the known headers are requested from different peers
+	blks := makeLightTestChain(t, cfg.Genesis.Hash(), 1, 40)
+	syncer.processHeaderBatch(blks)
+	require.Equal(uint64(40), syncer.headers.Tip())
+	headerRequests, bodyRequests = nil, make(map[string][]*iotexrpc.BlockSync)
+	syncer.worker.Sync()
+	require.Equal(0, len(headerRequests))
+	require.Equal(2, len(bodyRequests))
+	var intervals []syncBlocksInterval
+	for _, p := range peers {
+		reqs := bodyRequests[p.ID.Pretty()]
+		require.True(len(reqs) <= 1)
+		for _, req := range reqs {
+			intervals = append(intervals, syncBlocksInterval{Start: req.Start, End: req.End})
+		}
+	}
+	require.ElementsMatch([]syncBlocksInterval{{Start: 1, End: 20}, {Start: 21, End: 40}}, intervals)
+
+	// a body not matching its header is dropped with the headers from its height
+	require.NoError(bs.ProcessBlockSync(ctx, makeLightTestBlock(t, 1, hash.ZeroHash256, nil)))
+	require.Equal(uint64(0), syncer.pipeline.Used())
+	require.Equal(uint64(0), syncer.headers.Tip())
+	require.NoError(bs.ProcessBlockSync(ctx, blks[0]))
+	require.Equal(uint64(proto.Size(blks[0].ConvertToBlockPb())), syncer.pipeline.Used())
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"sync"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+const (
+	lightHeaderNS     = "lhr"
+	lightFooterNS     = "lfr"
+	lightCandidatesNS = "lcd"
+	lightMetaNS       = "lmt"
+)
+
+var (
+	lightTipHeightKey = []byte("th")
+
+	// ErrNoBlockFetcher is the error that the light chain cannot fetch the bodies of the blocks
+	ErrNoBlockFetcher = errors.New("no block fetcher specified")
+)
+
+type (
+	// BlockFetcher fetches the block of the given height with its receipts from a full node
+	BlockFetcher func(ctx context.Context, height uint64) (*block.Block, error)
+
+	// GenesisCandidates returns the candidates of the first epoch
+	GenesisCandidates func() (state.CandidateList, error)
+
+	// LightChainOption is the option to create a light chain
+	LightChainOption func(*LightChain) error
+
+	// LightChain keeps the verified headers and footers of the blockchain without running a state factory. The
+	// candidates of an epoch are taken from the poll results in the blocks, which are endorsed by the delegates of the
+	// previous epoch, so that the endorsements of every block can be verified against the delegates of its epoch.
+	// The blocks are synced with the headers only, except those carrying poll results, the bodies of which are
+	// verified against the tx roots. A peer leaving out a poll result only stalls the sync at the next epoch, since
+	// the endorsements cannot be verified without the candidates. The other bodies and the receipts are fetched on
+	// demand, and verified against the stored headers.
+	LightChain struct {
+		mu                sync.RWMutex
+		genesis           genesis.Genesis
+		kvStore           db.KVStore
+		fetcher           BlockFetcher
+		genesisCandidates GenesisCandidates
+		tipHeight         uint64
+		tipHash           hash.Hash256
+	}
+)
+
+// WithBlockFetcher is the option to set the function to fetch the bodies and receipts
+func WithBlockFetcher(fetcher BlockFetcher) LightChainOption {
+	return func(lc *LightChain) error {
+		lc.fetcher = fetcher
+		return nil
+	}
+}
+
+// WithGenesisCandidates is the option to set the function to get the candidates of the first epoch
+func WithGenesisCandidates(genesisCandidates GenesisCandidates) LightChainOption {
+	return func(lc *LightChain) error {
+		lc.genesisCandidates = genesisCandidates
+		return nil
+	}
+}
+
+// NewLightChain creates a light chain which stores the headers and footers in the kv store
+func NewLightChain(g genesis.Genesis, kvStore db.KVStore, opts ...LightChainOption) (*LightChain, error) {
+	if kvStore == nil {
+		return nil, errors.New("kv store cannot be nil")
+	}
+	lc := &LightChain{
+		genesis: g,
+		kvStore: kvStore,
+	}
+	for _, opt := range opts {
+		if err := opt(lc); err != nil {
+			return nil, err
+		}
+	}
+	return lc, nil
+}
+
+// Start starts the light chain
+func (lc *LightChain) Start(ctx context.Context) error {
+	if err := lc.kvStore.Start(ctx); err != nil {
+		return errors.Wrap(err, "failed to start light chain db")
+	}
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	value, err := lc.kvStore.Get(lightMetaNS, lightTipHeightKey)
+	switch errors.Cause(err) {
+	case nil:
+		lc.tipHeight = byteutil.BytesToUint64(value)
+	case db.ErrNotExist:
+		lc.tipHeight = 0
+	default:
+		return errors.Wrap(err, "failed to get tip height")
+	}
+	if lc.tipHeight == 0 {
+		lc.tipHash = lc.genesis.Hash()
+		return nil
+	}
+	header, err := lc.blockHeaderByHeight(lc.tipHeight)
+	if err != nil {
+		return err
+	}
+	lc.tipHash = header.HashBlock()
+	return nil
+}
+
+// Stop stops the light chain
+func (lc *LightChain) Stop(ctx context.Context) error {
+	return lc.kvStore.Stop(ctx)
+}
+
+// Genesis returns the genesis
+func (lc *LightChain) Genesis() genesis.Genesis {
+	return lc.genesis
+}
+
+// TipHeight returns the height of the last verified header
+func (lc *LightChain) TipHeight() uint64 {
+	lc.mu.RLock()
+	defer lc.mu.RUnlock()
+	return lc.tipHeight
+}
+
+// TipHash returns the hash of the last verified header
+func (lc *LightChain) TipHash() hash.Hash256 {
+	lc.mu.RLock()
+	defer lc.mu.RUnlock()
+	return lc.tipHash
+}
+
+// BlockHeaderByHeight returns the header of the given height
+func (lc *LightChain) BlockHeaderByHeight(height uint64) (*block.Header, error) {
+	lc.mu.RLock()
+	defer lc.mu.RUnlock()
+	return lc.blockHeaderByHeight(height)
+}
+
+// BlockFooterByHeight returns the footer of the given height
+func (lc *LightChain) BlockFooterByHeight(height uint64) (*block.Footer, error) {
+	value, err := lc.kvStore.Get(lightFooterNS, byteutil.Uint64ToBytes(height))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get footer of height %d", height)
+	}
+	footer := &block.Footer{}
+	if err := footer.Deserialize(value); err != nil {
+		return nil, errors.Wrapf(err, "failed to deserialize footer of height %d", height)
+	}
+	return footer, nil
+}
+
+// CandidatesByHeight returns the candidates put by the poll result of the given height, or the genesis candidates for
+// the first epoch
+func (lc *LightChain) CandidatesByHeight(height uint64) ([]*state.Candidate, error) {
+	value, err := lc.kvStore.Get(lightCandidatesNS, byteutil.Uint64ToBytes(height))
+	if errors.Cause(err) == db.ErrNotExist && height == 1 && lc.genesisCandidates != nil {
+		return lc.genesisCandidates()
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get candidates of height %d", height)
+	}
+	var candidates state.CandidateList
+	if err := candidates.Deserialize(value); err != nil {
+		return nil, errors.Wrapf(err, "failed to deserialize candidates of height %d", height)
+	}
+	return candidates, nil
+}
+
+// ValidateBlock validates the height, the link to the tip, the signature of the block, and the tx root if the body is
+// synced. The endorsements in the footer are validated by the footer validator of the consensus.
+func (lc *LightChain) ValidateBlock(blk *block.Block) error {
+	lc.mu.RLock()
+	defer lc.mu.RUnlock()
+	if len(blk.Actions) == 0 {
+		return blockchain.VerifyHeaderWithoutState(blk, lc.tipHeight, lc.tipHash)
+	}
+	return blockchain.VerifyBlockWithoutState(blk, lc.tipHeight, lc.tipHash)
+}
+
+// AppendBlock stores the header and footer of a validated block as the new tip, together with the candidates of the
+// poll result in it
+func (lc *LightChain) AppendBlock(blk *block.Block) error {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	height := blk.Height()
+	if height != lc.tipHeight+1 {
+		return errors.Wrapf(blockchain.ErrInvalidTipHeight, "wrong block height %d, expecting %d", height, lc.tipHeight+1)
+	}
+	header, err := blk.Header.Serialize()
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize header")
+	}
+	footer, err := blk.Footer.Serialize()
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize footer")
+	}
+	heightKey := byteutil.Uint64ToBytes(height)
+	b := db.NewBatch()
+	b.Put(lightHeaderNS, heightKey, header, "failed to put header of height %d", height)
+	b.Put(lightFooterNS, heightKey, footer, "failed to put footer of height %d", height)
+	for _, selp := range blk.Actions {
+		r, ok := selp.Action().(*action.PutPollResult)
+		if !ok {
+			continue
+		}
+		cl := r.Candidates()
+		candidates, err := cl.Serialize()
+		if err != nil {
+			return errors.Wrap(err, "failed to serialize candidates")
+		}
+		b.Put(lightCandidatesNS, byteutil.Uint64ToBytes(r.Height()), candidates, "failed to put candidates of height %d", r.Height())
+	}
+	b.Put(lightMetaNS, lightTipHeightKey, heightKey, "failed to put tip height")
+	if err := lc.kvStore.WriteBatch(b); err != nil {
+		return err
+	}
+	lc.tipHeight = height
+	lc.tipHash = blk.HashBlock()
+	return nil
+}
+
+// BlockByHeight fetches the block of the given height with its receipts, and verifies them against the stored header
+func (lc *LightChain) BlockByHeight(ctx context.Context, height uint64) (*block.Block, error) {
+	if lc.fetcher == nil {
+		return nil, ErrNoBlockFetcher
+	}
+	header, err := lc.BlockHeaderByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := lc.fetcher(ctx, height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch block of height %d", height)
+	}
+	if blk.HashBlock() != header.HashBlock() {
+		return nil, errors.Wrapf(blockchain.ErrInvalidBlock, "fetched block of height %d does not match the header", height)
+	}
+	if blk.CalculateTxRoot() != header.TxRoot() {
+		return nil, errors.Wrapf(blockchain.ErrInvalidBlock, "fetched body of height %d does not match the tx root", height)
+	}
+	if err := blk.VerifyReceiptRoot(block.CalculateReceiptRoot(blk.Receipts)); err != nil {
+		return nil, errors.Wrapf(blockchain.ErrInvalidBlock, "fetched receipts of height %d: %v", height, err)
+	}
+	return blk, nil
+}
+
+func (lc *LightChain) blockHeaderByHeight(height uint64) (*block.Header, error) {
+	value, err := lc.kvStore.Get(lightHeaderNS, byteutil.Uint64ToBytes(height))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get header of height %d", height)
+	}
+	header := &block.Header{}
+	if err := header.Deserialize(value); err != nil {
+		return nil, errors.Wrapf(err, "failed to deserialize header of height %d", height)
+	}
+	return header, nil
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/p2p"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+type footerValidatorFunc func(*block.Block) error
+
+func (f footerValidatorFunc) ValidateBlockFooter(blk *block.Block) error {
+	return f(blk)
+}
+
+func makeLightTestBlock(
+	t *testing.T,
+	height uint64,
+	prevHash hash.Hash256,
+	receipts []*action.Receipt,
+	acts ...action.SealedEnvelope,
+) *block.Block {
+	blk, err := block.NewBuilder(block.NewRunnableActionsBuilder().AddActions(acts...).Build()).
+		SetHeight(height).
+		SetTimestamp(testutil.TimestampNow()).
+		SetPrevBlockHash(prevHash).
+		SetReceipts(receipts).
+		SetReceiptRoot(block.CalculateReceiptRoot(receipts)).
+		SignAndBuild(identityset.PrivateKey(0))
+	require.NoError(t, err)
+	return &blk
+}
+
+func TestLightChain(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	cfg := config.Default
+	genesisCandidates := state.CandidateList{
+		{Address: identityset.Address(1).String(), Votes: big.NewInt(2), RewardAddress: identityset.Address(1).String()},
+	}
+	nextCandidates := state.CandidateList{
+		{Address: identityset.Address(2).String(), Votes: big.NewInt(3), RewardAddress: identityset.Address(2).String()},
+	}
+	var fetched *block.Block
+	kv := db.NewMemKVStore()
+	lc, err := NewLightChain(
+		cfg.Genesis,
+		kv,
+		WithGenesisCandidates(func() (state.CandidateList, error) {
+			return genesisCandidates, nil
+		}),
+		WithBlockFetcher(func(_ context.Context, height uint64) (*block.Block, error) {
+			return fetched, nil
+		}),
+	)
+	require.NoError(err)
+	require.NoError(lc.Start(ctx))
+	require.Equal(uint64(0), lc.TipHeight())
+	require.Equal(cfg.Genesis.Hash(), lc.TipHash())
+	candidates, err := lc.CandidatesByHeight(1)
+	require.NoError(err)
+	require.Equal(genesisCandidates, state.CandidateList(candidates))
+	_, err = lc.CandidatesByHeight(4)
+	require.Equal(db.ErrNotExist, errors.Cause(err))
+
+	elp := (&action.EnvelopeBuilder{}).SetAction(action.NewPutPollResult(0, 4, nextCandidates)).Build()
+	selp, err := action.Sign(elp, identityset.PrivateKey(0))
+	require.NoError(err)
+	receipts := []*action.Receipt{{Status: uint64(1), BlockHeight: 1, ActionHash: selp.Hash()}}
+	blk := makeLightTestBlock(t, 1, cfg.Genesis.Hash(), receipts, selp)
+
+	// not linked to the tip
+	require.Error(lc.ValidateBlock(makeLightTestBlock(t, 1, hash.ZeroHash256, nil)))
+	require.Equal(
+		blockchain.ErrInvalidTipHeight,
+		errors.Cause(lc.ValidateBlock(makeLightTestBlock(t, 2, cfg.Genesis.Hash(), nil))),
+	)
+	require.NoError(lc.ValidateBlock(blk))
+	// the header synced without the body is validated without the tx root, while a synced body must match it
+	require.NoError(lc.ValidateBlock(&block.Block{Header: blk.Header, Footer: blk.Footer}))
+	elp = (&action.EnvelopeBuilder{}).SetAction(action.NewPutPollResult(0, 4, genesisCandidates)).Build()
+	other, err := action.Sign(elp, identityset.PrivateKey(0))
+	require.NoError(err)
+	tampered := &block.Block{
+		Header: blk.Header,
+		Body:   block.Body{Actions: []action.SealedEnvelope{other}},
+		Footer: blk.Footer,
+	}
+	require.Equal(blockchain.ErrInvalidBlock, errors.Cause(lc.ValidateBlock(tampered)))
+	require.Equal(blockchain.ErrInvalidTipHeight, errors.Cause(lc.AppendBlock(makeLightTestBlock(t, 2, blk.HashBlock(), nil))))
+	require.NoError(lc.AppendBlock(blk))
+	require.Equal(uint64(1), lc.TipHeight())
+	require.Equal(blk.HashBlock(), lc.TipHash())
+	header, err := lc.BlockHeaderByHeight(1)
+	require.NoError(err)
+	require.Equal(blk.HashBlock(), header.HashBlock())
+	footer, err := lc.BlockFooterByHeight(1)
+	require.NoError(err)
+	require.Equal(blk.CommitTime().Unix(), footer.CommitTime().Unix())
+	candidates, err = lc.CandidatesByHeight(4)
+	require.NoError(err)
+	require.Equal(nextCandidates, state.CandidateList(candidates))
+
+	// bodies and receipts are verified against the header
+	fetched = blk
+	b, err := lc.BlockByHeight(ctx, 1)
+	require.NoError(err)
+	require.Equal(receipts, b.Receipts)
+	fetched = makeLightTestBlock(t, 1, cfg.Genesis.Hash(), receipts, selp)
+	fetched.Receipts = nil
+	_, err = lc.BlockByHeight(ctx, 1)
+	require.Equal(blockchain.ErrInvalidBlock, errors.Cause(err))
+	fetched = &block.Block{Header: blk.Header, Footer: blk.Footer, Receipts: receipts}
+	_, err = lc.BlockByHeight(ctx, 1)
+	require.Equal(blockchain.ErrInvalidBlock, errors.Cause(err))
+	_, err = lc.BlockByHeight(ctx, 2)
+	require.Equal(db.ErrNotExist, errors.Cause(err))
+
+	// the tip is restored on restart
+	require.NoError(lc.Stop(ctx))
+	lc, err = NewLightChain(cfg.Genesis, kv)
+	require.NoError(err)
+	require.NoError(lc.Start(ctx))
+	require.Equal(uint64(1), lc.TipHeight())
+	require.Equal(blk.HashBlock(), lc.TipHash())
+	_, err = lc.BlockByHeight(ctx, 1)
+	require.Equal(ErrNoBlockFetcher, err)
+}
+
+func TestLightBlockSyncer(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	cfg := config.Default
+	lc, err := NewLightChain(cfg.Genesis, db.NewMemKVStore())
+	require.NoError(err)
+	require.NoError(lc.Start(ctx))
+	var validated []uint64
+	validator := footerValidatorFunc(func(blk *block.Block) error {
+		validated = append(validated, blk.Height())
+		if blk.Height() == 3 {
+			return errors.New("insufficient endorsements")
+		}
+		return nil
+	})
+	_, err = NewLightBlockSyncer(cfg, nil, validator, opts...)
+	require.Error(err)
+	_, err = NewLightBlockSyncer(cfg, lc, nil, opts...)
+	require.Error(err)
+	bs, err := NewLightBlockSyncer(cfg, lc, validator, opts...)
+	require.NoError(err)
+
+	blk1 := makeLightTestBlock(t, 1, cfg.Genesis.Hash(), nil)
+	blk2 := makeLightTestBlock(t, 2, blk1.HashBlock(), nil)
+	blk3 := makeLightTestBlock(t, 3, blk2.HashBlock(), nil)
+	require.NoError(bs.ProcessBlock(ctx, blk2))
+	require.Equal(uint64(0), lc.TipHeight())
+	require.NoError(bs.ProcessBlockSync(ctx, blk1))
+	require.Equal(uint64(2), lc.TipHeight())
+	// the block with invalid endorsements is not appended
+	require.NoError(bs.ProcessBlock(ctx, blk3))
+	require.Equal(uint64(2), lc.TipHeight())
+	require.Equal([]uint64{1, 2, 3}, validated)
+	// a light node does not serve blocks
+	require.NoError(bs.ProcessSyncRequest(ctx, peerstore.PeerInfo{}, &iotexrpc.BlockSync{Start: 1, End: 2}))
+}
+
+func TestLightBlockSyncerHeadersOnly(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	cfg := config.Default
+	lc, err := NewLightChain(cfg.Genesis, db.NewMemKVStore())
+	require.NoError(err)
+	require.NoError(lc.Start(ctx))
+	validator := footerValidatorFunc(func(*block.Block) error { return nil })
+	peer := peerstore.PeerInfo{ID: "a"}
+	var requests []*iotexrpc.BlockSync
+	bs, err := NewLightBlockSyncer(
+		cfg,
+		lc,
+		validator,
+		WithUnicastOutBound(func(_ context.Context, _ peerstore.PeerInfo, msg proto.Message) error {
+			requests = append(requests, msg.(*iotexrpc.BlockSync))
+			return nil
+		}),
+		WithNeighbors(func(_ context.Context) ([]peerstore.PeerInfo, error) {
+			return []peerstore.PeerInfo{peer}, nil
+		}),
+	)
+	require.NoError(err)
+
+	// the blocks are requested with the headers only
+	syncer := bs.(*blockSyncer)
+	syncer.worker.SetTargetHeight(2)
+	syncer.worker.Sync()
+	require.NotEqual(0, len(requests))
+	for _, req := range requests {
+		require.True(req.GetCapability().GetHeadersOnly())
+	}
+
+	// the headers are appended to the light chain, and the poll results are taken from the bodies kept
+	candidates := state.CandidateList{
+		{Address: identityset.Address(1).String(), Votes: big.NewInt(2), RewardAddress: identityset.Address(1).String()},
+	}
+	elp := (&action.EnvelopeBuilder{}).SetAction(action.NewPutPollResult(0, 4, candidates)).Build()
+	poll, err := action.Sign(elp, identityset.PrivateKey(0))
+	require.NoError(err)
+	tsf, err := action.NewTransfer(1, big.NewInt(1), identityset.Address(2).String(), nil, 10000, big.NewInt(0))
+	require.NoError(err)
+	elp = (&action.EnvelopeBuilder{}).SetNonce(1).SetGasLimit(10000).SetAction(tsf).Build()
+	transfer, err := action.Sign(elp, identityset.PrivateKey(1))
+	require.NoError(err)
+	blk1 := makeLightTestBlock(t, 1, cfg.Genesis.Hash(), nil, poll)
+	blk2 := makeLightTestBlock(t, 2, blk1.HashBlock(), nil, transfer)
+	header2 := blk2.ConvertToBlockPb()
+	header2.Body = &iotextypes.BlockBody{}
+	batch, err := newBlockBatch([]*iotextypes.Block{blk1.ConvertToBlockPb(), header2}, true, true)
+	require.NoError(err)
+	require.NoError(bs.ProcessBlockBatch(p2p.WithPeer(ctx, peer), batch))
+	require.Equal(uint64(2), lc.TipHeight())
+	require.Equal(blk2.HashBlock(), lc.TipHash())
+	synced, err := lc.CandidatesByHeight(4)
+	require.NoError(err)
+	require.Equal(candidates, state.CandidateList(synced))
+}
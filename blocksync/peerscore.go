@@ -0,0 +1,317 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+const (
+	// invalidBlockPenalty is the score deducted for each invalid block served
+	invalidBlockPenalty = 20
+	// latencyDecay is the weight of the latest sample in the moving average of latency
+	latencyDecay = 0.2
+)
+
+// the metrics are not labeled by the peers, which come and go without bound, and the scores of the peers are served by
+// PeerScoresHandler instead
+var (
+	peerCountMtc = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "iotex_blocksync_peer_count",
+			Help: "Number of the peers scored for block sync requests.",
+		},
+		[]string{"status"},
+	)
+	peerEventMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_blocksync_peer_event",
+			Help: "Counter of the events of the peers serving block sync requests.",
+		},
+		[]string{"event"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(peerCountMtc)
+	prometheus.MustRegister(peerEventMtc)
+}
+
+type (
+	// PeerScore is the snapshot of the statistics of a peer serving block sync requests
+	PeerScore struct {
+		Peer        string        `json:"peer"`
+		Score       float64       `json:"score"`
+		Requested   uint64        `json:"requested"`
+		Delivered   uint64        `json:"delivered"`
+		Invalid     uint64        `json:"invalid"`
+		Timeouts    uint64        `json:"timeouts"`
+		Latency     time.Duration `json:"latency"`
+		BannedUntil time.Time     `json:"bannedUntil,omitempty"`
+	}
+
+	peerStats struct {
+		requested   uint64
+		delivered   uint64
+		invalid     uint64
+		timeouts    uint64
+		latency     time.Duration
+		bannedUntil time.Time
+		// pending keeps the time when each requested height is sent
+		pending map[uint64]time.Time
+	}
+
+	blockSource struct {
+		peer   string
+		height uint64
+	}
+
+	// peerScoreboard tracks how well the peers serve block sync requests. A peer is scored by the ratio of the
+	// delivered heights to the requested ones, less the penalty of invalid blocks and the latency in seconds, so that
+	// a new peer starts with the full score. A peer serving a block failing the validation is banned for a while. The
+	// statistics of a peer are dropped once it is no longer a neighbor, unless it is still banned.
+	peerScoreboard struct {
+		mu             sync.Mutex
+		peers          map[string]*peerStats
+		sources        map[hash.Hash256]blockSource
+		requestTimeout time.Duration
+		banDuration    time.Duration
+		now            func() time.Time
+	}
+)
+
+func newPeerScoreboard(requestTimeout, banDuration time.Duration) *peerScoreboard {
+	return &peerScoreboard{
+		peers:          make(map[string]*peerStats),
+		sources:        make(map[hash.Hash256]blockSource),
+		requestTimeout: requestTimeout,
+		banDuration:    banDuration,
+		now:            time.Now,
+	}
+}
+
+// Rank returns the peers not banned, ordered by their scores from high to low, and drops the statistics of the peers
+// not in the list
+func (sb *peerScoreboard) Rank(peers []peerstore.PeerInfo) []peerstore.PeerInfo {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	now := sb.now()
+	ranked := make([]peerstore.PeerInfo, 0, len(peers))
+	scores := make(map[string]float64, len(peers))
+	for _, p := range peers {
+		id := p.ID.Pretty()
+		stats := sb.stats(p)
+		if stats.banned(now) {
+			continue
+		}
+		scores[id] = stats.score()
+		ranked = append(ranked, p)
+	}
+	sb.prune(peers, now)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i].ID.Pretty()] > scores[ranked[j].ID.Pretty()]
+	})
+	return ranked
+}
+
+// Requested records the heights of a block sync request sent to the peer
+func (sb *peerScoreboard) Requested(p peerstore.PeerInfo, interval syncBlocksInterval) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	now := sb.now()
+	stats := sb.stats(p)
+	for h := interval.Start; h <= interval.End; h++ {
+		if _, ok := stats.pending[h]; ok {
+			continue
+		}
+		stats.pending[h] = now
+		stats.requested++
+	}
+}
+
+// Delivered records a block delivered by the peer, which counts only if it has been requested from the peer
+func (sb *peerScoreboard) Delivered(p peerstore.PeerInfo, blk *block.Block) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	id := p.ID.Pretty()
+	stats, ok := sb.peers[id]
+	if !ok {
+		return
+	}
+	height := blk.Height()
+	sent, ok := stats.pending[height]
+	if !ok {
+		return
+	}
+	delete(stats.pending, height)
+	stats.delivered++
+	latency := sb.now().Sub(sent)
+	if stats.latency == 0 {
+		stats.latency = latency
+	} else {
+		stats.latency = time.Duration(latencyDecay*float64(latency) + (1-latencyDecay)*float64(stats.latency))
+	}
+	sb.sources[blk.HashBlock()] = blockSource{peer: id, height: height}
+	peerEventMtc.WithLabelValues("delivered").Inc()
+}
+
+// Invalid bans the peer which has delivered the block failing the validation
+func (sb *peerScoreboard) Invalid(blk *block.Block) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	blkHash := blk.HashBlock()
+	source, ok := sb.sources[blkHash]
+	if !ok {
+		return
+	}
+	delete(sb.sources, blkHash)
+	stats, ok := sb.peers[source.peer]
+	if !ok {
+		return
+	}
+	stats.invalid++
+	stats.bannedUntil = sb.now().Add(sb.banDuration)
+	log.L().Warn("Ban the peer serving an invalid block.",
+		zap.String("peer", source.peer),
+		zap.Uint64("height", source.height),
+		zap.Time("bannedUntil", stats.bannedUntil))
+	peerEventMtc.WithLabelValues("invalid").Inc()
+}
+
+// Expire counts the timeouts of the requested heights not delivered in time, and forgets the sources of the blocks
+// at or below the tip height
+func (sb *peerScoreboard) Expire(tipHeight uint64) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	now := sb.now()
+	for _, stats := range sb.peers {
+		var timeouts uint64
+		for h, sent := range stats.pending {
+			if h <= tipHeight {
+				// delivered by others in time
+				delete(stats.pending, h)
+				continue
+			}
+			if now.Sub(sent) > sb.requestTimeout {
+				delete(stats.pending, h)
+				timeouts++
+			}
+		}
+		if timeouts == 0 {
+			continue
+		}
+		stats.timeouts += timeouts
+		peerEventMtc.WithLabelValues("timeout").Add(float64(timeouts))
+	}
+	for h, source := range sb.sources {
+		if source.height <= tipHeight {
+			delete(sb.sources, h)
+		}
+	}
+}
+
+// Scores returns the snapshot of the scoreboard ordered by the scores from high to low
+func (sb *peerScoreboard) Scores() []PeerScore {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	scores := make([]PeerScore, 0, len(sb.peers))
+	for id, stats := range sb.peers {
+		score := PeerScore{
+			Peer:      id,
+			Score:     stats.score(),
+			Requested: stats.requested,
+			Delivered: stats.delivered,
+			Invalid:   stats.invalid,
+			Timeouts:  stats.timeouts,
+			Latency:   stats.latency,
+		}
+		if stats.banned(sb.now()) {
+			score.BannedUntil = stats.bannedUntil
+		}
+		scores = append(scores, score)
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].Peer < scores[j].Peer
+	})
+	return scores
+}
+
+func (sb *peerScoreboard) stats(p peerstore.PeerInfo) *peerStats {
+	id := p.ID.Pretty()
+	stats, ok := sb.peers[id]
+	if !ok {
+		stats = &peerStats{pending: make(map[uint64]time.Time)}
+		sb.peers[id] = stats
+	}
+	return stats
+}
+
+// prune drops the statistics of the peers gone, except the banned ones, which would otherwise escape the ban by
+// reconnecting
+func (sb *peerScoreboard) prune(peers []peerstore.PeerInfo, now time.Time) {
+	neighbors := make(map[string]struct{}, len(peers))
+	for _, p := range peers {
+		neighbors[p.ID.Pretty()] = struct{}{}
+	}
+	var banned int
+	for id, stats := range sb.peers {
+		if stats.banned(now) {
+			banned++
+			continue
+		}
+		if _, ok := neighbors[id]; !ok {
+			delete(sb.peers, id)
+		}
+	}
+	peerCountMtc.WithLabelValues("active").Set(float64(len(sb.peers) - banned))
+	peerCountMtc.WithLabelValues("banned").Set(float64(banned))
+}
+
+func (s *peerStats) score() float64 {
+	score := 100 * float64(s.delivered+1) / float64(s.requested+1)
+	score -= float64(s.invalid * invalidBlockPenalty)
+	score -= s.latency.Seconds()
+	return score
+}
+
+func (s *peerStats) banned(now time.Time) bool {
+	return now.Before(s.bannedUntil)
+}
+
+// PeerScores returns the scoreboard of the peers serving block sync requests, or nil if the block syncer does not
+// score the peers
+func PeerScores(bs BlockSync) []PeerScore {
+	if s, ok := bs.(*blockSyncer); ok {
+		return s.scoreboard.Scores()
+	}
+	return nil
+}
+
+// PeerScoresHandler serves the scoreboard of the peers serving block sync requests in json
+func PeerScoresHandler(bs BlockSync) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(PeerScores(bs)); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/p2p"
+)
+
+func TestPeerScoreboard(t *testing.T) {
+	require := require.New(t)
+	now := time.Unix(1000, 0)
+	sb := newPeerScoreboard(10*time.Second, time.Minute)
+	sb.now = func() time.Time { return now }
+
+	good := peerstore.PeerInfo{ID: "good"}
+	slow := peerstore.PeerInfo{ID: "slow"}
+	bad := peerstore.PeerInfo{ID: "bad"}
+	peers := []peerstore.PeerInfo{bad, slow, good}
+	// new peers start with the same score
+	require.Equal(peers, sb.Rank(peers))
+
+	interval := syncBlocksInterval{Start: 1, End: 2}
+	for _, p := range peers {
+		sb.Requested(p, interval)
+	}
+	blk1 := makeLightTestBlock(t, 1, hash.ZeroHash256, nil)
+	blk2 := makeLightTestBlock(t, 2, blk1.HashBlock(), nil)
+	invalidBlk := makeLightTestBlock(t, 1, hash.Hash256b([]byte("fork")), nil)
+	now = now.Add(time.Second)
+	sb.Delivered(good, blk1)
+	sb.Delivered(good, blk2)
+	sb.Delivered(bad, invalidBlk)
+	now = now.Add(4 * time.Second)
+	sb.Delivered(slow, blk1)
+	// unsolicited blocks do not count
+	sb.Delivered(slow, blk1)
+	sb.Delivered(peerstore.PeerInfo{ID: "unknown"}, blk2)
+	// the slow peer is penalized by the latency
+	require.Equal([]peerstore.PeerInfo{good, bad, slow}, sb.Rank(peers))
+
+	// the pending height of the slow peer times out
+	now = now.Add(10 * time.Second)
+	sb.Expire(0)
+	// the peer serving an invalid block is banned
+	sb.Invalid(invalidBlk)
+	// a block not delivered by any peer is ignored
+	sb.Invalid(makeLightTestBlock(t, 2, hash.ZeroHash256, nil))
+	require.Equal([]peerstore.PeerInfo{good, slow}, sb.Rank(peers))
+	scores := sb.Scores()
+	require.Equal(3, len(scores))
+	require.Equal(PeerScore{
+		Peer:      good.ID.Pretty(),
+		Score:     100 - 1,
+		Requested: 2,
+		Delivered: 2,
+		Latency:   time.Second,
+	}, scores[0])
+	require.Equal(slow.ID.Pretty(), scores[1].Peer)
+	require.Equal(uint64(1), scores[1].Timeouts)
+	require.Equal(5*time.Second, scores[1].Latency)
+	require.Equal(bad.ID.Pretty(), scores[2].Peer)
+	require.Equal(uint64(1), scores[2].Invalid)
+	require.Equal(now.Add(time.Minute), scores[2].BannedUntil)
+
+	// the ban is lifted after a while
+	now = now.Add(time.Minute)
+	require.Equal(3, len(sb.Rank(peers)))
+	require.True(sb.Scores()[2].BannedUntil.IsZero())
+
+	// pending heights below the tip are delivered by others
+	sb.Requested(bad, syncBlocksInterval{Start: 3, End: 4})
+	sb.Expire(4)
+	now = now.Add(time.Minute)
+	sb.Expire(4)
+	require.Equal(uint64(1), sb.Scores()[2].Timeouts)
+	require.Equal(0, len(sb.sources))
+
+	// the peers gone are dropped, unless banned
+	sb.Requested(bad, syncBlocksInterval{Start: 5, End: 5})
+	blk5 := makeLightTestBlock(t, 5, hash.ZeroHash256, nil)
+	sb.Delivered(bad, blk5)
+	sb.Invalid(blk5)
+	require.Equal([]peerstore.PeerInfo{good}, sb.Rank([]peerstore.PeerInfo{good}))
+	scores = sb.Scores()
+	require.Equal(2, len(scores))
+	require.Equal(good.ID.Pretty(), scores[0].Peer)
+	require.Equal(bad.ID.Pretty(), scores[1].Peer)
+	now = now.Add(time.Minute)
+	require.Equal([]peerstore.PeerInfo{good}, sb.Rank([]peerstore.PeerInfo{good}))
+	require.Equal(1, len(sb.Scores()))
+}
+
+func TestBlockSyncerPeerScore(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	cfg := config.Default
+	lc, err := NewLightChain(cfg.Genesis, db.NewMemKVStore())
+	require.NoError(err)
+	require.NoError(lc.Start(ctx))
+	validator := footerValidatorFunc(func(*block.Block) error { return nil })
+	good := peerstore.PeerInfo{ID: "good"}
+	bad := peerstore.PeerInfo{ID: "bad"}
+	requests := make(map[string][]*iotexrpc.BlockSync)
+	bs, err := NewLightBlockSyncer(
+		cfg,
+		lc,
+		validator,
+		WithUnicastOutBound(func(_ context.Context, p peerstore.PeerInfo, msg proto.Message) error {
+			requests[p.ID.Pretty()] = append(requests[p.ID.Pretty()], msg.(*iotexrpc.BlockSync))
+			return nil
+		}),
+		WithNeighbors(func(_ context.Context) ([]peerstore.PeerInfo, error) {
+			return []peerstore.PeerInfo{bad, good}, nil
+		}),
+	)
+	require.NoError(err)
+	worker := bs.(*blockSyncer).worker
+
+	// both peers are requested for the first interval
+	worker.Sync()
+	require.Equal(cfg.BlockSync.IntervalSize, requests[good.ID.Pretty()][0].End)
+	require.Equal(cfg.BlockSync.IntervalSize, requests[bad.ID.Pretty()][0].End)
+
+	// the peer serving a block not linked to the tip is banned
+	blk1 := makeLightTestBlock(t, 1, cfg.Genesis.Hash(), nil)
+	require.NoError(bs.ProcessBlockSync(p2p.WithPeer(ctx, bad), makeLightTestBlock(t, 1, hash.ZeroHash256, nil)))
+	require.Equal(uint64(0), lc.TipHeight())
+	require.NoError(bs.ProcessBlockSync(p2p.WithPeer(ctx, good), blk1))
+	require.Equal(uint64(1), lc.TipHeight())
+	scores := PeerScores(bs)
+	require.Equal(2, len(scores))
+	require.Equal(good.ID.Pretty(), scores[0].Peer)
+	require.Equal(uint64(1), scores[0].Delivered)
+	require.Equal(uint64(1), scores[1].Invalid)
+	require.False(scores[1].BannedUntil.IsZero())
+
+	requests = make(map[string][]*iotexrpc.BlockSync)
+	worker.Sync()
+	require.Equal(0, len(requests[bad.ID.Pretty()]))
+	require.NotEqual(0, len(requests[good.ID.Pretty()]))
+
+	// the scoreboard is served in json
+	w := httptest.NewRecorder()
+	PeerScoresHandler(bs).ServeHTTP(w, httptest.NewRequest("GET", "/blocksync/peers", nil))
+	var served []PeerScore
+	require.NoError(json.Unmarshal(w.Body.Bytes(), &served))
+	require.Equal(2, len(served))
+	require.Equal(good.ID.Pretty(), served[0].Peer)
+}
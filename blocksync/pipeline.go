@@ -0,0 +1,193 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+var pipelineMemoryMtc = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "iotex_blocksync_pipeline_memory",
+		Help: "Size of the synced blocks being verified or buffered in pipelined mode.",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(pipelineMemoryMtc)
+}
+
+type (
+	pipelineTask struct {
+		blk  *block.Block
+		size uint64
+		done func(*block.Block)
+	}
+
+	// syncPipeline verifies the signatures and the roots of the synced blocks in parallel, before they are put into
+	// the buffer, where the state transitions and the commits are serialized. The size of the blocks being verified or
+	// buffered is bounded by the memory budget, and the blocks beyond the budget are dropped to be synced again later.
+	syncPipeline struct {
+		mu           sync.Mutex
+		buf          *blockBuffer
+		workers      int
+		budget       uint64
+		used         uint64
+		held         map[uint64]*pipelineTask
+		tasks        chan *pipelineTask
+		invalidBlock func(*block.Block)
+		wg           sync.WaitGroup
+		quit         chan struct{}
+	}
+)
+
+func newSyncPipeline(
+	buf *blockBuffer,
+	workers int,
+	budget uint64,
+	invalidBlock func(*block.Block),
+) *syncPipeline {
+	return &syncPipeline{
+		buf:          buf,
+		workers:      workers,
+		budget:       budget,
+		held:         make(map[uint64]*pipelineTask),
+		tasks:        make(chan *pipelineTask, 2*buf.bufferSize),
+		invalidBlock: invalidBlock,
+		quit:         make(chan struct{}),
+	}
+}
+
+// Start starts the verifying goroutines
+func (p *syncPipeline) Start(_ context.Context) error {
+	for i := 0; i < p.workers; i++ {
+		p.wg.Add(1)
+		go p.run()
+	}
+	return nil
+}
+
+// Stop stops the verifying goroutines
+func (p *syncPipeline) Stop(_ context.Context) error {
+	close(p.quit)
+	p.wg.Wait()
+	return nil
+}
+
+// Submit puts the block into the pipeline, and the done callback is called after the block is verified. It returns
+// false if the block is dropped because of the memory budget or the pipeline is full.
+func (p *syncPipeline) Submit(blk *block.Block, done func(*block.Block)) bool {
+	task := &pipelineTask{
+		blk:  blk,
+		size: uint64(proto.Size(blk.ConvertToBlockPb())),
+		done: done,
+	}
+	p.mu.Lock()
+	if p.used+task.size > p.budget {
+		p.sweep()
+	}
+	// the block next to the tip is always accepted, otherwise the buffered blocks could use up the budget forever
+	if p.used+task.size > p.budget && blk.Height() != p.buf.tipHeight()+1 {
+		p.mu.Unlock()
+		log.L().Debug("Drop the synced block beyond the memory budget.",
+			zap.Uint64("height", blk.Height()),
+			zap.Uint64("used", p.used),
+			zap.Uint64("budget", p.budget))
+		return false
+	}
+	p.used += task.size
+	pipelineMemoryMtc.Set(float64(p.used))
+	p.mu.Unlock()
+
+	select {
+	case p.tasks <- task:
+		return true
+	default:
+		p.mu.Lock()
+		p.release(task)
+		p.mu.Unlock()
+		log.L().Debug("Drop the synced block since the pipeline is full.", zap.Uint64("height", blk.Height()))
+		return false
+	}
+}
+
+// Used returns the size of the blocks being verified or buffered
+func (p *syncPipeline) Used() uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.sweep()
+	return p.used
+}
+
+func (p *syncPipeline) run() {
+	defer p.wg.Done()
+	for {
+		select {
+		case <-p.quit:
+			return
+		case task := <-p.tasks:
+			p.verify(task)
+		}
+	}
+}
+
+func (p *syncPipeline) verify(task *pipelineTask) {
+	if err := p.verifyBlock(task.blk); err != nil {
+		log.L().Warn("Failed to verify the synced block.", zap.Uint64("height", task.blk.Height()), zap.Error(err))
+		if p.invalidBlock != nil {
+			p.invalidBlock(task.blk)
+		}
+		p.mu.Lock()
+		p.release(task)
+		p.mu.Unlock()
+		return
+	}
+	task.done(task.blk)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	height := task.blk.Height()
+	if _, ok := p.held[height]; !ok && p.buf.holds(task.blk) {
+		p.held[height] = task
+	} else {
+		p.release(task)
+	}
+	p.sweep()
+}
+
+// verifyBlock verifies the signatures and the roots of the block, or only the signature of the header if the body is
+// not synced in light mode
+func (p *syncPipeline) verifyBlock(blk *block.Block) error {
+	if p.buf.light != nil && len(blk.Actions) == 0 {
+		return blockchain.VerifyHeaderSignature(blk)
+	}
+	return blockchain.VerifyBlockSignatures(blk)
+}
+
+// sweep releases the blocks which have left the buffer
+func (p *syncPipeline) sweep() {
+	for height, task := range p.held {
+		if !p.buf.holds(task.blk) {
+			delete(p.held, height)
+			p.release(task)
+		}
+	}
+}
+
+func (p *syncPipeline) release(task *pipelineTask) {
+	p.used -= task.size
+	pipelineMemoryMtc.Set(float64(p.used))
+}
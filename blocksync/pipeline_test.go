@@ -0,0 +1,96 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocksync
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/p2p"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestSyncPipeline(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	cfg := config.Default
+	cfg.BlockSync.Interval = 0
+	lc, err := NewLightChain(cfg.Genesis, db.NewMemKVStore())
+	require.NoError(err)
+	require.NoError(lc.Start(ctx))
+	validator := footerValidatorFunc(func(*block.Block) error { return nil })
+
+	blk1 := makeLightTestBlock(t, 1, cfg.Genesis.Hash(), nil)
+	blk2 := makeLightTestBlock(t, 2, blk1.HashBlock(), nil)
+	blk3 := makeLightTestBlock(t, 3, blk2.HashBlock(), nil)
+	blk4 := makeLightTestBlock(t, 4, blk3.HashBlock(), nil)
+	size := func(blk *block.Block) uint64 {
+		return uint64(proto.Size(blk.ConvertToBlockPb()))
+	}
+	cfg.BlockSync.Pipeline = true
+	cfg.BlockSync.PipelineWorkers = 2
+	cfg.BlockSync.PipelineMemoryBudget = size(blk2) + size(blk3) + size(blk4)/2
+	bs, err := NewLightBlockSyncer(cfg, lc, validator, opts...)
+	require.NoError(err)
+	pipeline := bs.(*blockSyncer).pipeline
+
+	// the blocks beyond the memory budget are dropped before verification
+	require.NoError(bs.ProcessBlockSync(ctx, blk3))
+	bs.(*blockSyncer).processBlockBatch(ctx, []*block.Block{blk2, blk4})
+	require.Equal(size(blk2)+size(blk3), pipeline.Used())
+	require.NoError(bs.Start(ctx))
+	defer func() {
+		require.NoError(bs.Stop(ctx))
+	}()
+	// the verified blocks wait in buffer for the missing one
+	require.NoError(testutil.WaitUntil(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+		return bs.(*blockSyncer).buf.holds(blk2) && bs.(*blockSyncer).buf.holds(blk3), nil
+	}))
+	require.Equal(uint64(0), lc.TipHeight())
+	require.Equal(size(blk2)+size(blk3), pipeline.Used())
+
+	// the block next to the tip is accepted beyond the budget
+	require.NoError(bs.ProcessBlock(p2p.WithPeer(ctx, peerstore.PeerInfo{ID: "peer"}), blk1))
+	require.NoError(testutil.WaitUntil(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+		return lc.TipHeight() == 3, nil
+	}))
+	require.Equal(uint64(0), pipeline.Used())
+	require.NoError(bs.ProcessBlockSync(ctx, blk4))
+	require.NoError(testutil.WaitUntil(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+		return lc.TipHeight() == 4, nil
+	}))
+
+	// the block with a tampered action signature is dropped, and the peer serving it is banned
+	tsf, err := testutil.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), 1, big.NewInt(20), []byte{}, 100000, big.NewInt(10))
+	require.NoError(err)
+	actPb := tsf.Proto()
+	actPb.Signature[10]++
+	var tampered action.SealedEnvelope
+	require.NoError(tampered.LoadProto(actPb))
+	bad := peerstore.PeerInfo{ID: "bad"}
+	bs.(*blockSyncer).scoreboard.Requested(bad, syncBlocksInterval{Start: 5, End: 5})
+	blk5 := makeLightTestBlock(t, 5, blk4.HashBlock(), nil, tampered)
+	require.NoError(bs.ProcessBlockSync(p2p.WithPeer(ctx, bad), blk5))
+	require.NoError(testutil.WaitUntil(10*time.Millisecond, 2*time.Second, func() (bool, error) {
+		scores := PeerScores(bs)
+		return len(scores) == 1 && scores[0].Invalid == 1, nil
+	}))
+	require.False(bs.(*blockSyncer).buf.holds(blk5))
+	require.Equal(uint64(4), lc.TipHeight())
+	require.Equal(uint64(0), pipeline.Used())
+}
@@ -13,12 +13,22 @@ import (
 	"github.com/iotexproject/iotex-core/consensus"
 )
 
+// invalidBlockError is the error that the block fails the validation, which means the peer serving it is faulty
+type invalidBlockError struct {
+	error
+}
+
+// Cause returns the validation error
+func (e *invalidBlockError) Cause() error {
+	return e.error
+}
+
 func commitBlock(bc blockchain.Blockchain, ap actpool.ActPool, cs consensus.Consensus, blk *block.Block) error {
 	if err := cs.ValidateBlockFooter(blk); err != nil {
 		return err
 	}
 	if err := bc.ValidateBlock(blk); err != nil {
-		return err
+		return &invalidBlockError{err}
 	}
 	if err := bc.CommitBlock(blk); err != nil {
 		return err
@@ -28,3 +38,13 @@ func commitBlock(bc blockchain.Blockchain, ap actpool.ActPool, cs consensus.Cons
 	ap.Reset()
 	return nil
 }
+
+func commitLightBlock(lc *LightChain, validator FooterValidator, blk *block.Block) error {
+	if err := validator.ValidateBlockFooter(blk); err != nil {
+		return err
+	}
+	if err := lc.ValidateBlock(blk); err != nil {
+		return &invalidBlockError{err}
+	}
+	return lc.AppendBlock(blk)
+}
@@ -8,7 +8,6 @@ package blocksync
 
 import (
 	"context"
-	"math/rand"
 	"sync"
 
 	"go.uber.org/zap"
@@ -34,6 +33,12 @@ type syncWorker struct {
 	task             *routine.RecurringTask
 	maxRepeat        int
 	repeatDecayStep  int
+	batchCapable     bool
+	batchCompression bool
+	light            bool
+	scoreboard       *peerScoreboard
+	batchRequests    *batchRequests
+	headers          *headerChain
 }
 
 func newSyncWorker(
@@ -42,6 +47,9 @@ func newSyncWorker(
 	unicastHandler UnicastOutbound,
 	neighborsHandler Neighbors,
 	buf *blockBuffer,
+	scoreboard *peerScoreboard,
+	batchRequests *batchRequests,
+	headers *headerChain,
 ) *syncWorker {
 	w := &syncWorker{
 		chainID:          chainID,
@@ -51,6 +59,12 @@ func newSyncWorker(
 		targetHeight:     0,
 		maxRepeat:        cfg.BlockSync.MaxRepeat,
 		repeatDecayStep:  cfg.BlockSync.RepeatDecayStep,
+		batchCapable:     cfg.BlockSync.BatchMaxBytes > 0,
+		batchCompression: cfg.BlockSync.BatchCompression,
+		light:            buf != nil && buf.light != nil,
+		scoreboard:       scoreboard,
+		batchRequests:    batchRequests,
+		headers:          headers,
 	}
 	if cfg.BlockSync.Interval != 0 {
 		w.task = routine.NewRecurringTask(w.Sync, cfg.BlockSync.Interval)
@@ -95,26 +109,73 @@ func (w *syncWorker) Sync() {
 		log.L().Warn("Error when get neighbor peers.", zap.Error(err))
 		return
 	}
-	intervals := w.buf.GetBlocksIntervalsToSync(w.targetHeight)
+	w.scoreboard.Expire(w.buf.tipHeight())
+	if peers = w.scoreboard.Rank(peers); len(peers) == 0 {
+		log.L().Warn("All peers are banned from block sync.")
+		return
+	}
+	targetHeight := w.targetHeight
+	headerFirst := false
+	if w.headers != nil && w.batchCapable {
+		if interval, ok := w.headers.NextInterval(w.targetHeight); ok {
+			w.batchRequests.Sent(peers[0], interval, true)
+			if err := w.unicastHandler(ctx, peers[0], w.headerRequest(interval)); err != nil {
+				log.L().Debug("Failed to sync headers.", zap.Error(err))
+			}
+		}
+		// the bodies are only requested for the known headers, unless no header is known ahead of the tip, e.g., the
+		// peers do not serve headers only
+		if tip := w.headers.Tip(); tip > w.buf.tipHeight() {
+			targetHeight, headerFirst = tip, true
+		}
+	}
+	intervals := w.buf.GetBlocksIntervalsToSync(targetHeight)
 	if intervals != nil {
 		log.L().Info("block sync intervals.",
 			zap.Any("intervals", intervals),
-			zap.Uint64("targetHeight", w.targetHeight))
+			zap.Uint64("targetHeight", targetHeight))
 	}
 
+	// the peers are ranked by scores, so that the lowest intervals, which block the commit and are repeated the most,
+	// go to the best peers first, and the repeats of an interval go to different peers
 	for i, interval := range intervals {
 		repeat := w.maxRepeat - i/w.repeatDecayStep
-		if repeat <= 0 {
+		if repeat <= 0 || headerFirst {
+			// the bodies of the known headers are downloaded from different peers concurrently, and a body not
+			// matching its header is requested again in the next round
 			repeat = 1
 		}
+		if repeat > len(peers) {
+			repeat = len(peers)
+		}
 		for j := 0; j < repeat; j++ {
-			rrIdx := rand.Intn(len(peers))
-			p := peers[rrIdx]
-			if err := w.unicastHandler(ctx, p, &iotexrpc.BlockSync{
-				Start: interval.Start, End: interval.End,
-			}); err != nil {
+			p := peers[(i+j)%len(peers)]
+			w.scoreboard.Requested(p, interval)
+			if w.batchCapable {
+				w.batchRequests.Sent(p, interval, w.light)
+			}
+			if err := w.unicastHandler(ctx, p, w.syncRequest(interval)); err != nil {
 				log.L().Debug("Failed to sync block.", zap.Error(err))
 			}
 		}
 	}
 }
+
+// syncRequest creates a block sync request of the interval, which advertises the capability of batched responses if
+// enabled, and asks for the headers only in light mode
+func (w *syncWorker) syncRequest(interval syncBlocksInterval) *iotexrpc.BlockSync {
+	return w.request(interval, w.light)
+}
+
+// headerRequest creates a request of the headers of the interval, which is only served in batches
+func (w *syncWorker) headerRequest(interval syncBlocksInterval) *iotexrpc.BlockSync {
+	return w.request(interval, true)
+}
+
+func (w *syncWorker) request(interval syncBlocksInterval, headersOnly bool) *iotexrpc.BlockSync {
+	sync := &iotexrpc.BlockSync{Start: interval.Start, End: interval.End}
+	if !w.batchCapable {
+		return sync
+	}
+	return withSyncCapability(sync, w.batchCompression, headersOnly)
+}
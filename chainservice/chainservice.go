@@ -23,9 +23,12 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
 	"github.com/iotexproject/iotex-core/action/protocol/execution"
+	"github.com/iotexproject/iotex-core/action/protocol/multisig"
 	"github.com/iotexproject/iotex-core/action/protocol/poll"
 	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/action/protocol/schedule"
+	"github.com/iotexproject/iotex-core/action/protocol/staking"
 	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/api"
 	"github.com/iotexproject/iotex-core/blockchain"
@@ -39,6 +42,7 @@ import (
 	"github.com/iotexproject/iotex-core/dispatcher"
 	"github.com/iotexproject/iotex-core/p2p"
 	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/snapshot"
 	"github.com/iotexproject/iotex-election/committee"
 )
 
@@ -53,6 +57,13 @@ type ChainService struct {
 	api          *api.Server
 	indexBuilder *blockdao.IndexBuilder
 	registry     *protocol.Registry
+	// snapshotCfg is the config to import the snapshot with, if the chain is bootstrapped from a snapshot
+	snapshotCfg *config.Config
+	chainID     uint32
+	// lightChain and blockFetcher are only set in light mode, in which there is no blockchain, action pool, consensus
+	// or API server
+	lightChain   *blocksync.LightChain
+	blockFetcher *apiBlockFetcher
 }
 
 type optionParams struct {
@@ -133,13 +144,23 @@ func New(
 			}
 		}
 	}
+	if cfg.BlockSync.Light {
+		var kvstore db.KVStore
+		if ops.isTesting {
+			kvstore = db.NewMemKVStore()
+		} else {
+			cfg.DB.DbPath = cfg.BlockSync.LightDBPath
+			kvstore = db.NewKVStore(cfg.DB)
+		}
+		return newLightChainService(cfg, p2pAgent, electionCommittee, kvstore)
+	}
 	// create indexer
 	var indexer blockindex.Indexer
 	_, gateway := cfg.Plugins[config.GatewayPlugin]
 	if gateway {
 		var err error
 		cfg.DB.DbPath = cfg.Chain.IndexDBPath
-		indexer, err = blockindex.NewIndexer(db.NewBoltDB(cfg.DB), cfg.Genesis.Hash())
+		indexer, err = blockindex.NewIndexer(db.NewKVStore(cfg.DB), cfg.Genesis.Hash())
 		if err != nil {
 			return nil, err
 		}
@@ -150,7 +171,7 @@ func New(
 		kvstore = db.NewMemKVStore()
 	} else {
 		cfg.DB.DbPath = cfg.Chain.ChainDBPath
-		kvstore = db.NewBoltDB(cfg.DB)
+		kvstore = db.NewKVStore(cfg.DB)
 	}
 	var dao blockdao.BlockDAO
 	if gateway && !cfg.Chain.EnableAsyncIndexWrite {
@@ -185,6 +206,11 @@ func New(
 		cfg.Genesis.NumSubEpochs,
 		rolldpos.EnableDardanellesSubEpoch(cfg.Genesis.DardanellesBlockHeight, cfg.Genesis.DardanellesNumSubEpochs),
 	)
+	if cfg.Snapshot.ExportDir != "" {
+		if err := chain.AddSubscriber(snapshot.NewExporter(cfg.Snapshot, chain, indexer, rDPoSProtocol)); err != nil {
+			log.L().Warn("Failed to add subscriber: snapshot exporter.", zap.Error(err))
+		}
+	}
 	pollProtocol, err := poll.NewProtocol(
 		cfg,
 		func(contract string, height uint64, ts time.Time, params []byte) ([]byte, error) {
@@ -214,6 +240,9 @@ func New(
 			}
 			return header.Timestamp(), nil
 		},
+		func() (protocol.StateManager, error) {
+			return chain.Factory().NewWorkingSet()
+		},
 		rDPoSProtocol,
 	)
 	if err != nil {
@@ -263,14 +292,28 @@ func New(
 	if err != nil {
 		return nil, err
 	}
+	multisigProtocol := multisig.NewProtocol(rewarding.DepositGas)
+	multisigPolicy := protocol.WithMultisigPolicy(func(addr string) (*action.MultisigPolicy, error) {
+		return multisigProtocol.Policy(chain.Factory(), addr)
+	})
+	chainID := protocol.WithChainID(chain.ChainID())
+	// only the actpool checks the recipients of the actions encoded in RLP against the tip, as those of the actions in a
+	// block are checked against the states the actions run on
+	recipientCheck := protocol.WithRecipientCheck(func(addr string) (bool, error) {
+		account, err := chain.Factory().AccountState(addr)
+		if err != nil {
+			return false, err
+		}
+		return account.IsContract(), nil
+	})
 	// Add action validators
 	actPool.
 		AddActionEnvelopeValidators(
-			protocol.NewGenericValidator(chain.Factory().Nonce),
+			protocol.NewGenericValidator(chain.Factory().Nonce, multisigPolicy, chainID, recipientCheck),
 		)
 	chain.Validator().
 		AddActionEnvelopeValidators(
-			protocol.NewGenericValidator(chain.Factory().Nonce),
+			protocol.NewGenericValidator(chain.Factory().Nonce, multisigPolicy, chainID),
 		)
 	if !ops.isSubchain {
 		chain.Validator().
@@ -283,7 +326,14 @@ func New(
 	rewardingProtocol := rewarding.NewProtocol(func(epochNum uint64) (uint64, map[string]uint64, error) {
 		return blockchain.ProductivityByEpoch(chain, epochNum)
 	}, rDPoSProtocol)
+	stakingProtocol := staking.NewProtocol(rewarding.DepositGas)
+	scheduleProtocol := schedule.NewProtocol(
+		rewarding.DepositGas,
+		chain.BlockDAO().GetBlockHash,
+		func() (schedule.StateReader, error) { return chain.Factory(), nil },
+	)
 	cs := &ChainService{
+		chainID:           chain.ChainID(),
 		actpool:           actPool,
 		chain:             chain,
 		blocksync:         bs,
@@ -293,8 +343,11 @@ func New(
 		api:               apiSvr,
 		registry:          registry,
 	}
+	if cfg.Snapshot.ImportPath != "" && !ops.isTesting {
+		cs.snapshotCfg = &cfg
+	}
 	// Install protocols
-	if err := cs.registerDefaultProtocols(accountProtocol, rDPoSProtocol, pollProtocol, executionProtocol, rewardingProtocol); err != nil {
+	if err := cs.registerDefaultProtocols(accountProtocol, rDPoSProtocol, pollProtocol, executionProtocol, rewardingProtocol, stakingProtocol, multisigProtocol, scheduleProtocol); err != nil {
 		return nil, err
 	}
 	return cs, nil
@@ -307,11 +360,36 @@ func (cs *ChainService) Start(ctx context.Context) error {
 			return errors.Wrap(err, "error when starting election committee")
 		}
 	}
-	if err := cs.chain.Start(ctx); err != nil {
-		return errors.Wrap(err, "error when starting blockchain")
+	if cs.snapshotCfg != nil {
+		if err := snapshot.Import(ctx, *cs.snapshotCfg, cs.snapshotCfg.Snapshot.ImportPath); err != nil {
+			return errors.Wrap(err, "error when importing snapshot")
+		}
+	}
+	if cs.lightChain != nil {
+		if err := cs.lightChain.Start(ctx); err != nil {
+			return errors.Wrap(err, "error when starting light chain")
+		}
+	}
+	if cs.blockFetcher != nil {
+		if err := cs.blockFetcher.Start(ctx); err != nil {
+			return errors.Wrap(err, "error when starting block fetcher")
+		}
 	}
-	if err := cs.consensus.Start(ctx); err != nil {
-		return errors.Wrap(err, "error when starting consensus")
+	if cs.chain != nil {
+		if err := cs.chain.Start(ctx); err != nil {
+			return errors.Wrap(err, "error when starting blockchain")
+		}
+	}
+	if cs.actpool != nil {
+		apCtx := protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{Registry: cs.registry})
+		if err := cs.actpool.Start(apCtx); err != nil {
+			return errors.Wrap(err, "error when starting actpool")
+		}
+	}
+	if cs.consensus != nil {
+		if err := cs.consensus.Start(ctx); err != nil {
+			return errors.Wrap(err, "error when starting consensus")
+		}
 	}
 	if cs.indexBuilder != nil {
 		if err := cs.indexBuilder.Start(ctx); err != nil {
@@ -344,20 +422,43 @@ func (cs *ChainService) Stop(ctx context.Context) error {
 			return errors.Wrap(err, "error when stopping API server")
 		}
 	}
-	if err := cs.consensus.Stop(ctx); err != nil {
-		return errors.Wrap(err, "error when stopping consensus")
+	if cs.consensus != nil {
+		if err := cs.consensus.Stop(ctx); err != nil {
+			return errors.Wrap(err, "error when stopping consensus")
+		}
 	}
 	if err := cs.blocksync.Stop(ctx); err != nil {
 		return errors.Wrap(err, "error when stopping blocksync")
 	}
-	if err := cs.chain.Stop(ctx); err != nil {
-		return errors.Wrap(err, "error when stopping blockchain")
+	if cs.actpool != nil {
+		if err := cs.actpool.Stop(ctx); err != nil {
+			return errors.Wrap(err, "error when stopping actpool")
+		}
+	}
+	if cs.chain != nil {
+		if err := cs.chain.Stop(ctx); err != nil {
+			return errors.Wrap(err, "error when stopping blockchain")
+		}
+	}
+	if cs.blockFetcher != nil {
+		if err := cs.blockFetcher.Stop(ctx); err != nil {
+			return errors.Wrap(err, "error when stopping block fetcher")
+		}
+	}
+	if cs.lightChain != nil {
+		if err := cs.lightChain.Stop(ctx); err != nil {
+			return errors.Wrap(err, "error when stopping light chain")
+		}
 	}
 	return nil
 }
 
 // HandleAction handles incoming action request.
 func (cs *ChainService) HandleAction(ctx context.Context, actPb *iotextypes.Action) error {
+	if cs.actpool == nil {
+		// a light node does not keep pending actions
+		return nil
+	}
 	var act action.SealedEnvelope
 	if err := act.LoadProto(actPb); err != nil {
 		return err
@@ -388,6 +489,11 @@ func (cs *ChainService) HandleBlockSync(ctx context.Context, pbBlock *iotextypes
 	return cs.blocksync.ProcessBlockSync(ctx, blk)
 }
 
+// HandleBlockBatch handles incoming batched block sync response.
+func (cs *ChainService) HandleBlockBatch(ctx context.Context, batch *iotexrpc.BlockBatch) error {
+	return cs.blocksync.ProcessBlockBatch(ctx, batch)
+}
+
 // HandleSyncRequest handles incoming sync request.
 func (cs *ChainService) HandleSyncRequest(ctx context.Context, peer peerstore.PeerInfo, sync *iotexrpc.BlockSync) error {
 	return cs.blocksync.ProcessSyncRequest(ctx, peer, sync)
@@ -395,11 +501,14 @@ func (cs *ChainService) HandleSyncRequest(ctx context.Context, peer peerstore.Pe
 
 // HandleConsensusMsg handles incoming consensus message.
 func (cs *ChainService) HandleConsensusMsg(msg *iotextypes.ConsensusMessage) error {
+	if cs.consensus == nil {
+		return nil
+	}
 	return cs.consensus.HandleConsensusMsg(msg)
 }
 
 // ChainID returns ChainID.
-func (cs *ChainService) ChainID() uint32 { return cs.chain.ChainID() }
+func (cs *ChainService) ChainID() uint32 { return cs.chainID }
 
 // Blockchain returns the Blockchain
 func (cs *ChainService) Blockchain() blockchain.Blockchain {
@@ -421,6 +530,11 @@ func (cs *ChainService) Consensus() consensus.Consensus {
 	return cs.consensus
 }
 
+// LightChain returns the light chain, which is nil unless in light mode
+func (cs *ChainService) LightChain() *blocksync.LightChain {
+	return cs.lightChain
+}
+
 // BlockSync returns the block syncer
 func (cs *ChainService) BlockSync() blocksync.BlockSync {
 	return cs.blocksync
@@ -439,7 +553,7 @@ func (cs *ChainService) registerProtocol(p protocol.Protocol) error {
 func (cs *ChainService) Registry() *protocol.Registry { return cs.registry }
 
 // registerDefaultProtocols registers default protocol into chainservice's registry
-func (cs *ChainService) registerDefaultProtocols(accountProtocol *account.Protocol, rDPoSProtocol *rolldpos.Protocol, pollProtocol poll.Protocol, executionProtocol *execution.Protocol, rewardingProtocol *rewarding.Protocol) (err error) {
+func (cs *ChainService) registerDefaultProtocols(accountProtocol *account.Protocol, rDPoSProtocol *rolldpos.Protocol, pollProtocol poll.Protocol, executionProtocol *execution.Protocol, rewardingProtocol *rewarding.Protocol, stakingProtocol *staking.Protocol, multisigProtocol *multisig.Protocol, scheduleProtocol *schedule.Protocol) (err error) {
 	if err = cs.registerProtocol(accountProtocol); err != nil {
 		return
 	}
@@ -454,6 +568,17 @@ func (cs *ChainService) registerDefaultProtocols(accountProtocol *account.Protoc
 	if err = cs.registerProtocol(executionProtocol); err != nil {
 		return
 	}
+	if err = cs.registerProtocol(rewardingProtocol); err != nil {
+		return
+	}
+
+	if err = cs.registerProtocol(stakingProtocol); err != nil {
+		return
+	}
+
+	if err = cs.registerProtocol(multisigProtocol); err != nil {
+		return
+	}
 
-	return cs.registerProtocol(rewardingProtocol)
+	return cs.registerProtocol(scheduleProtocol)
 }
@@ -0,0 +1,174 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package chainservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/iotex-election/committee"
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/poll"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blocksync"
+	"github.com/iotexproject/iotex-core/config"
+	rolldposscheme "github.com/iotexproject/iotex-core/consensus/scheme/rolldpos"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/p2p"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// newLightChainService creates a chain service in light mode, which follows the verified headers and footers without
+// a state factory, an action pool, a consensus or an API server
+func newLightChainService(
+	cfg config.Config,
+	p2pAgent *p2p.Agent,
+	electionCommittee committee.Committee,
+	kvStore db.KVStore,
+) (*ChainService, error) {
+	rDPoSProtocol := rolldpos.NewProtocol(
+		cfg.Genesis.NumCandidateDelegates,
+		cfg.Genesis.NumDelegates,
+		cfg.Genesis.NumSubEpochs,
+		rolldpos.EnableDardanellesSubEpoch(cfg.Genesis.DardanellesBlockHeight, cfg.Genesis.DardanellesNumSubEpochs),
+	)
+	var pollProtocol poll.Protocol
+	lcOpts := []blocksync.LightChainOption{
+		blocksync.WithGenesisCandidates(func() (state.CandidateList, error) {
+			return poll.GenesisCandidates(pollProtocol)
+		}),
+	}
+	var fetcher *apiBlockFetcher
+	if cfg.BlockSync.LightAPIEndpoint != "" {
+		fetcher = &apiBlockFetcher{endpoint: cfg.BlockSync.LightAPIEndpoint}
+		lcOpts = append(lcOpts, blocksync.WithBlockFetcher(fetcher.Fetch))
+	}
+	lc, err := blocksync.NewLightChain(cfg.Genesis, kvStore, lcOpts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create light chain")
+	}
+	pollProtocol, err = poll.NewProtocol(
+		cfg,
+		func(string, uint64, time.Time, []byte) ([]byte, error) {
+			return nil, errors.New("reading contract is not supported in light mode")
+		},
+		lc.CandidatesByHeight,
+		electionCommittee,
+		func(height uint64) (time.Time, error) {
+			header, err := lc.BlockHeaderByHeight(height)
+			if err != nil {
+				return time.Now(), errors.Wrapf(
+					err, "error when getting the block at height: %d",
+					height,
+				)
+			}
+			return header.Timestamp(), nil
+		},
+		func() (protocol.StateManager, error) {
+			return nil, errors.New("reading states is not supported in light mode")
+		},
+		rDPoSProtocol,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate poll protocol")
+	}
+	if pollProtocol == nil {
+		return nil, errors.New("light mode requires the poll protocol")
+	}
+	validator, err := rolldposscheme.NewFooterValidator(cfg, lc, rDPoSProtocol, pollProtocol.CandidatesByHeight)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create footer validator")
+	}
+	bs, err := blocksync.NewLightBlockSyncer(
+		cfg,
+		lc,
+		validator,
+		blocksync.WithUnicastOutBound(func(ctx context.Context, peer peerstore.PeerInfo, msg proto.Message) error {
+			ctx = p2p.WitContext(ctx, p2p.Context{ChainID: cfg.Chain.ID})
+			return p2pAgent.UnicastOutbound(ctx, peer, msg)
+		}),
+		blocksync.WithNeighbors(p2pAgent.Neighbors),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create blockSyncer")
+	}
+	cs := &ChainService{
+		chainID:           cfg.Chain.ID,
+		blocksync:         bs,
+		electionCommittee: electionCommittee,
+		lightChain:        lc,
+		blockFetcher:      fetcher,
+		registry:          protocol.NewRegistry(),
+	}
+	if err := cs.registerProtocol(rDPoSProtocol); err != nil {
+		return nil, err
+	}
+	if err := cs.registerProtocol(pollProtocol); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// apiBlockFetcher fetches the blocks with receipts from the API of a full node. The connection needs no security,
+// since the blocks are verified against the headers on the light chain.
+type apiBlockFetcher struct {
+	endpoint string
+	conn     *grpc.ClientConn
+	client   iotexapi.APIServiceClient
+}
+
+func (f *apiBlockFetcher) Start(_ context.Context) error {
+	conn, err := grpc.Dial(f.endpoint, grpc.WithInsecure())
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to %s", f.endpoint)
+	}
+	f.conn = conn
+	f.client = iotexapi.NewAPIServiceClient(conn)
+	return nil
+}
+
+func (f *apiBlockFetcher) Stop(_ context.Context) error {
+	if f.conn == nil {
+		return nil
+	}
+	return f.conn.Close()
+}
+
+func (f *apiBlockFetcher) Fetch(ctx context.Context, height uint64) (*block.Block, error) {
+	if f.client == nil {
+		return nil, errors.New("block fetcher is not started")
+	}
+	res, err := f.client.GetRawBlocks(ctx, &iotexapi.GetRawBlocksRequest{
+		StartHeight:  height,
+		Count:        1,
+		WithReceipts: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Blocks) != 1 {
+		return nil, errors.Errorf("expect 1 block, got %d", len(res.Blocks))
+	}
+	blk := &block.Block{}
+	if err := blk.ConvertFromBlockPb(res.Blocks[0].Block); err != nil {
+		return nil, err
+	}
+	for _, receiptPb := range res.Blocks[0].Receipts {
+		receipt := &action.Receipt{}
+		receipt.ConvertFromReceiptPb(receiptPb)
+		blk.Receipts = append(blk.Receipts, receipt)
+	}
+	return blk, nil
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/iotexproject/go-p2p"
 	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-election/committee"
 	"github.com/pkg/errors"
 	uconfig "go.uber.org/config"
@@ -54,6 +55,13 @@ const (
 	NOOPScheme = "NOOP"
 )
 
+const (
+	// BoltDBBackend is the KV store backend based on bolt DB
+	BoltDBBackend = "boltdb"
+	// LevelDBBackend is the KV store backend based on LevelDB
+	LevelDBBackend = "leveldb"
+)
+
 const (
 	// GatewayPlugin is the plugin of accepting user API requests and serving blockchain data to users
 	GatewayPlugin = iota
@@ -106,7 +114,7 @@ var (
 			Address:         "",
 			ProducerPrivKey: PrivateKey.HexString(),
 			EmptyGenesis:    false,
-			GravityChainDB:  DB{DbPath: "./poll.db", NumRetries: 10},
+			GravityChainDB:  DB{DbPath: "./poll.db", BackendByPath: map[string]string{}, NumRetries: 10},
 			Committee: committee.Config{
 				GravityChainAPIs: []string{},
 			},
@@ -119,12 +127,16 @@ var (
 			EnableHistoryStateDB:          false,
 		},
 		ActPool: ActPool{
-			MaxNumActsPerPool:  32000,
-			MaxGasLimitPerPool: 320000000,
-			MaxNumActsPerAcct:  2000,
-			ActionExpiry:       10 * time.Minute,
-			MinGasPriceStr:     big.NewInt(unit.Qev).String(),
-			BlackList:          []string{},
+			MaxNumActsPerPool:      32000,
+			MaxGasLimitPerPool:     320000000,
+			MaxNumActsPerAcct:      2000,
+			ActionExpiry:           10 * time.Minute,
+			MinGasPriceStr:         big.NewInt(unit.Qev).String(),
+			BlackList:              []string{},
+			ReplacementPriceBump:   10,
+			AccountSlots:           16,
+			JournalPath:            "",
+			JournalCompactInterval: time.Hour,
 		},
 		Consensus: Consensus{
 			Scheme: StandaloneScheme,
@@ -144,11 +156,19 @@ var (
 			},
 		},
 		BlockSync: BlockSync{
-			Interval:        10 * time.Second,
-			BufferSize:      200,
-			IntervalSize:    20,
-			MaxRepeat:       3,
-			RepeatDecayStep: 1,
+			Interval:                10 * time.Second,
+			BufferSize:              200,
+			IntervalSize:            20,
+			MaxRepeat:               3,
+			RepeatDecayStep:         1,
+			LightDBPath:             "./light.db",
+			BatchMaxBytes:           4 * 1024 * 1024,
+			BatchMaxReceiveBytes:    32 * 1024 * 1024,
+			PeerRequestTimeout:      30 * time.Second,
+			PeerBanDuration:         10 * time.Minute,
+			PipelineWorkers:         4,
+			PipelineMemoryBudget:    256 * 1024 * 1024,
+			PipelineHeaderLookahead: 1000,
 		},
 		Dispatcher: Dispatcher{
 			EventChanSize: 10000,
@@ -158,9 +178,13 @@ var (
 			Port:      14014,
 			TpsWindow: 10,
 			GasStation: GasStation{
-				SuggestBlockWindow: 20,
-				DefaultGas:         uint64(unit.Qev),
-				Percentile:         60,
+				SuggestBlockWindow:  20,
+				DefaultGas:          uint64(unit.Qev),
+				Percentile:          60,
+				SlowPercentile:      30,
+				FastPercentile:      90,
+				CongestionThreshold: 50,
+				FeeHistoryMaxCount:  1024,
 			},
 			RangeQueryLimit: 1000,
 		},
@@ -172,14 +196,25 @@ var (
 			StartSubChainInterval: 10 * time.Second,
 		},
 		DB: DB{
-			NumRetries:   3,
-			MaxCacheSize: 64,
+			Backend:       BoltDBBackend,
+			BackendByPath: map[string]string{},
+			NumRetries:    3,
+			MaxCacheSize:  64,
 			SQLITE3: SQLITE3{
 				SQLite3File: "./explorer.db",
 			},
 			SplitDBSizeMB:         0,
 			SplitDBHeight:         900000,
 			HistoryStateRetention: 2000,
+			TriePruneRetention:    0,
+			TriePruneInterval:     1000,
+			TriePruneBatchSize:    10000,
+		},
+		Snapshot: Snapshot{
+			ExportDir:           "",
+			ExportEpochInterval: 24,
+			ChunkSize:           4 * 1024 * 1024,
+			ImportPath:          "",
 		},
 		Genesis: genesis.Default,
 	}
@@ -193,6 +228,9 @@ var (
 		ValidateDispatcher,
 		ValidateAPI,
 		ValidateActPool,
+		ValidateDB,
+		ValidateSnapshot,
+		ValidateBlockSync,
 	}
 
 	// PrivateKey is a randomly generated producer's key for testing purpose
@@ -228,6 +266,8 @@ type (
 		GravityChainDB  DB               `yaml:"gravityChainDB"`
 		Committee       committee.Config `yaml:"committee"`
 
+		// EnableTrielessStateDB stores the states without a trie, which can neither serve state proofs nor produce and
+		// validate the blocks from the Hawaii height on, as they commit to the state root in the header
 		EnableTrielessStateDB bool `yaml:"enableTrielessStateDB"`
 		EnableHistoryStateDB  bool `yaml:"enableHistoryStateDB"`
 		// EnableAsyncIndexWrite enables writing the block actions' and receipts' index asynchronously
@@ -258,6 +298,35 @@ type (
 		MaxRepeat int `yaml:"maxRepeat"`
 		// RepeatDecayStep is the step for repeat number decreasing by 1
 		RepeatDecayStep int `yaml:"repeatDecayStep"`
+		// Light enables the light mode, which only keeps the verified headers and footers without a state factory
+		Light bool `yaml:"light"`
+		// LightDBPath is the path of the db to store the headers and footers in light mode
+		LightDBPath string `yaml:"lightDBPath"`
+		// LightAPIEndpoint is the API endpoint of a full node to fetch the bodies and receipts from on demand
+		LightAPIEndpoint string `yaml:"lightAPIEndpoint"`
+		// BatchMaxBytes is the maximal size of the blocks packed in a batched block sync response, and 0 disables the
+		// batched responses
+		BatchMaxBytes uint64 `yaml:"batchMaxBytes"`
+		// BatchMaxReceiveBytes is the maximal size of the blocks in a batched block sync response received, once
+		// decompressed, over which the response is rejected
+		BatchMaxReceiveBytes uint64 `yaml:"batchMaxReceiveBytes"`
+		// BatchCompression enables the compression of the batched block sync responses
+		BatchCompression bool `yaml:"batchCompression"`
+		// PeerRequestTimeout is the time for a peer to respond to a block sync request before a timeout is counted
+		PeerRequestTimeout time.Duration `yaml:"peerRequestTimeout"`
+		// PeerBanDuration is the duration for which a peer serving invalid blocks is excluded from block sync
+		PeerBanDuration time.Duration `yaml:"peerBanDuration"`
+		// Pipeline enables the pipelined mode, which fetches the headers ahead of the tip, downloads the bodies of the
+		// known headers from several peers concurrently, and verifies the signatures and the roots of the synced blocks
+		// in parallel ahead of the commit
+		Pipeline bool `yaml:"pipeline"`
+		// PipelineWorkers is the number of the goroutines verifying the synced blocks in pipelined mode
+		PipelineWorkers int `yaml:"pipelineWorkers"`
+		// PipelineMemoryBudget is the maximal size of the synced blocks being verified or buffered in pipelined mode
+		PipelineMemoryBudget uint64 `yaml:"pipelineMemoryBudget"`
+		// PipelineHeaderLookahead is the number of the headers fetched ahead of the tip in pipelined mode, which are
+		// only requested with batched responses enabled. The headers are not fetched ahead if it is zero.
+		PipelineHeaderLookahead uint64 `yaml:"pipelineHeaderLookahead"`
 	}
 
 	// RollDPoS is the config struct for RollDPoS consensus package
@@ -287,8 +356,12 @@ type (
 
 	// API is the api service config
 	API struct {
-		UseRDS          bool       `yaml:"useRDS"`
-		Port            int        `yaml:"port"`
+		UseRDS bool `yaml:"useRDS"`
+		Port   int  `yaml:"port"`
+		// Web3Port is the port of the Ethereum-compatible JSON-RPC endpoint (HTTP and WebSocket), which accepts the
+		// transactions signed by web3 wallets, and the other signed actions by iotex_sendRawAction. It is 0 by default,
+		// meaning the endpoint has been disabled
+		Web3Port        int        `yaml:"web3Port"`
 		TpsWindow       int        `yaml:"tpsWindow"`
 		GasStation      GasStation `yaml:"gasStation"`
 		RangeQueryLimit uint64     `yaml:"rangeQueryLimit"`
@@ -298,7 +371,17 @@ type (
 	GasStation struct {
 		SuggestBlockWindow int    `yaml:"suggestBlockWindow"`
 		DefaultGas         uint64 `yaml:"defaultGas"`
-		Percentile         int    `yaml:"Percentile"`
+		// Percentile is the percentile of the standard gas price among the smallest gas prices of the recent blocks
+		Percentile int `yaml:"Percentile"`
+		// SlowPercentile and FastPercentile are the percentiles of the slow and fast gas prices, which are clamped to
+		// no more and no less than the standard one respectively
+		SlowPercentile int `yaml:"slowPercentile"`
+		FastPercentile int `yaml:"fastPercentile"`
+		// CongestionThreshold is the percentage of the actpool gas capacity, beyond which the standard and fast gas
+		// prices are raised by the exceeding percentage
+		CongestionThreshold uint64 `yaml:"congestionThreshold"`
+		// FeeHistoryMaxCount is the max number of blocks a fee history query returns
+		FeeHistoryMaxCount uint64 `yaml:"feeHistoryMaxCount"`
 	}
 
 	// System is the system config
@@ -327,11 +410,26 @@ type (
 		MinGasPriceStr string `yaml:"minGasPrice"`
 		// BlackList lists the account address that are banned from initiating actions
 		BlackList []string `yaml:"blackList"`
+		// ReplacementPriceBump is the percentage by which the gas price of an action must exceed the one of the pending
+		// action with the same sender and nonce to replace it
+		ReplacementPriceBump uint64 `yaml:"replacementPriceBump"`
+		// AccountSlots is the number of actions an account is guaranteed to keep when the pool is full. The actions of
+		// the accounts beyond their slots are evicted first, and an account holding as many actions as the slots cannot
+		// evict the actions of others. 0 disables the limit
+		AccountSlots uint64 `yaml:"accountSlots"`
+		// JournalPath is the file persisting the actions in pool across restarts. Empty means disabled
+		JournalPath string `yaml:"journalPath"`
+		// JournalCompactInterval is the interval to rewrite the journal with the actions currently in pool
+		JournalCompactInterval time.Duration `yaml:"journalCompactInterval"`
 	}
 
 	// DB is the config for database
 	DB struct {
 		DbPath string `yaml:"dbPath"`
+		// Backend is the default backend of the KV stores, either boltdb or leveldb
+		Backend string `yaml:"backend"`
+		// BackendByPath overrides the backend of the KV store at a given path
+		BackendByPath map[string]string `yaml:"backendByPath"`
 		// NumRetries is the number of retries
 		NumRetries uint8 `yaml:"numRetries"`
 		// MaxCacheSize is the max number of blocks that will be put into an LRU cache. 0 means disabled
@@ -346,6 +444,13 @@ type (
 		SplitDBHeight uint64 `yaml:"splitDBHeight"`
 		// HistoryStateRetention is the number of blocks account/contract state will be retained
 		HistoryStateRetention uint64 `yaml:"historyStateRetention"`
+		// TriePruneRetention is the number of latest roots of the state trie whose nodes are kept by the trie pruner.
+		// It is 0 by default, meaning the trie pruner has been disabled
+		TriePruneRetention uint64 `yaml:"triePruneRetention"`
+		// TriePruneInterval is the number of blocks between two rounds of trie pruning
+		TriePruneInterval uint64 `yaml:"triePruneInterval"`
+		// TriePruneBatchSize is the number of keys scanned in one step of trie pruning
+		TriePruneBatchSize int `yaml:"triePruneBatchSize"`
 	}
 
 	// RDS is the cloud rds config
@@ -368,6 +473,23 @@ type (
 		SQLite3File string `yaml:"sqlite3File"`
 	}
 
+	// Snapshot is the config for exporting and importing state snapshots
+	Snapshot struct {
+		// ExportDir is the directory where snapshots are written to. It is empty by default, meaning snapshot export
+		// has been disabled
+		ExportDir string `yaml:"exportDir"`
+		// ExportEpochInterval is the number of epochs between two snapshots, taken at the last block of an epoch
+		ExportEpochInterval uint64 `yaml:"exportEpochInterval"`
+		// ChunkSize is the size in bytes a chunk of state entries is closed at
+		ChunkSize uint64 `yaml:"chunkSize"`
+		// ImportPath is the snapshot file to bootstrap an empty node from
+		ImportPath string `yaml:"importPath"`
+		// ImportBlockHash and ImportRootHash are the hex encoded hash of the block and root hash of the states of the
+		// snapshot to import. They must be obtained from a trusted source, as the snapshot file cannot vouch for itself.
+		ImportBlockHash string `yaml:"importBlockHash"`
+		ImportRootHash  string `yaml:"importRootHash"`
+	}
+
 	// Config is the root config struct, each package's config should be put as its sub struct
 	Config struct {
 		Plugins    map[int]interface{}         `ymal:"plugins"`
@@ -380,6 +502,7 @@ type (
 		API        API                         `yaml:"api"`
 		System     System                      `yaml:"system"`
 		DB         DB                          `yaml:"db"`
+		Snapshot   Snapshot                    `yaml:"snapshot"`
 		Log        log.GlobalConfig            `yaml:"log"`
 		SubLogs    map[string]log.GlobalConfig `yaml:"subLogs"`
 		Genesis    genesis.Genesis             `yaml:"genesis"`
@@ -511,6 +634,17 @@ func (ap ActPool) MinGasPrice() *big.Int {
 	return mgp
 }
 
+// KVStoreBackend returns the backend of the KV store at DbPath
+func (db DB) KVStoreBackend() string {
+	if backend, ok := db.BackendByPath[db.DbPath]; ok {
+		return backend
+	}
+	if db.Backend == "" {
+		return BoltDBBackend
+	}
+	return db.Backend
+}
+
 // ValidateDispatcher validates the dispatcher configs
 func ValidateDispatcher(cfg Config) error {
 	if cfg.Dispatcher.EventChanSize <= 0 {
@@ -537,6 +671,92 @@ func ValidateAPI(cfg Config) error {
 	if cfg.API.TpsWindow <= 0 {
 		return errors.Wrap(ErrInvalidCfg, "tps window is not a positive integer when the api is enabled")
 	}
+	gs := cfg.API.GasStation
+	for _, p := range []int{gs.SlowPercentile, gs.Percentile, gs.FastPercentile} {
+		if p < 0 || p > 100 {
+			return errors.Wrapf(ErrInvalidCfg, "gas station percentile %d is out of range", p)
+		}
+	}
+	return nil
+}
+
+// ValidateDB validates the db configs
+func ValidateDB(cfg Config) error {
+	validBackend := func(backend string) bool {
+		return backend == "" || backend == BoltDBBackend || backend == LevelDBBackend
+	}
+	if !validBackend(cfg.DB.Backend) {
+		return errors.Wrapf(ErrInvalidCfg, "unsupported db backend %s", cfg.DB.Backend)
+	}
+	for path, backend := range cfg.DB.BackendByPath {
+		if !validBackend(backend) {
+			return errors.Wrapf(ErrInvalidCfg, "unsupported db backend %s of path %s", backend, path)
+		}
+	}
+	// the archive of the gravity chain is always kept in boltdb
+	if gdb := cfg.Chain.GravityChainDB; len(gdb.BackendByPath) > 0 || gdb.KVStoreBackend() != BoltDBBackend {
+		return errors.Wrap(ErrInvalidCfg, "gravity chain db only supports boltdb backend")
+	}
+	if cfg.DB.TriePruneRetention > 0 {
+		if cfg.DB.TriePruneInterval == 0 || cfg.DB.TriePruneBatchSize <= 0 {
+			return errors.Wrap(ErrInvalidCfg, "trie prune interval and batch size should be positive")
+		}
+		// the roots within the history state retention should be kept
+		if cfg.DB.HistoryStateRetention == 0 || cfg.DB.TriePruneRetention <= cfg.DB.HistoryStateRetention {
+			return errors.Wrapf(
+				ErrInvalidCfg,
+				"trie prune retention %d should be larger than history state retention %d",
+				cfg.DB.TriePruneRetention,
+				cfg.DB.HistoryStateRetention,
+			)
+		}
+	}
+	return nil
+}
+
+// ValidateSnapshot validates the snapshot configs
+func ValidateSnapshot(cfg Config) error {
+	if cfg.Snapshot.ImportPath != "" {
+		for _, h := range []string{cfg.Snapshot.ImportBlockHash, cfg.Snapshot.ImportRootHash} {
+			if _, err := hash.HexStringToHash256(h); err != nil || h == "" {
+				return errors.Wrap(ErrInvalidCfg, "trusted block hash and root hash of the snapshot to import are required")
+			}
+		}
+	}
+	if cfg.Snapshot.ExportDir == "" {
+		return nil
+	}
+	if cfg.Snapshot.ExportEpochInterval == 0 {
+		return errors.Wrap(ErrInvalidCfg, "snapshot export epoch interval cannot be zero")
+	}
+	if cfg.Snapshot.ChunkSize == 0 {
+		return errors.Wrap(ErrInvalidCfg, "snapshot chunk size cannot be zero")
+	}
+	return nil
+}
+
+// ValidateBlockSync validates the block sync configs
+func ValidateBlockSync(cfg Config) error {
+	if cfg.BlockSync.Pipeline {
+		if cfg.BlockSync.PipelineWorkers <= 0 {
+			return errors.Wrap(ErrInvalidCfg, "pipeline workers must be positive in pipelined mode")
+		}
+		if cfg.BlockSync.PipelineMemoryBudget == 0 {
+			return errors.Wrap(ErrInvalidCfg, "pipeline memory budget must be positive in pipelined mode")
+		}
+	}
+	if cfg.BlockSync.BatchMaxBytes > cfg.BlockSync.BatchMaxReceiveBytes {
+		return errors.Wrap(ErrInvalidCfg, "batch max receive bytes cannot be less than batch max bytes")
+	}
+	if !cfg.BlockSync.Light {
+		return nil
+	}
+	if cfg.Consensus.Scheme != RollDPoSScheme {
+		return errors.Wrap(ErrInvalidCfg, "light mode is only supported with roll-DPoS consensus")
+	}
+	if cfg.BlockSync.LightDBPath == "" {
+		return errors.Wrap(ErrInvalidCfg, "light db path cannot be empty in light mode")
+	}
 	return nil
 }
 
@@ -556,6 +776,9 @@ func ValidateActPool(cfg Config) error {
 			"maximum number of actions per pool cannot be less than maximum number of actions per account",
 		)
 	}
+	if cfg.ActPool.JournalPath != "" && cfg.ActPool.JournalCompactInterval <= 0 {
+		return errors.Wrap(ErrInvalidCfg, "journal compact interval must be positive")
+	}
 	return nil
 }
 
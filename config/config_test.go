@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -210,6 +211,108 @@ func TestValidateRollDPoS(t *testing.T) {
 	)
 }
 
+func TestValidateDB(t *testing.T) {
+	cfg := Default
+	require.NoError(t, ValidateDB(cfg))
+	require.Equal(t, BoltDBBackend, cfg.DB.KVStoreBackend())
+
+	cfg.DB.DbPath = "trie.db"
+	cfg.DB.BackendByPath = map[string]string{"trie.db": LevelDBBackend}
+	require.NoError(t, ValidateDB(cfg))
+	require.Equal(t, LevelDBBackend, cfg.DB.KVStoreBackend())
+	cfg.DB.DbPath = "chain.db"
+	require.Equal(t, BoltDBBackend, cfg.DB.KVStoreBackend())
+
+	cfg.DB.BackendByPath["chain.db"] = "rocksdb"
+	err := ValidateDB(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "unsupported db backend rocksdb"))
+	cfg.DB.BackendByPath = nil
+	cfg.DB.Backend = "rocksdb"
+	require.Equal(t, ErrInvalidCfg, errors.Cause(ValidateDB(cfg)))
+
+	cfg = Default
+	cfg.Chain.GravityChainDB.Backend = LevelDBBackend
+	err = ValidateDB(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "gravity chain db only supports boltdb backend"))
+	cfg.Chain.GravityChainDB.Backend = ""
+	cfg.Chain.GravityChainDB.BackendByPath = map[string]string{"./poll.db": BoltDBBackend}
+	require.Equal(t, ErrInvalidCfg, errors.Cause(ValidateDB(cfg)))
+
+	cfg = Default
+	cfg.DB.TriePruneRetention = 2001
+	require.NoError(t, ValidateDB(cfg))
+	cfg.DB.TriePruneRetention = 2000
+	err = ValidateDB(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "should be larger than history state retention"))
+	cfg.DB.TriePruneRetention = 2001
+	cfg.DB.TriePruneBatchSize = 0
+	require.Equal(t, ErrInvalidCfg, errors.Cause(ValidateDB(cfg)))
+}
+
+func TestValidateSnapshot(t *testing.T) {
+	cfg := Default
+	require.NoError(t, ValidateSnapshot(cfg))
+
+	cfg.Snapshot.ExportDir = "snapshots"
+	require.NoError(t, ValidateSnapshot(cfg))
+	cfg.Snapshot.ChunkSize = 0
+	err := ValidateSnapshot(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "chunk size cannot be zero"))
+	cfg.Snapshot.ExportEpochInterval = 0
+	err = ValidateSnapshot(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "epoch interval cannot be zero"))
+
+	cfg = Default
+	cfg.Snapshot.ImportPath = "snapshot-3.snap"
+	err = ValidateSnapshot(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "trusted block hash and root hash"))
+	cfg.Snapshot.ImportBlockHash = strings.Repeat("ab", 32)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(ValidateSnapshot(cfg)))
+	cfg.Snapshot.ImportRootHash = strings.Repeat("cd", 32)
+	require.NoError(t, ValidateSnapshot(cfg))
+}
+
+func TestValidateBlockSync(t *testing.T) {
+	cfg := Default
+	require.NoError(t, ValidateBlockSync(cfg))
+
+	cfg.BlockSync.Light = true
+	err := ValidateBlockSync(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "only supported with roll-DPoS"))
+	cfg.Consensus.Scheme = RollDPoSScheme
+	require.NoError(t, ValidateBlockSync(cfg))
+	cfg.BlockSync.LightDBPath = ""
+	err = ValidateBlockSync(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "light db path cannot be empty"))
+
+	cfg = Default
+	cfg.BlockSync.Pipeline = true
+	require.NoError(t, ValidateBlockSync(cfg))
+	cfg.BlockSync.PipelineWorkers = 0
+	err = ValidateBlockSync(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "pipeline workers must be positive"))
+	cfg.BlockSync.PipelineWorkers = 4
+	cfg.BlockSync.PipelineMemoryBudget = 0
+	err = ValidateBlockSync(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "pipeline memory budget must be positive"))
+
+	cfg = Default
+	cfg.BlockSync.BatchMaxReceiveBytes = cfg.BlockSync.BatchMaxBytes - 1
+	err = ValidateBlockSync(cfg)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "batch max receive bytes"))
+}
+
 func TestValidateActPool(t *testing.T) {
 	cfg := Default
 	cfg.ActPool.MaxNumActsPerAcct = 0
@@ -248,6 +351,16 @@ func TestValidateActPool(t *testing.T) {
 			"maximum number of actions per pool cannot be less than maximum number of actions per account",
 		),
 	)
+
+	cfg.ActPool.MaxNumActsPerPool = 100
+	cfg.ActPool.JournalPath = "actpool.journal"
+	cfg.ActPool.JournalCompactInterval = 0
+	err = ValidateActPool(cfg)
+	require.Error(t, err)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "journal compact interval must be positive"))
+	cfg.ActPool.JournalCompactInterval = time.Hour
+	require.NoError(t, ValidateActPool(cfg))
 }
 
 func TestValidateMinGasPrice(t *testing.T) {
@@ -19,6 +19,9 @@ const (
 	Bering
 	Cook
 	Dardanelles
+	Fairbank
+	Greenland
+	Hawaii
 )
 
 type (
@@ -32,6 +35,9 @@ type (
 		beringHeight      uint64
 		cookHeight        uint64
 		dardanellesHeight uint64
+		fairbankHeight    uint64
+		greenlandHeight   uint64
+		hawaiiHeight      uint64
 	}
 )
 
@@ -43,6 +49,9 @@ func NewHeightUpgrade(cfg *genesis.Genesis) HeightUpgrade {
 		cfg.BeringBlockHeight,
 		cfg.CookBlockHeight,
 		cfg.DardanellesBlockHeight,
+		cfg.FairbankBlockHeight,
+		cfg.GreenlandBlockHeight,
+		cfg.HawaiiBlockHeight,
 	}
 }
 
@@ -60,6 +69,12 @@ func (hu *HeightUpgrade) IsPost(name HeightName, height uint64) bool {
 		h = hu.cookHeight
 	case Dardanelles:
 		h = hu.dardanellesHeight
+	case Fairbank:
+		h = hu.fairbankHeight
+	case Greenland:
+		h = hu.greenlandHeight
+	case Hawaii:
+		h = hu.hawaiiHeight
 	default:
 		log.Panic("invalid height name!")
 	}
@@ -85,3 +100,12 @@ func (hu *HeightUpgrade) CookBlockHeight() uint64 { return hu.cookHeight }
 
 // DardanellesBlockHeight returns the dardanelles height
 func (hu *HeightUpgrade) DardanellesBlockHeight() uint64 { return hu.dardanellesHeight }
+
+// FairbankBlockHeight returns the fairbank height
+func (hu *HeightUpgrade) FairbankBlockHeight() uint64 { return hu.fairbankHeight }
+
+// GreenlandBlockHeight returns the greenland height
+func (hu *HeightUpgrade) GreenlandBlockHeight() uint64 { return hu.greenlandHeight }
+
+// HawaiiBlockHeight returns the hawaii height
+func (hu *HeightUpgrade) HawaiiBlockHeight() uint64 { return hu.hawaiiHeight }
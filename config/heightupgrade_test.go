@@ -20,6 +20,9 @@ func TestNewHeightChange(t *testing.T) {
 	require.Equal(2, Bering)
 	require.Equal(3, Cook)
 	require.Equal(4, Dardanelles)
+	require.Equal(5, Fairbank)
+	require.Equal(6, Greenland)
+	require.Equal(7, Hawaii)
 
 	cfg := Default
 	cfg.Genesis.PacificBlockHeight = uint64(432001)
@@ -35,6 +38,12 @@ func TestNewHeightChange(t *testing.T) {
 	require.True(hu.IsPost(Cook, uint64(1641601)))
 	require.True(hu.IsPre(Dardanelles, uint64(1816200)))
 	require.True(hu.IsPost(Dardanelles, uint64(1816201)))
+	require.True(hu.IsPre(Fairbank, uint64(5157000)))
+	require.True(hu.IsPost(Fairbank, uint64(5157001)))
+	require.True(hu.IsPre(Greenland, uint64(5553000)))
+	require.True(hu.IsPost(Greenland, uint64(5553001)))
+	require.True(hu.IsPre(Hawaii, uint64(6544440)))
+	require.True(hu.IsPost(Hawaii, uint64(6544441)))
 	require.Panics(func() {
 		hu.IsPost(-1, 0)
 	})
@@ -44,5 +53,8 @@ func TestNewHeightChange(t *testing.T) {
 	require.Equal(hu.BeringBlockHeight(), uint64(1512001))
 	require.Equal(hu.CookBlockHeight(), uint64(1641601))
 	require.Equal(hu.DardanellesBlockHeight(), uint64(1816201))
+	require.Equal(hu.FairbankBlockHeight(), uint64(5157001))
+	require.Equal(hu.GreenlandBlockHeight(), uint64(5553001))
+	require.Equal(hu.HawaiiBlockHeight(), uint64(6544441))
 
 }
@@ -0,0 +1,89 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/consensus/consensusfsm"
+)
+
+// FooterValidator validates the endorsements in the block footers against the delegates of the epochs, without
+// running the consensus. It only reads the headers and footers of the chain, so it works for light nodes.
+type FooterValidator struct {
+	cfg       consensusfsm.ConsensusConfig
+	roundCalc *roundCalculator
+}
+
+// NewFooterValidator creates a footer validator
+func NewFooterValidator(
+	cfg config.Config,
+	chain ChainReader,
+	rp *rolldpos.Protocol,
+	candidatesByHeightFunc CandidatesByHeightFunc,
+) (*FooterValidator, error) {
+	if chain == nil {
+		return nil, errors.New("chain cannot be nil")
+	}
+	if rp == nil {
+		return nil, errors.New("roll dpos protocal cannot be nil")
+	}
+	if candidatesByHeightFunc == nil {
+		return nil, errors.New("canidates by height function cannot be nil")
+	}
+	return &FooterValidator{
+		cfg: consensusfsm.NewConsensusConfig(cfg),
+		roundCalc: &roundCalculator{
+			chain:                  chain,
+			timeBasedRotation:      cfg.Genesis.TimeBasedRotation,
+			rp:                     rp,
+			candidatesByHeightFunc: candidatesByHeightFunc,
+			beringHeight:           cfg.Genesis.BeringBlockHeight,
+		},
+	}, nil
+}
+
+// ValidateBlockFooter validates the signatures in the block footer, the same as RollDPoS does
+func (v *FooterValidator) ValidateBlockFooter(blk *block.Block) error {
+	return validateBlockFooter(v.roundCalc, v.cfg.BlockInterval(blk.Height()), blk)
+}
+
+func validateBlockFooter(roundCalc *roundCalculator, blockInterval time.Duration, blk *block.Block) error {
+	height := blk.Height()
+	round, err := roundCalc.NewRound(height, blockInterval, blk.Timestamp(), nil)
+	if err != nil {
+		return err
+	}
+	if !round.IsDelegate(blk.ProducerAddress()) {
+		return errors.Errorf(
+			"block proposer %s is not a valid delegate",
+			blk.ProducerAddress(),
+		)
+	}
+	if err := round.AddBlock(blk); err != nil {
+		return err
+	}
+	blkHash := blk.HashBlock()
+	for _, en := range blk.Endorsements() {
+		if err := round.AddVoteEndorsement(
+			NewConsensusVote(blkHash[:], COMMIT),
+			en,
+		); err != nil {
+			return err
+		}
+	}
+	if !round.EndorsedByMajority(blkHash[:], []ConsensusVoteTopic{COMMIT}) {
+		return ErrInsufficientEndorsements
+	}
+
+	return nil
+}
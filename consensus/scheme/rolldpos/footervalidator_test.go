@@ -0,0 +1,66 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
+)
+
+func TestFooterValidator(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := config.Default
+	cfg.Genesis.NumDelegates = 4
+	cfg.Genesis.NumSubEpochs = 1
+	cfg.Genesis.BlockInterval = 10 * time.Second
+	cfg.Genesis.Timestamp = int64(1500000000)
+	chain := mock_blockchain.NewMockBlockchain(ctrl)
+	chain.EXPECT().BlockFooterByHeight(uint64(8)).Return(&block.Footer{}, nil).AnyTimes()
+	chain.EXPECT().Genesis().Return(cfg.Genesis).AnyTimes()
+	rp := rolldpos.NewProtocol(
+		cfg.Genesis.NumCandidateDelegates,
+		cfg.Genesis.NumDelegates,
+		cfg.Genesis.NumSubEpochs,
+	)
+	candidatesByHeight := func(uint64) (state.CandidateList, error) {
+		candidates := state.CandidateList{}
+		for i := 0; i < 5; i++ {
+			candidates = append(candidates, &state.Candidate{Address: identityset.Address(i).String()})
+		}
+		return candidates, nil
+	}
+
+	_, err := NewFooterValidator(cfg, nil, rp, candidatesByHeight)
+	require.Error(err)
+	_, err = NewFooterValidator(cfg, chain, nil, candidatesByHeight)
+	require.Error(err)
+	_, err = NewFooterValidator(cfg, chain, rp, nil)
+	require.Error(err)
+	v, err := NewFooterValidator(cfg, chain, rp, candidatesByHeight)
+	require.NoError(err)
+
+	require.NoError(v.ValidateBlockFooter(makeBlock(t, 1, 4, false, 9)))
+	// proposer is not a delegate
+	require.Error(v.ValidateBlockFooter(makeBlock(t, 0, 4, false, 9)))
+	// not enough endorsements
+	require.Equal(ErrInsufficientEndorsements, v.ValidateBlockFooter(makeBlock(t, 1, 2, false, 9)))
+	// endorsements of a wrong topic
+	require.Error(v.ValidateBlockFooter(makeBlock(t, 1, 4, true, 9)))
+}
@@ -38,14 +38,19 @@ var (
 	ErrNotEnoughCandidates = errors.New("Candidate pool does not have enough candidates")
 )
 
-// ChainManager defines the blockchain interface
-type ChainManager interface {
+// ChainReader defines the interface to read the headers and footers of the blockchain
+type ChainReader interface {
 	// Genesis returns the genesis
 	Genesis() genesis.Genesis
 	// BlockHeaderByHeight return block header by height
 	BlockHeaderByHeight(height uint64) (*block.Header, error)
 	// BlockFooterByHeight return block footer by height
 	BlockFooterByHeight(height uint64) (*block.Footer, error)
+}
+
+// ChainManager defines the blockchain interface
+type ChainManager interface {
+	ChainReader
 	// MintNewBlock creates a new block with given actions
 	// Note: the coinbase transfer will be added to the given transfers when minting a new block
 	MintNewBlock(
@@ -161,34 +166,7 @@ func (r *RollDPoS) Calibrate(height uint64) {
 
 // ValidateBlockFooter validates the signatures in the block footer
 func (r *RollDPoS) ValidateBlockFooter(blk *block.Block) error {
-	height := blk.Height()
-	round, err := r.ctx.RoundCalc().NewRound(height, r.ctx.BlockInterval(height), blk.Timestamp(), nil)
-	if err != nil {
-		return err
-	}
-	if !round.IsDelegate(blk.ProducerAddress()) {
-		return errors.Errorf(
-			"block proposer %s is not a valid delegate",
-			blk.ProducerAddress(),
-		)
-	}
-	if err := round.AddBlock(blk); err != nil {
-		return err
-	}
-	blkHash := blk.HashBlock()
-	for _, en := range blk.Endorsements() {
-		if err := round.AddVoteEndorsement(
-			NewConsensusVote(blkHash[:], COMMIT),
-			en,
-		); err != nil {
-			return err
-		}
-	}
-	if !round.EndorsedByMajority(blkHash[:], []ConsensusVoteTopic{COMMIT}) {
-		return ErrInsufficientEndorsements
-	}
-
-	return nil
+	return validateBlockFooter(r.ctx.RoundCalc(), r.ctx.BlockInterval(blk.Height()), blk)
 }
 
 // Metrics returns RollDPoS consensus metrics
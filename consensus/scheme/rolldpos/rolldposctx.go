@@ -133,7 +133,7 @@ func newRollDPoSCtx(
 	}
 	var eManagerDB db.KVStore
 	if len(consensusDBConfig.DbPath) > 0 {
-		eManagerDB = db.NewBoltDB(consensusDBConfig)
+		eManagerDB = db.NewKVStore(consensusDBConfig)
 	}
 	roundCalc := &roundCalculator{
 		candidatesByHeightFunc: candidatesByHeightFunc,
@@ -18,7 +18,7 @@ import (
 )
 
 type roundCalculator struct {
-	chain                  ChainManager
+	chain                  ChainReader
 	timeBasedRotation      bool
 	rp                     *rolldpos.Protocol
 	candidatesByHeightFunc CandidatesByHeightFunc
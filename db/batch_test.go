@@ -40,6 +40,9 @@ func TestCachedBatch(t *testing.T) {
 	require.Equal(testK2[0], w.key)
 	require.Equal([]byte(nil), w.value)
 	require.Equal(Delete, w.writeType)
+	require.Equal(bucket1, w.Namespace())
+	require.Equal(testK2[0], w.Key())
+	require.Equal(Delete, w.WriteType())
 
 	w, err = cb.Entry(2)
 	require.NoError(err)
@@ -146,6 +146,11 @@ func TestCountingIndex(t *testing.T) {
 		defer testutil.CleanupPath(t, testPath)
 		testFunc(NewBoltDB(cfg), t)
 	})
+	t.Run("LevelDB", func(t *testing.T) {
+		testutil.CleanupPath(t, testPath)
+		defer testutil.CleanupPath(t, testPath)
+		testFunc(NewLevelDB(cfg), t)
+	})
 }
 
 const (
@@ -187,6 +192,11 @@ func TestBulk(t *testing.T) {
 		testutil.CleanupPath(t, cfg.DbPath)
 		testFunc(NewBoltDB(cfg), t)
 	})
+	t.Run("LevelDB", func(t *testing.T) {
+		cfg.DbPath = "test-bulk.level"
+		testutil.CleanupPath(t, cfg.DbPath)
+		testFunc(NewLevelDB(cfg), t)
+	})
 }
 
 func TestCheckBulk(t *testing.T) {
@@ -226,4 +236,9 @@ func TestCheckBulk(t *testing.T) {
 		defer testutil.CleanupPath(t, cfg.DbPath)
 		testFunc(NewBoltDB(cfg), t)
 	})
+	t.Run("LevelDB", func(t *testing.T) {
+		cfg.DbPath = "test-bulk.level"
+		defer testutil.CleanupPath(t, cfg.DbPath)
+		testFunc(NewLevelDB(cfg), t)
+	})
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 )
@@ -74,12 +75,29 @@ type (
 		// GetKeyByPrefix retrieves all keys those with const prefix
 		GetKeyByPrefix(namespace, prefix []byte) ([][]byte, error)
 	}
+
+	// KVStoreWithForEach is KVStore with ForEach() API
+	KVStoreWithForEach interface {
+		KVStore
+		// GetBucketByPrefix retrieves all bucket those with const namespace prefix
+		GetBucketByPrefix([]byte) ([][]byte, error)
+		// ForEach calls the function on each record of a namespace in key order, starting from the given key
+		ForEach(string, []byte, func([]byte, []byte) error) error
+	}
 )
 
 const (
 	keyDelimiter = "."
 )
 
+// NewKVStore instantiates a KVStore of the backend configured for DbPath, bolt DB by default
+func NewKVStore(cfg config.DB) KVStoreWithBucketFillPercent {
+	if cfg.KVStoreBackend() == config.LevelDBBackend {
+		return NewLevelDB(cfg)
+	}
+	return NewBoltDB(cfg)
+}
+
 // memKVStore is the in-memory implementation of KVStore for testing purpose
 type memKVStore struct {
 	data   *sync.Map
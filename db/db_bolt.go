@@ -139,6 +139,28 @@ func (b *boltDB) Range(namespace string, key []byte, count uint64) ([][]byte, er
 	return nil, errors.Wrap(ErrIO, err.Error())
 }
 
+// ForEach calls fn on each record of a namespace in key order, starting from key. The key and value passed to fn
+// are only valid during the call.
+func (b *boltDB) ForEach(namespace string, key []byte, fn func([]byte, []byte) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(namespace))
+		if bucket == nil {
+			return errors.Wrapf(ErrNotExist, "bucket = %s doesn't exist", namespace)
+		}
+		cur := bucket.Cursor()
+		for k, v := cur.Seek(key); k != nil; k, v = cur.Next() {
+			if v == nil {
+				// skip nested bucket
+				continue
+			}
+			if err := fn(k, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // GetBucketByPrefix retrieves all bucket those with const namespace prefix
 func (b *boltDB) GetBucketByPrefix(namespace []byte) ([][]byte, error) {
 	allKey := make([][]byte, 0)
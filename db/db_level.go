@@ -0,0 +1,420 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// LevelDB has a single flat key space, in which a namespace (bucket) is mapped to a key prefix. A record is stored
+// under
+//
+//	dataPrefix | 4-byte big endian length of namespace | namespace | key
+//
+// and the existence of a namespace is marked by an empty record under
+//
+//	bucketPrefix | namespace
+//
+// so that a namespace which is a prefix of another one is not mixed up with it, and namespaces can be listed.
+const (
+	levelDataPrefix   byte = 'd'
+	levelBucketPrefix byte = 'b'
+)
+
+// levelDB is KVStore implementation based on LevelDB
+type levelDB struct {
+	db     *leveldb.DB
+	path   string
+	config config.DB
+	// mutex serializes the read-modify-write operations of range index
+	mutex sync.Mutex
+}
+
+// NewLevelDB instantiates a LevelDB which implements KVStore
+func NewLevelDB(cfg config.DB) KVStoreWithBucketFillPercent {
+	return &levelDB{
+		db:     nil,
+		path:   cfg.DbPath,
+		config: cfg,
+	}
+}
+
+// Start opens the LevelDB (creates new directory if not existing yet)
+func (l *levelDB) Start(_ context.Context) error {
+	db, err := leveldb.OpenFile(l.path, nil)
+	if err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	l.db = db
+	return nil
+}
+
+// Stop closes the LevelDB
+func (l *levelDB) Stop(_ context.Context) error {
+	if l.db != nil {
+		if err := l.db.Close(); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+	}
+	return nil
+}
+
+// Put inserts a <key, value> record
+func (l *levelDB) Put(namespace string, key, value []byte) error {
+	batch := new(leveldb.Batch)
+	batch.Put(levelBucketKey([]byte(namespace)), nil)
+	batch.Put(levelDataKey([]byte(namespace), key), value)
+	return l.write(batch)
+}
+
+// Get retrieves a record
+func (l *levelDB) Get(namespace string, key []byte) ([]byte, error) {
+	value, err := l.db.Get(levelDataKey([]byte(namespace), key), nil)
+	if err == nil {
+		return value, nil
+	}
+	if err == leveldb.ErrNotFound {
+		return nil, errors.Wrapf(ErrNotExist, "key = %x doesn't exist", key)
+	}
+	return nil, errors.Wrap(ErrIO, err.Error())
+}
+
+// Range retrieves values for a range of keys
+func (l *levelDB) Range(namespace string, key []byte, count uint64) ([][]byte, error) {
+	value := make([][]byte, count)
+	iter := l.bucketIterator([]byte(namespace))
+	defer iter.Release()
+	if !iter.Seek(levelDataKey([]byte(namespace), key)) {
+		if err := iter.Error(); err != nil {
+			return nil, errors.Wrap(ErrIO, err.Error())
+		}
+		return nil, errors.Wrapf(ErrNotExist, "entry for key 0x%x doesn't exist", key)
+	}
+	// retrieve 'count' items
+	for i := uint64(0); i < count; i++ {
+		if i > 0 && !iter.Next() {
+			if err := iter.Error(); err != nil {
+				return nil, errors.Wrap(ErrIO, err.Error())
+			}
+			return nil, errors.Wrapf(ErrNotExist, "entry for key 0x%x doesn't exist", key)
+		}
+		value[i] = copyBytes(iter.Value())
+	}
+	return value, nil
+}
+
+// ForEach calls fn on each record of a namespace in key order, starting from key. The key and value passed to fn
+// are only valid during the call.
+func (l *levelDB) ForEach(namespace string, key []byte, fn func([]byte, []byte) error) error {
+	exist, err := l.bucketExists([]byte(namespace))
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.Wrapf(ErrNotExist, "bucket = %s doesn't exist", namespace)
+	}
+	iter := l.bucketIterator([]byte(namespace))
+	defer iter.Release()
+	offset := len(levelDataKey([]byte(namespace), nil))
+	for ok := iter.Seek(levelDataKey([]byte(namespace), key)); ok; ok = iter.Next() {
+		if err := fn(iter.Key()[offset:], iter.Value()); err != nil {
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+// GetBucketByPrefix retrieves all bucket those with const namespace prefix
+func (l *levelDB) GetBucketByPrefix(namespace []byte) ([][]byte, error) {
+	allKey := make([][]byte, 0)
+	iter := l.db.NewIterator(util.BytesPrefix(levelBucketKey(namespace)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		name := iter.Key()[1:]
+		if !bytes.Equal(name, namespace) {
+			allKey = append(allKey, copyBytes(name))
+		}
+	}
+	return allKey, iter.Error()
+}
+
+// GetKeyByPrefix retrieves all keys those with const prefix
+func (l *levelDB) GetKeyByPrefix(namespace, prefix []byte) ([][]byte, error) {
+	exist, err := l.bucketExists(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, ErrNotExist
+	}
+	allKey := make([][]byte, 0)
+	iter := l.db.NewIterator(util.BytesPrefix(levelDataKey(namespace, prefix)), nil)
+	defer iter.Release()
+	offset := len(levelDataKey(namespace, nil))
+	for iter.Next() {
+		allKey = append(allKey, copyBytes(iter.Key()[offset:]))
+	}
+	return allKey, iter.Error()
+}
+
+// Delete deletes a record, if key is nil, this will delete the whole bucket
+func (l *levelDB) Delete(namespace string, key []byte) error {
+	batch := new(leveldb.Batch)
+	if key == nil {
+		iter := l.bucketIterator([]byte(namespace))
+		for iter.Next() {
+			batch.Delete(copyBytes(iter.Key()))
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+		batch.Delete(levelBucketKey([]byte(namespace)))
+	} else {
+		batch.Delete(levelDataKey([]byte(namespace), key))
+	}
+	return l.write(batch)
+}
+
+// WriteBatch commits a batch
+func (l *levelDB) WriteBatch(kvsb KVStoreBatch) (err error) {
+	succeed := true
+	kvsb.Lock()
+	defer func() {
+		if succeed {
+			// clear the batch if commit succeeds
+			kvsb.ClearAndUnlock()
+		} else {
+			kvsb.Unlock()
+		}
+	}()
+
+	batch := new(leveldb.Batch)
+	for i := 0; i < kvsb.Size(); i++ {
+		write, err := kvsb.Entry(i)
+		if err != nil {
+			succeed = false
+			return err
+		}
+		if write.writeType == Put {
+			batch.Put(levelBucketKey([]byte(write.namespace)), nil)
+			batch.Put(levelDataKey([]byte(write.namespace), write.key), write.value)
+		} else if write.writeType == Delete {
+			batch.Delete(levelDataKey([]byte(write.namespace), write.key))
+		}
+	}
+	if err = l.write(batch); err != nil {
+		succeed = false
+	}
+	return err
+}
+
+// SetBucketFillPercent is a no-op, as LevelDB does not split pages by buckets
+func (l *levelDB) SetBucketFillPercent(namespace string, percent float64) error {
+	return nil
+}
+
+// ======================================
+// below functions used by RangeIndex
+// ======================================
+
+// Insert inserts a value into the index
+func (l *levelDB) Insert(name []byte, key uint64, value []byte) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if err := l.checkBucket(name); err != nil {
+		return err
+	}
+	batch := new(leveldb.Batch)
+	ak := byteutil.Uint64ToBytesBigEndian(key - 1)
+	k, v, err := l.seek(name, ak)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(k, ak) {
+		// insert new key
+		batch.Put(levelDataKey(name, ak), v)
+	} else {
+		// update an existing key
+		if k, _, err = l.next(name, ak); err != nil {
+			return err
+		}
+	}
+	if k != nil {
+		batch.Put(levelDataKey(name, k), value)
+	}
+	return l.write(batch)
+}
+
+// Seek returns value by the key
+func (l *levelDB) Seek(name []byte, key uint64) ([]byte, error) {
+	if err := l.checkBucket(name); err != nil {
+		return nil, err
+	}
+	_, v, err := l.seek(name, byteutil.Uint64ToBytesBigEndian(key))
+	if err != nil {
+		return nil, err
+	}
+	value := make([]byte, len(v))
+	copy(value, v)
+	return value, nil
+}
+
+// Remove removes an existing key
+func (l *levelDB) Remove(name []byte, key uint64) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if err := l.checkBucket(name); err != nil {
+		return err
+	}
+	ak := byteutil.Uint64ToBytesBigEndian(key - 1)
+	k, v, err := l.seek(name, ak)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(k, ak) {
+		// return nil if the key does not exist
+		return nil
+	}
+	batch := new(leveldb.Batch)
+	batch.Delete(levelDataKey(name, ak))
+	// write the corresponding value to next key
+	if k, _, err = l.next(name, ak); err != nil {
+		return err
+	}
+	if k != nil {
+		batch.Put(levelDataKey(name, k), v)
+	}
+	return l.write(batch)
+}
+
+// Purge deletes an existing key and all keys before it
+func (l *levelDB) Purge(name []byte, key uint64) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if err := l.checkBucket(name); err != nil {
+		return err
+	}
+	nk, _, err := l.seek(name, byteutil.Uint64ToBytesBigEndian(key))
+	if err != nil {
+		return err
+	}
+	batch := new(leveldb.Batch)
+	// delete all keys before this key
+	iter := l.bucketIterator(name)
+	for iter.Next() {
+		dataKey := iter.Key()
+		if nk != nil && bytes.Compare(dataKey, levelDataKey(name, nk)) >= 0 {
+			break
+		}
+		batch.Delete(copyBytes(dataKey))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	// write not exist value to next key
+	if nk != nil {
+		batch.Put(levelDataKey(name, nk), NotExist)
+	}
+	return l.write(batch)
+}
+
+// ======================================
+// private functions
+// ======================================
+
+func (l *levelDB) write(batch *leveldb.Batch) (err error) {
+	for c := uint8(0); c < l.config.NumRetries; c++ {
+		if err = l.db.Write(batch, nil); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		err = errors.Wrap(ErrIO, err.Error())
+	}
+	return err
+}
+
+func (l *levelDB) bucketExists(name []byte) (bool, error) {
+	exist, err := l.db.Has(levelBucketKey(name), nil)
+	if err != nil {
+		return false, errors.Wrap(ErrIO, err.Error())
+	}
+	return exist, nil
+}
+
+func (l *levelDB) checkBucket(name []byte) error {
+	exist, err := l.bucketExists(name)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", name)
+	}
+	return nil
+}
+
+func (l *levelDB) bucketIterator(name []byte) iterator.Iterator {
+	return l.db.NewIterator(util.BytesPrefix(levelDataKey(name, nil)), nil)
+}
+
+// seek returns the first <key, value> in the bucket whose key is equal to or greater than the given key, like the
+// cursor of bolt DB, or nil if there is no such key
+func (l *levelDB) seek(name, key []byte) ([]byte, []byte, error) {
+	iter := l.bucketIterator(name)
+	defer iter.Release()
+	if !iter.Seek(levelDataKey(name, key)) {
+		if err := iter.Error(); err != nil {
+			return nil, nil, errors.Wrap(ErrIO, err.Error())
+		}
+		return nil, nil, nil
+	}
+	offset := len(levelDataKey(name, nil))
+	return copyBytes(iter.Key()[offset:]), copyBytes(iter.Value()), nil
+}
+
+// next returns the first <key, value> in the bucket whose key is greater than the given key
+func (l *levelDB) next(name, key []byte) ([]byte, []byte, error) {
+	return l.seek(name, append(copyBytes(key), 0))
+}
+
+func levelBucketKey(namespace []byte) []byte {
+	k := make([]byte, 0, 1+len(namespace))
+	k = append(k, levelBucketPrefix)
+	return append(k, namespace...)
+}
+
+func levelDataKey(namespace, key []byte) []byte {
+	k := make([]byte, 0, 5+len(namespace)+len(key))
+	k = append(k, levelDataPrefix)
+	k = append(k, byteutil.Uint32ToBytesBigEndian(uint32(len(namespace)))...)
+	k = append(k, namespace...)
+	return append(k, key...)
+}
+
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
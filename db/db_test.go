@@ -14,6 +14,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/testutil"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -66,6 +67,11 @@ func TestKVStorePutGet(t *testing.T) {
 		defer testutil.CleanupPath(t, testPath)
 		testKVStorePutGet(NewBoltDB(cfg), t)
 	})
+	t.Run("LevelDB", func(t *testing.T) {
+		testutil.CleanupPath(t, testPath)
+		defer testutil.CleanupPath(t, testPath)
+		testKVStorePutGet(NewLevelDB(cfg), t)
+	})
 
 }
 
@@ -234,6 +240,11 @@ func TestDBBatch(t *testing.T) {
 		defer testutil.CleanupPath(t, testPath)
 		testBatchRollback(NewBoltDB(cfg), t)
 	})
+	t.Run("LevelDB", func(t *testing.T) {
+		testutil.CleanupPath(t, testPath)
+		defer testutil.CleanupPath(t, testPath)
+		testBatchRollback(NewLevelDB(cfg), t)
+	})
 
 }
 
@@ -286,6 +297,11 @@ func TestCacheKV(t *testing.T) {
 		defer testutil.CleanupPath(t, testPath)
 		testFunc(NewBoltDB(cfg), t)
 	})
+	t.Run("LevelDB", func(t *testing.T) {
+		testutil.CleanupPath(t, testPath)
+		defer testutil.CleanupPath(t, testPath)
+		testFunc(NewLevelDB(cfg), t)
+	})
 
 }
 
@@ -323,4 +339,81 @@ func TestDeleteBucket(t *testing.T) {
 		defer testutil.CleanupPath(t, testPath)
 		testFunc(NewBoltDB(cfg), t)
 	})
+	t.Run("LevelDB", func(t *testing.T) {
+		testutil.CleanupPath(t, testPath)
+		defer testutil.CleanupPath(t, testPath)
+		testFunc(NewLevelDB(cfg), t)
+	})
+}
+
+func TestForEach(t *testing.T) {
+	testFunc := func(kv KVStoreWithForEach, t *testing.T) {
+		require := require.New(t)
+
+		require.NoError(kv.Start(context.Background()))
+		defer func() {
+			require.NoError(kv.Stop(context.Background()))
+		}()
+
+		require.Equal(ErrNotExist, errors.Cause(kv.ForEach(bucket1, nil, func(k, v []byte) error {
+			return nil
+		})))
+		for i := range testK1 {
+			require.NoError(kv.Put(bucket1, testK1[i], testV1[i]))
+			require.NoError(kv.Put(bucket2, testK2[i], testV2[i]))
+		}
+		buckets, err := kv.GetBucketByPrefix(nil)
+		require.NoError(err)
+		require.ElementsMatch([][]byte{[]byte(bucket1), []byte(bucket2)}, buckets)
+
+		var keys, values [][]byte
+		require.NoError(kv.ForEach(bucket1, testK1[1], func(k, v []byte) error {
+			keys = append(keys, append([]byte{}, k...))
+			values = append(values, append([]byte{}, v...))
+			return nil
+		}))
+		require.Equal(testK1[1:], keys)
+		require.Equal(testV1[1:], values)
+
+		errStop := errors.New("stop")
+		count := 0
+		require.Equal(errStop, kv.ForEach(bucket2, nil, func(k, v []byte) error {
+			count++
+			return errStop
+		}))
+		require.Equal(1, count)
+	}
+
+	path := "test-for-each.bolt"
+	testFile, _ := ioutil.TempFile(os.TempDir(), path)
+	testPath := testFile.Name()
+	cfg.DbPath = testPath
+	t.Run("Bolt DB", func(t *testing.T) {
+		testutil.CleanupPath(t, testPath)
+		defer testutil.CleanupPath(t, testPath)
+		testFunc(NewBoltDB(cfg).(KVStoreWithForEach), t)
+	})
+	t.Run("LevelDB", func(t *testing.T) {
+		testutil.CleanupPath(t, testPath)
+		defer testutil.CleanupPath(t, testPath)
+		testFunc(NewLevelDB(cfg).(KVStoreWithForEach), t)
+	})
+}
+
+func TestNewKVStore(t *testing.T) {
+	require := require.New(t)
+
+	cfg := config.Default.DB
+	cfg.DbPath = "chain.db"
+	_, ok := NewKVStore(cfg).(*boltDB)
+	require.True(ok)
+	cfg.Backend = config.LevelDBBackend
+	_, ok = NewKVStore(cfg).(*levelDB)
+	require.True(ok)
+	cfg.BackendByPath = map[string]string{"chain.db": config.BoltDBBackend}
+	_, ok = NewKVStore(cfg).(*boltDB)
+	require.True(ok)
+	cfg.DbPath = "trie.db"
+	_, ok = NewKVStore(cfg).(*levelDB)
+	require.True(ok)
 }
@@ -11,8 +11,6 @@ import (
 	"context"
 	"sync"
 
-	"github.com/golang/protobuf/proto"
-	"github.com/iotexproject/iotex-core/db/trie/triepb"
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/iotex-core/db"
@@ -135,6 +133,44 @@ func (tr *branchRootTrie) Upsert(key []byte, value []byte) error {
 	return nil
 }
 
+func (tr *branchRootTrie) Prove(key []byte) ([][]byte, error) {
+	trieMtc.WithLabelValues("root", "Prove").Inc()
+	kt, err := tr.checkKeyType(key)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		proof  [][]byte
+		node   Node = tr.root
+		offset int
+	)
+	for node != nil {
+		proof = append(proof, node.serialize())
+		switch n := node.(type) {
+		case *branchNode:
+			h, ok := n.hashes[kt[offset]]
+			if !ok {
+				return proof, nil
+			}
+			if node, err = tr.loadNodeFromDB(h); err != nil {
+				return nil, err
+			}
+			offset++
+		case *extensionNode:
+			if int(n.commonPrefixLength(kt[offset:])) != len(n.path) {
+				return proof, nil
+			}
+			if node, err = n.child(tr); err != nil {
+				return nil, err
+			}
+			offset += len(n.path)
+		default:
+			node = nil
+		}
+	}
+	return proof, nil
+}
+
 func (tr *branchRootTrie) DB() KVStore {
 	return tr.kvStore
 }
@@ -168,20 +204,7 @@ func (tr *branchRootTrie) loadNodeFromDB(key []byte) (Node, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get key %x", key)
 	}
-	pb := triepb.NodePb{}
-	if err := proto.Unmarshal(s, &pb); err != nil {
-		return nil, err
-	}
-	if pbBranch := pb.GetBranch(); pbBranch != nil {
-		return newBranchNodeFromProtoPb(pbBranch), nil
-	}
-	if pbLeaf := pb.GetLeaf(); pbLeaf != nil {
-		return newLeafNodeFromProtoPb(pbLeaf), nil
-	}
-	if pbExtend := pb.GetExtend(); pbExtend != nil {
-		return newExtensionNodeFromProtoPb(pbExtend), nil
-	}
-	return nil, errors.New("invalid node type")
+	return deserializeNode(s)
 }
 
 func (tr *branchRootTrie) isEmptyRootHash(h []byte) bool {
@@ -0,0 +1,50 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package trie
+
+// NodeIterator goes through all the nodes under the root of a trie in depth-first order
+type NodeIterator struct {
+	tr    Trie
+	stack [][]byte
+	last  Node
+}
+
+// NewNodeIterator returns a new node iterator
+func NewNodeIterator(tr Trie) *NodeIterator {
+	return &NodeIterator{tr: tr, stack: [][]byte{tr.RootHash()}}
+}
+
+// Next returns the hash of the next node and the node. The children of the node returned last are visited before its
+// siblings, unless SkipChildren is called.
+func (ni *NodeIterator) Next() ([]byte, Node, error) {
+	switch n := ni.last.(type) {
+	case *branchNode:
+		for _, h := range n.hashes {
+			ni.stack = append(ni.stack, h)
+		}
+	case *extensionNode:
+		ni.stack = append(ni.stack, n.childHash)
+	}
+	ni.last = nil
+	size := len(ni.stack)
+	if size == 0 {
+		return nil, nil, ErrEndOfIterator
+	}
+	h := ni.stack[size-1]
+	ni.stack = ni.stack[:size-1]
+	node, err := ni.tr.loadNodeFromDB(h)
+	if err != nil {
+		return nil, nil, err
+	}
+	ni.last = node
+	return h, node, nil
+}
+
+// SkipChildren skips the children of the node returned last
+func (ni *NodeIterator) SkipChildren() {
+	ni.last = nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package trie
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidProof indicates a proof which does not match the root hash or the key
+var ErrInvalidProof = errors.New("invalid proof")
+
+// VerifyProof verifies a proof returned by Trie.Prove against the root hash, with the hash function of the trie. It
+// returns the value of the key if the proof shows the key exists, and ErrNotExist if the proof shows the key does not.
+func VerifyProof(rootHash []byte, key []byte, proof [][]byte, hashFunc HashFunc) ([]byte, error) {
+	expected := rootHash
+	offset := 0
+	for i, s := range proof {
+		if !bytes.Equal(hashFunc(s), expected) {
+			return nil, errors.Wrapf(ErrInvalidProof, "hash of node %d does not match", i)
+		}
+		node, err := deserializeNode(s)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidProof, "failed to deserialize node %d: %v", i, err)
+		}
+		last := i == len(proof)-1
+		switch n := node.(type) {
+		case *branchNode:
+			if offset >= len(key) {
+				return nil, errors.Wrapf(ErrInvalidProof, "branch node %d is deeper than the key", i)
+			}
+			h, ok := n.hashes[key[offset]]
+			if !ok {
+				if last {
+					return nil, ErrNotExist
+				}
+				return nil, errors.Wrapf(ErrInvalidProof, "branch node %d has no child for the key", i)
+			}
+			expected = h
+			offset++
+		case *extensionNode:
+			if len(n.path) > len(key)-offset || !bytes.Equal(n.path, key[offset:offset+len(n.path)]) {
+				if last {
+					return nil, ErrNotExist
+				}
+				return nil, errors.Wrapf(ErrInvalidProof, "path of extension node %d does not match the key", i)
+			}
+			expected = n.childHash
+			offset += len(n.path)
+		case *leafNode:
+			if !last {
+				return nil, errors.Wrapf(ErrInvalidProof, "leaf node %d is not the last one", i)
+			}
+			if len(n.key) != len(key) || !bytes.Equal(n.key[:offset], key[:offset]) {
+				return nil, errors.Wrap(ErrInvalidProof, "key of leaf node does not match the path")
+			}
+			if !bytes.Equal(n.key, key) {
+				return nil, ErrNotExist
+			}
+			return n.value, nil
+		}
+	}
+	return nil, errors.Wrap(ErrInvalidProof, "incomplete proof")
+}
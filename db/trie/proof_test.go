@@ -0,0 +1,89 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package trie
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProof(t *testing.T) {
+	require := require.New(t)
+
+	tr, err := NewTrie(KeyLengthOption(8))
+	require.NoError(err)
+	require.NoError(tr.Start(context.Background()))
+	defer func() { require.NoError(tr.Stop(context.Background())) }()
+
+	// empty trie
+	proof, err := tr.Prove(cat)
+	require.NoError(err)
+	require.Equal(1, len(proof))
+	_, err = VerifyProof(tr.RootHash(), cat, proof, DefaultHashFunc)
+	require.Equal(ErrNotExist, err)
+
+	keys := [][]byte{ham, car, cat, rat, egg, dog, fox, cow}
+	for i, k := range keys {
+		require.NoError(tr.Upsert(k, testV[i]))
+	}
+	require.NoError(tr.Delete(rat))
+	root := tr.RootHash()
+
+	// existing keys
+	for i, k := range keys {
+		if i == 3 {
+			continue
+		}
+		proof, err := tr.Prove(k)
+		require.NoError(err)
+		v, err := VerifyProof(root, k, proof, DefaultHashFunc)
+		require.NoError(err)
+		require.Equal(testV[i], v)
+	}
+
+	// absent keys, which end at a branch, an extension and a leaf respectively
+	for _, k := range [][]byte{rat, ant, {1, 2, 3, 4, 5, 6, 0, 0}, {1, 2, 5, 6, 7, 8, 9, 1}} {
+		proof, err := tr.Prove(k)
+		require.NoError(err)
+		_, err = VerifyProof(root, k, proof, DefaultHashFunc)
+		require.Equal(ErrNotExist, err)
+	}
+
+	// invalid proofs
+	proof, err = tr.Prove(cat)
+	require.NoError(err)
+	require.True(len(proof) > 2)
+	_, err = VerifyProof(root, car, proof, DefaultHashFunc)
+	require.Equal(ErrInvalidProof, errors.Cause(err))
+	_, err = VerifyProof(root, cat, proof[:len(proof)-1], DefaultHashFunc)
+	require.Equal(ErrInvalidProof, errors.Cause(err))
+	_, err = VerifyProof(root, cat, proof[1:], DefaultHashFunc)
+	require.Equal(ErrInvalidProof, errors.Cause(err))
+	tampered := append([][]byte{}, proof...)
+	tampered[len(proof)-1] = append([]byte{}, proof[len(proof)-1]...)
+	tampered[len(proof)-1][len(tampered[len(proof)-1])-1]++
+	_, err = VerifyProof(root, cat, tampered, DefaultHashFunc)
+	require.Equal(ErrInvalidProof, errors.Cause(err))
+	_, err = VerifyProof(root, cat, proof, func(data []byte) []byte {
+		return DefaultHashFunc(append([]byte{1}, data...))
+	})
+	require.Equal(ErrInvalidProof, errors.Cause(err))
+
+	// a proof stays valid against the old root after the trie is updated
+	require.NoError(tr.Upsert(cat, []byte("tiger")))
+	v, err := VerifyProof(root, cat, proof, DefaultHashFunc)
+	require.NoError(err)
+	require.Equal(testV[2], v)
+	_, err = VerifyProof(tr.RootHash(), cat, proof, DefaultHashFunc)
+	require.Equal(ErrInvalidProof, errors.Cause(err))
+
+	_, err = tr.Prove([]byte{1, 2, 3})
+	require.Error(err)
+}
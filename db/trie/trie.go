@@ -54,6 +54,9 @@ type Trie interface {
 	Get([]byte) ([]byte, error)
 	// Delete deletes an entry
 	Delete([]byte) error
+	// Prove returns the serialized nodes on the path from the root to the key. If the key does not exist, the path
+	// ends at the node where it diverges from the key, which proves the absence of the key.
+	Prove([]byte) ([][]byte, error)
 	// RootHash returns trie's root hash
 	RootHash() []byte
 	// SetRootHash sets a new root to trie
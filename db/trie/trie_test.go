@@ -568,3 +568,53 @@ func TestPressure(t *testing.T) {
 	require.NoError(tr.Stop(context.Background()))
 	t.Logf("Warning: test %d entries", c)
 }
+
+func TestNodeIterator(t *testing.T) {
+	require := require.New(t)
+
+	trieDB := newInMemKVStore().(*inMemKVStore)
+	tr, err := NewTrie(KVStoreOption(trieDB), KeyLengthOption(8))
+	require.NoError(err)
+	require.NoError(tr.Start(context.Background()))
+	keys := [][]byte{ham, car, cat, rat, egg, dog, fox, cow}
+	for i, k := range keys {
+		require.NoError(tr.Upsert(k, testV[i]))
+	}
+	require.NoError(tr.Delete(rat))
+
+	// all the nodes in DB are reachable from the root
+	nodes := map[mKeyType]bool{}
+	leaves := map[string][]byte{}
+	iter := NewNodeIterator(tr)
+	for {
+		h, n, err := iter.Next()
+		if err == ErrEndOfIterator {
+			break
+		}
+		require.NoError(err)
+		require.False(nodes[castKeyType(h)])
+		nodes[castKeyType(h)] = true
+		if n.Type() == LEAF {
+			leaves[string(n.Key())] = n.Value()
+		}
+	}
+	require.Equal(len(trieDB.kvpairs), len(nodes))
+	require.Equal(7, len(leaves))
+	for i, k := range keys {
+		if i == 3 {
+			continue
+		}
+		require.Equal(testV[i], leaves[string(k)])
+	}
+
+	// skip the children of the root
+	iter = NewNodeIterator(tr)
+	h, n, err := iter.Next()
+	require.NoError(err)
+	require.Equal(tr.RootHash(), h)
+	require.Equal(BRANCH, n.Type())
+	iter.SkipChildren()
+	_, _, err = iter.Next()
+	require.Equal(ErrEndOfIterator, err)
+	require.NoError(tr.Stop(context.Background()))
+}
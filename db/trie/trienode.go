@@ -6,6 +6,13 @@
 
 package trie
 
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/db/trie/triepb"
+)
+
 type (
 	keyType []byte
 
@@ -50,3 +57,21 @@ func commonPrefixLength(key1, key2 []byte) uint8 {
 
 	return match
 }
+
+// deserializeNode decodes a node from its serialized data
+func deserializeNode(s []byte) (Node, error) {
+	pb := triepb.NodePb{}
+	if err := proto.Unmarshal(s, &pb); err != nil {
+		return nil, err
+	}
+	if pbBranch := pb.GetBranch(); pbBranch != nil {
+		return newBranchNodeFromProtoPb(pbBranch), nil
+	}
+	if pbLeaf := pb.GetLeaf(); pbLeaf != nil {
+		return newLeafNodeFromProtoPb(pbLeaf), nil
+	}
+	if pbExtend := pb.GetExtend(); pbExtend != nil {
+		return newExtensionNodeFromProtoPb(pbExtend), nil
+	}
+	return nil, errors.New("invalid node type")
+}
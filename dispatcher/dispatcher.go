@@ -19,6 +19,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/p2p"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	goproto "github.com/iotexproject/iotex-proto/golang"
@@ -32,6 +33,7 @@ type Subscriber interface {
 	HandleBlock(context.Context, *iotextypes.Block) error
 	HandleBlockSync(context.Context, *iotextypes.Block) error
 	HandleSyncRequest(context.Context, peerstore.PeerInfo, *iotexrpc.BlockSync) error
+	HandleBlockBatch(context.Context, *iotexrpc.BlockBatch) error
 	HandleConsensusMsg(*iotextypes.ConsensusMessage) error
 }
 
@@ -84,6 +86,17 @@ func (m blockSyncMsg) ChainID() uint32 {
 	return m.chainID
 }
 
+// blockBatchMsg packages a proto block batch message.
+type blockBatchMsg struct {
+	ctx     context.Context
+	chainID uint32
+	batch   *iotexrpc.BlockBatch
+}
+
+func (m blockBatchMsg) ChainID() uint32 {
+	return m.chainID
+}
+
 // actionMsg packages a proto action message.
 type actionMsg struct {
 	ctx     context.Context
@@ -182,6 +195,8 @@ loop:
 				d.handleBlockMsg(msg)
 			case *blockSyncMsg:
 				d.handleBlockSyncMsg(msg)
+			case *blockBatchMsg:
+				d.handleBlockBatchMsg(msg)
 
 			default:
 				log.L().Warn("Invalid message type in block handler.", zap.Any("msg", msg))
@@ -241,6 +256,20 @@ func (d *IotxDispatcher) handleBlockSyncMsg(m *blockSyncMsg) {
 	}
 }
 
+// handleBlockBatchMsg handles batched block sync responses from peers.
+func (d *IotxDispatcher) handleBlockBatchMsg(m *blockBatchMsg) {
+	d.subscribersMU.RLock()
+	defer d.subscribersMU.RUnlock()
+	if subscriber, ok := d.subscribers[m.ChainID()]; ok {
+		d.updateEventAudit(iotexrpc.MessageType_BLOCK_BATCH)
+		if err := subscriber.HandleBlockBatch(m.ctx, m.batch); err != nil {
+			log.L().Error("Fail to handle the block batch.", zap.Error(err))
+		}
+	} else {
+		log.L().Info("No subscriber specified in the dispatcher.", zap.Uint32("chainID", m.ChainID()))
+	}
+}
+
 // dispatchAction adds the passed action message to the news handling queue.
 func (d *IotxDispatcher) dispatchAction(ctx context.Context, chainID uint32, msg proto.Message) {
 	if atomic.LoadInt32(&d.shutdown) != 0 {
@@ -278,6 +307,18 @@ func (d *IotxDispatcher) dispatchBlockSyncReq(ctx context.Context, chainID uint3
 	})
 }
 
+// dispatchBlockBatch adds the passed block batch message to the news handling queue.
+func (d *IotxDispatcher) dispatchBlockBatch(ctx context.Context, chainID uint32, msg proto.Message) {
+	if atomic.LoadInt32(&d.shutdown) != 0 {
+		return
+	}
+	d.enqueueEvent(&blockBatchMsg{
+		ctx:     ctx,
+		chainID: chainID,
+		batch:   (msg).(*iotexrpc.BlockBatch),
+	})
+}
+
 // HandleBroadcast handles incoming broadcast message
 func (d *IotxDispatcher) HandleBroadcast(ctx context.Context, chainID uint32, message proto.Message) {
 	msgType, err := goproto.GetTypeFromRPCMsg(message)
@@ -313,11 +354,14 @@ func (d *IotxDispatcher) HandleTell(ctx context.Context, chainID uint32, peer pe
 	if err != nil {
 		log.L().Warn("Unexpected message handled by HandleTell.", zap.Error(err))
 	}
+	ctx = p2p.WithPeer(ctx, peer)
 	switch msgType {
 	case iotexrpc.MessageType_BLOCK_REQUEST:
 		d.dispatchBlockSyncReq(ctx, chainID, peer, message)
 	case iotexrpc.MessageType_BLOCK:
 		d.dispatchBlockCommit(ctx, chainID, message)
+	case iotexrpc.MessageType_BLOCK_BATCH:
+		d.dispatchBlockBatch(ctx, chainID, message)
 	default:
 		log.L().Warn("Unexpected msgType handled by HandleTell.", zap.Any("msgType", msgType))
 	}
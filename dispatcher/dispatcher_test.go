@@ -52,6 +52,7 @@ func setTestCase() []proto.Message {
 		&iotextypes.ConsensusMessage{},
 		&iotextypes.Block{},
 		&iotexrpc.BlockSync{},
+		&iotexrpc.BlockBatch{},
 		&testingpb.TestPayload{},
 	}
 }
@@ -96,6 +97,10 @@ func (s *DummySubscriber) HandleSyncRequest(context.Context, peerstore.PeerInfo,
 	return nil
 }
 
+func (s *DummySubscriber) HandleBlockBatch(context.Context, *iotexrpc.BlockBatch) error {
+	return nil
+}
+
 func (s *DummySubscriber) HandleAction(context.Context, *iotextypes.Action) error { return nil }
 
 func (s *DummySubscriber) HandleConsensusMsg(*iotextypes.ConsensusMessage) error { return nil }
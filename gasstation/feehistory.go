@@ -0,0 +1,151 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package gasstation
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+)
+
+type (
+	// FeeHistory is the gas usage and the gas prices of a range of blocks
+	FeeHistory struct {
+		OldestBlock uint64
+		// GasUsedRatio is the ratio of the gas consumed to the gas limit of each block
+		GasUsedRatio []float64
+		// Rewards are the gas prices at the requested percentiles of each block, weighted by the gas consumed
+		Rewards [][]*big.Int
+	}
+
+	actionGas struct {
+		price *big.Int
+		gas   uint64
+	}
+
+	// gasStats is the gas usage of a block
+	gasStats struct {
+		// acts are the user actions sorted by gas price
+		acts    []actionGas
+		gasUsed uint64
+	}
+)
+
+// FeeHistory returns the fee history of the blockCount blocks ending at newestBlock, at most FeeHistoryMaxCount blocks
+func (gs *GasStation) FeeHistory(blockCount, newestBlock uint64, percentiles []float64) (*FeeHistory, error) {
+	if blockCount == 0 {
+		return nil, errors.New("block count should be positive")
+	}
+	if newestBlock == 0 || newestBlock > gs.bc.TipHeight() {
+		return nil, errors.Errorf("invalid newest block %d", newestBlock)
+	}
+	for i, p := range percentiles {
+		if p < 0 || p > 100 {
+			return nil, errors.Errorf("invalid percentile %f", p)
+		}
+		if i > 0 && p < percentiles[i-1] {
+			return nil, errors.Errorf("percentiles should be in ascending order")
+		}
+	}
+	if blockCount > gs.cfg.GasStation.FeeHistoryMaxCount {
+		blockCount = gs.cfg.GasStation.FeeHistoryMaxCount
+	}
+	if blockCount > newestBlock {
+		blockCount = newestBlock
+	}
+
+	gasLimit := gs.bc.Genesis().BlockGasLimit
+	history := &FeeHistory{
+		OldestBlock:  newestBlock - blockCount + 1,
+		GasUsedRatio: make([]float64, 0, blockCount),
+	}
+	if len(percentiles) > 0 {
+		history.Rewards = make([][]*big.Int, 0, blockCount)
+	}
+	for height := history.OldestBlock; height <= newestBlock; height++ {
+		stats, err := gs.blockGasStats(height)
+		if err != nil {
+			return nil, err
+		}
+		ratio := float64(0)
+		if gasLimit > 0 {
+			ratio = float64(stats.gasUsed) / float64(gasLimit)
+		}
+		history.GasUsedRatio = append(history.GasUsedRatio, ratio)
+		if len(percentiles) > 0 {
+			history.Rewards = append(history.Rewards, stats.rewards(percentiles))
+		}
+	}
+	return history, nil
+}
+
+// blockGasStats returns the gas usage of the block at the height, which is cached since a committed block never
+// changes
+func (gs *GasStation) blockGasStats(height uint64) (*gasStats, error) {
+	if stats, ok := gs.blockStats.Get(height); ok {
+		return stats.(*gasStats), nil
+	}
+	dao := gs.bc.BlockDAO()
+	blk, err := dao.GetBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := dao.GetReceipts(height)
+	if err != nil {
+		return nil, err
+	}
+	gasConsumed := make(map[hash.Hash256]uint64, len(receipts))
+	stats := &gasStats{}
+	for _, r := range receipts {
+		gasConsumed[r.ActionHash] = r.GasConsumed
+		stats.gasUsed += r.GasConsumed
+	}
+	for _, act := range blk.Actions {
+		if gs.IsSystemAction(act) {
+			continue
+		}
+		stats.acts = append(stats.acts, actionGas{
+			price: act.GasPrice(),
+			gas:   gasConsumed[act.Hash()],
+		})
+	}
+	sort.SliceStable(stats.acts, func(i, j int) bool {
+		return stats.acts[i].price.Cmp(stats.acts[j].price) < 0
+	})
+	gs.blockStats.Add(height, stats)
+	return stats, nil
+}
+
+// rewards returns the gas prices at the percentiles, which are zero if there is no user action in the block
+func (s *gasStats) rewards(percentiles []float64) []*big.Int {
+	rewards := make([]*big.Int, len(percentiles))
+	if len(s.acts) == 0 {
+		for i := range rewards {
+			rewards[i] = big.NewInt(0)
+		}
+		return rewards
+	}
+	var total uint64
+	for _, act := range s.acts {
+		total += act.gas
+	}
+	var (
+		idx        int
+		cumulative = s.acts[0].gas
+	)
+	for i, p := range percentiles {
+		threshold := uint64(float64(total) * p / 100)
+		for cumulative < threshold && idx < len(s.acts)-1 {
+			idx++
+			cumulative += s.acts[idx].gas
+		}
+		rewards[i] = new(big.Int).Set(s.acts[idx].price)
+	}
+	return rewards
+}
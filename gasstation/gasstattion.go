@@ -9,83 +9,159 @@ package gasstation
 import (
 	"math/big"
 	"sort"
+	"sync"
 
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/cache"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
 )
 
+// ErrExecutionFailed indicates the execution fails even with the max gas limit
+var ErrExecutionFailed = errors.New("execution simulation failed")
+
 // GasStation provide gas related api
 type GasStation struct {
-	bc  blockchain.Blockchain
-	cfg config.API
+	bc         blockchain.Blockchain
+	ap         actpool.ActPool
+	cfg        config.API
+	blockStats *cache.ThreadSafeLruCache
+	mutex      sync.Mutex
+	tipHeight  uint64
+	tipPrices  *GasPrices
+}
+
+// GasPrices are the suggested gas prices for the actions to be included slowly, normally and quickly
+type GasPrices struct {
+	Slow     uint64
+	Standard uint64
+	Fast     uint64
 }
 
 // NewGasStation creates a new gas station
-func NewGasStation(bc blockchain.Blockchain, cfg config.API) *GasStation {
+func NewGasStation(bc blockchain.Blockchain, ap actpool.ActPool, cfg config.API) *GasStation {
+	cacheSize := cfg.GasStation.SuggestBlockWindow
+	if cfg.GasStation.FeeHistoryMaxCount > uint64(cacheSize) {
+		cacheSize = int(cfg.GasStation.FeeHistoryMaxCount)
+	}
 	return &GasStation{
-		bc:  bc,
-		cfg: cfg,
+		bc:         bc,
+		ap:         ap,
+		cfg:        cfg,
+		blockStats: cache.NewThreadSafeLruCache(cacheSize),
 	}
 }
 
 //IsSystemAction determine whether input action belongs to system action
 func (gs *GasStation) IsSystemAction(act action.SealedEnvelope) bool {
-	switch act.Action().(type) {
-	case *action.GrantReward:
-		return true
-	case *action.PutPollResult:
-		return true
-	default:
-		return false
-	}
+	return protocol.IsSystemAction(act.Action())
 }
 
 // SuggestGasPrice suggest gas price
 func (gs *GasStation) SuggestGasPrice() (uint64, error) {
-	var smallestPrices []*big.Int
+	prices, err := gs.SuggestGasPrices()
+	if err != nil {
+		return gs.cfg.GasStation.DefaultGas, err
+	}
+	return prices.Standard, nil
+}
+
+// SuggestGasPrices suggests the gas prices of the tiers, based on the smallest gas prices of the recent blocks and
+// raised if the actpool is congested
+func (gs *GasStation) SuggestGasPrices() (*GasPrices, error) {
+	prices, err := gs.historyGasPrices()
+	if err != nil {
+		return nil, err
+	}
+	pressure := gs.pressure()
+	if pressure <= gs.cfg.GasStation.CongestionThreshold {
+		return prices, nil
+	}
+	// the actions paying the slow price are fine to wait until the congestion is gone
+	bump := 100 + pressure - gs.cfg.GasStation.CongestionThreshold
+	return &GasPrices{
+		Slow:     prices.Slow,
+		Standard: prices.Standard * bump / 100,
+		Fast:     prices.Fast * bump / 100,
+	}, nil
+}
+
+// historyGasPrices returns the gas prices suggested by the recent blocks, which are cached until the tip changes
+func (gs *GasStation) historyGasPrices() (*GasPrices, error) {
+	gs.mutex.Lock()
+	defer gs.mutex.Unlock()
+
 	tip := gs.bc.TipHeight()
+	if gs.tipPrices != nil && gs.tipHeight == tip {
+		return gs.tipPrices, nil
+	}
 
+	var smallestPrices []*big.Int
 	endBlockHeight := uint64(0)
 	if tip > uint64(gs.cfg.GasStation.SuggestBlockWindow) {
 		endBlockHeight = tip - uint64(gs.cfg.GasStation.SuggestBlockWindow)
 	}
-
 	for height := tip; height > endBlockHeight; height-- {
-		blk, err := gs.bc.BlockDAO().GetBlockByHeight(height)
+		stats, err := gs.blockGasStats(height)
 		if err != nil {
-			return gs.cfg.GasStation.DefaultGas, err
+			return nil, err
 		}
-		if len(blk.Actions) == 0 {
+		if len(stats.acts) == 0 {
 			continue
 		}
-		if len(blk.Actions) == 1 && gs.IsSystemAction(blk.Actions[0]) {
-			continue
-		}
-		smallestPrice := blk.Actions[0].GasPrice()
-		for _, act := range blk.Actions {
-			if gs.IsSystemAction(act) {
-				continue
-			}
-			if smallestPrice.Cmp(act.GasPrice()) == 1 {
-				smallestPrice = act.GasPrice()
+		smallestPrices = append(smallestPrices, stats.acts[0].price)
+	}
+
+	prices := &GasPrices{
+		Slow:     gs.cfg.GasStation.DefaultGas,
+		Standard: gs.cfg.GasStation.DefaultGas,
+		Fast:     gs.cfg.GasStation.DefaultGas,
+	}
+	if len(smallestPrices) > 0 {
+		sort.Sort(bigIntArray(smallestPrices))
+		percentile := func(p int) uint64 {
+			gasPrice := smallestPrices[(len(smallestPrices)-1)*p/100].Uint64()
+			if gasPrice < gs.cfg.GasStation.DefaultGas {
+				gasPrice = gs.cfg.GasStation.DefaultGas
 			}
+			return gasPrice
+		}
+		// the tiers are clamped around the standard percentile, which could be configured lower than the default slow
+		// percentile or higher than the default fast one
+		standard := gs.cfg.GasStation.Percentile
+		slow, fast := gs.cfg.GasStation.SlowPercentile, gs.cfg.GasStation.FastPercentile
+		if slow > standard {
+			slow = standard
 		}
-		smallestPrices = append(smallestPrices, smallestPrice)
+		if fast < standard {
+			fast = standard
+		}
+		prices.Slow = percentile(slow)
+		prices.Standard = percentile(standard)
+		prices.Fast = percentile(fast)
 	}
+	gs.tipHeight = tip
+	gs.tipPrices = prices
+	return prices, nil
+}
 
-	if len(smallestPrices) == 0 {
-		// return default price
-		return gs.cfg.GasStation.DefaultGas, nil
+// pressure returns the percentage of the actpool gas capacity taken by the pending actions
+func (gs *GasStation) pressure() uint64 {
+	if gs.ap == nil || gs.ap.GetGasCapacity() == 0 {
+		return 0
 	}
-	sort.Sort(bigIntArray(smallestPrices))
-	gasPrice := smallestPrices[(len(smallestPrices)-1)*gs.cfg.GasStation.Percentile/100].Uint64()
-	if gasPrice < gs.cfg.GasStation.DefaultGas {
-		gasPrice = gs.cfg.GasStation.DefaultGas
+	pressure := new(big.Int).Mul(new(big.Int).SetUint64(gs.ap.GetGasSize()), big.NewInt(100))
+	pressure.Div(pressure, new(big.Int).SetUint64(gs.ap.GetGasCapacity()))
+	if !pressure.IsUint64() || pressure.Uint64() > 100 {
+		return 100
 	}
-	return gasPrice, nil
+	return pressure.Uint64()
 }
 
 // EstimateGasForAction estimate gas for action
@@ -100,18 +176,70 @@ func (gs *GasStation) EstimateGasForAction(actPb *iotextypes.Action) (uint64, er
 		if err != nil {
 			return 0, err
 		}
+		return gs.EstimateExecutionGas(callerAddr, sc)
+	}
+	gas, err := selp.IntrinsicGas()
+	if err != nil {
+		return 0, err
+	}
+	return gas, nil
+}
 
-		_, receipt, err := blockchain.SimulateExecution(gs.bc, callerAddr, sc)
+// EstimateExecutionGas estimates the gas limit of the execution, by searching for the lowest gas limit between the
+// intrinsic gas and the action gas limit with which the simulation succeeds. The gas consumed with the max gas limit is
+// not necessarily enough, because of the refunds and the gas-dependent branches.
+func (gs *GasStation) EstimateExecutionGas(caller address.Address, ex *action.Execution) (uint64, error) {
+	intrinsicGas, err := ex.IntrinsicGas()
+	if err != nil {
+		return 0, err
+	}
+	high := gs.bc.Genesis().ActionGasLimit
+	if high < intrinsicGas {
+		return 0, errors.Errorf("intrinsic gas %d exceeds the action gas limit %d", intrinsicGas, high)
+	}
+	retval, receipt, err := gs.simulateExecution(caller, ex, high)
+	if err != nil {
+		return 0, err
+	}
+	if receipt.Status != uint64(iotextypes.ReceiptStatus_Success) {
+		// the reason is decoded out of the data returned by the execution
+		if receipt.Status == uint64(iotextypes.ReceiptStatus_ErrExecutionReverted) {
+			if reason := evm.RevertReason(retval); reason != "" {
+				return 0, errors.Wrapf(ErrExecutionFailed, "execution reverted: %s", reason)
+			}
+		}
+		return 0, errors.Wrapf(ErrExecutionFailed, "receipt status %d", receipt.Status)
+	}
+	// the gas limit lower than the gas consumed never succeeds
+	low := intrinsicGas - 1
+	if receipt.GasConsumed > intrinsicGas {
+		low = receipt.GasConsumed - 1
+	}
+	for low+1 < high {
+		mid := low + (high-low)/2
+		_, receipt, err := gs.simulateExecution(caller, ex, mid)
 		if err != nil {
 			return 0, err
 		}
-		return receipt.GasConsumed, nil
+		if receipt.Status == uint64(iotextypes.ReceiptStatus_Success) {
+			high = mid
+		} else {
+			low = mid
+		}
 	}
-	gas, err := selp.IntrinsicGas()
+	return high, nil
+}
+
+func (gs *GasStation) simulateExecution(
+	caller address.Address,
+	ex *action.Execution,
+	gasLimit uint64,
+) ([]byte, *action.Receipt, error) {
+	sc, err := action.NewExecution(ex.Contract(), ex.Nonce(), ex.Amount(), gasLimit, big.NewInt(0), ex.Data())
 	if err != nil {
-		return 0, err
+		return nil, nil, err
 	}
-	return gas, nil
+	return blockchain.SimulateExecution(gs.bc, caller, sc)
 }
 
 type bigIntArray []*big.Int
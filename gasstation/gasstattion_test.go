@@ -8,12 +8,15 @@ package gasstation
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"testing"
 
 	"github.com/iotexproject/iotex-core/pkg/unit"
 
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/action"
@@ -28,13 +31,14 @@ import (
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/version"
 	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_actpool"
 	"github.com/iotexproject/iotex-core/testutil"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
 
 func TestNewGasStation(t *testing.T) {
 	require := require.New(t)
-	require.NotNil(NewGasStation(nil, config.Default.API))
+	require.NotNil(NewGasStation(nil, nil, config.Default.API))
 }
 func TestSuggestGasPriceForUserAction(t *testing.T) {
 	ctx := context.Background()
@@ -101,13 +105,85 @@ func TestSuggestGasPriceForUserAction(t *testing.T) {
 	height := bc.TipHeight()
 	fmt.Printf("Open blockchain pass, height = %d\n", height)
 
-	gs := NewGasStation(bc, cfg.API)
+	gs := NewGasStation(bc, nil, cfg.API)
 	require.NotNil(t, gs)
 
 	gp, err := gs.SuggestGasPrice()
 	require.NoError(t, err)
 	// i from 10 to 29,gasprice for 20 to 39,60%*20+20=31
 	require.Equal(t, big.NewInt(1).Mul(big.NewInt(int64(31)), big.NewInt(unit.Qev)).Uint64(), gp)
+
+	qev := func(n uint64) uint64 { return n * uint64(unit.Qev) }
+	gps, err := gs.SuggestGasPrices()
+	require.NoError(t, err)
+	require.Equal(t, &GasPrices{Slow: qev(25), Standard: qev(31), Fast: qev(37)}, gps)
+	// the prices are cached until the tip changes
+	require.Equal(t, height, gs.tipHeight)
+	require.Equal(t, gps, gs.tipPrices)
+
+	// the standard and fast prices are raised if the actpool is congested
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ap := mock_actpool.NewMockActPool(ctrl)
+	ap.EXPECT().GetGasCapacity().Return(uint64(1000)).AnyTimes()
+	ap.EXPECT().GetGasSize().Return(uint64(500)).Times(1)
+	ap.EXPECT().GetGasSize().Return(uint64(800)).Times(1)
+	gs.ap = ap
+	gps, err = gs.SuggestGasPrices()
+	require.NoError(t, err)
+	require.Equal(t, &GasPrices{Slow: qev(25), Standard: qev(31), Fast: qev(37)}, gps)
+	gps, err = gs.SuggestGasPrices()
+	require.NoError(t, err)
+	require.Equal(t, &GasPrices{Slow: qev(25), Standard: qev(31) * 130 / 100, Fast: qev(37) * 130 / 100}, gps)
+
+	// fee history
+	history, err := gs.FeeHistory(5, height, []float64{0, 50, 100})
+	require.NoError(t, err)
+	require.Equal(t, height-4, history.OldestBlock)
+	require.Equal(t, 5, len(history.GasUsedRatio))
+	require.Equal(t, 5, len(history.Rewards))
+	for i, ratio := range history.GasUsedRatio {
+		require.True(t, ratio > 0 && ratio <= 1)
+		// each block has one transfer paying 10+(height-1) Qev
+		price := new(big.Int).SetUint64(qev(history.OldestBlock + uint64(i) + 9))
+		require.Equal(t, []*big.Int{price, price, price}, history.Rewards[i])
+	}
+	history, err = gs.FeeHistory(100, 3, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), history.OldestBlock)
+	require.Equal(t, 3, len(history.GasUsedRatio))
+	require.Nil(t, history.Rewards)
+	_, err = gs.FeeHistory(0, height, nil)
+	require.Error(t, err)
+	_, err = gs.FeeHistory(1, height+1, nil)
+	require.Error(t, err)
+	_, err = gs.FeeHistory(1, height, []float64{50, 10})
+	require.Error(t, err)
+
+	// the slow price is clamped to the standard one if the standard percentile is lower than the slow percentile
+	cfg.API.GasStation.Percentile = 20
+	require.NoError(t, config.ValidateAPI(cfg))
+	gs = NewGasStation(bc, nil, cfg.API)
+	gps, err = gs.SuggestGasPrices()
+	require.NoError(t, err)
+	require.Equal(t, &GasPrices{Slow: qev(23), Standard: qev(23), Fast: qev(37)}, gps)
+}
+
+func TestGasStatsRewards(t *testing.T) {
+	require := require.New(t)
+
+	stats := &gasStats{}
+	require.Equal([]*big.Int{big.NewInt(0), big.NewInt(0)}, stats.rewards([]float64{10, 90}))
+
+	stats.acts = []actionGas{
+		{price: big.NewInt(1), gas: 10},
+		{price: big.NewInt(2), gas: 60},
+		{price: big.NewInt(3), gas: 30},
+	}
+	require.Equal(
+		[]*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(2), big.NewInt(2), big.NewInt(3), big.NewInt(3)},
+		stats.rewards([]float64{0, 10, 11, 70, 71, 100}),
+	)
 }
 
 func TestSuggestGasPriceForSystemAction(t *testing.T) {
@@ -157,7 +233,7 @@ func TestSuggestGasPriceForSystemAction(t *testing.T) {
 	height := bc.TipHeight()
 	fmt.Printf("Open blockchain pass, height = %d\n", height)
 
-	gs := NewGasStation(bc, cfg.API)
+	gs := NewGasStation(bc, nil, cfg.API)
 	require.NotNil(t, gs)
 
 	gp, err := gs.SuggestGasPrice()
@@ -176,7 +252,7 @@ func TestEstimateGasForAction(t *testing.T) {
 	bc := blockchain.NewBlockchain(cfg, blkMemDao, blockchain.InMemStateFactoryOption())
 	require.NoError(bc.Start(context.Background()))
 	require.NotNil(bc)
-	gs := NewGasStation(bc, config.Default.API)
+	gs := NewGasStation(bc, nil, config.Default.API)
 	require.NotNil(gs)
 	ret, err := gs.EstimateGasForAction(act)
 	require.NoError(err)
@@ -193,6 +269,48 @@ func TestEstimateGasForAction(t *testing.T) {
 	// base intrinsic gas 10000,plus data size*ExecutionDataGas
 	require.Equal(uint64(10000)+10*action.ExecutionDataGas, ret)
 }
+func TestEstimateExecutionGas(t *testing.T) {
+	require := require.New(t)
+	cfg := config.Default
+	cfg.Genesis.BeringBlockHeight = 0
+	blkMemDao := blockdao.NewBlockDAO(db.NewMemKVStore(), nil, cfg.Chain.CompressBlock, cfg.DB)
+	bc := blockchain.NewBlockchain(cfg, blkMemDao, blockchain.InMemStateFactoryOption())
+	require.NoError(bc.Start(context.Background()))
+	defer func() {
+		require.NoError(bc.Stop(context.Background()))
+	}()
+	gs := NewGasStation(bc, nil, config.Default.API)
+	caller := identityset.Address(27)
+
+	// the deployment sets a storage slot and clears it, whose refund makes the gas consumed not enough
+	data, err := hex.DecodeString("600160005560006000550000")
+	require.NoError(err)
+	ex, err := action.NewExecution(action.EmptyAddress, 1, big.NewInt(0), 0, big.NewInt(0), data)
+	require.NoError(err)
+	gas, err := gs.EstimateExecutionGas(caller, ex)
+	require.NoError(err)
+	_, receipt, err := gs.simulateExecution(caller, ex, gas)
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+	require.True(receipt.GasConsumed < gas)
+	_, receipt, err = gs.simulateExecution(caller, ex, gas-1)
+	require.NoError(err)
+	require.NotEqual(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+
+	// the deployment reverts with the reason "no way"
+	data, err = hex.DecodeString("6064600c60003960646000fd" +
+		"08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000006" +
+		"6e6f207761790000000000000000000000000000000000000000000000000000")
+	require.NoError(err)
+	ex, err = action.NewExecution(action.EmptyAddress, 1, big.NewInt(0), 0, big.NewInt(0), data)
+	require.NoError(err)
+	_, err = gs.EstimateExecutionGas(caller, ex)
+	require.Equal(ErrExecutionFailed, errors.Cause(err))
+	require.Contains(err.Error(), "no way")
+}
+
 func getAction() (act *iotextypes.Action) {
 	pubKey1 := identityset.PrivateKey(28).PublicKey()
 	addr2 := identityset.Address(29).String()
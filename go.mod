@@ -4,6 +4,7 @@ go 1.13
 
 require (
 	github.com/cenkalti/backoff v2.1.1+incompatible
+	github.com/deckarep/golang-set v1.7.1
 	github.com/ethereum/go-ethereum v1.8.27
 	github.com/facebookgo/clock v0.0.0-20150410010913-600d898af40a
 	github.com/go-sql-driver/mysql v1.4.1
@@ -26,9 +27,11 @@ require (
 	github.com/multiformats/go-multiaddr v0.0.2
 	github.com/pkg/errors v0.8.1
 	github.com/prometheus/client_golang v1.0.0
+	github.com/rs/cors v1.7.0
 	github.com/rs/zerolog v1.14.3
 	github.com/spf13/cobra v0.0.4
 	github.com/stretchr/testify v1.3.0
+	github.com/syndtr/goleveldb v1.0.0
 	go.etcd.io/bbolt v1.3.2
 	go.uber.org/automaxprocs v1.2.0
 	go.uber.org/config v1.3.1
@@ -44,3 +47,6 @@ require (
 )
 
 replace github.com/ethereum/go-ethereum => github.com/iotexproject/go-ethereum v0.3.0
+
+// the fields and actions not released by iotex-proto yet are added to the fork under third_party
+replace github.com/iotexproject/iotex-proto => ./third_party/iotex-proto
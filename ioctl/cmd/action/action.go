@@ -47,6 +47,10 @@ var (
 	bytecodeFlag = flag.NewStringVarP("bytecode", "b", "", "set the byte code")
 	yesFlag      = flag.BoolVarP("assume-yes", "y", false, " answer yes for all confirmations")
 	passwordFlag = flag.NewStringVarP("password", "P", "", "input password for account")
+	unsignedFlag = flag.BoolVarP("unsigned", "", false,
+		"print the unsigned action for the keys of a multisig account to sign, instead of sending it")
+	sponsorFlag = flag.NewStringVarP("sponsor", "", "",
+		"print the action signed for the sponsor to pay its gas and send it, instead of sending it")
 )
 
 // ActionCmd represents the action command
@@ -71,12 +75,16 @@ func (m *sendMessage) String() string {
 func init() {
 	ActionCmd.AddCommand(actionHashCmd)
 	ActionCmd.AddCommand(actionTransferCmd)
+	ActionCmd.AddCommand(actionBatchTransferCmd)
 	ActionCmd.AddCommand(actionDeployCmd)
 	ActionCmd.AddCommand(actionInvokeCmd)
 	ActionCmd.AddCommand(actionReadCmd)
 	ActionCmd.AddCommand(actionClaimCmd)
 	ActionCmd.AddCommand(actionDepositCmd)
 	ActionCmd.AddCommand(actionSendRawCmd)
+	ActionCmd.AddCommand(actionResendCmd)
+	ActionCmd.AddCommand(multisigCmd)
+	ActionCmd.AddCommand(actionSponsorCmd)
 	ActionCmd.PersistentFlags().StringVar(&config.ReadConfig.Endpoint, "endpoint",
 		config.ReadConfig.Endpoint, "set endpoint for once")
 	ActionCmd.PersistentFlags().BoolVar(&config.Insecure, "insecure", config.Insecure,
@@ -110,6 +118,8 @@ func registerWriteCommand(cmd *cobra.Command) {
 	nonceFlag.RegisterCommand(cmd)
 	yesFlag.RegisterCommand(cmd)
 	passwordFlag.RegisterCommand(cmd)
+	unsignedFlag.RegisterCommand(cmd)
+	sponsorFlag.RegisterCommand(cmd)
 }
 
 // gasPriceInRau returns the suggest gas price
@@ -196,40 +206,31 @@ func SendRaw(selp *iotextypes.Action) error {
 
 // SendAction sends signed action to blockchain
 func SendAction(elp action.Envelope, signer string) error {
-	var (
-		prvKey           crypto.PrivateKey
-		err              error
-		prvKeyOrPassword string
-	)
-	if !signerIsExist(signer) {
-		output.PrintQuery(fmt.Sprintf("Enter private key #%s:", signer))
-		prvKeyOrPassword, err = util.ReadSecretFromStdin()
-		if err != nil {
-			return output.NewError(output.InputError, "failed to get private key", err)
-		}
-		prvKey, err = crypto.HexStringToPrivateKey(prvKeyOrPassword)
-		if err != nil {
-			return output.NewError(output.InputError, "failed to HexString private key", err)
-		}
-	} else if passwordFlag.Value() == "" {
-		output.PrintQuery(fmt.Sprintf("Enter password #%s:\n", signer))
-		prvKeyOrPassword, err = util.ReadSecretFromStdin()
-		if err != nil {
-			return output.NewError(output.InputError, "failed to get password", err)
-		}
-	} else {
-		prvKeyOrPassword = passwordFlag.Value().(string)
+	if unsignedFlag.Value() == true {
+		return printUnsigned(elp)
 	}
-	prvKey, err = account.KsAccountToPrivateKey(signer, prvKeyOrPassword)
+	sponsor, err := sponsorAddress()
+	if err != nil {
+		return err
+	}
+	prvKey, err := privateKey(signer)
 	if err != nil {
-		return output.NewError(output.KeystoreError, "failed to get private key from keystore", err)
+		return err
 	}
 	defer prvKey.Zero()
-	sealed, err := action.Sign(elp, prvKey)
+	var sealed action.SealedEnvelope
+	if sponsor != nil {
+		sealed, err = action.SignSponsored(elp, prvKey, sponsor)
+	} else {
+		sealed, err = action.Sign(elp, prvKey)
+	}
 	prvKey.Zero()
 	if err != nil {
 		return output.NewError(output.CryptoError, "failed to sign action", err)
 	}
+	if sponsor != nil {
+		return printSigned(sealed)
+	}
 	if err := isBalanceEnough(signer, sealed); err != nil {
 		return output.NewError(0, "failed to pass balance check", err) // TODO: undefined error
 	}
@@ -253,6 +254,76 @@ func SendAction(elp action.Envelope, signer string) error {
 	return SendRaw(selp)
 }
 
+// sponsorAddress returns the address of the sponsor given by flag --sponsor, which is nil if the flag is not set
+func sponsorAddress() (address.Address, error) {
+	sponsor := sponsorFlag.Value().(string)
+	if len(sponsor) == 0 {
+		return nil, nil
+	}
+	addr, err := util.Address(sponsor)
+	if err != nil {
+		return nil, output.NewError(output.AddressError, "failed to get sponsor address", err)
+	}
+	sponsorAddr, err := address.FromString(addr)
+	if err != nil {
+		return nil, output.NewError(output.ConvertError, "invalid sponsor address", err)
+	}
+	return sponsorAddr, nil
+}
+
+// privateKey reads the private key of the signer from the keystore, or from stdin if the signer is not in keystore
+func privateKey(signer string) (crypto.PrivateKey, error) {
+	var (
+		prvKey           crypto.PrivateKey
+		err              error
+		prvKeyOrPassword string
+	)
+	if !signerIsExist(signer) {
+		output.PrintQuery(fmt.Sprintf("Enter private key #%s:", signer))
+		prvKeyOrPassword, err = util.ReadSecretFromStdin()
+		if err != nil {
+			return nil, output.NewError(output.InputError, "failed to get private key", err)
+		}
+		prvKey, err = crypto.HexStringToPrivateKey(prvKeyOrPassword)
+		if err != nil {
+			return nil, output.NewError(output.InputError, "failed to HexString private key", err)
+		}
+	} else if passwordFlag.Value() == "" {
+		output.PrintQuery(fmt.Sprintf("Enter password #%s:\n", signer))
+		prvKeyOrPassword, err = util.ReadSecretFromStdin()
+		if err != nil {
+			return nil, output.NewError(output.InputError, "failed to get password", err)
+		}
+	} else {
+		prvKeyOrPassword = passwordFlag.Value().(string)
+	}
+	prvKey, err = account.KsAccountToPrivateKey(signer, prvKeyOrPassword)
+	if err != nil {
+		return nil, output.NewError(output.KeystoreError, "failed to get private key from keystore", err)
+	}
+	return prvKey, nil
+}
+
+// printUnsigned prints the unsigned action, which the keys of a multisig account sign offline
+func printUnsigned(elp action.Envelope) error {
+	actBytes, err := proto.Marshal(elp.Proto())
+	if err != nil {
+		return output.NewError(output.SerializationError, "failed to marshal action", err)
+	}
+	output.PrintResult(hex.EncodeToString(actBytes))
+	return nil
+}
+
+// printSigned prints the signed action, whose gas a sponsor pays
+func printSigned(sealed action.SealedEnvelope) error {
+	actBytes, err := proto.Marshal(sealed.Proto())
+	if err != nil {
+		return output.NewError(output.SerializationError, "failed to marshal action", err)
+	}
+	output.PrintResult(hex.EncodeToString(actBytes))
+	return nil
+}
+
 // Execute sends signed execution transaction to blockchain
 func Execute(contract string, amount *big.Int, bytecode []byte) error {
 	gasPriceRau, err := gasPriceInRau()
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+	"github.com/iotexproject/iotex-core/ioctl/util"
+)
+
+// actionBatchTransferCmd represents the action batchtransfer command
+var actionBatchTransferCmd = &cobra.Command{
+	Use: "batchtransfer CSV_FILE [DATA]" +
+		" [-s SIGNER] [-n NONCE] [-l GAS_LIMIT] [-p GAS_PRICE] [-P PASSWORD] [-y]",
+	Short: "Transfer tokens to multiple recipients listed in a CSV file of \"(ALIAS|RECIPIENT_ADDRESS),AMOUNT_IOTX\" lines",
+	Args:  cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := batchTransfer(args)
+		return output.PrintError(err)
+	},
+}
+
+func init() {
+	registerWriteCommand(actionBatchTransferCmd)
+}
+
+func batchTransfer(args []string) error {
+	file, err := os.Open(args[0])
+	if err != nil {
+		return output.NewError(output.ReadFileError, "failed to open CSV file", err)
+	}
+	defer file.Close()
+	items, err := readBatchTransferItems(file)
+	if err != nil {
+		return err
+	}
+	var payload []byte
+	if len(args) == 2 {
+		payload, err = hex.DecodeString(args[1])
+		if err != nil {
+			return output.NewError(output.ConvertError, "failed to decode data", err)
+		}
+	}
+	sender, err := signer()
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get signed address", err)
+	}
+	gasPriceRau, err := gasPriceInRau()
+	if err != nil {
+		return output.NewError(0, "failed to get gas price", err)
+	}
+	nonce, err := nonce(sender)
+	if err != nil {
+		return output.NewError(0, "failed to get nonce ", err)
+	}
+	gasLimit := gasLimitFlag.Value().(uint64)
+	tx, err := action.NewBatchTransfer(nonce, items, payload, gasLimit, gasPriceRau)
+	if err != nil {
+		return output.NewError(output.InstantiationError, "failed to make a BatchTransfer instance", err)
+	}
+	if gasLimit == 0 {
+		if gasLimit, err = tx.IntrinsicGas(); err != nil {
+			return output.NewError(output.InstantiationError, "failed to get intrinsic gas", err)
+		}
+	}
+	return SendAction(
+		(&action.EnvelopeBuilder{}).
+			SetNonce(nonce).
+			SetGasPrice(gasPriceRau).
+			SetGasLimit(gasLimit).
+			SetAction(tx).Build(),
+		sender,
+	)
+}
+
+// readBatchTransferItems reads the recipients and the amounts in IOTX from the CSV lines
+func readBatchTransferItems(r io.Reader) ([]action.BatchTransferItem, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, output.NewError(output.ReadFileError, "failed to read CSV file", err)
+	}
+	if len(records) == 0 {
+		return nil, output.NewError(output.ValidationError, "no recipient in CSV file", nil)
+	}
+	if len(records) > action.MaxBatchTransferRecipients {
+		return nil, output.NewError(output.ValidationError, "too many recipients in CSV file", nil)
+	}
+	items := make([]action.BatchTransferItem, 0, len(records))
+	for _, record := range records {
+		recipient, err := util.Address(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, output.NewError(output.AddressError, "failed to get recipient address", err)
+		}
+		amount, err := util.StringToRau(strings.TrimSpace(record[1]), util.IotxDecimalNum)
+		if err != nil {
+			return nil, output.NewError(output.ConvertError, "invalid amount", err)
+		}
+		items = append(items, action.BatchTransferItem{Recipient: recipient, Amount: amount})
+	}
+	return items, nil
+}
@@ -23,6 +23,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/ioctl/cmd/alias"
 	"github.com/iotexproject/iotex-core/ioctl/cmd/config"
 	"github.com/iotexproject/iotex-core/ioctl/output"
@@ -154,9 +155,23 @@ func printActionProto(action *iotextypes.Action) (string, error) {
 		fmt.Sprintf("gasPrice: %s Rau\n", action.Core.GasPrice) +
 		fmt.Sprintf("senderAddress: %s %s\n", senderAddress.String(),
 			Match(senderAddress.String(), "address"))
+	batchTransfer := loadBatchTransfer(action.Core)
 	switch {
 	default:
 		result += proto.MarshalTextString(action.Core)
+	case batchTransfer != nil:
+		result += "batchTransfer: <\n"
+		for _, item := range batchTransfer.Items() {
+			result += "  item: <\n" +
+				fmt.Sprintf("    recipient: %s %s\n", item.Recipient,
+					Match(item.Recipient, "address")) +
+				fmt.Sprintf("    amount: %s IOTX\n", util.RauToString(item.Amount, util.IotxDecimalNum)) +
+				"  >\n"
+		}
+		if len(batchTransfer.Payload()) != 0 {
+			result += fmt.Sprintf("  payload: %s\n", batchTransfer.Payload())
+		}
+		result += ">\n"
 	case action.Core.GetTransfer() != nil:
 		transfer := action.Core.GetTransfer()
 		amount, err := util.StringToIOTX(transfer.Amount)
@@ -198,10 +213,33 @@ func printActionProto(action *iotextypes.Action) (string, error) {
 	}
 	result += fmt.Sprintf("senderPubKey: %x\n", action.SenderPubKey) +
 		fmt.Sprintf("signature: %x\n", action.Signature)
+	if sealed := loadSponsored(action); sealed != nil {
+		result += fmt.Sprintf("sponsorPubKey: %x\n", sealed.SponsorPubkey().Bytes()) +
+			fmt.Sprintf("sponsorSignature: %x\n", sealed.SponsorSignature())
+	}
 
 	return result, nil
 }
 
+// loadBatchTransfer returns the batch transfer carried by the action core, or nil if it is not a batch transfer
+func loadBatchTransfer(core *iotextypes.ActionCore) *action.BatchTransfer {
+	var elp action.Envelope
+	if err := elp.LoadProto(core); err != nil {
+		return nil
+	}
+	bt, _ := elp.Action().(*action.BatchTransfer)
+	return bt
+}
+
+// loadSponsored returns the sealed action if it is sponsored, or nil if it is not
+func loadSponsored(act *iotextypes.Action) *action.SealedEnvelope {
+	sealed := &action.SealedEnvelope{}
+	if err := sealed.LoadProto(act); err != nil || !sealed.IsSponsored() {
+		return nil
+	}
+	return sealed
+}
+
 func printReceiptProto(receipt *iotextypes.Receipt) string {
 	result := fmt.Sprintf("status: %d %s\n", receipt.Status,
 		Match(strconv.Itoa(int(receipt.Status)), "status")) +
@@ -213,6 +251,12 @@ func printReceiptProto(receipt *iotextypes.Receipt) string {
 		result += fmt.Sprintf("\ncontractAddress: %s %s", receipt.ContractAddress,
 			Match(receipt.ContractAddress, "address"))
 	}
+	if len(receipt.ExecutionRevertMsg) != 0 {
+		result += fmt.Sprintf("\nrevertReason: %s", receipt.ExecutionRevertMsg)
+	}
+	if len(receipt.GasPayer) != 0 {
+		result += fmt.Sprintf("\ngasPayer: %s %s", receipt.GasPayer, Match(receipt.GasPayer, "address"))
+	}
 	return result
 }
 
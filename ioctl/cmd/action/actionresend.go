@@ -0,0 +1,114 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-proto/golang/iotexapi"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/ioctl/cmd/config"
+	"github.com/iotexproject/iotex-core/ioctl/flag"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+	"github.com/iotexproject/iotex-core/ioctl/util"
+)
+
+// defaultPriceBump is the percentage by which the gas price is raised if not specified, which matches the default
+// replacement price bump of the action pool
+const defaultPriceBump = 10
+
+var resendGasPriceFlag = flag.NewStringVarP("gas-price", "p", "",
+	"set gas price (unit: 10^(-6)IOTX), raise the original gas price by 10% if empty")
+
+// actionResendCmd represents the action resend command
+var actionResendCmd = &cobra.Command{
+	Use:   "resend ACTION_HASH [-p GAS_PRICE] [-P PASSWORD] [-y]",
+	Short: "Resend a pending action with a higher gas price",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := resend(args[0])
+		return output.PrintError(err)
+	},
+}
+
+func init() {
+	resendGasPriceFlag.RegisterCommand(actionResendCmd)
+	yesFlag.RegisterCommand(actionResendCmd)
+	passwordFlag.RegisterCommand(actionResendCmd)
+}
+
+// resend signs the pending action again with a higher gas price, which replaces the pending one in the action pool
+func resend(hash string) error {
+	conn, err := util.ConnectToEndpoint(config.ReadConfig.SecureConnect && !config.Insecure)
+	if err != nil {
+		return output.NewError(output.NetworkError, "failed to connect to endpoint", err)
+	}
+	defer conn.Close()
+	cli := iotexapi.NewAPIServiceClient(conn)
+	request := &iotexapi.GetActionsRequest{
+		Lookup: &iotexapi.GetActionsRequest_ByHash{
+			ByHash: &iotexapi.GetActionByHashRequest{
+				ActionHash:   hash,
+				CheckPending: true,
+			},
+		},
+	}
+	response, err := cli.GetActions(context.Background(), request)
+	if err != nil {
+		sta, ok := status.FromError(err)
+		if ok {
+			return output.NewError(output.APIError, sta.Message(), nil)
+		}
+		return output.NewError(output.NetworkError, "failed to invoke GetActions api", err)
+	}
+	if len(response.ActionInfo) == 0 {
+		return output.NewError(output.APIError, "no action info returned", nil)
+	}
+	info := response.ActionInfo[0]
+	if info.BlkHeight != 0 {
+		return output.NewError(output.ValidationError, "action has been written on blockchain", nil)
+	}
+
+	core := info.Action.GetCore()
+	oldPrice, ok := new(big.Int).SetString(core.GetGasPrice(), 10)
+	if !ok {
+		return output.NewError(output.ConvertError, "failed to convert gas price into big int", nil)
+	}
+	gasPrice := bumpGasPrice(oldPrice)
+	if price := resendGasPriceFlag.Value().(string); len(price) != 0 {
+		gasPrice, err = util.StringToRau(price, util.GasPriceDecimalNum)
+		if err != nil {
+			return output.NewError(output.ConvertError, "failed to convert gas price", err)
+		}
+		if gasPrice.Cmp(oldPrice) <= 0 {
+			return output.NewError(output.ValidationError, "gas price must be higher than the original one", nil)
+		}
+	}
+	core.GasPrice = gasPrice.String()
+	var elp action.Envelope
+	if err := elp.LoadProto(core); err != nil {
+		return output.NewError(output.SerializationError, "failed to load action", err)
+	}
+	return SendAction(elp, info.Sender)
+}
+
+// bumpGasPrice raises the gas price by the default price bump, rounding up
+func bumpGasPrice(price *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(price, big.NewInt(100+defaultPriceBump))
+	bumped.Add(bumped, big.NewInt(99))
+	bumped.Div(bumped, big.NewInt(100))
+	if bumped.Cmp(price) <= 0 {
+		bumped.Add(price, big.NewInt(1))
+	}
+	return bumped
+}
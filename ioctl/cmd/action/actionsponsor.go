@@ -0,0 +1,86 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+)
+
+// actionSponsorCmd represents the action sponsor command
+var actionSponsorCmd = &cobra.Command{
+	Use:   "sponsor SIGNED_ACTION [-s SPONSOR] [-P PASSWORD] [-y]",
+	Short: "Pay the gas of an action signed with flag --sponsor and send it to blockchain",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := sponsor(args[0])
+		return output.PrintError(err)
+	},
+}
+
+func init() {
+	signerFlag.RegisterCommand(actionSponsorCmd)
+	passwordFlag.RegisterCommand(actionSponsorCmd)
+	yesFlag.RegisterCommand(actionSponsorCmd)
+}
+
+func sponsor(signed string) error {
+	actBytes, err := hex.DecodeString(signed)
+	if err != nil {
+		return output.NewError(output.ConvertError, "failed to decode signed action", err)
+	}
+	act := &iotextypes.Action{}
+	if err := proto.Unmarshal(actBytes, act); err != nil {
+		return output.NewError(output.SerializationError, "failed to unmarshal signed action", err)
+	}
+	sealed := action.SealedEnvelope{}
+	if err := sealed.LoadProto(act); err != nil {
+		return output.NewError(output.SerializationError, "failed to load signed action", err)
+	}
+	signer, err := signer()
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get sponsor address", err)
+	}
+	prvKey, err := privateKey(signer)
+	if err != nil {
+		return err
+	}
+	defer prvKey.Zero()
+	sponsored, err := action.Sponsor(sealed, prvKey)
+	prvKey.Zero()
+	if err != nil {
+		return output.NewError(output.CryptoError, "failed to sign action as sponsor", err)
+	}
+	selp := sponsored.Proto()
+
+	actionInfo, err := printActionProto(selp)
+	if err != nil {
+		return output.NewError(0, "failed to print action proto message", err)
+	}
+	if yesFlag.Value() == false {
+		var confirm string
+		info := fmt.Sprintln(actionInfo + "\nPlease confirm to pay the gas of the action.\n")
+		message := output.ConfirmationMessage{Info: info, Options: []string{"yes"}}
+		fmt.Println(message.String())
+		fmt.Scanf("%s", &confirm)
+		if !strings.EqualFold(confirm, "yes") {
+			output.PrintResult("quit")
+			return nil
+		}
+	}
+	return SendRaw(selp)
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/ioctl/output"
+)
+
+// multisigCmd represents the action multisig command
+var multisigCmd = &cobra.Command{
+	Use:   "multisig",
+	Short: "Manage multisig accounts and their actions",
+}
+
+// multisigSetPolicyCmd represents the action multisig setpolicy command
+var multisigSetPolicyCmd = &cobra.Command{
+	Use: "setpolicy THRESHOLD [PUBLIC_KEY:WEIGHT...]" +
+		" [-s SIGNER] [-n NONCE] [-l GAS_LIMIT] [-p GAS_PRICE] [-P PASSWORD] [-y] [--unsigned]",
+	Short: "Put the signer under the control of weighted keys, or release it with zero threshold and no key",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := setMultisigPolicy(args)
+		return output.PrintError(err)
+	},
+}
+
+// multisigSignCmd represents the action multisig sign command
+var multisigSignCmd = &cobra.Command{
+	Use:   "sign ACCOUNT_PUBLIC_KEY UNSIGNED_ACTION [-s SIGNER] [-P PASSWORD]",
+	Short: "Sign an unsigned action of a multisig account with one of its keys",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := cosign(args[0], args[1])
+		return output.PrintError(err)
+	},
+}
+
+// multisigSubmitCmd represents the action multisig submit command
+var multisigSubmitCmd = &cobra.Command{
+	Use:   "submit ACCOUNT_PUBLIC_KEY UNSIGNED_ACTION COSIGNATURE...",
+	Short: "Combine the cosignatures of an action of a multisig account and send it to blockchain",
+	Args:  cobra.MinimumNArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		err := submitMultisig(args[0], args[1], args[2:])
+		return output.PrintError(err)
+	},
+}
+
+func init() {
+	registerWriteCommand(multisigSetPolicyCmd)
+	signerFlag.RegisterCommand(multisigSignCmd)
+	passwordFlag.RegisterCommand(multisigSignCmd)
+	multisigCmd.AddCommand(multisigSetPolicyCmd)
+	multisigCmd.AddCommand(multisigSignCmd)
+	multisigCmd.AddCommand(multisigSubmitCmd)
+}
+
+func setMultisigPolicy(args []string) error {
+	policy, err := parseMultisigPolicy(args[0], args[1:])
+	if err != nil {
+		return err
+	}
+	if err := policy.Validate(); err != nil {
+		return output.NewError(output.ValidationError, "invalid multisig policy", err)
+	}
+	sender, err := signer()
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get signed address", err)
+	}
+	gasPriceRau, err := gasPriceInRau()
+	if err != nil {
+		return output.NewError(0, "failed to get gas price", err)
+	}
+	nonce, err := nonce(sender)
+	if err != nil {
+		return output.NewError(0, "failed to get nonce ", err)
+	}
+	gasLimit := gasLimitFlag.Value().(uint64)
+	tx, err := action.NewSetMultisigPolicy(nonce, policy, gasLimit, gasPriceRau)
+	if err != nil {
+		return output.NewError(output.InstantiationError, "failed to make a SetMultisigPolicy instance", err)
+	}
+	if gasLimit == 0 {
+		if gasLimit, err = tx.IntrinsicGas(); err != nil {
+			return output.NewError(output.InstantiationError, "failed to get intrinsic gas", err)
+		}
+	}
+	return SendAction(
+		(&action.EnvelopeBuilder{}).
+			SetNonce(nonce).
+			SetGasPrice(gasPriceRau).
+			SetGasLimit(gasLimit).
+			SetAction(tx).Build(),
+		sender,
+	)
+}
+
+// parseMultisigPolicy parses the threshold and the "PUBLIC_KEY:WEIGHT" pairs of a multisig policy
+func parseMultisigPolicy(threshold string, keys []string) (action.MultisigPolicy, error) {
+	policy := action.MultisigPolicy{}
+	var err error
+	if policy.Threshold, err = strconv.ParseUint(threshold, 10, 64); err != nil {
+		return policy, output.NewError(output.ConvertError, "invalid threshold", err)
+	}
+	for _, key := range keys {
+		pair := strings.Split(key, ":")
+		if len(pair) != 2 {
+			return policy, output.NewError(output.InputError, "key should be in format PUBLIC_KEY:WEIGHT", nil)
+		}
+		pubKey, err := crypto.HexStringToPublicKey(pair[0])
+		if err != nil {
+			return policy, output.NewError(output.ConvertError, "invalid public key", err)
+		}
+		weight, err := strconv.ParseUint(pair[1], 10, 64)
+		if err != nil {
+			return policy, output.NewError(output.ConvertError, "invalid weight", err)
+		}
+		policy.Keys = append(policy.Keys, action.WeightedKey{PubKey: pubKey, Weight: weight})
+	}
+	return policy, nil
+}
+
+func cosign(accountPubKey string, unsigned string) error {
+	pubKey, err := crypto.HexStringToPublicKey(accountPubKey)
+	if err != nil {
+		return output.NewError(output.ConvertError, "invalid public key of multisig account", err)
+	}
+	elp, err := loadUnsigned(unsigned)
+	if err != nil {
+		return err
+	}
+	signer, err := signer()
+	if err != nil {
+		return output.NewError(output.AddressError, "failed to get signer address", err)
+	}
+	prvKey, err := privateKey(signer)
+	if err != nil {
+		return err
+	}
+	defer prvKey.Zero()
+	cosig, err := action.Cosign(elp, pubKey, nil, prvKey)
+	prvKey.Zero()
+	if err != nil {
+		return output.NewError(output.CryptoError, "failed to sign action", err)
+	}
+	cosigBytes, err := proto.Marshal(cosig.Proto())
+	if err != nil {
+		return output.NewError(output.SerializationError, "failed to marshal cosignature", err)
+	}
+	output.PrintResult(hex.EncodeToString(cosigBytes))
+	return nil
+}
+
+func submitMultisig(accountPubKey string, unsigned string, cosignatures []string) error {
+	pubKey, err := crypto.HexStringToPublicKey(accountPubKey)
+	if err != nil {
+		return output.NewError(output.ConvertError, "invalid public key of multisig account", err)
+	}
+	elp, err := loadUnsigned(unsigned)
+	if err != nil {
+		return err
+	}
+	cosigs := make([]action.Cosignature, 0, len(cosignatures))
+	for _, cosignature := range cosignatures {
+		cosigBytes, err := hex.DecodeString(cosignature)
+		if err != nil {
+			return output.NewError(output.ConvertError, "failed to decode cosignature", err)
+		}
+		pb := &iotextypes.Cosignature{}
+		if err := proto.Unmarshal(cosigBytes, pb); err != nil {
+			return output.NewError(output.SerializationError, "failed to unmarshal cosignature", err)
+		}
+		var cosig action.Cosignature
+		if err := cosig.LoadProto(pb); err != nil {
+			return output.NewError(output.SerializationError, "failed to load cosignature", err)
+		}
+		cosigs = append(cosigs, cosig)
+	}
+	sealed := action.AssembleMultisigEnvelope(elp, pubKey, cosigs)
+	if err := action.Verify(sealed); err != nil {
+		return output.NewError(output.CryptoError, "failed to verify cosignatures", err)
+	}
+	return SendRaw(sealed.Proto())
+}
+
+// loadUnsigned loads the unsigned action printed with flag --unsigned
+func loadUnsigned(unsigned string) (action.Envelope, error) {
+	var elp action.Envelope
+	actBytes, err := hex.DecodeString(unsigned)
+	if err != nil {
+		return elp, output.NewError(output.ConvertError, "failed to decode unsigned action", err)
+	}
+	core := &iotextypes.ActionCore{}
+	if err := proto.Unmarshal(actBytes, core); err != nil {
+		return elp, output.NewError(output.SerializationError, "failed to unmarshal unsigned action", err)
+	}
+	if err := elp.LoadProto(core); err != nil {
+		return elp, output.NewError(output.SerializationError, "failed to load unsigned action", err)
+	}
+	return elp, nil
+}
@@ -19,9 +19,32 @@ import (
 
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/testutil"
+	goproto "github.com/iotexproject/iotex-proto/golang"
+	"github.com/iotexproject/iotex-proto/golang/iotexrpc"
 	"github.com/iotexproject/iotex-proto/golang/testingpb"
 )
 
+func TestConvertAppMsg(t *testing.T) {
+	require := require.New(t)
+	for _, c := range []struct {
+		msg proto.Message
+		t   iotexrpc.MessageType
+	}{
+		{&iotexrpc.BlockBatch{Version: 1, Compressed: true, Payload: []byte("blocks")}, iotexrpc.MessageType_BLOCK_BATCH},
+		{&iotexrpc.BlockSync{Start: 1, End: 2}, iotexrpc.MessageType_BLOCK_REQUEST},
+		{&testingpb.TestPayload{MsgBody: []byte("test")}, iotexrpc.MessageType_TEST},
+	} {
+		msgType, body, err := convertAppMsg(c.msg)
+		require.NoError(err)
+		require.Equal(c.t, msgType)
+		msg, err := goproto.TypifyRPCMsg(msgType, body)
+		require.NoError(err)
+		require.True(proto.Equal(c.msg, msg))
+	}
+	_, _, err := convertAppMsg(&iotexrpc.Blocks{})
+	require.Error(err)
+}
+
 func TestBroadcast(t *testing.T) {
 	ctx := context.Background()
 	n := 10
@@ -9,9 +9,15 @@ package compress
 import (
 	"bytes"
 	"compress/gzip"
+	"io"
 	"io/ioutil"
+
+	"github.com/pkg/errors"
 )
 
+// ErrExceedLimit is the error that the decompressed data exceeds the limit
+var ErrExceedLimit = errors.New("decompressed data exceeds the limit")
+
 // Compress uses gzip to compress the input bytes
 func Compress(data []byte) ([]byte, error) {
 	var bb bytes.Buffer
@@ -38,3 +44,20 @@ func Decompress(data []byte) ([]byte, error) {
 	r.Close()
 	return ioutil.ReadAll(r)
 }
+
+// DecompressWithLimit decompresses the data, which is rejected once it exceeds the limit of bytes decompressed
+func DecompressWithLimit(data []byte, limit uint64) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	output, err := ioutil.ReadAll(io.LimitReader(r, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(output)) > limit {
+		return nil, errors.Wrapf(ErrExceedLimit, "limit is %d bytes", limit)
+	}
+	return output, nil
+}
@@ -9,6 +9,7 @@ package compress
 import (
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -21,3 +22,16 @@ func TestCompression(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, data, dcd)
 }
+
+func TestDecompressWithLimit(t *testing.T) {
+	data := []byte("11111111111111111111111111111111111111110000000000000000000000000000000000000000")
+	cd, err := Compress(data)
+	require.NoError(t, err)
+	dcd, err := DecompressWithLimit(cd, uint64(len(data)))
+	require.NoError(t, err)
+	assert.Equal(t, data, dcd)
+	_, err = DecompressWithLimit(cd, uint64(len(data)-1))
+	require.Equal(t, ErrExceedLimit, errors.Cause(err))
+	_, err = DecompressWithLimit(data, uint64(len(data)))
+	require.Error(t, err)
+}
@@ -93,6 +93,19 @@ func (h *HeartbeatHandler) Log() {
 	heartbeatMtc.WithLabelValues("pendingDispatcherEvents", "node").Set(float64(numDPEvts))
 	// chain service
 	for _, c := range h.s.chainservices {
+		if lc := c.LightChain(); lc != nil {
+			height := lc.TipHeight()
+			targetHeight := c.BlockSync().TargetHeight()
+			log.L().Info("light chain service status",
+				zap.Uint64("blockchainHeight", height),
+				zap.Uint32("chainID", c.ChainID()),
+				zap.Uint64("targetHeight", targetHeight),
+			)
+			chainIDStr := strconv.FormatUint(uint64(c.ChainID()), 10)
+			heartbeatMtc.WithLabelValues("blockchainHeight", chainIDStr).Set(float64(height))
+			heartbeatMtc.WithLabelValues("targetHeight", chainIDStr).Set(float64(targetHeight))
+			continue
+		}
 		// Consensus metrics
 		cs, ok := c.Consensus().(*consensus.IotxConsensus)
 		if !ok {
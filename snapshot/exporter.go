@@ -0,0 +1,154 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package snapshot
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/blockindex"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+// Exporter is a block creation subscriber which exports a snapshot at the last block of every given number of epochs
+type Exporter struct {
+	cfg       config.Snapshot
+	bc        blockchain.Blockchain
+	indexer   blockindex.Indexer
+	rp        *rolldpos.Protocol
+	exporting int32
+}
+
+// NewExporter creates a snapshot exporter, the indexer is optional
+func NewExporter(cfg config.Snapshot, bc blockchain.Blockchain, indexer blockindex.Indexer, rp *rolldpos.Protocol) *Exporter {
+	return &Exporter{
+		cfg:     cfg,
+		bc:      bc,
+		indexer: indexer,
+		rp:      rp,
+	}
+}
+
+// HandleBlock exports a snapshot if the block is the last one of an epoch to export at
+func (e *Exporter) HandleBlock(blk *block.Block) error {
+	height := blk.Height()
+	epochNum := e.rp.GetEpochNum(height)
+	if e.rp.GetEpochLastBlockHeight(epochNum) != height || epochNum%e.cfg.ExportEpochInterval != 0 {
+		return nil
+	}
+	if !atomic.CompareAndSwapInt32(&e.exporting, 0, 1) {
+		log.L().Warn("Skip snapshot since the previous one is still being exported.", zap.Uint64("height", height))
+		return nil
+	}
+	defer atomic.StoreInt32(&e.exporting, 0)
+
+	sf, ok := e.bc.Factory().(factory.SnapshotExporter)
+	if !ok {
+		return errors.New("state factory does not support exporting snapshot")
+	}
+	path, err := Export(e.cfg.ExportDir, e.bc.ChainID(), height, sf, e.bc.BlockDAO(), e.indexer, e.cfg.ChunkSize)
+	if err != nil {
+		return errors.Wrapf(err, "failed to export snapshot at height %d", height)
+	}
+	log.L().Info("Exported snapshot.", zap.Uint64("height", height), zap.String("path", path))
+	return nil
+}
+
+// Export exports the states at the end of the given height into a snapshot file in the directory, and returns the path
+// of the file. The total number of actions is recorded if the indexer is given and has indexed the height.
+func Export(
+	dir string,
+	chainID uint32,
+	height uint64,
+	sf factory.SnapshotExporter,
+	dao blockdao.BlockDAO,
+	indexer blockindex.Indexer,
+	chunkSize uint64,
+) (string, error) {
+	blk, err := dao.GetBlockByHeight(height)
+	if err != nil {
+		return "", err
+	}
+	receipts, err := dao.GetReceipts(height)
+	if err != nil && errors.Cause(err) != db.ErrNotExist {
+		return "", err
+	}
+	header := &Header{
+		ChainID:   chainID,
+		Height:    height,
+		BlockHash: blk.HashBlock(),
+		Block:     blk,
+		Receipts:  receipts,
+	}
+	if indexer != nil {
+		total, err := blockindex.TotalActionsAtHeight(indexer, height)
+		if err != nil {
+			log.L().Warn("Snapshot is exported without the total number of actions.", zap.Error(err))
+		} else {
+			header.HasTotalActions = true
+			header.TotalActions = total
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	f, err := ioutil.TempFile(dir, "snapshot-*.tmp")
+	if err != nil {
+		return "", err
+	}
+	tmp := f.Name()
+	defer func() {
+		// clean up the temp file if it fails to export, these are no-ops once the file is renamed
+		f.Close()
+		os.Remove(tmp)
+	}()
+	sw, err := newWriter(f, chunkSize)
+	if err != nil {
+		return "", err
+	}
+	if header.RootHash, err = sf.ExportSnapshot(height, sw.Put); err != nil {
+		return "", err
+	}
+	if err := verifyStateRoot(header); err != nil {
+		return "", err
+	}
+	if err := sw.Close(header); err != nil {
+		return "", err
+	}
+	if err := f.Sync(); err != nil {
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("snapshot-%d.snap", height))
+	if err := os.Rename(tmp, path); err != nil {
+		return "", err
+	}
+	// the hashes are to be published for the importers to trust the snapshot
+	log.L().Info(
+		"Snapshot hashes.",
+		zap.String("path", path),
+		log.Hex("blockHash", header.BlockHash[:]),
+		log.Hex("rootHash", header.RootHash[:]),
+	)
+	return path, nil
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package snapshot
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/blockindex"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+// Import bootstraps the chain, state and index DBs in the config from a snapshot file, so that the node starts syncing
+// from the block after the snapshot. It does nothing if the chain DB already has blocks.
+//
+// The snapshot cannot vouch for its own states, as anyone can sign a block. The block hash and root hash of the snapshot
+// are checked against the trusted ones in the config, and every state imported is verified against the root hash.
+func Import(ctx context.Context, cfg config.Config, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	header, err := ReadHeader(f)
+	if err != nil {
+		return err
+	}
+	if header.ChainID != cfg.Chain.ID {
+		return errors.Errorf("snapshot of chain %d cannot be imported into chain %d", header.ChainID, cfg.Chain.ID)
+	}
+	if err := verifyTrustedHashes(cfg.Snapshot, header); err != nil {
+		return err
+	}
+	_, gateway := cfg.Plugins[config.GatewayPlugin]
+	if gateway && !header.HasTotalActions {
+		return errors.New("snapshot without the total number of actions cannot be imported with gateway plugin")
+	}
+
+	cfg.DB.DbPath = cfg.Chain.ChainDBPath
+	dao := blockdao.NewBlockDAO(db.NewKVStore(cfg.DB), nil, cfg.Chain.CompressBlock, cfg.DB)
+	if err := dao.Start(ctx); err != nil {
+		return errors.Wrap(err, "failed to start chain db")
+	}
+	defer func() {
+		if err := dao.Stop(ctx); err != nil {
+			log.L().Error("Failed to stop chain db.", zap.Error(err))
+		}
+	}()
+	tip, err := dao.GetTipHeight()
+	if err != nil {
+		return err
+	}
+	if tip > 0 {
+		log.L().Info("Skip importing snapshot into an existing chain.", zap.Uint64("height", tip))
+		return nil
+	}
+
+	// the block is put last, so an interrupted import is retried on the next start
+	if err := importStates(ctx, cfg, f, header); err != nil {
+		return errors.Wrap(err, "failed to import states")
+	}
+	if gateway {
+		cfg.DB.DbPath = cfg.Chain.IndexDBPath
+		if err := blockindex.ImportSnapshot(
+			ctx,
+			db.NewKVStore(cfg.DB),
+			cfg.Genesis.Hash(),
+			header.Block,
+			header.TotalActions,
+		); err != nil {
+			return errors.Wrap(err, "failed to import index")
+		}
+	}
+	header.Block.Receipts = header.Receipts
+	if err := dao.PutBlock(header.Block); err != nil {
+		return errors.Wrapf(err, "failed to put block %d", header.Height)
+	}
+	log.L().Info("Imported snapshot.", zap.Uint64("height", header.Height), zap.String("path", path))
+	return nil
+}
+
+func verifyTrustedHashes(cfg config.Snapshot, header *Header) error {
+	blkHash, err := hash.HexStringToHash256(cfg.ImportBlockHash)
+	if err != nil || cfg.ImportBlockHash == "" {
+		return errors.Wrap(ErrUntrusted, "trusted block hash is not configured")
+	}
+	rootHash, err := hash.HexStringToHash256(cfg.ImportRootHash)
+	if err != nil || cfg.ImportRootHash == "" {
+		return errors.Wrap(ErrUntrusted, "trusted root hash is not configured")
+	}
+	// the block hash is recomputed from the block in the header when it is read
+	if header.BlockHash != blkHash {
+		return errors.Wrapf(ErrUntrusted, "block hash %x does not match the trusted %x", header.BlockHash, blkHash)
+	}
+	if header.RootHash != rootHash {
+		return errors.Wrapf(ErrUntrusted, "root hash %x does not match the trusted %x", header.RootHash, rootHash)
+	}
+	return verifyStateRoot(header)
+}
+
+// verifyStateRoot verifies the root hash of the snapshot against the state root committed in the header of its block,
+// if the block commits to one
+func verifyStateRoot(header *Header) error {
+	root := header.Block.Header.StateRoot()
+	if root != hash.ZeroHash256 && header.RootHash != root {
+		return errors.Wrapf(ErrUntrusted, "root hash %x does not match the state root %x", header.RootHash, root)
+	}
+	return nil
+}
+
+func importStates(ctx context.Context, cfg config.Config, f *os.File, header *Header) (err error) {
+	cfg.DB.DbPath = cfg.Chain.TrieDBPath
+	kv := db.NewKVStore(cfg.DB)
+	if err := kv.Start(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if stopErr := kv.Stop(ctx); err == nil {
+			err = stopErr
+		}
+	}()
+	imp, err := factory.NewSnapshotImporter(kv, cfg.Chain.EnableTrielessStateDB)
+	if err != nil {
+		return err
+	}
+	sr, err := newReader(f, header)
+	if err != nil {
+		return err
+	}
+	for {
+		e, err := sr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := imp.Import(e); err != nil {
+			return err
+		}
+	}
+	if sr.ChunksDigest() != header.ChunksDigest {
+		return errors.Wrap(ErrInvalidFile, "digest of chunks does not match")
+	}
+	return imp.Finish(header.Height, header.RootHash)
+}
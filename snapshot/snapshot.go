@@ -0,0 +1,394 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/crypto"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+// A snapshot file is laid out as
+//   magic | version | chunk ... | header | hash of header | offset of header | length of header | magic
+// where a chunk is
+//   length of payload | payload | hash of payload
+// and the payload of a chunk is a sequence of state entries
+//   kind | length of key | key | length of value | value
+// All integers are big endian.
+
+// Version is the version of the snapshot file format
+const Version uint32 = 1
+
+const (
+	hashLen   = 32
+	footerLen = 8 + 4 + 8
+	// maxChunkLen guards against allocating a huge buffer from a corrupted length
+	maxChunkLen = 1 << 30
+)
+
+var (
+	magic = []byte("IOTXSNAP")
+
+	// ErrInvalidFile indicates that the snapshot file is corrupted or not a snapshot file
+	ErrInvalidFile = errors.New("invalid snapshot file")
+	// ErrUntrusted indicates that the snapshot does not match the trusted block hash and root hash
+	ErrUntrusted = errors.New("untrusted snapshot")
+)
+
+type (
+	// Header describes the states in a snapshot, and the block at the end of which the states are taken
+	Header struct {
+		Version   uint32
+		ChainID   uint32
+		Height    uint64
+		BlockHash hash.Hash256
+		// RootHash is the root hash of the account trie built from the states
+		RootHash hash.Hash256
+		// HasTotalActions tells whether the total number of actions is known, which requires a block indexer
+		HasTotalActions bool
+		TotalActions    uint64
+		NumChunks       uint32
+		// ChunksDigest is the hash of the concatenated hashes of the chunks
+		ChunksDigest hash.Hash256
+		Block        *block.Block
+		Receipts     []*action.Receipt
+	}
+
+	// writer writes state entries into chunks
+	writer struct {
+		w         *bufio.Writer
+		offset    uint64
+		chunkSize uint64
+		chunk     bytes.Buffer
+		hashes    bytes.Buffer
+		numChunks uint32
+	}
+
+	// reader reads state entries from chunks
+	reader struct {
+		r         *bufio.Reader
+		numChunks uint32
+		chunk     *bytes.Reader
+		read      uint32
+		hashes    bytes.Buffer
+	}
+)
+
+// Serialize encodes the header into bytes
+func (h *Header) Serialize() ([]byte, error) {
+	blk, err := h.Block.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	receipts := iotextypes.Receipts{}
+	for _, r := range h.Receipts {
+		receipts.Receipts = append(receipts.Receipts, r.ConvertToReceiptPb())
+	}
+	rb, err := proto.Marshal(&receipts)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	for _, v := range []interface{}{
+		h.Version,
+		h.ChainID,
+		h.Height,
+		h.BlockHash,
+		h.RootHash,
+		h.HasTotalActions,
+		h.TotalActions,
+		h.NumChunks,
+		h.ChunksDigest,
+	} {
+		if err := binary.Write(&buf, binary.BigEndian, v); err != nil {
+			return nil, err
+		}
+	}
+	writeBytes(&buf, blk)
+	writeBytes(&buf, rb)
+	return buf.Bytes(), nil
+}
+
+// Deserialize decodes the header from bytes, and verifies the block and the receipts in it
+func (h *Header) Deserialize(buf []byte) error {
+	r := bytes.NewReader(buf)
+	for _, v := range []interface{}{
+		&h.Version,
+		&h.ChainID,
+		&h.Height,
+		&h.BlockHash,
+		&h.RootHash,
+		&h.HasTotalActions,
+		&h.TotalActions,
+		&h.NumChunks,
+		&h.ChunksDigest,
+	} {
+		if err := binary.Read(r, binary.BigEndian, v); err != nil {
+			return errors.Wrap(ErrInvalidFile, err.Error())
+		}
+	}
+	blk, err := readBytes(r)
+	if err != nil {
+		return err
+	}
+	rb, err := readBytes(r)
+	if err != nil {
+		return err
+	}
+	if r.Len() != 0 {
+		return errors.Wrap(ErrInvalidFile, "trailing bytes in header")
+	}
+	h.Block = &block.Block{}
+	if err := h.Block.Deserialize(blk); err != nil {
+		return errors.Wrap(err, "failed to deserialize block")
+	}
+	if h.Block.Height() != h.Height || h.Block.HashBlock() != h.BlockHash {
+		return errors.Wrapf(ErrInvalidFile, "block does not match height %d and hash %x", h.Height, h.BlockHash)
+	}
+	receipts := iotextypes.Receipts{}
+	if err := proto.Unmarshal(rb, &receipts); err != nil {
+		return errors.Wrap(err, "failed to deserialize receipts")
+	}
+	h.Receipts = nil
+	hashes := make([]hash.Hash256, 0, len(receipts.Receipts))
+	for _, pb := range receipts.Receipts {
+		receipt := &action.Receipt{}
+		receipt.ConvertFromReceiptPb(pb)
+		h.Receipts = append(h.Receipts, receipt)
+		hashes = append(hashes, receipt.Hash())
+	}
+	root := hash.ZeroHash256
+	if len(hashes) > 0 {
+		root = crypto.NewMerkleTree(hashes).HashTree()
+	}
+	return h.Block.VerifyReceiptRoot(root)
+}
+
+func newWriter(w io.Writer, chunkSize uint64) (*writer, error) {
+	sw := &writer{
+		w:         bufio.NewWriter(w),
+		chunkSize: chunkSize,
+	}
+	if err := sw.write(magic); err != nil {
+		return nil, err
+	}
+	if err := sw.write(byteutil.Uint32ToBytesBigEndian(Version)); err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
+
+func (sw *writer) write(b []byte) error {
+	n, err := sw.w.Write(b)
+	sw.offset += uint64(n)
+	return err
+}
+
+// Put appends an entry into the current chunk, and closes the chunk once it reaches the chunk size
+func (sw *writer) Put(e *factory.SnapshotEntry) error {
+	sw.chunk.WriteByte(byte(e.Kind))
+	writeBytes(&sw.chunk, e.Key)
+	writeBytes(&sw.chunk, e.Value)
+	if uint64(sw.chunk.Len()) >= sw.chunkSize {
+		return sw.flushChunk()
+	}
+	return nil
+}
+
+func (sw *writer) flushChunk() error {
+	if sw.chunk.Len() == 0 {
+		return nil
+	}
+	h := hash.Hash256b(sw.chunk.Bytes())
+	if err := sw.write(byteutil.Uint32ToBytesBigEndian(uint32(sw.chunk.Len()))); err != nil {
+		return err
+	}
+	if err := sw.write(sw.chunk.Bytes()); err != nil {
+		return err
+	}
+	if err := sw.write(h[:]); err != nil {
+		return err
+	}
+	sw.hashes.Write(h[:])
+	sw.numChunks++
+	sw.chunk.Reset()
+	return nil
+}
+
+// Close flushes the last chunk, completes the header with the chunks, and writes it at the end of the file
+func (sw *writer) Close(h *Header) error {
+	if err := sw.flushChunk(); err != nil {
+		return err
+	}
+	h.Version = Version
+	h.NumChunks = sw.numChunks
+	h.ChunksDigest = hash.Hash256b(sw.hashes.Bytes())
+	b, err := h.Serialize()
+	if err != nil {
+		return err
+	}
+	offset := sw.offset
+	hh := hash.Hash256b(b)
+	for _, v := range [][]byte{
+		b,
+		hh[:],
+		byteutil.Uint64ToBytesBigEndian(offset),
+		byteutil.Uint32ToBytesBigEndian(uint32(len(b))),
+		magic,
+	} {
+		if err := sw.write(v); err != nil {
+			return err
+		}
+	}
+	return sw.w.Flush()
+}
+
+// ReadHeader reads and verifies the header of a snapshot file
+func ReadHeader(f *os.File) (*Header, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	prefixLen := int64(len(magic) + 4)
+	if size < prefixLen+footerLen+hashLen {
+		return nil, errors.Wrap(ErrInvalidFile, "file is too short")
+	}
+	prefix := make([]byte, prefixLen)
+	if _, err := f.ReadAt(prefix, 0); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(prefix[:len(magic)], magic) {
+		return nil, errors.Wrap(ErrInvalidFile, "magic does not match")
+	}
+	if v := binary.BigEndian.Uint32(prefix[len(magic):]); v != Version {
+		return nil, errors.Wrapf(ErrInvalidFile, "unsupported version %d", v)
+	}
+	footer := make([]byte, footerLen)
+	if _, err := f.ReadAt(footer, size-footerLen); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(footer[12:], magic) {
+		return nil, errors.Wrap(ErrInvalidFile, "magic does not match")
+	}
+	offset := binary.BigEndian.Uint64(footer[:8])
+	length := uint64(binary.BigEndian.Uint32(footer[8:12]))
+	if offset < uint64(prefixLen) || offset+length+hashLen+footerLen != uint64(size) {
+		return nil, errors.Wrap(ErrInvalidFile, "invalid header offset")
+	}
+	b := make([]byte, length+hashLen)
+	if _, err := f.ReadAt(b, int64(offset)); err != nil {
+		return nil, err
+	}
+	if h := hash.Hash256b(b[:length]); !bytes.Equal(h[:], b[length:]) {
+		return nil, errors.Wrap(ErrInvalidFile, "header hash does not match")
+	}
+	header := &Header{}
+	if err := header.Deserialize(b[:length]); err != nil {
+		return nil, err
+	}
+	if header.Version != Version {
+		return nil, errors.Wrapf(ErrInvalidFile, "unsupported version %d", header.Version)
+	}
+	return header, nil
+}
+
+func newReader(f *os.File, h *Header) (*reader, error) {
+	if _, err := f.Seek(int64(len(magic)+4), io.SeekStart); err != nil {
+		return nil, err
+	}
+	return &reader{
+		r:         bufio.NewReader(f),
+		numChunks: h.NumChunks,
+	}, nil
+}
+
+// Next returns the next entry, or io.EOF after the last chunk. Each chunk is verified against its hash before any
+// entry in it is returned.
+func (sr *reader) Next() (*factory.SnapshotEntry, error) {
+	for sr.chunk == nil || sr.chunk.Len() == 0 {
+		if sr.read == sr.numChunks {
+			return nil, io.EOF
+		}
+		if err := sr.readChunk(); err != nil {
+			return nil, err
+		}
+	}
+	kind, err := sr.chunk.ReadByte()
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidFile, err.Error())
+	}
+	key, err := readBytes(sr.chunk)
+	if err != nil {
+		return nil, err
+	}
+	value, err := readBytes(sr.chunk)
+	if err != nil {
+		return nil, err
+	}
+	return &factory.SnapshotEntry{Kind: factory.SnapshotEntryKind(kind), Key: key, Value: value}, nil
+}
+
+func (sr *reader) readChunk() error {
+	var length uint32
+	if err := binary.Read(sr.r, binary.BigEndian, &length); err != nil {
+		return errors.Wrap(ErrInvalidFile, err.Error())
+	}
+	if length == 0 || length > maxChunkLen {
+		return errors.Wrapf(ErrInvalidFile, "invalid chunk length %d", length)
+	}
+	b := make([]byte, int(length)+hashLen)
+	if _, err := io.ReadFull(sr.r, b); err != nil {
+		return errors.Wrap(ErrInvalidFile, err.Error())
+	}
+	payload := b[:length]
+	if h := hash.Hash256b(payload); !bytes.Equal(h[:], b[length:]) {
+		return errors.Wrapf(ErrInvalidFile, "hash of chunk %d does not match", sr.read)
+	}
+	sr.hashes.Write(b[length:])
+	sr.chunk = bytes.NewReader(payload)
+	sr.read++
+	return nil
+}
+
+// ChunksDigest returns the digest of the chunks read so far
+func (sr *reader) ChunksDigest() hash.Hash256 {
+	return hash.Hash256b(sr.hashes.Bytes())
+}
+
+func writeBytes(buf *bytes.Buffer, b []byte) {
+	buf.Write(byteutil.Uint32ToBytesBigEndian(uint32(len(b))))
+	buf.Write(b)
+}
+
+func readBytes(r *bytes.Reader) ([]byte, error) {
+	var length uint32
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, errors.Wrap(ErrInvalidFile, err.Error())
+	}
+	if int(length) > r.Len() {
+		return nil, errors.Wrapf(ErrInvalidFile, "length %d exceeds the remaining %d bytes", length, r.Len())
+	}
+	b := make([]byte, length)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, errors.Wrap(ErrInvalidFile, err.Error())
+	}
+	return b, nil
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package snapshot
+
+import (
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/blockindex"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func newTestConfig(t *testing.T, dir string) config.Config {
+	cfg := config.Default
+	cfg.Plugins = map[int]interface{}{config.GatewayPlugin: true}
+	cfg.Genesis.EnableGravityChainVoting = false
+	cfg.Chain.ChainDBPath = filepath.Join(dir, "chain.db")
+	cfg.Chain.TrieDBPath = filepath.Join(dir, "trie.db")
+	cfg.Chain.IndexDBPath = filepath.Join(dir, "index.db")
+	return cfg
+}
+
+func newTestChain(t *testing.T, cfg config.Config) (blockchain.Blockchain, blockindex.Indexer) {
+	require := require.New(t)
+	registry := protocol.NewRegistry()
+	require.NoError(account.NewProtocol(rewarding.DepositGas).Register(registry))
+	rp := rolldpos.NewProtocol(cfg.Genesis.NumCandidateDelegates, cfg.Genesis.NumDelegates, cfg.Genesis.NumSubEpochs)
+	require.NoError(rp.Register(registry))
+	require.NoError(rewarding.NewProtocol(nil, rp).Register(registry))
+	cfg.DB.DbPath = cfg.Chain.IndexDBPath
+	indexer, err := blockindex.NewIndexer(db.NewKVStore(cfg.DB), cfg.Genesis.Hash())
+	require.NoError(err)
+	cfg.DB.DbPath = cfg.Chain.ChainDBPath
+	dao := blockdao.NewBlockDAO(db.NewKVStore(cfg.DB), indexer, cfg.Chain.CompressBlock, cfg.DB)
+	bc := blockchain.NewBlockchain(
+		cfg,
+		dao,
+		blockchain.DefaultStateFactoryOption(),
+		blockchain.RegistryOption(registry),
+	)
+	bc.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(bc.Factory().Nonce))
+	require.NoError(bc.Start(context.Background()))
+	return bc, indexer
+}
+
+func addTestingBlock(t *testing.T, bc blockchain.Blockchain, nonce uint64) {
+	require := require.New(t)
+	tsf, err := action.NewTransfer(nonce, big.NewInt(100), identityset.Address(27).String(), nil, 10000, big.NewInt(0))
+	require.NoError(err)
+	elp := (&action.EnvelopeBuilder{}).SetAction(tsf).SetNonce(nonce).SetGasLimit(10000).Build()
+	selp, err := action.Sign(elp, identityset.PrivateKey(0))
+	require.NoError(err)
+	blk, err := bc.MintNewBlock(
+		map[string][]action.SealedEnvelope{identityset.Address(0).String(): {selp}},
+		testutil.TimestampNow(),
+	)
+	require.NoError(err)
+	require.NoError(bc.ValidateBlock(blk))
+	require.NoError(bc.CommitBlock(blk))
+}
+
+func TestExportImport(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	// export a snapshot at the end of every 3 epochs, each of which has one block
+	// the blocks commit to the state root from the Hawaii height on, which requires the state trie
+	cfg := newTestConfig(t, filepath.Join(dir, "a"))
+	cfg.Chain.EnableTrielessStateDB = false
+	cfg.Genesis.HawaiiBlockHeight = 0
+	require.NoError(os.MkdirAll(filepath.Join(dir, "a"), 0755))
+	cfg.Snapshot.ExportDir = filepath.Join(dir, "snapshots")
+	cfg.Snapshot.ExportEpochInterval = 3
+	cfg.Snapshot.ChunkSize = 64
+	bc, indexer := newTestChain(t, cfg)
+	exporter := NewExporter(cfg.Snapshot, bc, indexer, rolldpos.NewProtocol(1, 1, 1))
+	for i := uint64(1); i <= 3; i++ {
+		addTestingBlock(t, bc, i)
+		blk, err := bc.BlockDAO().GetBlockByHeight(i)
+		require.NoError(err)
+		require.NoError(exporter.HandleBlock(blk))
+	}
+	files, err := ioutil.ReadDir(cfg.Snapshot.ExportDir)
+	require.NoError(err)
+	require.Equal(1, len(files))
+	path := filepath.Join(cfg.Snapshot.ExportDir, "snapshot-3.snap")
+	require.Equal(filepath.Base(path), files[0].Name())
+	_, err = Export(cfg.Snapshot.ExportDir, bc.ChainID(), 2, bc.Factory().(factory.SnapshotExporter), bc.BlockDAO(), indexer, 64)
+	require.Equal(factory.ErrNoArchiveData, errors.Cause(err))
+
+	f, err := os.Open(path)
+	require.NoError(err)
+	header, err := ReadHeader(f)
+	require.NoError(err)
+	require.NoError(f.Close())
+	blk, err := bc.BlockDAO().GetBlockByHeight(3)
+	require.NoError(err)
+	require.Equal(uint64(3), header.Height)
+	require.Equal(blk.HashBlock(), header.BlockHash)
+	require.True(header.HasTotalActions)
+	totalActions, err := indexer.GetTotalActions()
+	require.NoError(err)
+	require.Equal(totalActions, header.TotalActions)
+	require.True(header.NumChunks > 1)
+	balance, err := bc.Factory().Balance(identityset.Address(27).String())
+	require.NoError(err)
+	require.NoError(bc.Stop(ctx))
+
+	trust := func(cfg *config.Config) {
+		cfg.Snapshot.ImportBlockHash = hex.EncodeToString(header.BlockHash[:])
+		cfg.Snapshot.ImportRootHash = hex.EncodeToString(header.RootHash[:])
+	}
+
+	// snapshot of another chain
+	cfgB := newTestConfig(t, filepath.Join(dir, "b"))
+	require.NoError(os.MkdirAll(filepath.Join(dir, "b"), 0755))
+	trust(&cfgB)
+	cfgB.Chain.ID++
+	require.Error(Import(ctx, cfgB, path))
+
+	// snapshot not matching the trusted hashes
+	cfgB = newTestConfig(t, filepath.Join(dir, "b"))
+	require.Equal(ErrUntrusted, errors.Cause(Import(ctx, cfgB, path)))
+	trust(&cfgB)
+	cfgB.Snapshot.ImportRootHash = hex.EncodeToString(hash.ZeroHash256[:])
+	require.Equal(ErrUntrusted, errors.Cause(Import(ctx, cfgB, path)))
+	trust(&cfgB)
+	cfgB.Snapshot.ImportBlockHash = hex.EncodeToString(hash.ZeroHash256[:])
+	require.Equal(ErrUntrusted, errors.Cause(Import(ctx, cfgB, path)))
+
+	// snapshot not matching the state root committed in the block
+	require.Equal(blk.Header.StateRoot(), header.RootHash)
+	require.NoError(verifyStateRoot(header))
+	root := header.RootHash
+	header.RootHash = hash.ZeroHash256
+	require.Equal(ErrUntrusted, errors.Cause(verifyStateRoot(header)))
+	header.RootHash = root
+
+	// corrupted snapshot
+	data, err := ioutil.ReadFile(path)
+	require.NoError(err)
+	for _, offset := range []int{0, 20, len(data) - 100, len(data) - 1} {
+		tampered := append([]byte{}, data...)
+		tampered[offset]++
+		tamperedPath := filepath.Join(dir, "tampered.snap")
+		require.NoError(ioutil.WriteFile(tamperedPath, tampered, 0644))
+		cfgB = newTestConfig(t, filepath.Join(dir, "b"))
+		trust(&cfgB)
+		require.Error(Import(ctx, cfgB, tamperedPath))
+	}
+	require.NoError(os.RemoveAll(filepath.Join(dir, "b")))
+
+	// bootstrap a new node from the snapshot, and continue the chain
+	cfgB = newTestConfig(t, filepath.Join(dir, "b"))
+	require.NoError(os.MkdirAll(filepath.Join(dir, "b"), 0755))
+	trust(&cfgB)
+	require.NoError(Import(ctx, cfgB, path))
+	// importing again is a no-op
+	require.NoError(Import(ctx, cfgB, path))
+	bc, indexer = newTestChain(t, cfgB)
+	defer func() {
+		require.NoError(bc.Stop(ctx))
+	}()
+	require.Equal(uint64(3), bc.TipHeight())
+	b, err := bc.Factory().Balance(identityset.Address(27).String())
+	require.NoError(err)
+	require.Equal(balance, b)
+	receipts, err := bc.BlockDAO().GetReceipts(3)
+	require.NoError(err)
+	require.Equal(len(blk.Actions), len(receipts))
+	addTestingBlock(t, bc, 4)
+	require.Equal(uint64(4), bc.TipHeight())
+	total, err := indexer.GetTotalActions()
+	require.NoError(err)
+	require.Equal(totalActions+2, total)
+	height, err := indexer.GetBlockchainHeight()
+	require.NoError(err)
+	require.Equal(uint64(4), height)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
 	"github.com/iotexproject/iotex-core/config"
@@ -39,6 +40,15 @@ const (
 	AccountTrieRootKey = "accountTrieRoot"
 )
 
+var (
+	// ErrNotSupported is the error that the operation is not supported by the state factory
+	ErrNotSupported = errors.New("not supported")
+	// ErrNoArchiveData is the error that the history state of the requested height is not available
+	ErrNoArchiveData = errors.New("no archive data")
+	// ErrHistoryPruned is the error that the history state of the requested height is beyond the retention window
+	ErrHistoryPruned = errors.New("history state pruned")
+)
+
 type (
 	// Factory defines an interface for managing states
 	Factory interface {
@@ -47,15 +57,24 @@ type (
 		Balance(string) (*big.Int, error)
 		Nonce(string) (uint64, error) // Note that Nonce starts with 1.
 		AccountState(string) (*state.Account, error)
+		// AccountStateAtHeight returns the account state at the end of the given height
+		AccountStateAtHeight(string, uint64) (*state.Account, error)
 		RootHash() hash.Hash256
 		RootHashByHeight(uint64) (hash.Hash256, error)
 		Height() (uint64, error)
 		NewWorkingSet() (WorkingSet, error)
+		// NewWorkingSetAtHeight returns a working set to run the block at the given height, on top of the states of
+		// its parent block
+		NewWorkingSetAtHeight(uint64) (WorkingSet, error)
 		Commit(WorkingSet) error
 		// CandidatesByHeight returns array of Candidates in candidate pool of a given height
 		CandidatesByHeight(uint64) ([]*state.Candidate, error)
 
 		State(hash.Hash160, interface{}) error
+		// StateAtHeight returns the state at the end of the given height
+		StateAtHeight(uint64, hash.Hash160, interface{}) error
+		// ReadContractAtHeight runs a read-only execution on top of the states at the end of the given height
+		ReadContractAtHeight(context.Context, uint64, *action.Execution, evm.GetBlockHash) ([]byte, *action.Receipt, error)
 	}
 
 	// factory implements StateFactory interface, tracks changes to account/contract and batch-commits to DB
@@ -68,6 +87,7 @@ type (
 		accountTrie        trie.Trie  // global state trie
 		dao                db.KVStore // the underlying DB for account/contract storage
 		timerFactory       *prometheustimer.TimerFactory
+		pruner             *triePruner
 	}
 )
 
@@ -93,7 +113,7 @@ func DefaultTrieOption() Option {
 			return errors.New("Invalid empty trie db path")
 		}
 		cfg.DB.DbPath = dbPath // TODO: remove this after moving TrieDBPath from cfg.Chain to cfg.DB
-		sf.dao = db.NewBoltDB(cfg.DB)
+		sf.dao = db.NewKVStore(cfg.DB)
 		sf.saveHistory = cfg.Chain.EnableHistoryStateDB
 		return nil
 	}
@@ -103,6 +123,7 @@ func DefaultTrieOption() Option {
 func InMemTrieOption() Option {
 	return func(sf *factory, cfg config.Config) (err error) {
 		sf.dao = db.NewMemKVStore()
+		sf.saveHistory = cfg.Chain.EnableHistoryStateDB
 		return nil
 	}
 }
@@ -131,6 +152,18 @@ func NewFactory(cfg config.Config, opts ...Option) (Factory, error) {
 		return nil, errors.Wrap(err, "failed to generate accountTrie from config")
 	}
 	sf.lifecycle.Add(sf.accountTrie)
+	if cfg.DB.TriePruneRetention > 0 {
+		if !sf.saveHistory {
+			// the trie nodes are deleted on commit without history
+			log.L().Warn("Trie pruner is disabled since history state db is disabled.")
+		} else {
+			kv, ok := sf.dao.(db.KVStoreWithForEach)
+			if !ok {
+				return nil, errors.New("trie pruner requires a db which could be iterated")
+			}
+			sf.pruner = newTriePruner(kv, cfg.DB)
+		}
+	}
 	timerFactory, err := prometheustimer.New(
 		"iotex_statefactory_perf",
 		"Performance of state factory module",
@@ -170,6 +203,9 @@ func (sf *factory) Start(ctx context.Context) error {
 func (sf *factory) Stop(ctx context.Context) error {
 	sf.mutex.Lock()
 	defer sf.mutex.Unlock()
+	if sf.pruner != nil {
+		sf.pruner.Stop()
+	}
 	if err := sf.dao.Stop(ctx); err != nil {
 		return err
 	}
@@ -249,6 +285,21 @@ func (sf *factory) NewWorkingSet() (WorkingSet, error) {
 	return newWorkingSet(sf.currentChainHeight+1, sf.dao, sf.rootHash(), sf.saveHistory)
 }
 
+// NewWorkingSetAtHeight returns new working set on top of the states of the given height's parent block
+func (sf *factory) NewWorkingSetAtHeight(height uint64) (WorkingSet, error) {
+	sf.mutex.RLock()
+	defer sf.mutex.RUnlock()
+
+	if height == 0 || height > sf.currentChainHeight+1 {
+		return nil, errors.Errorf("invalid height %d, current height is %d", height, sf.currentChainHeight)
+	}
+	root, err := sf.rootHashAtHeight(height - 1)
+	if err != nil {
+		return nil, err
+	}
+	return newWorkingSet(height, sf.dao, root, sf.saveHistory)
+}
+
 // Commit persists all changes in RunActions() into the DB
 func (sf *factory) Commit(ws WorkingSet) error {
 	sf.mutex.Lock()
@@ -308,10 +359,77 @@ func (sf *factory) State(addr hash.Hash160, state interface{}) error {
 	return sf.state(addr, state)
 }
 
+// AccountStateAtHeight returns the confirmed account state at the end of the given height
+func (sf *factory) AccountStateAtHeight(addr string, height uint64) (*state.Account, error) {
+	sf.mutex.RLock()
+	defer sf.mutex.RUnlock()
+
+	ws, err := sf.workingSetAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	return loadAccount(addr, ws.State)
+}
+
+// StateAtHeight returns a confirmed state at the end of the given height
+func (sf *factory) StateAtHeight(height uint64, addr hash.Hash160, state interface{}) error {
+	sf.mutex.RLock()
+	defer sf.mutex.RUnlock()
+
+	ws, err := sf.workingSetAtHeight(height)
+	if err != nil {
+		return err
+	}
+	return ws.State(addr, state)
+}
+
+// ReadContractAtHeight runs a read-only execution on top of the states at the end of the given height
+func (sf *factory) ReadContractAtHeight(
+	ctx context.Context,
+	height uint64,
+	ex *action.Execution,
+	getBlockHash evm.GetBlockHash,
+) ([]byte, *action.Receipt, error) {
+	sf.mutex.RLock()
+	ws, err := sf.workingSetAtHeight(height)
+	sf.mutex.RUnlock()
+	if err != nil {
+		return nil, nil, err
+	}
+	return evm.ExecuteContract(ctx, ws, ex, getBlockHash)
+}
+
 //======================================
 // private trie constructor functions
 //======================================
 
+// rootHashAtHeight returns the root hash of the state trie at the end of the given height
+func (sf *factory) rootHashAtHeight(height uint64) (hash.Hash256, error) {
+	if height > sf.currentChainHeight {
+		return hash.ZeroHash256, errors.Errorf("invalid height %d, current height is %d", height, sf.currentChainHeight)
+	}
+	if height == sf.currentChainHeight {
+		return sf.rootHash(), nil
+	}
+	if err := checkHistory(sf.saveHistory, sf.cfg.DB.HistoryStateRetention, height, sf.currentChainHeight); err != nil {
+		return hash.ZeroHash256, err
+	}
+	data, err := sf.dao.Get(AccountKVNameSpace, []byte(fmt.Sprintf("%s-%d", AccountTrieRootKey, height)))
+	if err != nil {
+		return hash.ZeroHash256, errors.Wrapf(ErrNoArchiveData, "failed to get root hash of height %d: %v", height, err)
+	}
+	return hash.BytesToHash256(data), nil
+}
+
+// workingSetAtHeight returns a read-only working set on top of the states at the end of the given height
+func (sf *factory) workingSetAtHeight(height uint64) (WorkingSet, error) {
+	root, err := sf.rootHashAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	return newWorkingSet(height+1, sf.dao, root, false)
+}
+
 func (sf *factory) rootHash() hash.Hash256 {
 	return hash.BytesToHash256(sf.accountTrie.RootHash())
 }
@@ -332,23 +450,13 @@ func (sf *factory) state(addr hash.Hash160, s interface{}) error {
 
 func (sf *factory) accountState(encodedAddr string) (*state.Account, error) {
 	// TODO: state db shouldn't serve this function
-	addr, err := address.FromString(encodedAddr)
-	if err != nil {
-		return nil, errors.Wrap(err, "error when getting the pubkey hash")
-	}
-	pkHash := hash.BytesToHash160(addr.Bytes())
-	var account state.Account
-	if err := sf.state(pkHash, &account); err != nil {
-		if errors.Cause(err) == state.ErrStateNotExist {
-			account = state.EmptyAccount()
-			return &account, nil
-		}
-		return nil, errors.Wrapf(err, "error when loading state of %x", pkHash)
-	}
-	return &account, nil
+	return loadAccount(encodedAddr, sf.state)
 }
 
 func (sf *factory) commit(ws WorkingSet) error {
+	if sf.pruner != nil {
+		sf.pruner.MarkWritten(ws.GetCachedBatch())
+	}
 	if err := ws.Commit(); err != nil {
 		return errors.Wrap(err, "failed to commit working set")
 	}
@@ -361,9 +469,47 @@ func (sf *factory) commit(ws WorkingSet) error {
 	if err := sf.accountTrie.SetRootHash(h[:]); err != nil {
 		return errors.Wrap(err, "failed to commit working set")
 	}
+	if sf.pruner != nil {
+		sf.pruner.Trigger(sf.currentChainHeight)
+	}
 	return nil
 }
 
+// checkHistory checks whether the states at the end of the given height are still kept
+func checkHistory(saveHistory bool, retention, height, tip uint64) error {
+	if !saveHistory {
+		return errors.Wrapf(ErrNoArchiveData, "states of height %d are not kept without chain.enableHistoryStateDB", height)
+	}
+	if retention > 0 && height+retention < tip {
+		return errors.Wrapf(
+			ErrHistoryPruned,
+			"states of height %d are beyond the retention window of %d blocks, current height is %d",
+			height,
+			retention,
+			tip,
+		)
+	}
+	return nil
+}
+
+// loadAccount loads an account with the given state reader, and returns an empty account if it does not exist
+func loadAccount(encodedAddr string, stateFunc func(hash.Hash160, interface{}) error) (*state.Account, error) {
+	addr, err := address.FromString(encodedAddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "error when getting the pubkey hash")
+	}
+	pkHash := hash.BytesToHash160(addr.Bytes())
+	var account state.Account
+	if err := stateFunc(pkHash, &account); err != nil {
+		if errors.Cause(err) == state.ErrStateNotExist {
+			account = state.EmptyAccount()
+			return &account, nil
+		}
+		return nil, errors.Wrapf(err, "error when loading state of %x", pkHash)
+	}
+	return &account, nil
+}
+
 // Initialize initializes the state factory
 func (sf *factory) createGenesisStates(ctx context.Context) error {
 	ws, err := newWorkingSet(0, sf.dao, sf.rootHash(), sf.saveHistory)
@@ -27,11 +27,13 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
 	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/trie"
 	"github.com/iotexproject/iotex-core/pkg/enc"
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 	"github.com/iotexproject/iotex-core/pkg/util/fileutil"
@@ -495,6 +497,135 @@ func TestFactory_RootHashByHeight(t *testing.T) {
 	require.NotEqual(t, hash.ZeroHash256, rootHash)
 }
 
+func TestFactory_NewWorkingSetAtHeight(t *testing.T) {
+	require := require.New(t)
+	cfg := config.Default
+	cfg.Chain.EnableHistoryStateDB = true
+	ctx := context.Background()
+	sf, err := NewFactory(cfg, InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+
+	addrHash := hash.BytesToHash160(identityset.Address(28).Bytes())
+	for i := int64(1); i <= 2; i++ {
+		ws, err := sf.NewWorkingSet()
+		require.NoError(err)
+		require.NoError(ws.PutState(addrHash, &state.Account{Balance: big.NewInt(i)}))
+		_, err = ws.RunActions(ctx, nil)
+		require.NoError(err)
+		require.NoError(ws.Finalize())
+		require.NoError(sf.Commit(ws))
+	}
+
+	for _, height := range []uint64{2, 3} {
+		ws, err := sf.NewWorkingSetAtHeight(height)
+		require.NoError(err)
+		require.Equal(height, ws.Height())
+		var acct state.Account
+		require.NoError(ws.State(addrHash, &acct))
+		require.Equal(int64(height-1), acct.Balance.Int64())
+	}
+	_, err = sf.NewWorkingSetAtHeight(0)
+	require.Error(err)
+	_, err = sf.NewWorkingSetAtHeight(4)
+	require.Error(err)
+
+	// history states are not kept
+	cfg.Chain.EnableHistoryStateDB = false
+	sf, err = NewFactory(cfg, InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	_, err = ws.RunActions(ctx, nil)
+	require.NoError(err)
+	require.NoError(ws.Finalize())
+	require.NoError(sf.Commit(ws))
+	_, err = sf.NewWorkingSetAtHeight(1)
+	require.Equal(ErrNoArchiveData, errors.Cause(err))
+	_, err = sf.NewWorkingSetAtHeight(2)
+	require.NoError(err)
+}
+
+func TestHistoryState(t *testing.T) {
+	cfg := config.Default
+	cfg.Chain.EnableHistoryStateDB = true
+	cfg.DB.HistoryStateRetention = 2
+	sf, err := NewFactory(cfg, InMemTrieOption())
+	require.NoError(t, err)
+	testHistoryState(sf, t)
+}
+
+func TestSDBHistoryState(t *testing.T) {
+	testDBFile, _ := ioutil.TempFile(os.TempDir(), stateDBPath)
+	testDBPath := testDBFile.Name()
+
+	cfg := config.Default
+	cfg.Chain.TrieDBPath = testDBPath
+	cfg.Chain.EnableHistoryStateDB = true
+	cfg.DB.HistoryStateRetention = 2
+	sdb, err := NewStateDB(cfg, DefaultStateDBOption())
+	require.NoError(t, err)
+	testHistoryState(sdb, t)
+}
+
+func testHistoryState(sf Factory, t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+
+	a := identityset.Address(28)
+	b := identityset.Address(29)
+	for i := int64(1); i <= 5; i++ {
+		ws, err := sf.NewWorkingSet()
+		require.NoError(err)
+		require.NoError(ws.PutState(hash.BytesToHash160(a.Bytes()), &state.Account{Balance: big.NewInt(i), Nonce: uint64(i)}))
+		if i == 4 {
+			require.NoError(ws.PutState(hash.BytesToHash160(b.Bytes()), &state.Account{Balance: big.NewInt(100)}))
+		}
+		_, err = ws.RunActions(ctx, nil)
+		require.NoError(err)
+		require.NoError(ws.Finalize())
+		require.NoError(sf.Commit(ws))
+	}
+
+	for height := uint64(3); height <= 5; height++ {
+		acct, err := sf.AccountStateAtHeight(a.String(), height)
+		require.NoError(err)
+		require.Equal(int64(height), acct.Balance.Int64())
+		require.Equal(height, acct.Nonce)
+		var s state.Account
+		require.NoError(sf.StateAtHeight(height, hash.BytesToHash160(a.Bytes()), &s))
+		require.Equal(int64(height), s.Balance.Int64())
+	}
+	// the account is created at height 4
+	acct, err := sf.AccountStateAtHeight(b.String(), 3)
+	require.NoError(err)
+	require.Equal(big.NewInt(0), acct.Balance)
+	var s state.Account
+	require.Equal(state.ErrStateNotExist, errors.Cause(sf.StateAtHeight(3, hash.BytesToHash160(b.Bytes()), &s)))
+	acct, err = sf.AccountStateAtHeight(b.String(), 4)
+	require.NoError(err)
+	require.Equal(big.NewInt(100), acct.Balance)
+
+	// beyond the retention window
+	_, err = sf.AccountStateAtHeight(a.String(), 2)
+	require.Equal(ErrHistoryPruned, errors.Cause(err))
+	require.Equal(ErrHistoryPruned, errors.Cause(sf.StateAtHeight(2, hash.BytesToHash160(a.Bytes()), &s)))
+	// beyond the tip
+	_, err = sf.AccountStateAtHeight(a.String(), 6)
+	require.Error(err)
+}
+
 func TestRunActions(t *testing.T) {
 	require := require.New(t)
 	testTrieFile, _ := ioutil.TempFile(os.TempDir(), triePath)
@@ -894,3 +1025,358 @@ func benchRunAction(sf Factory, b *testing.B) {
 func init() {
 	rand.Seed(time.Now().UnixNano())
 }
+
+func TestExportImportSnapshot(t *testing.T) {
+	cfg := config.Default
+	sf, err := NewFactory(cfg, InMemTrieOption())
+	require.NoError(t, err)
+	testExportImportSnapshot(sf, false, t)
+}
+
+func TestSDBExportImportSnapshot(t *testing.T) {
+	testDBFile, _ := ioutil.TempFile(os.TempDir(), stateDBPath)
+	testDBPath := testDBFile.Name()
+	defer testutil.CleanupPath(t, testDBPath)
+
+	cfg := config.Default
+	cfg.Chain.TrieDBPath = testDBPath
+	sdb, err := NewStateDB(cfg, DefaultStateDBOption())
+	require.NoError(t, err)
+	testExportImportSnapshot(sdb, true, t)
+}
+
+func testExportImportSnapshot(sf Factory, trieless bool, t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+
+	a := hash.BytesToHash160(identityset.Address(28).Bytes())
+	b := hash.BytesToHash160(identityset.Address(29).Bytes())
+	c := hash.BytesToHash160(identityset.Address(30).Bytes())
+	code := []byte("contract code")
+	codeHash := hash.Hash256b(code)
+	for height := 1; height <= 2; height++ {
+		ws, err := sf.NewWorkingSet()
+		require.NoError(err)
+		require.NoError(ws.PutState(a, &state.Account{Balance: big.NewInt(int64(height)), Nonce: uint64(height)}))
+		if height == 1 {
+			require.NoError(ws.PutState(b, &state.Account{Balance: big.NewInt(100)}))
+			tr, err := evm.NewStorageTrie(c, hash.ZeroHash256, ws.GetDB(), ws.GetCachedBatch())
+			require.NoError(err)
+			for i := byte(1); i <= 3; i++ {
+				slot := hash.Hash256b([]byte{i})
+				require.NoError(tr.Upsert(slot[:], []byte{i}))
+			}
+			ws.GetCachedBatch().Put(evm.CodeKVNameSpace, codeHash[:], code, "failed to put code")
+			require.NoError(ws.PutState(c, &state.Account{
+				Balance:  big.NewInt(0),
+				Root:     hash.BytesToHash256(tr.RootHash()),
+				CodeHash: codeHash[:],
+			}))
+		} else {
+			require.NoError(ws.DelState(b))
+		}
+		_, err = ws.RunActions(ctx, nil)
+		require.NoError(err)
+		require.NoError(ws.Finalize())
+		require.NoError(sf.Commit(ws))
+	}
+
+	exporter, ok := sf.(SnapshotExporter)
+	require.True(ok)
+	_, err := exporter.ExportSnapshot(1, func(*SnapshotEntry) error { return nil })
+	if trieless {
+		require.Equal(ErrNotSupported, errors.Cause(err))
+	} else {
+		require.Equal(ErrNoArchiveData, errors.Cause(err))
+	}
+	var entries []*SnapshotEntry
+	root, err := exporter.ExportSnapshot(2, func(e *SnapshotEntry) error {
+		entries = append(entries, e)
+		return nil
+	})
+	require.NoError(err)
+	if !trieless {
+		require.Equal(sf.RootHash(), root)
+	}
+	// states of a and c, the code and 3 slots of c
+	require.Len(entries, 6)
+
+	importSnapshot := func(entries []*SnapshotEntry) (db.KVStore, error) {
+		kv := db.NewMemKVStore()
+		require.NoError(kv.Start(ctx))
+		imp, err := NewSnapshotImporter(kv, trieless)
+		require.NoError(err)
+		for _, e := range entries {
+			if err := imp.Import(e); err != nil {
+				return nil, err
+			}
+		}
+		return kv, imp.Finish(2, root)
+	}
+	kv, err := importSnapshot(entries)
+	require.NoError(err)
+	_, err = NewSnapshotImporter(kv, trieless)
+	require.Error(err)
+
+	var imported Factory
+	if trieless {
+		imported, err = NewStateDB(config.Default, PrecreatedStateDBOption(kv))
+	} else {
+		imported, err = NewFactory(config.Default, PrecreatedTrieDBOption(kv))
+	}
+	require.NoError(err)
+	require.NoError(imported.Start(ctx))
+	height, err := imported.Height()
+	require.NoError(err)
+	require.EqualValues(2, height)
+	if !trieless {
+		require.Equal(root, imported.RootHash())
+	}
+	var s state.Account
+	require.NoError(imported.State(a, &s))
+	require.Equal(big.NewInt(2), s.Balance)
+	require.Equal(state.ErrStateNotExist, errors.Cause(imported.State(b, &s)))
+	require.NoError(imported.State(c, &s))
+	v, err := kv.Get(evm.CodeKVNameSpace, codeHash[:])
+	require.NoError(err)
+	require.Equal(code, v)
+	tr, err := evm.NewStorageTrie(c, s.Root, kv, nil)
+	require.NoError(err)
+	slot := hash.Hash256b([]byte{2})
+	v, err = tr.Get(slot[:])
+	require.NoError(err)
+	require.Equal([]byte{2}, v)
+
+	// tampered states
+	for i, e := range entries {
+		tampered := make([]*SnapshotEntry, len(entries))
+		copy(tampered, entries)
+		tampered[i] = &SnapshotEntry{Kind: e.Kind, Key: e.Key, Value: append([]byte{1}, e.Value...)}
+		_, err := importSnapshot(tampered)
+		require.Equal(ErrInvalidSnapshot, errors.Cause(err))
+	}
+	_, err = importSnapshot(entries[:len(entries)-1])
+	require.Equal(ErrInvalidSnapshot, errors.Cause(err))
+}
+
+func TestTriePruner(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	testTrieFile, _ := ioutil.TempFile(os.TempDir(), triePath)
+	testTriePath := testTrieFile.Name()
+	defer testutil.CleanupPath(t, testTriePath)
+
+	cfg := config.Default
+	cfg.Chain.TrieDBPath = testTriePath
+	cfg.Chain.EnableHistoryStateDB = true
+	cfg.DB.HistoryStateRetention = 2
+	cfg.DB.TriePruneRetention = 3
+	cfg.DB.TriePruneInterval = 4
+	cfg.DB.TriePruneBatchSize = 2
+	f, err := NewFactory(cfg, DefaultTrieOption())
+	require.NoError(err)
+	require.NoError(f.Start(ctx))
+	defer func() {
+		require.NoError(f.Stop(ctx))
+	}()
+	sf := f.(*factory)
+	require.NotNil(sf.pruner)
+	kv := sf.dao.(db.KVStoreWithForEach)
+	// purged nodes of contract storage tagged with height 1 and 7
+	require.NoError(kv.Put(evm.PruneKVNameSpace, append(byteutil.Uint64ToBytesBigEndian(1), 1), []byte{}))
+	require.NoError(kv.Put(evm.PruneKVNameSpace, append(byteutil.Uint64ToBytesBigEndian(7), 7), []byte{}))
+
+	a := hash.BytesToHash160(identityset.Address(28).Bytes())
+	c := hash.BytesToHash160(identityset.Address(30).Bytes())
+	codeHash := hash.Hash256b([]byte("contract code"))
+	storageRoot := hash.ZeroHash256
+	storageRoots := map[uint64]hash.Hash256{}
+	for height := uint64(1); height <= 8; height++ {
+		ws, err := sf.NewWorkingSet()
+		require.NoError(err)
+		require.NoError(ws.PutState(a, &state.Account{Balance: new(big.Int).SetUint64(height)}))
+		tr, err := evm.NewStorageTrie(c, storageRoot, ws.GetDB(), ws.GetCachedBatch())
+		require.NoError(err)
+		slot := hash.Hash256b([]byte{byte(height % 3)})
+		require.NoError(tr.Upsert(slot[:], []byte{byte(height)}))
+		storageRoot = hash.BytesToHash256(tr.RootHash())
+		storageRoots[height] = storageRoot
+		require.NoError(ws.PutState(c, &state.Account{
+			Balance:  big.NewInt(0),
+			Root:     storageRoot,
+			CodeHash: codeHash[:],
+		}))
+		_, err = ws.RunActions(ctx, nil)
+		require.NoError(err)
+		require.NoError(ws.Finalize())
+		require.NoError(sf.Commit(ws))
+		if height == 7 || height == 8 {
+			// wait for the round triggered at height 4, which runs alongside commits, and the one at height 8
+			sf.pruner.wg.Wait()
+		}
+	}
+
+	// the nodes kept are exactly those reachable from the roots of height 6 to 8
+	reachable := map[hash.Hash256]bool{}
+	for height := uint64(6); height <= 8; height++ {
+		root, err := sf.RootHashByHeight(height)
+		require.NoError(err)
+		dbForTrie, err := db.NewKVStoreForTrie(AccountKVNameSpace, evm.PruneKVNameSpace, kv)
+		require.NoError(err)
+		tr, err := trie.NewTrie(trie.KVStoreOption(dbForTrie), trie.RootHashOption(root[:]))
+		require.NoError(err)
+		storage, err := evm.NewStorageTrie(c, storageRoots[height], kv, nil)
+		require.NoError(err)
+		for _, tr := range []trie.Trie{tr, storage} {
+			iter := trie.NewNodeIterator(tr)
+			for {
+				h, _, err := iter.Next()
+				if err == trie.ErrEndOfIterator {
+					break
+				}
+				require.NoError(err)
+				reachable[hash.BytesToHash256(h)] = true
+			}
+		}
+		var s state.Account
+		require.NoError(sf.StateAtHeight(height, a, &s))
+		require.Equal(new(big.Int).SetUint64(height), s.Balance)
+	}
+	var kept int
+	for _, ns := range []string{AccountKVNameSpace, evm.ContractKVNameSpace} {
+		require.NoError(kv.ForEach(ns, nil, func(k, v []byte) error {
+			if len(k) == len(hash.ZeroHash256) {
+				require.True(reachable[hash.BytesToHash256(k)])
+				kept++
+			}
+			return nil
+		}))
+	}
+	require.Equal(len(reachable), kept)
+	_, err = kv.Get(AccountKVNameSpace, []byte(AccountTrieRootKey+"-5"))
+	require.Equal(db.ErrNotExist, errors.Cause(err))
+	_, err = kv.Get(evm.PruneKVNameSpace, append(byteutil.Uint64ToBytesBigEndian(1), 1))
+	require.Equal(db.ErrNotExist, errors.Cause(err))
+	_, err = kv.Get(evm.PruneKVNameSpace, append(byteutil.Uint64ToBytesBigEndian(7), 7))
+	require.NoError(err)
+	// the marks are cleared once a round finishes
+	require.NoError(kv.ForEach(trieMarkKVNameSpace, nil, func(k, v []byte) error {
+		return errors.Errorf("mark %x is not cleared", k)
+	}))
+
+	// a node bootstrapped from a snapshot has no root hash of the heights before the snapshot
+	require.NoError(kv.Delete(AccountKVNameSpace, []byte(AccountTrieRootKey+"-6")))
+	require.NoError(kv.Delete(AccountKVNameSpace, []byte(AccountTrieRootKey+"-7")))
+	p := newTriePruner(kv, cfg.DB)
+	p.visited = map[hash.Hash256]struct{}{}
+	p.marks = db.NewBatch()
+	p.written = map[hash.Hash256]struct{}{}
+	require.NoError(p.prune(ctx, 8))
+	var s state.Account
+	require.NoError(sf.StateAtHeight(8, a, &s))
+	require.Equal(big.NewInt(8), s.Balance)
+	require.NoError(kv.Delete(AccountKVNameSpace, []byte(AccountTrieRootKey+"-8")))
+	require.Error(p.prune(ctx, 8))
+
+	// nodes written during a round are not swept
+	node := hash.Hash256b([]byte("node"))
+	b := db.NewBatch()
+	b.Put(evm.ContractKVNameSpace, node[:], []byte("node"), "failed to put node")
+	p = newTriePruner(kv, cfg.DB)
+	p.MarkWritten(b)
+	require.Nil(p.written)
+	p.running = true
+	p.visited = map[hash.Hash256]struct{}{}
+	p.written = map[hash.Hash256]struct{}{}
+	p.MarkWritten(b)
+	require.False(p.isGarbage(node[:]))
+	other := hash.Hash256b([]byte("other"))
+	require.True(p.isGarbage(other[:]))
+	require.False(p.isGarbage([]byte(CurrentHeightKey)))
+}
+
+func TestProveAtHeight(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	testTrieFile, _ := ioutil.TempFile(os.TempDir(), triePath)
+	testTriePath := testTrieFile.Name()
+	defer testutil.CleanupPath(t, testTriePath)
+
+	cfg := config.Default
+	cfg.Chain.TrieDBPath = testTriePath
+	cfg.Chain.EnableHistoryStateDB = true
+	f, err := NewFactory(cfg, DefaultTrieOption())
+	require.NoError(err)
+	require.NoError(f.Start(ctx))
+	defer func() {
+		require.NoError(f.Stop(ctx))
+	}()
+	prover, ok := f.(Prover)
+	require.True(ok)
+
+	a := hash.BytesToHash160(identityset.Address(28).Bytes())
+	c := hash.BytesToHash160(identityset.Address(30).Bytes())
+	codeHash := hash.Hash256b([]byte("contract code"))
+	slot1 := hash.Hash256b([]byte("slot1"))
+	slot2 := hash.Hash256b([]byte("slot2"))
+	storageRoot := hash.ZeroHash256
+	for height := uint64(1); height <= 2; height++ {
+		ws, err := f.NewWorkingSet()
+		require.NoError(err)
+		require.NoError(ws.PutState(a, &state.Account{Balance: new(big.Int).SetUint64(height)}))
+		tr, err := evm.NewStorageTrie(c, storageRoot, ws.GetDB(), ws.GetCachedBatch())
+		require.NoError(err)
+		require.NoError(tr.Upsert(slot1[:], []byte{byte(height)}))
+		storageRoot = hash.BytesToHash256(tr.RootHash())
+		require.NoError(ws.PutState(c, &state.Account{
+			Balance:  big.NewInt(0),
+			Root:     storageRoot,
+			CodeHash: codeHash[:],
+		}))
+		_, err = ws.RunActions(ctx, nil)
+		require.NoError(err)
+		require.NoError(ws.Finalize())
+		require.NoError(f.Commit(ws))
+	}
+
+	for height := uint64(1); height <= 2; height++ {
+		root, err := f.RootHashByHeight(height)
+		require.NoError(err)
+		proof, err := prover.ProveAtHeight(height, a, nil)
+		require.NoError(err)
+		require.Equal(root, proof.RootHash)
+		require.Equal(new(big.Int).SetUint64(height), proof.Account.Balance)
+		require.NoError(VerifyStateProof(a, proof))
+		proof.Account.Balance = big.NewInt(100)
+		require.Equal(trie.ErrInvalidProof, errors.Cause(VerifyStateProof(a, proof)))
+
+		proof, err = prover.ProveAtHeight(height, c, []hash.Hash256{slot1, slot2})
+		require.NoError(err)
+		require.Equal(2, len(proof.StorageProofs))
+		require.Equal([]byte{byte(height)}, proof.StorageProofs[0].Value)
+		require.Nil(proof.StorageProofs[1].Value)
+		require.NoError(VerifyStateProof(c, proof))
+		proof.StorageProofs[1].Value = []byte{1}
+		require.Equal(trie.ErrInvalidProof, errors.Cause(VerifyStateProof(c, proof)))
+		proof.StorageProofs[1].Value = nil
+		// proof of another account
+		require.Equal(trie.ErrInvalidProof, errors.Cause(VerifyStateProof(a, proof)))
+	}
+
+	// account which does not exist
+	b := hash.BytesToHash160(identityset.Address(29).Bytes())
+	proof, err := prover.ProveAtHeight(2, b, []hash.Hash256{slot1})
+	require.NoError(err)
+	require.Nil(proof.Account)
+	require.Nil(proof.StorageProofs[0].Value)
+	require.NoError(VerifyStateProof(b, proof))
+	proof.Account = &state.Account{Balance: big.NewInt(0)}
+	require.Equal(trie.ErrInvalidProof, errors.Cause(VerifyStateProof(b, proof)))
+
+	_, err = prover.ProveAtHeight(3, a, nil)
+	require.Error(err)
+}
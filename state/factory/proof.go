@@ -0,0 +1,173 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+type (
+	// StateProof is the Merkle proof of an account and the storage slots of the contract against the root hash of the
+	// states at the end of a height
+	StateProof struct {
+		Height   uint64
+		RootHash hash.Hash256
+		// Account is nil if the account does not exist
+		Account       *state.Account
+		AccountProof  [][]byte
+		StorageProofs []*StorageProof
+	}
+
+	// StorageProof is the Merkle proof of a storage slot against the storage root of the contract
+	StorageProof struct {
+		Key hash.Hash256
+		// Value is nil if the slot does not exist
+		Value []byte
+		Proof [][]byte
+	}
+
+	// Prover is a state factory which proves the states against its root hash. Only the trie state factory is a prover,
+	// as the stateDB factory, i.e., chain.enableTrielessStateDB which is turned on by default, keeps no trie to prove
+	// the states with.
+	//
+	// A proof only shows that the states are consistent with its root hash. The root hash is trusted once it matches
+	// the state root committed in the header of the block, which every block sets and every delegate verifies before
+	// endorsing the block from the Hawaii height on.
+	Prover interface {
+		// ProveAtHeight returns the proof of the account and the storage slots of the contract at the end of the given
+		// height
+		ProveAtHeight(uint64, hash.Hash160, []hash.Hash256) (*StateProof, error)
+	}
+)
+
+// ProveAtHeight returns the proof of the account and the storage slots of the contract at the end of the given height
+func (sf *factory) ProveAtHeight(height uint64, addr hash.Hash160, keys []hash.Hash256) (*StateProof, error) {
+	sf.mutex.RLock()
+	defer sf.mutex.RUnlock()
+
+	root, err := sf.rootHashAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	dbForTrie, err := db.NewKVStoreForTrie(AccountKVNameSpace, evm.PruneKVNameSpace, sf.dao)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create db for trie")
+	}
+	tr, err := trie.NewTrie(trie.KVStoreOption(dbForTrie), trie.RootHashOption(root[:]))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create account trie")
+	}
+	if err := tr.Start(context.Background()); err != nil {
+		return nil, errors.Wrapf(err, "failed to load account trie from root = %x", root)
+	}
+	proof := &StateProof{Height: height, RootHash: root}
+	if proof.AccountProof, err = tr.Prove(addr[:]); err != nil {
+		return nil, errors.Wrapf(err, "failed to prove account %x", addr)
+	}
+	switch data, err := tr.Get(addr[:]); errors.Cause(err) {
+	case nil:
+		proof.Account = &state.Account{}
+		if err := proof.Account.Deserialize(data); err != nil {
+			return nil, errors.Wrapf(err, "failed to deserialize account %x", addr)
+		}
+	case trie.ErrNotExist:
+	default:
+		return nil, errors.Wrapf(err, "failed to get account %x", addr)
+	}
+
+	var storage trie.Trie
+	if proof.Account != nil && proof.Account.Root != hash.ZeroHash256 {
+		if storage, err = evm.NewStorageTrie(addr, proof.Account.Root, sf.dao, nil); err != nil {
+			return nil, err
+		}
+	}
+	for _, key := range keys {
+		sp := &StorageProof{Key: key}
+		if storage != nil {
+			if sp.Proof, err = storage.Prove(key[:]); err != nil {
+				return nil, errors.Wrapf(err, "failed to prove slot %x of contract %x", key, addr)
+			}
+			switch sp.Value, err = storage.Get(key[:]); errors.Cause(err) {
+			case nil:
+			case trie.ErrNotExist:
+				sp.Value = nil
+			default:
+				return nil, errors.Wrapf(err, "failed to get slot %x of contract %x", key, addr)
+			}
+		}
+		proof.StorageProofs = append(proof.StorageProofs, sp)
+	}
+	return proof, nil
+}
+
+// VerifyStateProofAtRoot verifies the proof of an account and the storage slots of the contract against the state root
+// committed in the header of the block at the height, so the proof is as trusted as the header, e.g., once the header
+// is endorsed by the delegates
+func VerifyStateProofAtRoot(height uint64, root hash.Hash256, addr hash.Hash160, proof *StateProof) error {
+	if root == hash.ZeroHash256 {
+		return errors.Errorf("block %d does not commit to a state root", height)
+	}
+	if proof.Height != height {
+		return errors.Errorf("proof of height %d does not match block %d", proof.Height, height)
+	}
+	if proof.RootHash != root {
+		return errors.Errorf("proof root %x does not match state root %x", proof.RootHash, root)
+	}
+	return VerifyStateProof(addr, proof)
+}
+
+// VerifyStateProof verifies the proof of an account and the storage slots of the contract against its root hash
+func VerifyStateProof(addr hash.Hash160, proof *StateProof) error {
+	data, err := trie.VerifyProof(proof.RootHash[:], addr[:], proof.AccountProof, trie.DefaultHashFunc)
+	switch errors.Cause(err) {
+	case nil:
+		if proof.Account == nil {
+			return errors.Wrapf(trie.ErrInvalidProof, "account %x exists", addr)
+		}
+		expected, err := proof.Account.Serialize()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(expected, data) {
+			return errors.Wrapf(trie.ErrInvalidProof, "account %x does not match", addr)
+		}
+	case trie.ErrNotExist:
+		if proof.Account != nil {
+			return errors.Wrapf(trie.ErrInvalidProof, "account %x does not exist", addr)
+		}
+	default:
+		return err
+	}
+
+	var storageRoot hash.Hash256
+	if proof.Account != nil {
+		storageRoot = proof.Account.Root
+	}
+	for _, sp := range proof.StorageProofs {
+		value, err := evm.VerifyStorageProof(addr, storageRoot, sp.Key, sp.Proof)
+		switch errors.Cause(err) {
+		case nil:
+		case trie.ErrNotExist:
+			value = nil
+		default:
+			return err
+		}
+		if !bytes.Equal(value, sp.Value) {
+			return errors.Wrapf(trie.ErrInvalidProof, "slot %x of contract %x does not match", sp.Key, addr)
+		}
+	}
+	return nil
+}
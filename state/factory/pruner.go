@@ -0,0 +1,436 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+var (
+	triePrunerMtc = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "iotex_trie_pruner",
+			Help: "Progress of the current round of trie pruning",
+		},
+		[]string{"type"},
+	)
+	triePrunerReclaimedMtc = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "iotex_trie_pruner_reclaimed_bytes",
+			Help: "Bytes of keys and values deleted by the trie pruner",
+		},
+	)
+	errStopSweep = errors.New("stop sweeping")
+)
+
+func init() {
+	prometheus.MustRegister(triePrunerMtc)
+	prometheus.MustRegister(triePrunerReclaimedMtc)
+}
+
+const (
+	// trieNodeKeyLength is the length of the key of a trie node, i.e., its hash
+	trieNodeKeyLength = len(hash.ZeroHash256)
+	// trieMarkKVNameSpace is the namespace of the nodes marked by the running round of pruning
+	trieMarkKVNameSpace = "TrieMark"
+)
+
+// triePruner deletes the nodes of the account trie and the contract storage tries which are not reachable from the
+// roots of the latest heights. A round of pruning marks the nodes reachable from the roots, and then sweeps the other
+// nodes in batches. The marks are persisted in batches, so that the memory they take doesn't grow with the tries, and
+// they are cleared once the round finishes. It runs alongside the commits of the state factory, which mark the nodes
+// they write during a round so that they are not swept.
+type triePruner struct {
+	kv        db.KVStoreWithForEach
+	retention uint64
+	interval  uint64
+	batchSize int
+
+	mutex   sync.Mutex
+	running bool
+	visited map[hash.Hash256]struct{}
+	marks   db.KVStoreBatch
+	written map[hash.Hash256]struct{}
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// sweptEntry is a key to be swept, and the size of the key and value
+type sweptEntry struct {
+	key  []byte
+	size int
+}
+
+func newTriePruner(kv db.KVStoreWithForEach, cfg config.DB) *triePruner {
+	return &triePruner{
+		kv:        kv,
+		retention: cfg.TriePruneRetention,
+		interval:  cfg.TriePruneInterval,
+		batchSize: cfg.TriePruneBatchSize,
+	}
+}
+
+// MarkWritten marks the trie nodes put by the batch, if a round of pruning is running
+func (p *triePruner) MarkWritten(b db.KVStoreBatch) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if !p.running {
+		return
+	}
+	for i := 0; i < b.Size(); i++ {
+		w, err := b.Entry(i)
+		if err != nil || w.WriteType() != db.Put || len(w.Key()) != trieNodeKeyLength {
+			continue
+		}
+		if ns := w.Namespace(); ns == AccountKVNameSpace || ns == evm.ContractKVNameSpace {
+			p.written[hash.BytesToHash256(w.Key())] = struct{}{}
+		}
+	}
+}
+
+// Trigger starts a round of pruning in background at every interval of heights, if the last round has finished
+func (p *triePruner) Trigger(height uint64) {
+	if height < p.retention || height%p.interval != 0 {
+		return
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.running {
+		log.L().Warn("Skip trie pruning since the last round is still running.", zap.Uint64("height", height))
+		return
+	}
+	p.running = true
+	p.visited = make(map[hash.Hash256]struct{})
+	p.marks = db.NewBatch()
+	p.written = make(map[hash.Hash256]struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		if err := p.prune(ctx, height); err != nil {
+			log.L().Error("Failed to prune trie.", zap.Uint64("height", height), zap.Error(err))
+		}
+		p.mutex.Lock()
+		p.running = false
+		p.visited = nil
+		p.marks = nil
+		p.written = nil
+		p.mutex.Unlock()
+	}()
+}
+
+// Stop stops the running round of pruning and waits for it to return
+func (p *triePruner) Stop() {
+	p.mutex.Lock()
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.mutex.Unlock()
+	p.wg.Wait()
+}
+
+// prune keeps the nodes reachable from the roots of the latest retention heights at the given height
+func (p *triePruner) prune(ctx context.Context, height uint64) error {
+	for _, label := range []string{"marked", "scanned", "deleted"} {
+		triePrunerMtc.WithLabelValues(label).Set(0)
+	}
+	triePrunerMtc.WithLabelValues("height").Set(float64(height))
+	lowest := height - p.retention + 1
+	roots := make(map[hash.Hash256]struct{})
+	for h := lowest; h <= height; h++ {
+		root, err := p.kv.Get(AccountKVNameSpace, []byte(fmt.Sprintf("%s-%d", AccountTrieRootKey, h)))
+		if errors.Cause(err) == db.ErrNotExist && len(roots) == 0 {
+			// a node bootstrapped from a snapshot has no root hash of the heights before the snapshot
+			lowest = h + 1
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to get root hash of height %d", h)
+		}
+		roots[hash.BytesToHash256(root)] = struct{}{}
+	}
+	if len(roots) == 0 {
+		return errors.Errorf("no root hash from height %d to %d", height-p.retention+1, height)
+	}
+	// clear the marks left by an interrupted round
+	if err := p.clearMarks(ctx); err != nil {
+		return err
+	}
+	for root := range roots {
+		if err := p.markAccountTrie(ctx, root); err != nil {
+			return err
+		}
+	}
+	if err := p.flushMarks(); err != nil {
+		return err
+	}
+	rootKeyPrefix := []byte(AccountTrieRootKey + "-")
+	if err := p.sweep(ctx, AccountKVNameSpace, func(key []byte) (bool, bool) {
+		if bytes.HasPrefix(key, rootKeyPrefix) {
+			// the root hash of the heights which are pruned
+			h, err := strconv.ParseUint(strings.TrimPrefix(string(key), string(rootKeyPrefix)), 10, 64)
+			return err == nil && h < lowest, false
+		}
+		return p.isGarbage(key), false
+	}); err != nil {
+		return err
+	}
+	if err := p.sweep(ctx, evm.ContractKVNameSpace, func(key []byte) (bool, bool) {
+		return p.isGarbage(key), false
+	}); err != nil {
+		return err
+	}
+	// the nodes purged from contract storage tries are tagged with the height in big endian
+	if err := p.sweep(ctx, evm.PruneKVNameSpace, func(key []byte) (bool, bool) {
+		if len(key) < 8 || byteutil.BytesToUint64BigEndian(key[:8]) >= lowest {
+			return false, true
+		}
+		return true, false
+	}); err != nil {
+		return err
+	}
+	return p.clearMarks(ctx)
+}
+
+func (p *triePruner) markAccountTrie(ctx context.Context, root hash.Hash256) error {
+	dbForTrie, err := db.NewKVStoreForTrie(AccountKVNameSpace, evm.PruneKVNameSpace, p.kv)
+	if err != nil {
+		return errors.Wrap(err, "failed to create db for trie")
+	}
+	tr, err := trie.NewTrie(trie.KVStoreOption(dbForTrie), trie.RootHashOption(root[:]))
+	if err != nil {
+		return errors.Wrap(err, "failed to create account trie")
+	}
+	return p.mark(ctx, tr, func(node trie.Node) error {
+		var account state.Account
+		if err := account.Deserialize(node.Value()); err != nil || !account.IsContract() ||
+			account.Root == hash.ZeroHash256 {
+			// not a contract with storage
+			return nil
+		}
+		storage, err := evm.NewStorageTrie(hash.BytesToHash160(node.Key()), account.Root, p.kv, nil)
+		if errors.Cause(err) == db.ErrNotExist {
+			// a state that happens to be decoded as a contract
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return p.mark(ctx, storage, nil)
+	})
+}
+
+// mark marks the nodes of the trie which have not been visited, and calls the function on the leaves
+func (p *triePruner) mark(ctx context.Context, tr trie.Trie, leafFn func(trie.Node) error) error {
+	iter := trie.NewNodeIterator(tr)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		h, node, err := iter.Next()
+		if err == trie.ErrEndOfIterator {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		key := hash.BytesToHash256(h)
+		visited, err := p.isMarked(key)
+		if err != nil {
+			return err
+		}
+		if visited {
+			// all the nodes under it have been visited
+			iter.SkipChildren()
+			continue
+		}
+		if err := p.markVisited(key); err != nil {
+			return err
+		}
+		triePrunerMtc.WithLabelValues("marked").Inc()
+		if node.Type() == trie.LEAF && leafFn != nil {
+			if err := leafFn(node); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// markVisited marks the node visited, and persists the marks once a batch of them is collected. The marks not
+// persisted yet are only accessed in the goroutine of the round.
+func (p *triePruner) markVisited(key hash.Hash256) error {
+	p.visited[key] = struct{}{}
+	p.marks.Put(trieMarkKVNameSpace, key[:], []byte{}, "failed to mark node %x", key)
+	if len(p.visited) < p.batchSize {
+		return nil
+	}
+	return p.flushMarks()
+}
+
+func (p *triePruner) flushMarks() error {
+	if len(p.visited) == 0 {
+		return nil
+	}
+	if err := p.kv.WriteBatch(p.marks); err != nil {
+		return errors.Wrap(err, "failed to persist the marks of trie nodes")
+	}
+	p.visited = make(map[hash.Hash256]struct{})
+	p.marks = db.NewBatch()
+	return nil
+}
+
+// isMarked tells whether the node has been visited by the running round
+func (p *triePruner) isMarked(key hash.Hash256) (bool, error) {
+	if _, ok := p.visited[key]; ok {
+		return true, nil
+	}
+	_, err := p.kv.Get(trieMarkKVNameSpace, key[:])
+	switch errors.Cause(err) {
+	case nil:
+		return true, nil
+	case db.ErrNotExist:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// clearMarks deletes the persisted marks in batches
+func (p *triePruner) clearMarks(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		b := db.NewBatch()
+		err := p.kv.ForEach(trieMarkKVNameSpace, nil, func(k, v []byte) error {
+			if b.Size() == p.batchSize {
+				return errStopSweep
+			}
+			b.Delete(trieMarkKVNameSpace, append(k[:0:0], k...), "failed to delete mark %x", k)
+			return nil
+		})
+		switch errors.Cause(err) {
+		case nil, errStopSweep:
+		case db.ErrNotExist:
+			// the namespace has not been created
+			return nil
+		default:
+			return err
+		}
+		if b.Size() == 0 {
+			return nil
+		}
+		if err := p.kv.WriteBatch(b); err != nil {
+			return err
+		}
+	}
+}
+
+// isGarbage tells whether the key is a trie node which has not been marked, it should be called with the mutex held.
+// A node is kept if its mark fails to be read.
+func (p *triePruner) isGarbage(key []byte) bool {
+	if len(key) != trieNodeKeyLength {
+		return false
+	}
+	h := hash.BytesToHash256(key)
+	if _, ok := p.written[h]; ok {
+		return false
+	}
+	marked, err := p.isMarked(h)
+	return err == nil && !marked
+}
+
+// sweep goes through the keys in the namespace in batches, and deletes the garbage. The filter tells whether a key is
+// garbage and whether to stop sweeping, and it is called with the mutex held, so that no commit could write a node in
+// between the check and the deletion.
+func (p *triePruner) sweep(ctx context.Context, ns string, filter func([]byte) (bool, bool)) error {
+	var start []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var (
+			entries []sweptEntry
+			next    []byte
+		)
+		err := p.kv.ForEach(ns, start, func(k, v []byte) error {
+			if len(entries) == p.batchSize {
+				next = append(k[:0:0], k...)
+				return errStopSweep
+			}
+			entries = append(entries, sweptEntry{key: append(k[:0:0], k...), size: len(k) + len(v)})
+			return nil
+		})
+		switch errors.Cause(err) {
+		case nil, errStopSweep:
+		case db.ErrNotExist:
+			// the namespace has not been created
+			return nil
+		default:
+			return err
+		}
+		done, err := p.deleteGarbage(ns, entries, filter)
+		if err != nil {
+			return err
+		}
+		if done || next == nil {
+			return nil
+		}
+		start = next
+	}
+}
+
+func (p *triePruner) deleteGarbage(ns string, entries []sweptEntry, filter func([]byte) (bool, bool)) (bool, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	var (
+		b         = db.NewBatch()
+		reclaimed int
+		done      bool
+	)
+	for _, e := range entries {
+		garbage, stop := filter(e.key)
+		if stop {
+			done = true
+			break
+		}
+		triePrunerMtc.WithLabelValues("scanned").Inc()
+		if garbage {
+			b.Delete(ns, e.key, "failed to delete key %x", e.key)
+			reclaimed += e.size
+		}
+	}
+	deleted := b.Size()
+	if deleted == 0 {
+		return done, nil
+	}
+	if err := p.kv.WriteBatch(b); err != nil {
+		return false, err
+	}
+	triePrunerMtc.WithLabelValues("deleted").Add(float64(deleted))
+	triePrunerReclaimedMtc.Add(float64(reclaimed))
+	return done, nil
+}
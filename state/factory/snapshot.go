@@ -0,0 +1,342 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// the kinds of snapshot entries
+const (
+	// StateEntry is a state of the account trie, keyed by the address hash
+	StateEntry SnapshotEntryKind = iota + 1
+	// CodeEntry is the code of a contract, keyed by the code hash
+	CodeEntry
+	// StorageEntry is a slot of contract storage, keyed by the contract address hash followed by the slot key
+	StorageEntry
+)
+
+// snapshotFlushSize is the number of pending writes which triggers a flush into DB during import
+const snapshotFlushSize = 10000
+
+// ErrInvalidSnapshot indicates that the snapshot entries do not match the root hash
+var ErrInvalidSnapshot = errors.New("invalid snapshot")
+
+type (
+	// SnapshotEntryKind is the kind of a snapshot entry
+	SnapshotEntryKind uint8
+
+	// SnapshotEntry is an entry of the states exported into a snapshot. The entries of a contract, i.e., its code and
+	// storage, immediately follow its account state.
+	SnapshotEntry struct {
+		Kind  SnapshotEntryKind
+		Key   []byte
+		Value []byte
+	}
+
+	// SnapshotExporter is the state factory which could export its states into a snapshot
+	SnapshotExporter interface {
+		// ExportSnapshot calls the function on each entry of the states at the end of the given height, and returns
+		// the root hash of the account trie built from these states
+		ExportSnapshot(uint64, func(*SnapshotEntry) error) (hash.Hash256, error)
+	}
+
+	// SnapshotImporter rebuilds the states of a state factory from the entries of a snapshot
+	SnapshotImporter interface {
+		// Import imports an entry, in the order they are exported
+		Import(*SnapshotEntry) error
+		// Finish verifies the states against the root hash, and sets the height of the state factory
+		Finish(uint64, hash.Hash256) error
+	}
+
+	snapshotImporter struct {
+		kv          db.KVStore
+		trieless    bool
+		cb          db.CachedBatch
+		accountTrie trie.Trie
+		// the contract whose code and storage are being imported
+		contract     hash.Hash160
+		account      *state.Account
+		codeImported bool
+		storage      trie.Trie
+	}
+)
+
+// ExportSnapshot exports the states at the end of the given height
+func (sf *factory) ExportSnapshot(height uint64, fn func(*SnapshotEntry) error) (hash.Hash256, error) {
+	sf.mutex.RLock()
+	defer sf.mutex.RUnlock()
+
+	root, err := sf.rootHashAtHeight(height)
+	if err != nil {
+		return hash.ZeroHash256, err
+	}
+	dbForTrie, err := db.NewKVStoreForTrie(AccountKVNameSpace, evm.PruneKVNameSpace, sf.dao)
+	if err != nil {
+		return hash.ZeroHash256, errors.Wrap(err, "failed to create db for trie")
+	}
+	tr, err := trie.NewTrie(trie.KVStoreOption(dbForTrie), trie.RootHashOption(root[:]))
+	if err != nil {
+		return hash.ZeroHash256, errors.Wrap(err, "failed to create account trie")
+	}
+	iter, err := trie.NewLeafIterator(tr)
+	if err != nil {
+		return hash.ZeroHash256, errors.Wrapf(err, "failed to iterate account trie of height %d", height)
+	}
+	for {
+		key, value, err := iter.Next()
+		if err == trie.ErrEndOfIterator {
+			return root, nil
+		}
+		if err != nil {
+			return hash.ZeroHash256, err
+		}
+		if err := exportState(sf.dao, key, value, fn); err != nil {
+			return hash.ZeroHash256, err
+		}
+	}
+}
+
+// ExportSnapshot exports the states at the end of the given height, which could only be the current height, and
+// computes the root hash of the states as the trie factory does
+func (sdb *stateDB) ExportSnapshot(height uint64, fn func(*SnapshotEntry) error) (hash.Hash256, error) {
+	sdb.mutex.RLock()
+	defer sdb.mutex.RUnlock()
+
+	if height != sdb.currentChainHeight {
+		return hash.ZeroHash256, errors.Wrapf(
+			ErrNotSupported,
+			"cannot export states of height %d, current height is %d",
+			height,
+			sdb.currentChainHeight,
+		)
+	}
+	kv, ok := sdb.dao.(db.KVStoreWithForEach)
+	if !ok {
+		return hash.ZeroHash256, errors.Wrap(ErrNotSupported, "state db cannot be iterated")
+	}
+	tr, err := trie.NewTrie()
+	if err != nil {
+		return hash.ZeroHash256, err
+	}
+	if err := tr.Start(context.Background()); err != nil {
+		return hash.ZeroHash256, err
+	}
+	if err := kv.ForEach(AccountKVNameSpace, nil, func(key, value []byte) error {
+		if len(key) != len(hash.Hash160{}) {
+			// skip the height and other special keys
+			return nil
+		}
+		if err := tr.Upsert(key, value); err != nil {
+			return err
+		}
+		return exportState(sdb.dao, key, value, fn)
+	}); err != nil && errors.Cause(err) != db.ErrNotExist {
+		return hash.ZeroHash256, err
+	}
+	return hash.BytesToHash256(tr.RootHash()), nil
+}
+
+// exportState exports a state, followed by the code and storage if it is a contract
+func exportState(dao db.KVStore, key, value []byte, fn func(*SnapshotEntry) error) error {
+	if err := fn(&SnapshotEntry{Kind: StateEntry, Key: key, Value: value}); err != nil {
+		return err
+	}
+	account := &state.Account{}
+	if err := account.Deserialize(value); err != nil || !account.IsContract() {
+		// not a contract
+		return nil
+	}
+	code, err := dao.Get(evm.CodeKVNameSpace, account.CodeHash)
+	if errors.Cause(err) == db.ErrNotExist {
+		// a state that happens to be decoded as a contract
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get code of contract %x", key)
+	}
+	if err := fn(&SnapshotEntry{Kind: CodeEntry, Key: account.CodeHash, Value: code}); err != nil {
+		return err
+	}
+	if account.Root == hash.ZeroHash256 {
+		return nil
+	}
+	addr := hash.BytesToHash160(key)
+	tr, err := evm.NewStorageTrie(addr, account.Root, dao, nil)
+	if err != nil {
+		return err
+	}
+	iter, err := trie.NewLeafIterator(tr)
+	if err != nil {
+		return errors.Wrapf(err, "failed to iterate storage of contract %x", key)
+	}
+	for {
+		slot, v, err := iter.Next()
+		if err == trie.ErrEndOfIterator {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(&SnapshotEntry{Kind: StorageEntry, Key: append(addr[:], slot...), Value: v}); err != nil {
+			return err
+		}
+	}
+}
+
+// NewSnapshotImporter creates an importer which writes the states into the DB of a state factory, which should
+// have been started and be empty. The states are written as the trieless state DB does if trieless is true.
+func NewSnapshotImporter(kv db.KVStore, trieless bool) (SnapshotImporter, error) {
+	if _, err := kv.Get(AccountKVNameSpace, []byte(CurrentHeightKey)); err == nil {
+		return nil, errors.New("cannot import snapshot into a non-empty state db")
+	}
+	imp := &snapshotImporter{
+		kv:       kv,
+		trieless: trieless,
+		cb:       db.NewCachedBatch(),
+	}
+	var err error
+	if trieless {
+		// the trie is only used to verify the states
+		imp.accountTrie, err = trie.NewTrie()
+	} else {
+		var dbForTrie trie.KVStore
+		dbForTrie, err = db.NewKVStoreForTrie(AccountKVNameSpace, evm.PruneKVNameSpace, kv, db.CachedBatchOption(imp.cb))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create db for trie")
+		}
+		imp.accountTrie, err = trie.NewTrie(trie.KVStoreOption(dbForTrie))
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create account trie")
+	}
+	if err := imp.accountTrie.Start(context.Background()); err != nil {
+		return nil, err
+	}
+	return imp, nil
+}
+
+func (imp *snapshotImporter) Import(e *SnapshotEntry) error {
+	switch e.Kind {
+	case StateEntry:
+		if err := imp.finishContract(); err != nil {
+			return err
+		}
+		if len(e.Key) != len(hash.Hash160{}) {
+			return errors.Wrapf(ErrInvalidSnapshot, "invalid state key %x", e.Key)
+		}
+		if err := imp.accountTrie.Upsert(e.Key, e.Value); err != nil {
+			return err
+		}
+		if imp.trieless {
+			imp.cb.Put(AccountKVNameSpace, e.Key, e.Value, "failed to put state %x", e.Key)
+		}
+		account := &state.Account{}
+		if err := account.Deserialize(e.Value); err == nil && account.IsContract() {
+			imp.contract = hash.BytesToHash160(e.Key)
+			imp.account = account
+		}
+	case CodeEntry:
+		if imp.account == nil || imp.codeImported || !bytes.Equal(e.Key, imp.account.CodeHash) {
+			return errors.Wrapf(ErrInvalidSnapshot, "unexpected code %x", e.Key)
+		}
+		if h := hash.Hash256b(e.Value); !bytes.Equal(h[:], e.Key) {
+			return errors.Wrapf(ErrInvalidSnapshot, "code does not match code hash %x", e.Key)
+		}
+		imp.cb.Put(evm.CodeKVNameSpace, e.Key, e.Value, "failed to put code %x", e.Key)
+		imp.codeImported = true
+	case StorageEntry:
+		if imp.account == nil || !imp.codeImported || len(e.Key) <= len(imp.contract) ||
+			!bytes.Equal(e.Key[:len(imp.contract)], imp.contract[:]) {
+			return errors.Wrapf(ErrInvalidSnapshot, "unexpected storage %x", e.Key)
+		}
+		if imp.storage == nil {
+			var err error
+			if imp.storage, err = evm.NewStorageTrie(imp.contract, hash.ZeroHash256, imp.kv, imp.cb); err != nil {
+				return err
+			}
+		}
+		if err := imp.storage.Upsert(e.Key[len(imp.contract):], e.Value); err != nil {
+			return err
+		}
+	default:
+		return errors.Wrapf(ErrInvalidSnapshot, "unknown entry kind %d", e.Kind)
+	}
+	if imp.cb.Size() >= snapshotFlushSize {
+		return imp.kv.WriteBatch(imp.cb)
+	}
+	return nil
+}
+
+func (imp *snapshotImporter) Finish(height uint64, root hash.Hash256) error {
+	if err := imp.finishContract(); err != nil {
+		return err
+	}
+	if h := hash.BytesToHash256(imp.accountTrie.RootHash()); h != root {
+		return errors.Wrapf(ErrInvalidSnapshot, "root hash of states is %x, expecting %x", h, root)
+	}
+	if !imp.trieless {
+		imp.cb.Put(AccountKVNameSpace, []byte(AccountTrieRootKey), root[:], "failed to store accountTrie's root hash")
+		imp.cb.Put(
+			AccountKVNameSpace,
+			[]byte(fmt.Sprintf("%s-%d", AccountTrieRootKey, height)),
+			root[:],
+			"failed to store accountTrie's root hash",
+		)
+	}
+	h := byteutil.Uint64ToBytes(height)
+	imp.cb.Put(AccountKVNameSpace, []byte(CurrentHeightKey), h, "failed to store accountTrie's current Height")
+	return imp.kv.WriteBatch(imp.cb)
+}
+
+// finishContract verifies the code and storage of the contract being imported
+func (imp *snapshotImporter) finishContract() error {
+	if imp.account == nil {
+		return nil
+	}
+	defer func() {
+		imp.account = nil
+		imp.codeImported = false
+		imp.storage = nil
+	}()
+	// a state decoded as a contract without code is not a contract
+	if !imp.codeImported {
+		return nil
+	}
+	root := hash.ZeroHash256
+	if imp.account.Root != hash.ZeroHash256 {
+		if imp.storage == nil {
+			// the storage of the contract has been emptied
+			var err error
+			if imp.storage, err = evm.NewStorageTrie(imp.contract, hash.ZeroHash256, imp.kv, imp.cb); err != nil {
+				return err
+			}
+		}
+		root = hash.BytesToHash256(imp.storage.RootHash())
+	}
+	if root != imp.account.Root {
+		return errors.Wrapf(
+			ErrInvalidSnapshot,
+			"storage root of contract %x is %x, expecting %x",
+			imp.contract,
+			root,
+			imp.account.Root,
+		)
+	}
+	return nil
+}
@@ -18,6 +18,8 @@ import (
 
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/db"
@@ -59,7 +61,7 @@ func DefaultStateDBOption() StateDBOption {
 			return errors.New("Invalid empty trie db path")
 		}
 		cfg.DB.DbPath = dbPath // TODO: remove this after moving TrieDBPath from cfg.Chain to cfg.DB
-		sdb.dao = db.NewBoltDB(cfg.DB)
+		sdb.dao = db.NewKVStore(cfg.DB)
 		sdb.saveHistory = cfg.Chain.EnableHistoryStateDB
 		return nil
 	}
@@ -194,6 +196,21 @@ func (sdb *stateDB) NewWorkingSet() (WorkingSet, error) {
 	return newStateTX(sdb.currentChainHeight+1, sdb.dao, sdb.saveHistory), nil
 }
 
+// NewWorkingSetAtHeight returns new working set on top of the states of the given height's parent block, which is
+// only supported for the next block
+func (sdb *stateDB) NewWorkingSetAtHeight(height uint64) (WorkingSet, error) {
+	sdb.mutex.RLock()
+	defer sdb.mutex.RUnlock()
+
+	if height == 0 || height > sdb.currentChainHeight+1 {
+		return nil, errors.Errorf("invalid height %d, current height is %d", height, sdb.currentChainHeight)
+	}
+	if height == sdb.currentChainHeight+1 {
+		return newStateTX(height, sdb.dao, sdb.saveHistory), nil
+	}
+	return sdb.workingSetAtHeight(height - 1)
+}
+
 // Commit persists all changes in RunActions() into the DB
 func (sdb *stateDB) Commit(ws WorkingSet) error {
 	sdb.mutex.Lock()
@@ -253,10 +270,64 @@ func (sdb *stateDB) State(addr hash.Hash160, state interface{}) error {
 	return sdb.state(addr, state)
 }
 
+// AccountStateAtHeight returns the confirmed account state at the end of the given height
+func (sdb *stateDB) AccountStateAtHeight(addr string, height uint64) (*state.Account, error) {
+	sdb.mutex.RLock()
+	defer sdb.mutex.RUnlock()
+
+	ws, err := sdb.workingSetAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	return loadAccount(addr, ws.State)
+}
+
+// StateAtHeight returns a confirmed state at the end of the given height
+func (sdb *stateDB) StateAtHeight(height uint64, addr hash.Hash160, state interface{}) error {
+	sdb.mutex.RLock()
+	defer sdb.mutex.RUnlock()
+
+	ws, err := sdb.workingSetAtHeight(height)
+	if err != nil {
+		return err
+	}
+	return ws.State(addr, state)
+}
+
+// ReadContractAtHeight runs a read-only execution on top of the states at the end of the given height
+func (sdb *stateDB) ReadContractAtHeight(
+	ctx context.Context,
+	height uint64,
+	ex *action.Execution,
+	getBlockHash evm.GetBlockHash,
+) ([]byte, *action.Receipt, error) {
+	sdb.mutex.RLock()
+	ws, err := sdb.workingSetAtHeight(height)
+	sdb.mutex.RUnlock()
+	if err != nil {
+		return nil, nil, err
+	}
+	return evm.ExecuteContract(ctx, ws, ex, getBlockHash)
+}
+
 //======================================
 // private trie constructor functions
 //======================================
 
+// workingSetAtHeight returns a read-only working set on top of the states at the end of the given height
+func (sdb *stateDB) workingSetAtHeight(height uint64) (WorkingSet, error) {
+	if height > sdb.currentChainHeight {
+		return nil, errors.Errorf("invalid height %d, current height is %d", height, sdb.currentChainHeight)
+	}
+	if height == sdb.currentChainHeight {
+		return newStateTX(height+1, sdb.dao, false), nil
+	}
+	if err := checkHistory(sdb.saveHistory, sdb.cfg.DB.HistoryStateRetention, height, sdb.currentChainHeight); err != nil {
+		return nil, err
+	}
+	return newHistoryStateTX(height+1, sdb.dao, height), nil
+}
+
 func (sdb *stateDB) state(addr hash.Hash160, s interface{}) error {
 	data, err := sdb.dao.Get(AccountKVNameSpace, addr[:])
 	if err != nil {
@@ -28,6 +28,9 @@ type stateTX struct {
 	finalized   bool
 	saveHistory bool
 	blockHeight uint64
+	// readHistory makes the states read from the history index at the end of historyHeight
+	readHistory   bool
+	historyHeight uint64
 }
 
 // newStateTX creates a new state tx
@@ -45,6 +48,15 @@ func newStateTX(
 	}
 }
 
+// newHistoryStateTX creates a new state tx on top of the states at the end of the history height, which must not
+// be committed
+func newHistoryStateTX(blockHeight uint64, kv db.KVStore, historyHeight uint64) *stateTX {
+	stx := newStateTX(blockHeight, kv, false)
+	stx.readHistory = true
+	stx.historyHeight = historyHeight
+	return stx
+}
+
 // RootHash returns the hash of the root node of the accountTrie
 func (stx *stateTX) RootHash() (hash.Hash256, error) {
 	if !stx.finalized {
@@ -138,10 +150,18 @@ func (stx *stateTX) runAction(
 
 	actionCtx.IntrinsicGas = intrinsicGas
 	actionCtx.Nonce = elp.Nonce()
+	if elp.IsSponsored() {
+		if actionCtx.GasPayer, err = address.FromBytes(elp.SponsorPubkey().Hash()); err != nil {
+			return nil, err
+		}
+	}
 	if bcCtx.Registry == nil {
 		return nil, nil
 	}
 	ctx = protocol.WithActionCtx(ctx, actionCtx)
+	if err := validateWithState(ctx, elp, stx); err != nil {
+		return nil, err
+	}
 	for _, actionHandler := range bcCtx.Registry.All() {
 		receipt, err := actionHandler.Handle(ctx, elp.Action(), stx)
 		if err != nil {
@@ -154,6 +174,9 @@ func (stx *stateTX) runAction(
 			)
 		}
 		if receipt != nil {
+			if actionCtx.GasPayer != nil {
+				receipt.GasPayer = actionCtx.GasPayer.String()
+			}
 			return receipt, nil
 		}
 	}
@@ -186,6 +209,9 @@ func (stx *stateTX) Commit() error {
 	if !stx.finalized {
 		return errors.New("cannot commit a working set which has not been finalized")
 	}
+	if stx.readHistory {
+		return errors.Wrap(ErrNotSupported, "cannot commit a working set on top of history states")
+	}
 	// Commit all changes in a batch
 	dbBatchSizelMtc.WithLabelValues().Set(float64(stx.cb.Size()))
 	var cb db.KVStoreBatch
@@ -216,7 +242,7 @@ func (stx *stateTX) State(hash hash.Hash160, s interface{}) error {
 	stateDBMtc.WithLabelValues("get").Inc()
 	mstate, err := stx.cb.Get(AccountKVNameSpace, hash[:])
 	if errors.Cause(err) == db.ErrNotExist {
-		if mstate, err = stx.dao.Get(AccountKVNameSpace, hash[:]); errors.Cause(err) == db.ErrNotExist {
+		if mstate, err = stx.get(hash); errors.Cause(err) == db.ErrNotExist {
 			return errors.Wrapf(state.ErrStateNotExist, "k = %x doesn't exist", hash)
 		}
 	}
@@ -229,6 +255,13 @@ func (stx *stateTX) State(hash hash.Hash160, s interface{}) error {
 	return state.Deserialize(s, mstate)
 }
 
+func (stx *stateTX) get(pkHash hash.Hash160) ([]byte, error) {
+	if stx.readHistory {
+		return stateAtHeight(stx.dao, stx.historyHeight, pkHash)
+	}
+	return stx.dao.Get(AccountKVNameSpace, pkHash[:])
+}
+
 // PutState puts a state into DB
 func (stx *stateTX) PutState(pkHash hash.Hash160, s interface{}) error {
 	stateDBMtc.WithLabelValues("put").Inc()
@@ -249,6 +282,27 @@ func (stx *stateTX) DelState(pkHash hash.Hash160) error {
 	return nil
 }
 
+// stateAtHeight reads a state at the end of the given height from the history index
+func stateAtHeight(kv db.KVStore, height uint64, pkHash hash.Hash160) ([]byte, error) {
+	ns := append([]byte(AccountKVNameSpace), pkHash[:]...)
+	// the index is created upon the first write of the state
+	if _, err := kv.Get(string(ns), db.MaxKey); err != nil {
+		return nil, err
+	}
+	ri, err := db.NewRangeIndex(kv, ns, db.NotExist)
+	if err != nil {
+		return nil, err
+	}
+	ss, err := ri.Get(height)
+	if err != nil {
+		return nil, err
+	}
+	if len(ss) == 0 {
+		return nil, errors.Wrapf(db.ErrNotExist, "state of %x doesn't exist at height %d", pkHash, height)
+	}
+	return ss, nil
+}
+
 // putIndex insert height-state
 func (stx *stateTX) putIndex(pkHash hash.Hash160, ss []byte) error {
 	version := stx.blockHeight
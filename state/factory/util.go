@@ -11,6 +11,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 )
 
@@ -28,3 +29,16 @@ func createGenesisStates(ctx context.Context, ws WorkingSet) error {
 
 	return ws.Finalize()
 }
+
+// validateWithState validates the action against the states it is about to run on
+func validateWithState(ctx context.Context, elp action.SealedEnvelope, sm protocol.StateManager) error {
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+	for _, p := range bcCtx.Registry.All() {
+		if v, ok := p.(protocol.ActionEnvelopeStateValidator); ok {
+			if err := v.ValidateWithState(ctx, elp, sm); err != nil {
+				return errors.Wrapf(err, "action %x is rejected by the states", elp.Hash())
+			}
+		}
+	}
+	return nil
+}
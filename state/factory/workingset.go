@@ -196,11 +196,19 @@ func (ws *workingSet) runAction(
 	}
 	actionCtx.IntrinsicGas = intrinsicGas
 	actionCtx.Nonce = elp.Nonce()
+	if elp.IsSponsored() {
+		if actionCtx.GasPayer, err = address.FromBytes(elp.SponsorPubkey().Hash()); err != nil {
+			return nil, err
+		}
+	}
 
 	ctx = protocol.WithActionCtx(ctx, actionCtx)
 	if bcCtx.Registry == nil {
 		return nil, nil
 	}
+	if err := validateWithState(ctx, elp, ws); err != nil {
+		return nil, err
+	}
 	for _, actionHandler := range bcCtx.Registry.All() {
 		receipt, err := actionHandler.Handle(ctx, elp.Action(), ws)
 		if err != nil {
@@ -213,6 +221,9 @@ func (ws *workingSet) runAction(
 			)
 		}
 		if receipt != nil {
+			if actionCtx.GasPayer != nil {
+				receipt.GasPayer = actionCtx.GasPayer.String()
+			}
 			return receipt, nil
 		}
 	}
@@ -10,6 +10,7 @@ import (
 	hash "github.com/iotexproject/go-pkgs/hash"
 	action "github.com/iotexproject/iotex-core/action"
 	protocol "github.com/iotexproject/iotex-core/action/protocol"
+	actpool "github.com/iotexproject/iotex-core/actpool"
 	reflect "reflect"
 )
 
@@ -36,6 +37,34 @@ func (m *MockActPool) EXPECT() *MockActPoolMockRecorder {
 	return m.recorder
 }
 
+// Start mocks base method
+func (m *MockActPool) Start(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start
+func (mr *MockActPoolMockRecorder) Start(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockActPool)(nil).Start), arg0)
+}
+
+// Stop mocks base method
+func (m *MockActPool) Stop(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop
+func (mr *MockActPoolMockRecorder) Stop(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockActPool)(nil).Stop), arg0)
+}
+
 // Reset mocks base method
 func (m *MockActPool) Reset() {
 	m.ctrl.T.Helper()
@@ -176,6 +205,34 @@ func (mr *MockActPoolMockRecorder) GetGasCapacity() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGasCapacity", reflect.TypeOf((*MockActPool)(nil).GetGasCapacity))
 }
 
+// AddSubscriber mocks base method
+func (m *MockActPool) AddSubscriber(arg0 actpool.PendingActionSubscriber) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSubscriber", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSubscriber indicates an expected call of AddSubscriber
+func (mr *MockActPoolMockRecorder) AddSubscriber(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSubscriber", reflect.TypeOf((*MockActPool)(nil).AddSubscriber), arg0)
+}
+
+// RemoveSubscriber mocks base method
+func (m *MockActPool) RemoveSubscriber(arg0 actpool.PendingActionSubscriber) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSubscriber", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSubscriber indicates an expected call of RemoveSubscriber
+func (mr *MockActPoolMockRecorder) RemoveSubscriber(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSubscriber", reflect.TypeOf((*MockActPool)(nil).RemoveSubscriber), arg0)
+}
+
 // AddActionEnvelopeValidators mocks base method
 func (m *MockActPool) AddActionEnvelopeValidators(arg0 ...protocol.ActionEnvelopeValidator) {
 	m.ctrl.T.Helper()
@@ -414,3 +414,83 @@ func (mr *MockServiceClientMockRecorder) GetElectionBuckets(ctx, in interface{},
 	varargs := append([]interface{}{ctx, in}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElectionBuckets", reflect.TypeOf((*MockServiceClient)(nil).GetElectionBuckets), varargs...)
 }
+
+// SuggestGasPrices mocks base method
+func (m *MockServiceClient) SuggestGasPrices(ctx context.Context, in *iotexapi.SuggestGasPricesRequest, opts ...grpc.CallOption) (*iotexapi.SuggestGasPricesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuggestGasPrices", varargs...)
+	ret0, _ := ret[0].(*iotexapi.SuggestGasPricesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestGasPrices indicates an expected call of SuggestGasPrices
+func (mr *MockServiceClientMockRecorder) SuggestGasPrices(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestGasPrices", reflect.TypeOf((*MockServiceClient)(nil).SuggestGasPrices), varargs...)
+}
+
+// GetFeeHistory mocks base method
+func (m *MockServiceClient) GetFeeHistory(ctx context.Context, in *iotexapi.GetFeeHistoryRequest, opts ...grpc.CallOption) (*iotexapi.GetFeeHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFeeHistory", varargs...)
+	ret0, _ := ret[0].(*iotexapi.GetFeeHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeeHistory indicates an expected call of GetFeeHistory
+func (mr *MockServiceClientMockRecorder) GetFeeHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeHistory", reflect.TypeOf((*MockServiceClient)(nil).GetFeeHistory), varargs...)
+}
+
+// SimulateActions mocks base method
+func (m *MockServiceClient) SimulateActions(ctx context.Context, in *iotexapi.SimulateActionsRequest, opts ...grpc.CallOption) (*iotexapi.SimulateActionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SimulateActions", varargs...)
+	ret0, _ := ret[0].(*iotexapi.SimulateActionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateActions indicates an expected call of SimulateActions
+func (mr *MockServiceClientMockRecorder) SimulateActions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateActions", reflect.TypeOf((*MockServiceClient)(nil).SimulateActions), varargs...)
+}
+
+// StreamPendingActions mocks base method
+func (m *MockServiceClient) StreamPendingActions(ctx context.Context, in *iotexapi.StreamPendingActionsRequest, opts ...grpc.CallOption) (iotexapi.APIService_StreamPendingActionsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamPendingActions", varargs...)
+	ret0, _ := ret[0].(iotexapi.APIService_StreamPendingActionsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamPendingActions indicates an expected call of StreamPendingActions
+func (mr *MockServiceClientMockRecorder) StreamPendingActions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamPendingActions", reflect.TypeOf((*MockServiceClient)(nil).StreamPendingActions), varargs...)
+}
@@ -119,3 +119,17 @@ func (mr *MockBlockSyncMockRecorder) ProcessBlockSync(ctx, blk interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessBlockSync", reflect.TypeOf((*MockBlockSync)(nil).ProcessBlockSync), ctx, blk)
 }
+
+// ProcessBlockBatch mocks base method
+func (m *MockBlockSync) ProcessBlockBatch(ctx context.Context, batch *iotexrpc.BlockBatch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessBlockBatch", ctx, batch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessBlockBatch indicates an expected call of ProcessBlockBatch
+func (mr *MockBlockSyncMockRecorder) ProcessBlockBatch(ctx, batch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessBlockBatch", reflect.TypeOf((*MockBlockSync)(nil).ProcessBlockBatch), ctx, batch)
+}
@@ -94,6 +94,20 @@ func (mr *MockSubscriberMockRecorder) HandleSyncRequest(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSyncRequest", reflect.TypeOf((*MockSubscriber)(nil).HandleSyncRequest), arg0, arg1, arg2)
 }
 
+// HandleBlockBatch mocks base method
+func (m *MockSubscriber) HandleBlockBatch(arg0 context.Context, arg1 *iotexrpc.BlockBatch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleBlockBatch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleBlockBatch indicates an expected call of HandleBlockBatch
+func (mr *MockSubscriberMockRecorder) HandleBlockBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleBlockBatch", reflect.TypeOf((*MockSubscriber)(nil).HandleBlockBatch), arg0, arg1)
+}
+
 // HandleConsensusMsg mocks base method
 func (m *MockSubscriber) HandleConsensusMsg(arg0 *iotextypes.ConsensusMessage) error {
 	m.ctrl.T.Helper()
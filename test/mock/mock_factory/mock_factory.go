@@ -8,6 +8,8 @@ import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
 	hash "github.com/iotexproject/go-pkgs/hash"
+	action "github.com/iotexproject/iotex-core/action"
+	evm "github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	state "github.com/iotexproject/iotex-core/state"
 	factory "github.com/iotexproject/iotex-core/state/factory"
 	big "math/big"
@@ -110,6 +112,21 @@ func (mr *MockFactoryMockRecorder) AccountState(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountState", reflect.TypeOf((*MockFactory)(nil).AccountState), arg0)
 }
 
+// AccountStateAtHeight mocks base method
+func (m *MockFactory) AccountStateAtHeight(arg0 string, arg1 uint64) (*state.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountStateAtHeight", arg0, arg1)
+	ret0, _ := ret[0].(*state.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountStateAtHeight indicates an expected call of AccountStateAtHeight
+func (mr *MockFactoryMockRecorder) AccountStateAtHeight(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountStateAtHeight", reflect.TypeOf((*MockFactory)(nil).AccountStateAtHeight), arg0, arg1)
+}
+
 // RootHash mocks base method
 func (m *MockFactory) RootHash() hash.Hash256 {
 	m.ctrl.T.Helper()
@@ -169,6 +186,21 @@ func (mr *MockFactoryMockRecorder) NewWorkingSet() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWorkingSet", reflect.TypeOf((*MockFactory)(nil).NewWorkingSet))
 }
 
+// NewWorkingSetAtHeight mocks base method
+func (m *MockFactory) NewWorkingSetAtHeight(arg0 uint64) (factory.WorkingSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewWorkingSetAtHeight", arg0)
+	ret0, _ := ret[0].(factory.WorkingSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewWorkingSetAtHeight indicates an expected call of NewWorkingSetAtHeight
+func (mr *MockFactoryMockRecorder) NewWorkingSetAtHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWorkingSetAtHeight", reflect.TypeOf((*MockFactory)(nil).NewWorkingSetAtHeight), arg0)
+}
+
 // Commit mocks base method
 func (m *MockFactory) Commit(arg0 factory.WorkingSet) error {
 	m.ctrl.T.Helper()
@@ -211,3 +243,33 @@ func (mr *MockFactoryMockRecorder) State(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "State", reflect.TypeOf((*MockFactory)(nil).State), arg0, arg1)
 }
+
+// StateAtHeight mocks base method
+func (m *MockFactory) StateAtHeight(arg0 uint64, arg1 hash.Hash160, arg2 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateAtHeight", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StateAtHeight indicates an expected call of StateAtHeight
+func (mr *MockFactoryMockRecorder) StateAtHeight(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateAtHeight", reflect.TypeOf((*MockFactory)(nil).StateAtHeight), arg0, arg1, arg2)
+}
+
+// ReadContractAtHeight mocks base method
+func (m *MockFactory) ReadContractAtHeight(arg0 context.Context, arg1 uint64, arg2 *action.Execution, arg3 evm.GetBlockHash) ([]byte, *action.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadContractAtHeight", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*action.Receipt)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReadContractAtHeight indicates an expected call of ReadContractAtHeight
+func (mr *MockFactoryMockRecorder) ReadContractAtHeight(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadContractAtHeight", reflect.TypeOf((*MockFactory)(nil).ReadContractAtHeight), arg0, arg1, arg2, arg3)
+}
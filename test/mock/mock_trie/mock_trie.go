@@ -105,6 +105,21 @@ func (mr *MockTrieMockRecorder) Delete(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTrie)(nil).Delete), arg0)
 }
 
+// Prove mocks base method
+func (m *MockTrie) Prove(arg0 []byte) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prove", arg0)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Prove indicates an expected call of Prove
+func (mr *MockTrieMockRecorder) Prove(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prove", reflect.TypeOf((*MockTrie)(nil).Prove), arg0)
+}
+
 // RootHash mocks base method
 func (m *MockTrie) RootHash() []byte {
 	m.ctrl.T.Helper()
@@ -0,0 +1,5493 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/api/api.proto
+
+package iotexapi
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	iotextypes "github.com/iotexproject/iotex-proto/golang/iotextypes"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type PendingActionEvent int32
+
+const (
+	// the action is accepted into the pool
+	PendingActionEvent_ACCEPTED PendingActionEvent = 0
+	// the action is replaced by another one with the same sender and nonce
+	PendingActionEvent_REPLACED PendingActionEvent = 1
+	// the action is dropped, e.g., evicted from the full pool or no longer affordable
+	PendingActionEvent_DROPPED PendingActionEvent = 2
+	// the action stays in the pool too long
+	PendingActionEvent_EXPIRED PendingActionEvent = 3
+)
+
+var PendingActionEvent_name = map[int32]string{
+	0: "ACCEPTED",
+	1: "REPLACED",
+	2: "DROPPED",
+	3: "EXPIRED",
+}
+
+var PendingActionEvent_value = map[string]int32{
+	"ACCEPTED": 0,
+	"REPLACED": 1,
+	"DROPPED":  2,
+	"EXPIRED":  3,
+}
+
+func (x PendingActionEvent) String() string {
+	return proto.EnumName(PendingActionEvent_name, int32(x))
+}
+
+func (PendingActionEvent) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{0}
+}
+
+type GetVotesRequest struct {
+	Votee                string   `protobuf:"bytes,1,opt,name=votee,proto3" json:"votee,omitempty"`
+	Height               string   `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Offset               uint32   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVotesRequest) Reset()         { *m = GetVotesRequest{} }
+func (m *GetVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVotesRequest) ProtoMessage()    {}
+func (*GetVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{0}
+}
+
+func (m *GetVotesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVotesRequest.Unmarshal(m, b)
+}
+func (m *GetVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVotesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVotesRequest.Merge(m, src)
+}
+func (m *GetVotesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetVotesRequest.Size(m)
+}
+func (m *GetVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVotesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVotesRequest proto.InternalMessageInfo
+
+func (m *GetVotesRequest) GetVotee() string {
+	if m != nil {
+		return m.Votee
+	}
+	return ""
+}
+
+func (m *GetVotesRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+func (m *GetVotesRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *GetVotesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetVotesResponse struct {
+	Buckets              []*Bucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetVotesResponse) Reset()         { *m = GetVotesResponse{} }
+func (m *GetVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetVotesResponse) ProtoMessage()    {}
+func (*GetVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{1}
+}
+
+func (m *GetVotesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVotesResponse.Unmarshal(m, b)
+}
+func (m *GetVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVotesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVotesResponse.Merge(m, src)
+}
+func (m *GetVotesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetVotesResponse.Size(m)
+}
+func (m *GetVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVotesResponse proto.InternalMessageInfo
+
+func (m *GetVotesResponse) GetBuckets() []*Bucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type Bucket struct {
+	// hex string
+	Voter         string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Votes         string `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	WeightedVotes string `protobuf:"bytes,3,opt,name=weightedVotes,proto3" json:"weightedVotes,omitempty"`
+	// human readable duration
+	RemainingDuration    string   `protobuf:"bytes,4,opt,name=remainingDuration,proto3" json:"remainingDuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Bucket) Reset()         { *m = Bucket{} }
+func (m *Bucket) String() string { return proto.CompactTextString(m) }
+func (*Bucket) ProtoMessage()    {}
+func (*Bucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{2}
+}
+
+func (m *Bucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Bucket.Unmarshal(m, b)
+}
+func (m *Bucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Bucket.Marshal(b, m, deterministic)
+}
+func (m *Bucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bucket.Merge(m, src)
+}
+func (m *Bucket) XXX_Size() int {
+	return xxx_messageInfo_Bucket.Size(m)
+}
+func (m *Bucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bucket proto.InternalMessageInfo
+
+func (m *Bucket) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *Bucket) GetVotes() string {
+	if m != nil {
+		return m.Votes
+	}
+	return ""
+}
+
+func (m *Bucket) GetWeightedVotes() string {
+	if m != nil {
+		return m.WeightedVotes
+	}
+	return ""
+}
+
+func (m *Bucket) GetRemainingDuration() string {
+	if m != nil {
+		return m.RemainingDuration
+	}
+	return ""
+}
+
+type GetAccountRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the block height at the end of which to query, within the history state retention window, 0 meaning the tip
+	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
+func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountRequest) ProtoMessage()    {}
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{3}
+}
+
+func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountRequest.Unmarshal(m, b)
+}
+func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountRequest.Merge(m, src)
+}
+func (m *GetAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountRequest.Size(m)
+}
+func (m *GetAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountRequest proto.InternalMessageInfo
+
+func (m *GetAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetAccountRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type GetAccountResponse struct {
+	AccountMeta          *iotextypes.AccountMeta `protobuf:"bytes,1,opt,name=accountMeta,proto3" json:"accountMeta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetAccountResponse) Reset()         { *m = GetAccountResponse{} }
+func (m *GetAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountResponse) ProtoMessage()    {}
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{4}
+}
+
+func (m *GetAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountResponse.Unmarshal(m, b)
+}
+func (m *GetAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountResponse.Merge(m, src)
+}
+func (m *GetAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAccountResponse.Size(m)
+}
+func (m *GetAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountResponse proto.InternalMessageInfo
+
+func (m *GetAccountResponse) GetAccountMeta() *iotextypes.AccountMeta {
+	if m != nil {
+		return m.AccountMeta
+	}
+	return nil
+}
+
+type GetActionsRequest struct {
+	// Types that are valid to be assigned to Lookup:
+	//	*GetActionsRequest_ByIndex
+	//	*GetActionsRequest_ByHash
+	//	*GetActionsRequest_ByAddr
+	//	*GetActionsRequest_UnconfirmedByAddr
+	//	*GetActionsRequest_ByBlk
+	Lookup               isGetActionsRequest_Lookup `protobuf_oneof:"lookup"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GetActionsRequest) Reset()         { *m = GetActionsRequest{} }
+func (m *GetActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetActionsRequest) ProtoMessage()    {}
+func (*GetActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{5}
+}
+
+func (m *GetActionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActionsRequest.Unmarshal(m, b)
+}
+func (m *GetActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActionsRequest.Merge(m, src)
+}
+func (m *GetActionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetActionsRequest.Size(m)
+}
+func (m *GetActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActionsRequest proto.InternalMessageInfo
+
+type isGetActionsRequest_Lookup interface {
+	isGetActionsRequest_Lookup()
+}
+
+type GetActionsRequest_ByIndex struct {
+	ByIndex *GetActionsByIndexRequest `protobuf:"bytes,1,opt,name=byIndex,proto3,oneof"`
+}
+
+type GetActionsRequest_ByHash struct {
+	ByHash *GetActionByHashRequest `protobuf:"bytes,2,opt,name=byHash,proto3,oneof"`
+}
+
+type GetActionsRequest_ByAddr struct {
+	ByAddr *GetActionsByAddressRequest `protobuf:"bytes,3,opt,name=byAddr,proto3,oneof"`
+}
+
+type GetActionsRequest_UnconfirmedByAddr struct {
+	UnconfirmedByAddr *GetUnconfirmedActionsByAddressRequest `protobuf:"bytes,4,opt,name=unconfirmedByAddr,proto3,oneof"`
+}
+
+type GetActionsRequest_ByBlk struct {
+	ByBlk *GetActionsByBlockRequest `protobuf:"bytes,5,opt,name=byBlk,proto3,oneof"`
+}
+
+func (*GetActionsRequest_ByIndex) isGetActionsRequest_Lookup() {}
+
+func (*GetActionsRequest_ByHash) isGetActionsRequest_Lookup() {}
+
+func (*GetActionsRequest_ByAddr) isGetActionsRequest_Lookup() {}
+
+func (*GetActionsRequest_UnconfirmedByAddr) isGetActionsRequest_Lookup() {}
+
+func (*GetActionsRequest_ByBlk) isGetActionsRequest_Lookup() {}
+
+func (m *GetActionsRequest) GetLookup() isGetActionsRequest_Lookup {
+	if m != nil {
+		return m.Lookup
+	}
+	return nil
+}
+
+func (m *GetActionsRequest) GetByIndex() *GetActionsByIndexRequest {
+	if x, ok := m.GetLookup().(*GetActionsRequest_ByIndex); ok {
+		return x.ByIndex
+	}
+	return nil
+}
+
+func (m *GetActionsRequest) GetByHash() *GetActionByHashRequest {
+	if x, ok := m.GetLookup().(*GetActionsRequest_ByHash); ok {
+		return x.ByHash
+	}
+	return nil
+}
+
+func (m *GetActionsRequest) GetByAddr() *GetActionsByAddressRequest {
+	if x, ok := m.GetLookup().(*GetActionsRequest_ByAddr); ok {
+		return x.ByAddr
+	}
+	return nil
+}
+
+func (m *GetActionsRequest) GetUnconfirmedByAddr() *GetUnconfirmedActionsByAddressRequest {
+	if x, ok := m.GetLookup().(*GetActionsRequest_UnconfirmedByAddr); ok {
+		return x.UnconfirmedByAddr
+	}
+	return nil
+}
+
+func (m *GetActionsRequest) GetByBlk() *GetActionsByBlockRequest {
+	if x, ok := m.GetLookup().(*GetActionsRequest_ByBlk); ok {
+		return x.ByBlk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*GetActionsRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*GetActionsRequest_ByIndex)(nil),
+		(*GetActionsRequest_ByHash)(nil),
+		(*GetActionsRequest_ByAddr)(nil),
+		(*GetActionsRequest_UnconfirmedByAddr)(nil),
+		(*GetActionsRequest_ByBlk)(nil),
+	}
+}
+
+type GetActionsByIndexRequest struct {
+	Start                uint64   `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetActionsByIndexRequest) Reset()         { *m = GetActionsByIndexRequest{} }
+func (m *GetActionsByIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*GetActionsByIndexRequest) ProtoMessage()    {}
+func (*GetActionsByIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{6}
+}
+
+func (m *GetActionsByIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActionsByIndexRequest.Unmarshal(m, b)
+}
+func (m *GetActionsByIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActionsByIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *GetActionsByIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActionsByIndexRequest.Merge(m, src)
+}
+func (m *GetActionsByIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_GetActionsByIndexRequest.Size(m)
+}
+func (m *GetActionsByIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActionsByIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActionsByIndexRequest proto.InternalMessageInfo
+
+func (m *GetActionsByIndexRequest) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *GetActionsByIndexRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GetActionByHashRequest struct {
+	ActionHash           string   `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+	CheckPending         bool     `protobuf:"varint,2,opt,name=checkPending,proto3" json:"checkPending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetActionByHashRequest) Reset()         { *m = GetActionByHashRequest{} }
+func (m *GetActionByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*GetActionByHashRequest) ProtoMessage()    {}
+func (*GetActionByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{7}
+}
+
+func (m *GetActionByHashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActionByHashRequest.Unmarshal(m, b)
+}
+func (m *GetActionByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActionByHashRequest.Marshal(b, m, deterministic)
+}
+func (m *GetActionByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActionByHashRequest.Merge(m, src)
+}
+func (m *GetActionByHashRequest) XXX_Size() int {
+	return xxx_messageInfo_GetActionByHashRequest.Size(m)
+}
+func (m *GetActionByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActionByHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActionByHashRequest proto.InternalMessageInfo
+
+func (m *GetActionByHashRequest) GetActionHash() string {
+	if m != nil {
+		return m.ActionHash
+	}
+	return ""
+}
+
+func (m *GetActionByHashRequest) GetCheckPending() bool {
+	if m != nil {
+		return m.CheckPending
+	}
+	return false
+}
+
+type GetActionsByAddressRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Start                uint64   `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count                uint64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetActionsByAddressRequest) Reset()         { *m = GetActionsByAddressRequest{} }
+func (m *GetActionsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetActionsByAddressRequest) ProtoMessage()    {}
+func (*GetActionsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{8}
+}
+
+func (m *GetActionsByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActionsByAddressRequest.Unmarshal(m, b)
+}
+func (m *GetActionsByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActionsByAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetActionsByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActionsByAddressRequest.Merge(m, src)
+}
+func (m *GetActionsByAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetActionsByAddressRequest.Size(m)
+}
+func (m *GetActionsByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActionsByAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActionsByAddressRequest proto.InternalMessageInfo
+
+func (m *GetActionsByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetActionsByAddressRequest) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *GetActionsByAddressRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GetUnconfirmedActionsByAddressRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Start                uint64   `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count                uint64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUnconfirmedActionsByAddressRequest) Reset()         { *m = GetUnconfirmedActionsByAddressRequest{} }
+func (m *GetUnconfirmedActionsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUnconfirmedActionsByAddressRequest) ProtoMessage()    {}
+func (*GetUnconfirmedActionsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{9}
+}
+
+func (m *GetUnconfirmedActionsByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUnconfirmedActionsByAddressRequest.Unmarshal(m, b)
+}
+func (m *GetUnconfirmedActionsByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUnconfirmedActionsByAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetUnconfirmedActionsByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUnconfirmedActionsByAddressRequest.Merge(m, src)
+}
+func (m *GetUnconfirmedActionsByAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUnconfirmedActionsByAddressRequest.Size(m)
+}
+func (m *GetUnconfirmedActionsByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUnconfirmedActionsByAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUnconfirmedActionsByAddressRequest proto.InternalMessageInfo
+
+func (m *GetUnconfirmedActionsByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetUnconfirmedActionsByAddressRequest) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *GetUnconfirmedActionsByAddressRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GetActionsByBlockRequest struct {
+	BlkHash              string   `protobuf:"bytes,1,opt,name=blkHash,proto3" json:"blkHash,omitempty"`
+	Start                uint64   `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count                uint64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetActionsByBlockRequest) Reset()         { *m = GetActionsByBlockRequest{} }
+func (m *GetActionsByBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*GetActionsByBlockRequest) ProtoMessage()    {}
+func (*GetActionsByBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{10}
+}
+
+func (m *GetActionsByBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActionsByBlockRequest.Unmarshal(m, b)
+}
+func (m *GetActionsByBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActionsByBlockRequest.Marshal(b, m, deterministic)
+}
+func (m *GetActionsByBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActionsByBlockRequest.Merge(m, src)
+}
+func (m *GetActionsByBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_GetActionsByBlockRequest.Size(m)
+}
+func (m *GetActionsByBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActionsByBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActionsByBlockRequest proto.InternalMessageInfo
+
+func (m *GetActionsByBlockRequest) GetBlkHash() string {
+	if m != nil {
+		return m.BlkHash
+	}
+	return ""
+}
+
+func (m *GetActionsByBlockRequest) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *GetActionsByBlockRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ActionInfo struct {
+	Action               *iotextypes.Action   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	ActHash              string               `protobuf:"bytes,2,opt,name=actHash,proto3" json:"actHash,omitempty"`
+	BlkHash              string               `protobuf:"bytes,3,opt,name=blkHash,proto3" json:"blkHash,omitempty"`
+	BlkHeight            uint64               `protobuf:"varint,5,opt,name=blkHeight,proto3" json:"blkHeight,omitempty"`
+	Sender               string               `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	GasFee               string               `protobuf:"bytes,7,opt,name=gasFee,proto3" json:"gasFee,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ActionInfo) Reset()         { *m = ActionInfo{} }
+func (m *ActionInfo) String() string { return proto.CompactTextString(m) }
+func (*ActionInfo) ProtoMessage()    {}
+func (*ActionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{11}
+}
+
+func (m *ActionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionInfo.Unmarshal(m, b)
+}
+func (m *ActionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionInfo.Marshal(b, m, deterministic)
+}
+func (m *ActionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionInfo.Merge(m, src)
+}
+func (m *ActionInfo) XXX_Size() int {
+	return xxx_messageInfo_ActionInfo.Size(m)
+}
+func (m *ActionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionInfo proto.InternalMessageInfo
+
+func (m *ActionInfo) GetAction() *iotextypes.Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *ActionInfo) GetActHash() string {
+	if m != nil {
+		return m.ActHash
+	}
+	return ""
+}
+
+func (m *ActionInfo) GetBlkHash() string {
+	if m != nil {
+		return m.BlkHash
+	}
+	return ""
+}
+
+func (m *ActionInfo) GetBlkHeight() uint64 {
+	if m != nil {
+		return m.BlkHeight
+	}
+	return 0
+}
+
+func (m *ActionInfo) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ActionInfo) GetGasFee() string {
+	if m != nil {
+		return m.GasFee
+	}
+	return ""
+}
+
+func (m *ActionInfo) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type ReceiptInfo struct {
+	Receipt              *iotextypes.Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	BlkHash              string              `protobuf:"bytes,2,opt,name=blkHash,proto3" json:"blkHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReceiptInfo) Reset()         { *m = ReceiptInfo{} }
+func (m *ReceiptInfo) String() string { return proto.CompactTextString(m) }
+func (*ReceiptInfo) ProtoMessage()    {}
+func (*ReceiptInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{12}
+}
+
+func (m *ReceiptInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptInfo.Unmarshal(m, b)
+}
+func (m *ReceiptInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptInfo.Marshal(b, m, deterministic)
+}
+func (m *ReceiptInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptInfo.Merge(m, src)
+}
+func (m *ReceiptInfo) XXX_Size() int {
+	return xxx_messageInfo_ReceiptInfo.Size(m)
+}
+func (m *ReceiptInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptInfo proto.InternalMessageInfo
+
+func (m *ReceiptInfo) GetReceipt() *iotextypes.Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *ReceiptInfo) GetBlkHash() string {
+	if m != nil {
+		return m.BlkHash
+	}
+	return ""
+}
+
+type BlockProducerInfo struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Votes                string   `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	Active               bool     `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Production           uint64   `protobuf:"varint,4,opt,name=production,proto3" json:"production,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockProducerInfo) Reset()         { *m = BlockProducerInfo{} }
+func (m *BlockProducerInfo) String() string { return proto.CompactTextString(m) }
+func (*BlockProducerInfo) ProtoMessage()    {}
+func (*BlockProducerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{13}
+}
+
+func (m *BlockProducerInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockProducerInfo.Unmarshal(m, b)
+}
+func (m *BlockProducerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockProducerInfo.Marshal(b, m, deterministic)
+}
+func (m *BlockProducerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockProducerInfo.Merge(m, src)
+}
+func (m *BlockProducerInfo) XXX_Size() int {
+	return xxx_messageInfo_BlockProducerInfo.Size(m)
+}
+func (m *BlockProducerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockProducerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockProducerInfo proto.InternalMessageInfo
+
+func (m *BlockProducerInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BlockProducerInfo) GetVotes() string {
+	if m != nil {
+		return m.Votes
+	}
+	return ""
+}
+
+func (m *BlockProducerInfo) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *BlockProducerInfo) GetProduction() uint64 {
+	if m != nil {
+		return m.Production
+	}
+	return 0
+}
+
+type BlockInfo struct {
+	Block                *iotextypes.Block     `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Receipts             []*iotextypes.Receipt `protobuf:"bytes,2,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BlockInfo) Reset()         { *m = BlockInfo{} }
+func (m *BlockInfo) String() string { return proto.CompactTextString(m) }
+func (*BlockInfo) ProtoMessage()    {}
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{14}
+}
+
+func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockInfo.Unmarshal(m, b)
+}
+func (m *BlockInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockInfo.Marshal(b, m, deterministic)
+}
+func (m *BlockInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockInfo.Merge(m, src)
+}
+func (m *BlockInfo) XXX_Size() int {
+	return xxx_messageInfo_BlockInfo.Size(m)
+}
+func (m *BlockInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockInfo proto.InternalMessageInfo
+
+func (m *BlockInfo) GetBlock() *iotextypes.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlockInfo) GetReceipts() []*iotextypes.Receipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+type GetActionsResponse struct {
+	Total                uint64        `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	ActionInfo           []*ActionInfo `protobuf:"bytes,1,rep,name=actionInfo,proto3" json:"actionInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetActionsResponse) Reset()         { *m = GetActionsResponse{} }
+func (m *GetActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetActionsResponse) ProtoMessage()    {}
+func (*GetActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{15}
+}
+
+func (m *GetActionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActionsResponse.Unmarshal(m, b)
+}
+func (m *GetActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActionsResponse.Merge(m, src)
+}
+func (m *GetActionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetActionsResponse.Size(m)
+}
+func (m *GetActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActionsResponse proto.InternalMessageInfo
+
+func (m *GetActionsResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GetActionsResponse) GetActionInfo() []*ActionInfo {
+	if m != nil {
+		return m.ActionInfo
+	}
+	return nil
+}
+
+type GetBlockMetasRequest struct {
+	// Types that are valid to be assigned to Lookup:
+	//	*GetBlockMetasRequest_ByIndex
+	//	*GetBlockMetasRequest_ByHash
+	Lookup               isGetBlockMetasRequest_Lookup `protobuf_oneof:"lookup"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *GetBlockMetasRequest) Reset()         { *m = GetBlockMetasRequest{} }
+func (m *GetBlockMetasRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockMetasRequest) ProtoMessage()    {}
+func (*GetBlockMetasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{16}
+}
+
+func (m *GetBlockMetasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockMetasRequest.Unmarshal(m, b)
+}
+func (m *GetBlockMetasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockMetasRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockMetasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockMetasRequest.Merge(m, src)
+}
+func (m *GetBlockMetasRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockMetasRequest.Size(m)
+}
+func (m *GetBlockMetasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockMetasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockMetasRequest proto.InternalMessageInfo
+
+type isGetBlockMetasRequest_Lookup interface {
+	isGetBlockMetasRequest_Lookup()
+}
+
+type GetBlockMetasRequest_ByIndex struct {
+	ByIndex *GetBlockMetasByIndexRequest `protobuf:"bytes,1,opt,name=byIndex,proto3,oneof"`
+}
+
+type GetBlockMetasRequest_ByHash struct {
+	ByHash *GetBlockMetaByHashRequest `protobuf:"bytes,2,opt,name=byHash,proto3,oneof"`
+}
+
+func (*GetBlockMetasRequest_ByIndex) isGetBlockMetasRequest_Lookup() {}
+
+func (*GetBlockMetasRequest_ByHash) isGetBlockMetasRequest_Lookup() {}
+
+func (m *GetBlockMetasRequest) GetLookup() isGetBlockMetasRequest_Lookup {
+	if m != nil {
+		return m.Lookup
+	}
+	return nil
+}
+
+func (m *GetBlockMetasRequest) GetByIndex() *GetBlockMetasByIndexRequest {
+	if x, ok := m.GetLookup().(*GetBlockMetasRequest_ByIndex); ok {
+		return x.ByIndex
+	}
+	return nil
+}
+
+func (m *GetBlockMetasRequest) GetByHash() *GetBlockMetaByHashRequest {
+	if x, ok := m.GetLookup().(*GetBlockMetasRequest_ByHash); ok {
+		return x.ByHash
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*GetBlockMetasRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*GetBlockMetasRequest_ByIndex)(nil),
+		(*GetBlockMetasRequest_ByHash)(nil),
+	}
+}
+
+type GetBlockMetasByIndexRequest struct {
+	Start                uint64   `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockMetasByIndexRequest) Reset()         { *m = GetBlockMetasByIndexRequest{} }
+func (m *GetBlockMetasByIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockMetasByIndexRequest) ProtoMessage()    {}
+func (*GetBlockMetasByIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{17}
+}
+
+func (m *GetBlockMetasByIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockMetasByIndexRequest.Unmarshal(m, b)
+}
+func (m *GetBlockMetasByIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockMetasByIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockMetasByIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockMetasByIndexRequest.Merge(m, src)
+}
+func (m *GetBlockMetasByIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockMetasByIndexRequest.Size(m)
+}
+func (m *GetBlockMetasByIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockMetasByIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockMetasByIndexRequest proto.InternalMessageInfo
+
+func (m *GetBlockMetasByIndexRequest) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *GetBlockMetasByIndexRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GetBlockMetaByHashRequest struct {
+	BlkHash              string   `protobuf:"bytes,1,opt,name=blkHash,proto3" json:"blkHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockMetaByHashRequest) Reset()         { *m = GetBlockMetaByHashRequest{} }
+func (m *GetBlockMetaByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockMetaByHashRequest) ProtoMessage()    {}
+func (*GetBlockMetaByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{18}
+}
+
+func (m *GetBlockMetaByHashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockMetaByHashRequest.Unmarshal(m, b)
+}
+func (m *GetBlockMetaByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockMetaByHashRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockMetaByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockMetaByHashRequest.Merge(m, src)
+}
+func (m *GetBlockMetaByHashRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockMetaByHashRequest.Size(m)
+}
+func (m *GetBlockMetaByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockMetaByHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockMetaByHashRequest proto.InternalMessageInfo
+
+func (m *GetBlockMetaByHashRequest) GetBlkHash() string {
+	if m != nil {
+		return m.BlkHash
+	}
+	return ""
+}
+
+type GetBlockMetasResponse struct {
+	Total                uint64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	BlkMetas             []*iotextypes.BlockMeta `protobuf:"bytes,1,rep,name=blkMetas,proto3" json:"blkMetas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetBlockMetasResponse) Reset()         { *m = GetBlockMetasResponse{} }
+func (m *GetBlockMetasResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockMetasResponse) ProtoMessage()    {}
+func (*GetBlockMetasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{19}
+}
+
+func (m *GetBlockMetasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockMetasResponse.Unmarshal(m, b)
+}
+func (m *GetBlockMetasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockMetasResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBlockMetasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockMetasResponse.Merge(m, src)
+}
+func (m *GetBlockMetasResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBlockMetasResponse.Size(m)
+}
+func (m *GetBlockMetasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockMetasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockMetasResponse proto.InternalMessageInfo
+
+func (m *GetBlockMetasResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GetBlockMetasResponse) GetBlkMetas() []*iotextypes.BlockMeta {
+	if m != nil {
+		return m.BlkMetas
+	}
+	return nil
+}
+
+type GetChainMetaRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetChainMetaRequest) Reset()         { *m = GetChainMetaRequest{} }
+func (m *GetChainMetaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetChainMetaRequest) ProtoMessage()    {}
+func (*GetChainMetaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{20}
+}
+
+func (m *GetChainMetaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChainMetaRequest.Unmarshal(m, b)
+}
+func (m *GetChainMetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChainMetaRequest.Marshal(b, m, deterministic)
+}
+func (m *GetChainMetaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChainMetaRequest.Merge(m, src)
+}
+func (m *GetChainMetaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetChainMetaRequest.Size(m)
+}
+func (m *GetChainMetaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChainMetaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChainMetaRequest proto.InternalMessageInfo
+
+type GetChainMetaResponse struct {
+	ChainMeta            *iotextypes.ChainMeta `protobuf:"bytes,1,opt,name=chainMeta,proto3" json:"chainMeta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetChainMetaResponse) Reset()         { *m = GetChainMetaResponse{} }
+func (m *GetChainMetaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetChainMetaResponse) ProtoMessage()    {}
+func (*GetChainMetaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{21}
+}
+
+func (m *GetChainMetaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetChainMetaResponse.Unmarshal(m, b)
+}
+func (m *GetChainMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetChainMetaResponse.Marshal(b, m, deterministic)
+}
+func (m *GetChainMetaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChainMetaResponse.Merge(m, src)
+}
+func (m *GetChainMetaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetChainMetaResponse.Size(m)
+}
+func (m *GetChainMetaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChainMetaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChainMetaResponse proto.InternalMessageInfo
+
+func (m *GetChainMetaResponse) GetChainMeta() *iotextypes.ChainMeta {
+	if m != nil {
+		return m.ChainMeta
+	}
+	return nil
+}
+
+type GetServerMetaRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetServerMetaRequest) Reset()         { *m = GetServerMetaRequest{} }
+func (m *GetServerMetaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetServerMetaRequest) ProtoMessage()    {}
+func (*GetServerMetaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{22}
+}
+
+func (m *GetServerMetaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetServerMetaRequest.Unmarshal(m, b)
+}
+func (m *GetServerMetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetServerMetaRequest.Marshal(b, m, deterministic)
+}
+func (m *GetServerMetaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServerMetaRequest.Merge(m, src)
+}
+func (m *GetServerMetaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetServerMetaRequest.Size(m)
+}
+func (m *GetServerMetaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServerMetaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServerMetaRequest proto.InternalMessageInfo
+
+type GetServerMetaResponse struct {
+	ServerMeta           *iotextypes.ServerMeta `protobuf:"bytes,1,opt,name=serverMeta,proto3" json:"serverMeta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetServerMetaResponse) Reset()         { *m = GetServerMetaResponse{} }
+func (m *GetServerMetaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetServerMetaResponse) ProtoMessage()    {}
+func (*GetServerMetaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{23}
+}
+
+func (m *GetServerMetaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetServerMetaResponse.Unmarshal(m, b)
+}
+func (m *GetServerMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetServerMetaResponse.Marshal(b, m, deterministic)
+}
+func (m *GetServerMetaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServerMetaResponse.Merge(m, src)
+}
+func (m *GetServerMetaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetServerMetaResponse.Size(m)
+}
+func (m *GetServerMetaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServerMetaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServerMetaResponse proto.InternalMessageInfo
+
+func (m *GetServerMetaResponse) GetServerMeta() *iotextypes.ServerMeta {
+	if m != nil {
+		return m.ServerMeta
+	}
+	return nil
+}
+
+type SendActionRequest struct {
+	Action               *iotextypes.Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SendActionRequest) Reset()         { *m = SendActionRequest{} }
+func (m *SendActionRequest) String() string { return proto.CompactTextString(m) }
+func (*SendActionRequest) ProtoMessage()    {}
+func (*SendActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{24}
+}
+
+func (m *SendActionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendActionRequest.Unmarshal(m, b)
+}
+func (m *SendActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendActionRequest.Marshal(b, m, deterministic)
+}
+func (m *SendActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendActionRequest.Merge(m, src)
+}
+func (m *SendActionRequest) XXX_Size() int {
+	return xxx_messageInfo_SendActionRequest.Size(m)
+}
+func (m *SendActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendActionRequest proto.InternalMessageInfo
+
+func (m *SendActionRequest) GetAction() *iotextypes.Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+type SendSignedActionBytesRequest struct {
+	SignedActionBytes    string   `protobuf:"bytes,1,opt,name=signedActionBytes,proto3" json:"signedActionBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendSignedActionBytesRequest) Reset()         { *m = SendSignedActionBytesRequest{} }
+func (m *SendSignedActionBytesRequest) String() string { return proto.CompactTextString(m) }
+func (*SendSignedActionBytesRequest) ProtoMessage()    {}
+func (*SendSignedActionBytesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{25}
+}
+
+func (m *SendSignedActionBytesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendSignedActionBytesRequest.Unmarshal(m, b)
+}
+func (m *SendSignedActionBytesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendSignedActionBytesRequest.Marshal(b, m, deterministic)
+}
+func (m *SendSignedActionBytesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendSignedActionBytesRequest.Merge(m, src)
+}
+func (m *SendSignedActionBytesRequest) XXX_Size() int {
+	return xxx_messageInfo_SendSignedActionBytesRequest.Size(m)
+}
+func (m *SendSignedActionBytesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendSignedActionBytesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendSignedActionBytesRequest proto.InternalMessageInfo
+
+func (m *SendSignedActionBytesRequest) GetSignedActionBytes() string {
+	if m != nil {
+		return m.SignedActionBytes
+	}
+	return ""
+}
+
+type SendActionResponse struct {
+	ActionHash           string   `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendActionResponse) Reset()         { *m = SendActionResponse{} }
+func (m *SendActionResponse) String() string { return proto.CompactTextString(m) }
+func (*SendActionResponse) ProtoMessage()    {}
+func (*SendActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{26}
+}
+
+func (m *SendActionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendActionResponse.Unmarshal(m, b)
+}
+func (m *SendActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendActionResponse.Marshal(b, m, deterministic)
+}
+func (m *SendActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendActionResponse.Merge(m, src)
+}
+func (m *SendActionResponse) XXX_Size() int {
+	return xxx_messageInfo_SendActionResponse.Size(m)
+}
+func (m *SendActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendActionResponse proto.InternalMessageInfo
+
+func (m *SendActionResponse) GetActionHash() string {
+	if m != nil {
+		return m.ActionHash
+	}
+	return ""
+}
+
+type GetReceiptByActionRequest struct {
+	ActionHash           string   `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReceiptByActionRequest) Reset()         { *m = GetReceiptByActionRequest{} }
+func (m *GetReceiptByActionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReceiptByActionRequest) ProtoMessage()    {}
+func (*GetReceiptByActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{27}
+}
+
+func (m *GetReceiptByActionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReceiptByActionRequest.Unmarshal(m, b)
+}
+func (m *GetReceiptByActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReceiptByActionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReceiptByActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReceiptByActionRequest.Merge(m, src)
+}
+func (m *GetReceiptByActionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReceiptByActionRequest.Size(m)
+}
+func (m *GetReceiptByActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReceiptByActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReceiptByActionRequest proto.InternalMessageInfo
+
+func (m *GetReceiptByActionRequest) GetActionHash() string {
+	if m != nil {
+		return m.ActionHash
+	}
+	return ""
+}
+
+type GetReceiptByActionResponse struct {
+	ReceiptInfo          *ReceiptInfo `protobuf:"bytes,1,opt,name=receiptInfo,proto3" json:"receiptInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetReceiptByActionResponse) Reset()         { *m = GetReceiptByActionResponse{} }
+func (m *GetReceiptByActionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReceiptByActionResponse) ProtoMessage()    {}
+func (*GetReceiptByActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{28}
+}
+
+func (m *GetReceiptByActionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReceiptByActionResponse.Unmarshal(m, b)
+}
+func (m *GetReceiptByActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReceiptByActionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReceiptByActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReceiptByActionResponse.Merge(m, src)
+}
+func (m *GetReceiptByActionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReceiptByActionResponse.Size(m)
+}
+func (m *GetReceiptByActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReceiptByActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReceiptByActionResponse proto.InternalMessageInfo
+
+func (m *GetReceiptByActionResponse) GetReceiptInfo() *ReceiptInfo {
+	if m != nil {
+		return m.ReceiptInfo
+	}
+	return nil
+}
+
+type ReadContractRequest struct {
+	Execution     *iotextypes.Execution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	CallerAddress string                `protobuf:"bytes,2,opt,name=callerAddress,proto3" json:"callerAddress,omitempty"`
+	// the block height at the end of which to query, within the history state retention window, 0 meaning the tip
+	Height               uint64   `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadContractRequest) Reset()         { *m = ReadContractRequest{} }
+func (m *ReadContractRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadContractRequest) ProtoMessage()    {}
+func (*ReadContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{29}
+}
+
+func (m *ReadContractRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadContractRequest.Unmarshal(m, b)
+}
+func (m *ReadContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadContractRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadContractRequest.Merge(m, src)
+}
+func (m *ReadContractRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadContractRequest.Size(m)
+}
+func (m *ReadContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadContractRequest proto.InternalMessageInfo
+
+func (m *ReadContractRequest) GetExecution() *iotextypes.Execution {
+	if m != nil {
+		return m.Execution
+	}
+	return nil
+}
+
+func (m *ReadContractRequest) GetCallerAddress() string {
+	if m != nil {
+		return m.CallerAddress
+	}
+	return ""
+}
+
+func (m *ReadContractRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ReadContractResponse struct {
+	Data                 string              `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Receipt              *iotextypes.Receipt `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReadContractResponse) Reset()         { *m = ReadContractResponse{} }
+func (m *ReadContractResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadContractResponse) ProtoMessage()    {}
+func (*ReadContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{30}
+}
+
+func (m *ReadContractResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadContractResponse.Unmarshal(m, b)
+}
+func (m *ReadContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadContractResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadContractResponse.Merge(m, src)
+}
+func (m *ReadContractResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadContractResponse.Size(m)
+}
+func (m *ReadContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadContractResponse proto.InternalMessageInfo
+
+func (m *ReadContractResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ReadContractResponse) GetReceipt() *iotextypes.Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+type GetStateProofRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the storage slots of the contract to prove
+	StorageKeys [][]byte `protobuf:"bytes,2,rep,name=storageKeys,proto3" json:"storageKeys,omitempty"`
+	// the block height at the end of which to prove, within the history state retention window, 0 meaning the tip
+	Height               uint64   `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStateProofRequest) Reset()         { *m = GetStateProofRequest{} }
+func (m *GetStateProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStateProofRequest) ProtoMessage()    {}
+func (*GetStateProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{31}
+}
+
+func (m *GetStateProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateProofRequest.Unmarshal(m, b)
+}
+func (m *GetStateProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateProofRequest.Marshal(b, m, deterministic)
+}
+func (m *GetStateProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateProofRequest.Merge(m, src)
+}
+func (m *GetStateProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStateProofRequest.Size(m)
+}
+func (m *GetStateProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateProofRequest proto.InternalMessageInfo
+
+func (m *GetStateProofRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetStateProofRequest) GetStorageKeys() [][]byte {
+	if m != nil {
+		return m.StorageKeys
+	}
+	return nil
+}
+
+func (m *GetStateProofRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type GetStateProofResponse struct {
+	// the header of the block at the height of the proof, which commits to the state root if its producer keeps a state
+	// trie
+	Header *iotextypes.BlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// the root hash of the state trie which the proof is against
+	RootHash []byte `protobuf:"bytes,2,opt,name=rootHash,proto3" json:"rootHash,omitempty"`
+	// the serialized account, which is empty if the account does not exist
+	Account              []byte          `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	AccountProof         [][]byte        `protobuf:"bytes,4,rep,name=accountProof,proto3" json:"accountProof,omitempty"`
+	StorageProofs        []*StorageProof `protobuf:"bytes,5,rep,name=storageProofs,proto3" json:"storageProofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetStateProofResponse) Reset()         { *m = GetStateProofResponse{} }
+func (m *GetStateProofResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStateProofResponse) ProtoMessage()    {}
+func (*GetStateProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{32}
+}
+
+func (m *GetStateProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateProofResponse.Unmarshal(m, b)
+}
+func (m *GetStateProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateProofResponse.Marshal(b, m, deterministic)
+}
+func (m *GetStateProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateProofResponse.Merge(m, src)
+}
+func (m *GetStateProofResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStateProofResponse.Size(m)
+}
+func (m *GetStateProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateProofResponse proto.InternalMessageInfo
+
+func (m *GetStateProofResponse) GetHeader() *iotextypes.BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetStateProofResponse) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *GetStateProofResponse) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *GetStateProofResponse) GetAccountProof() [][]byte {
+	if m != nil {
+		return m.AccountProof
+	}
+	return nil
+}
+
+func (m *GetStateProofResponse) GetStorageProofs() []*StorageProof {
+	if m != nil {
+		return m.StorageProofs
+	}
+	return nil
+}
+
+type StorageProof struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// the value of the slot, which is empty if the slot does not exist
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Proof                [][]byte `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageProof) Reset()         { *m = StorageProof{} }
+func (m *StorageProof) String() string { return proto.CompactTextString(m) }
+func (*StorageProof) ProtoMessage()    {}
+func (*StorageProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{33}
+}
+
+func (m *StorageProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageProof.Unmarshal(m, b)
+}
+func (m *StorageProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageProof.Marshal(b, m, deterministic)
+}
+func (m *StorageProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageProof.Merge(m, src)
+}
+func (m *StorageProof) XXX_Size() int {
+	return xxx_messageInfo_StorageProof.Size(m)
+}
+func (m *StorageProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageProof proto.InternalMessageInfo
+
+func (m *StorageProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StorageProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StorageProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type TraceTransactionRequest struct {
+	ActionHash string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+	// asks for the opcode level logs in addition to the call tree
+	StructLogs           bool     `protobuf:"varint,2,opt,name=structLogs,proto3" json:"structLogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TraceTransactionRequest) Reset()         { *m = TraceTransactionRequest{} }
+func (m *TraceTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*TraceTransactionRequest) ProtoMessage()    {}
+func (*TraceTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{34}
+}
+
+func (m *TraceTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceTransactionRequest.Unmarshal(m, b)
+}
+func (m *TraceTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *TraceTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceTransactionRequest.Merge(m, src)
+}
+func (m *TraceTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_TraceTransactionRequest.Size(m)
+}
+func (m *TraceTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceTransactionRequest proto.InternalMessageInfo
+
+func (m *TraceTransactionRequest) GetActionHash() string {
+	if m != nil {
+		return m.ActionHash
+	}
+	return ""
+}
+
+func (m *TraceTransactionRequest) GetStructLogs() bool {
+	if m != nil {
+		return m.StructLogs
+	}
+	return false
+}
+
+type TraceCallRequest struct {
+	Execution     *iotextypes.Execution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	CallerAddress string                `protobuf:"bytes,2,opt,name=callerAddress,proto3" json:"callerAddress,omitempty"`
+	// asks for the opcode level logs in addition to the call tree
+	StructLogs           bool     `protobuf:"varint,3,opt,name=structLogs,proto3" json:"structLogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TraceCallRequest) Reset()         { *m = TraceCallRequest{} }
+func (m *TraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*TraceCallRequest) ProtoMessage()    {}
+func (*TraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{35}
+}
+
+func (m *TraceCallRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceCallRequest.Unmarshal(m, b)
+}
+func (m *TraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceCallRequest.Marshal(b, m, deterministic)
+}
+func (m *TraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceCallRequest.Merge(m, src)
+}
+func (m *TraceCallRequest) XXX_Size() int {
+	return xxx_messageInfo_TraceCallRequest.Size(m)
+}
+func (m *TraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceCallRequest proto.InternalMessageInfo
+
+func (m *TraceCallRequest) GetExecution() *iotextypes.Execution {
+	if m != nil {
+		return m.Execution
+	}
+	return nil
+}
+
+func (m *TraceCallRequest) GetCallerAddress() string {
+	if m != nil {
+		return m.CallerAddress
+	}
+	return ""
+}
+
+func (m *TraceCallRequest) GetStructLogs() bool {
+	if m != nil {
+		return m.StructLogs
+	}
+	return false
+}
+
+type TraceResponse struct {
+	Trace                *ExecutionTrace     `protobuf:"bytes,1,opt,name=trace,proto3" json:"trace,omitempty"`
+	Receipt              *iotextypes.Receipt `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *TraceResponse) Reset()         { *m = TraceResponse{} }
+func (m *TraceResponse) String() string { return proto.CompactTextString(m) }
+func (*TraceResponse) ProtoMessage()    {}
+func (*TraceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{36}
+}
+
+func (m *TraceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceResponse.Unmarshal(m, b)
+}
+func (m *TraceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceResponse.Marshal(b, m, deterministic)
+}
+func (m *TraceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceResponse.Merge(m, src)
+}
+func (m *TraceResponse) XXX_Size() int {
+	return xxx_messageInfo_TraceResponse.Size(m)
+}
+func (m *TraceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceResponse proto.InternalMessageInfo
+
+func (m *TraceResponse) GetTrace() *ExecutionTrace {
+	if m != nil {
+		return m.Trace
+	}
+	return nil
+}
+
+func (m *TraceResponse) GetReceipt() *iotextypes.Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+type ExecutionTrace struct {
+	Call                 *CallFrame   `protobuf:"bytes,1,opt,name=call,proto3" json:"call,omitempty"`
+	StructLogs           []*StructLog `protobuf:"bytes,2,rep,name=structLogs,proto3" json:"structLogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ExecutionTrace) Reset()         { *m = ExecutionTrace{} }
+func (m *ExecutionTrace) String() string { return proto.CompactTextString(m) }
+func (*ExecutionTrace) ProtoMessage()    {}
+func (*ExecutionTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{37}
+}
+
+func (m *ExecutionTrace) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecutionTrace.Unmarshal(m, b)
+}
+func (m *ExecutionTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecutionTrace.Marshal(b, m, deterministic)
+}
+func (m *ExecutionTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionTrace.Merge(m, src)
+}
+func (m *ExecutionTrace) XXX_Size() int {
+	return xxx_messageInfo_ExecutionTrace.Size(m)
+}
+func (m *ExecutionTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionTrace proto.InternalMessageInfo
+
+func (m *ExecutionTrace) GetCall() *CallFrame {
+	if m != nil {
+		return m.Call
+	}
+	return nil
+}
+
+func (m *ExecutionTrace) GetStructLogs() []*StructLog {
+	if m != nil {
+		return m.StructLogs
+	}
+	return nil
+}
+
+// a call in the call tree of a traced execution
+type CallFrame struct {
+	Type                 string       `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	From                 string       `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string       `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value                string       `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Gas                  uint64       `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasUsed              uint64       `protobuf:"varint,6,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Input                []byte       `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Output               []byte       `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
+	Error                string       `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	RevertReason         string       `protobuf:"bytes,10,opt,name=revertReason,proto3" json:"revertReason,omitempty"`
+	Calls                []*CallFrame `protobuf:"bytes,11,rep,name=calls,proto3" json:"calls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CallFrame) Reset()         { *m = CallFrame{} }
+func (m *CallFrame) String() string { return proto.CompactTextString(m) }
+func (*CallFrame) ProtoMessage()    {}
+func (*CallFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{38}
+}
+
+func (m *CallFrame) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallFrame.Unmarshal(m, b)
+}
+func (m *CallFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallFrame.Marshal(b, m, deterministic)
+}
+func (m *CallFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallFrame.Merge(m, src)
+}
+func (m *CallFrame) XXX_Size() int {
+	return xxx_messageInfo_CallFrame.Size(m)
+}
+func (m *CallFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallFrame proto.InternalMessageInfo
+
+func (m *CallFrame) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CallFrame) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *CallFrame) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *CallFrame) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *CallFrame) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *CallFrame) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *CallFrame) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *CallFrame) GetOutput() []byte {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *CallFrame) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CallFrame) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
+	}
+	return ""
+}
+
+func (m *CallFrame) GetCalls() []*CallFrame {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+// an opcode executed by a traced execution
+type StructLog struct {
+	Pc      uint64 `protobuf:"varint,1,opt,name=pc,proto3" json:"pc,omitempty"`
+	Op      string `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Gas     uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasCost uint64 `protobuf:"varint,4,opt,name=gasCost,proto3" json:"gasCost,omitempty"`
+	Memory  []byte `protobuf:"bytes,5,opt,name=memory,proto3" json:"memory,omitempty"`
+	// the stack items, each of which is a big-endian 256-bit word
+	Stack [][]byte `protobuf:"bytes,6,rep,name=stack,proto3" json:"stack,omitempty"`
+	// the storage slots accessed so far, keyed by the hex encoded slot
+	Storage              map[string][]byte `protobuf:"bytes,7,rep,name=storage,proto3" json:"storage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Depth                int32             `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`
+	Refund               uint64            `protobuf:"varint,9,opt,name=refund,proto3" json:"refund,omitempty"`
+	Error                string            `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *StructLog) Reset()         { *m = StructLog{} }
+func (m *StructLog) String() string { return proto.CompactTextString(m) }
+func (*StructLog) ProtoMessage()    {}
+func (*StructLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{39}
+}
+
+func (m *StructLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StructLog.Unmarshal(m, b)
+}
+func (m *StructLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StructLog.Marshal(b, m, deterministic)
+}
+func (m *StructLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StructLog.Merge(m, src)
+}
+func (m *StructLog) XXX_Size() int {
+	return xxx_messageInfo_StructLog.Size(m)
+}
+func (m *StructLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_StructLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StructLog proto.InternalMessageInfo
+
+func (m *StructLog) GetPc() uint64 {
+	if m != nil {
+		return m.Pc
+	}
+	return 0
+}
+
+func (m *StructLog) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *StructLog) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *StructLog) GetGasCost() uint64 {
+	if m != nil {
+		return m.GasCost
+	}
+	return 0
+}
+
+func (m *StructLog) GetMemory() []byte {
+	if m != nil {
+		return m.Memory
+	}
+	return nil
+}
+
+func (m *StructLog) GetStack() [][]byte {
+	if m != nil {
+		return m.Stack
+	}
+	return nil
+}
+
+func (m *StructLog) GetStorage() map[string][]byte {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *StructLog) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *StructLog) GetRefund() uint64 {
+	if m != nil {
+		return m.Refund
+	}
+	return 0
+}
+
+func (m *StructLog) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SuggestGasPriceRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuggestGasPriceRequest) Reset()         { *m = SuggestGasPriceRequest{} }
+func (m *SuggestGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*SuggestGasPriceRequest) ProtoMessage()    {}
+func (*SuggestGasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{40}
+}
+
+func (m *SuggestGasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuggestGasPriceRequest.Unmarshal(m, b)
+}
+func (m *SuggestGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuggestGasPriceRequest.Marshal(b, m, deterministic)
+}
+func (m *SuggestGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestGasPriceRequest.Merge(m, src)
+}
+func (m *SuggestGasPriceRequest) XXX_Size() int {
+	return xxx_messageInfo_SuggestGasPriceRequest.Size(m)
+}
+func (m *SuggestGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestGasPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestGasPriceRequest proto.InternalMessageInfo
+
+type SuggestGasPriceResponse struct {
+	GasPrice             uint64   `protobuf:"varint,1,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuggestGasPriceResponse) Reset()         { *m = SuggestGasPriceResponse{} }
+func (m *SuggestGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*SuggestGasPriceResponse) ProtoMessage()    {}
+func (*SuggestGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{41}
+}
+
+func (m *SuggestGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuggestGasPriceResponse.Unmarshal(m, b)
+}
+func (m *SuggestGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuggestGasPriceResponse.Marshal(b, m, deterministic)
+}
+func (m *SuggestGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestGasPriceResponse.Merge(m, src)
+}
+func (m *SuggestGasPriceResponse) XXX_Size() int {
+	return xxx_messageInfo_SuggestGasPriceResponse.Size(m)
+}
+func (m *SuggestGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestGasPriceResponse proto.InternalMessageInfo
+
+func (m *SuggestGasPriceResponse) GetGasPrice() uint64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+type SuggestGasPricesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuggestGasPricesRequest) Reset()         { *m = SuggestGasPricesRequest{} }
+func (m *SuggestGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*SuggestGasPricesRequest) ProtoMessage()    {}
+func (*SuggestGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{42}
+}
+
+func (m *SuggestGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuggestGasPricesRequest.Unmarshal(m, b)
+}
+func (m *SuggestGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuggestGasPricesRequest.Marshal(b, m, deterministic)
+}
+func (m *SuggestGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestGasPricesRequest.Merge(m, src)
+}
+func (m *SuggestGasPricesRequest) XXX_Size() int {
+	return xxx_messageInfo_SuggestGasPricesRequest.Size(m)
+}
+func (m *SuggestGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestGasPricesRequest proto.InternalMessageInfo
+
+type SuggestGasPricesResponse struct {
+	Slow                 uint64   `protobuf:"varint,1,opt,name=slow,proto3" json:"slow,omitempty"`
+	Standard             uint64   `protobuf:"varint,2,opt,name=standard,proto3" json:"standard,omitempty"`
+	Fast                 uint64   `protobuf:"varint,3,opt,name=fast,proto3" json:"fast,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuggestGasPricesResponse) Reset()         { *m = SuggestGasPricesResponse{} }
+func (m *SuggestGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*SuggestGasPricesResponse) ProtoMessage()    {}
+func (*SuggestGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{43}
+}
+
+func (m *SuggestGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuggestGasPricesResponse.Unmarshal(m, b)
+}
+func (m *SuggestGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuggestGasPricesResponse.Marshal(b, m, deterministic)
+}
+func (m *SuggestGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestGasPricesResponse.Merge(m, src)
+}
+func (m *SuggestGasPricesResponse) XXX_Size() int {
+	return xxx_messageInfo_SuggestGasPricesResponse.Size(m)
+}
+func (m *SuggestGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestGasPricesResponse proto.InternalMessageInfo
+
+func (m *SuggestGasPricesResponse) GetSlow() uint64 {
+	if m != nil {
+		return m.Slow
+	}
+	return 0
+}
+
+func (m *SuggestGasPricesResponse) GetStandard() uint64 {
+	if m != nil {
+		return m.Standard
+	}
+	return 0
+}
+
+func (m *SuggestGasPricesResponse) GetFast() uint64 {
+	if m != nil {
+		return m.Fast
+	}
+	return 0
+}
+
+type GetFeeHistoryRequest struct {
+	BlockCount uint64 `protobuf:"varint,1,opt,name=blockCount,proto3" json:"blockCount,omitempty"`
+	// newestBlock is the height of the last block, 0 meaning the tip
+	NewestBlock uint64 `protobuf:"varint,2,opt,name=newestBlock,proto3" json:"newestBlock,omitempty"`
+	// rewardPercentiles are the ascending percentiles of the gas prices weighted by the gas consumed
+	RewardPercentiles    []float64 `protobuf:"fixed64,3,rep,packed,name=rewardPercentiles,proto3" json:"rewardPercentiles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetFeeHistoryRequest) Reset()         { *m = GetFeeHistoryRequest{} }
+func (m *GetFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFeeHistoryRequest) ProtoMessage()    {}
+func (*GetFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{44}
+}
+
+func (m *GetFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFeeHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFeeHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeeHistoryRequest.Merge(m, src)
+}
+func (m *GetFeeHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFeeHistoryRequest.Size(m)
+}
+func (m *GetFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *GetFeeHistoryRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *GetFeeHistoryRequest) GetNewestBlock() uint64 {
+	if m != nil {
+		return m.NewestBlock
+	}
+	return 0
+}
+
+func (m *GetFeeHistoryRequest) GetRewardPercentiles() []float64 {
+	if m != nil {
+		return m.RewardPercentiles
+	}
+	return nil
+}
+
+type FeeHistoryRewards struct {
+	GasPrices            []string `protobuf:"bytes,1,rep,name=gasPrices,proto3" json:"gasPrices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeHistoryRewards) Reset()         { *m = FeeHistoryRewards{} }
+func (m *FeeHistoryRewards) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryRewards) ProtoMessage()    {}
+func (*FeeHistoryRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{45}
+}
+
+func (m *FeeHistoryRewards) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeHistoryRewards.Unmarshal(m, b)
+}
+func (m *FeeHistoryRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeHistoryRewards.Marshal(b, m, deterministic)
+}
+func (m *FeeHistoryRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryRewards.Merge(m, src)
+}
+func (m *FeeHistoryRewards) XXX_Size() int {
+	return xxx_messageInfo_FeeHistoryRewards.Size(m)
+}
+func (m *FeeHistoryRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeHistoryRewards proto.InternalMessageInfo
+
+func (m *FeeHistoryRewards) GetGasPrices() []string {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+type GetFeeHistoryResponse struct {
+	OldestBlock          uint64               `protobuf:"varint,1,opt,name=oldestBlock,proto3" json:"oldestBlock,omitempty"`
+	GasUsedRatio         []float64            `protobuf:"fixed64,2,rep,packed,name=gasUsedRatio,proto3" json:"gasUsedRatio,omitempty"`
+	Rewards              []*FeeHistoryRewards `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetFeeHistoryResponse) Reset()         { *m = GetFeeHistoryResponse{} }
+func (m *GetFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFeeHistoryResponse) ProtoMessage()    {}
+func (*GetFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{46}
+}
+
+func (m *GetFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFeeHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFeeHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeeHistoryResponse.Merge(m, src)
+}
+func (m *GetFeeHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFeeHistoryResponse.Size(m)
+}
+func (m *GetFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *GetFeeHistoryResponse) GetOldestBlock() uint64 {
+	if m != nil {
+		return m.OldestBlock
+	}
+	return 0
+}
+
+func (m *GetFeeHistoryResponse) GetGasUsedRatio() []float64 {
+	if m != nil {
+		return m.GasUsedRatio
+	}
+	return nil
+}
+
+func (m *GetFeeHistoryResponse) GetRewards() []*FeeHistoryRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+type SimulationStep struct {
+	CallerAddress string `protobuf:"bytes,1,opt,name=callerAddress,proto3" json:"callerAddress,omitempty"`
+	// the nonce of the action is ignored, which is filled with the next nonce of the caller
+	Action               *iotextypes.ActionCore `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SimulationStep) Reset()         { *m = SimulationStep{} }
+func (m *SimulationStep) String() string { return proto.CompactTextString(m) }
+func (*SimulationStep) ProtoMessage()    {}
+func (*SimulationStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{47}
+}
+
+func (m *SimulationStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulationStep.Unmarshal(m, b)
+}
+func (m *SimulationStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulationStep.Marshal(b, m, deterministic)
+}
+func (m *SimulationStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulationStep.Merge(m, src)
+}
+func (m *SimulationStep) XXX_Size() int {
+	return xxx_messageInfo_SimulationStep.Size(m)
+}
+func (m *SimulationStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulationStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulationStep proto.InternalMessageInfo
+
+func (m *SimulationStep) GetCallerAddress() string {
+	if m != nil {
+		return m.CallerAddress
+	}
+	return ""
+}
+
+func (m *SimulationStep) GetAction() *iotextypes.ActionCore {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+type SimulateActionsRequest struct {
+	Steps []*SimulationStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	// includePending asks for running the pending actions of the callers in the action pool first
+	IncludePending       bool     `protobuf:"varint,2,opt,name=includePending,proto3" json:"includePending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimulateActionsRequest) Reset()         { *m = SimulateActionsRequest{} }
+func (m *SimulateActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateActionsRequest) ProtoMessage()    {}
+func (*SimulateActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{48}
+}
+
+func (m *SimulateActionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateActionsRequest.Unmarshal(m, b)
+}
+func (m *SimulateActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateActionsRequest.Marshal(b, m, deterministic)
+}
+func (m *SimulateActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateActionsRequest.Merge(m, src)
+}
+func (m *SimulateActionsRequest) XXX_Size() int {
+	return xxx_messageInfo_SimulateActionsRequest.Size(m)
+}
+func (m *SimulateActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateActionsRequest proto.InternalMessageInfo
+
+func (m *SimulateActionsRequest) GetSteps() []*SimulationStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func (m *SimulateActionsRequest) GetIncludePending() bool {
+	if m != nil {
+		return m.IncludePending
+	}
+	return false
+}
+
+type AccountState struct {
+	Nonce                uint64   `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Balance              string   `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	StorageRoot          []byte   `protobuf:"bytes,3,opt,name=storageRoot,proto3" json:"storageRoot,omitempty"`
+	CodeHash             []byte   `protobuf:"bytes,4,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountState) Reset()         { *m = AccountState{} }
+func (m *AccountState) String() string { return proto.CompactTextString(m) }
+func (*AccountState) ProtoMessage()    {}
+func (*AccountState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{49}
+}
+
+func (m *AccountState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountState.Unmarshal(m, b)
+}
+func (m *AccountState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountState.Marshal(b, m, deterministic)
+}
+func (m *AccountState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountState.Merge(m, src)
+}
+func (m *AccountState) XXX_Size() int {
+	return xxx_messageInfo_AccountState.Size(m)
+}
+func (m *AccountState) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountState proto.InternalMessageInfo
+
+func (m *AccountState) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AccountState) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *AccountState) GetStorageRoot() []byte {
+	if m != nil {
+		return m.StorageRoot
+	}
+	return nil
+}
+
+func (m *AccountState) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+type AccountDiff struct {
+	Address              string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Before               *AccountState `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After                *AccountState `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AccountDiff) Reset()         { *m = AccountDiff{} }
+func (m *AccountDiff) String() string { return proto.CompactTextString(m) }
+func (*AccountDiff) ProtoMessage()    {}
+func (*AccountDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{50}
+}
+
+func (m *AccountDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountDiff.Unmarshal(m, b)
+}
+func (m *AccountDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountDiff.Marshal(b, m, deterministic)
+}
+func (m *AccountDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountDiff.Merge(m, src)
+}
+func (m *AccountDiff) XXX_Size() int {
+	return xxx_messageInfo_AccountDiff.Size(m)
+}
+func (m *AccountDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountDiff proto.InternalMessageInfo
+
+func (m *AccountDiff) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountDiff) GetBefore() *AccountState {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *AccountDiff) GetAfter() *AccountState {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+type SimulationResult struct {
+	Receipt *iotextypes.Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Diffs   []*AccountDiff      `protobuf:"bytes,2,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	// error is the reason the action fails, after which the rest actions are not simulated
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimulationResult) Reset()         { *m = SimulationResult{} }
+func (m *SimulationResult) String() string { return proto.CompactTextString(m) }
+func (*SimulationResult) ProtoMessage()    {}
+func (*SimulationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{51}
+}
+
+func (m *SimulationResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulationResult.Unmarshal(m, b)
+}
+func (m *SimulationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulationResult.Marshal(b, m, deterministic)
+}
+func (m *SimulationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulationResult.Merge(m, src)
+}
+func (m *SimulationResult) XXX_Size() int {
+	return xxx_messageInfo_SimulationResult.Size(m)
+}
+func (m *SimulationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulationResult proto.InternalMessageInfo
+
+func (m *SimulationResult) GetReceipt() *iotextypes.Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *SimulationResult) GetDiffs() []*AccountDiff {
+	if m != nil {
+		return m.Diffs
+	}
+	return nil
+}
+
+func (m *SimulationResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SimulateActionsResponse struct {
+	Results              []*SimulationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SimulateActionsResponse) Reset()         { *m = SimulateActionsResponse{} }
+func (m *SimulateActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateActionsResponse) ProtoMessage()    {}
+func (*SimulateActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{52}
+}
+
+func (m *SimulateActionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateActionsResponse.Unmarshal(m, b)
+}
+func (m *SimulateActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateActionsResponse.Marshal(b, m, deterministic)
+}
+func (m *SimulateActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateActionsResponse.Merge(m, src)
+}
+func (m *SimulateActionsResponse) XXX_Size() int {
+	return xxx_messageInfo_SimulateActionsResponse.Size(m)
+}
+func (m *SimulateActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateActionsResponse proto.InternalMessageInfo
+
+func (m *SimulateActionsResponse) GetResults() []*SimulationResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// To be deprecated
+type EstimateGasForActionRequest struct {
+	Action               *iotextypes.Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *EstimateGasForActionRequest) Reset()         { *m = EstimateGasForActionRequest{} }
+func (m *EstimateGasForActionRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasForActionRequest) ProtoMessage()    {}
+func (*EstimateGasForActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{53}
+}
+
+func (m *EstimateGasForActionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateGasForActionRequest.Unmarshal(m, b)
+}
+func (m *EstimateGasForActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateGasForActionRequest.Marshal(b, m, deterministic)
+}
+func (m *EstimateGasForActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasForActionRequest.Merge(m, src)
+}
+func (m *EstimateGasForActionRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateGasForActionRequest.Size(m)
+}
+func (m *EstimateGasForActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasForActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateGasForActionRequest proto.InternalMessageInfo
+
+func (m *EstimateGasForActionRequest) GetAction() *iotextypes.Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+type EstimateActionGasConsumptionRequest struct {
+	// Types that are valid to be assigned to Action:
+	//	*EstimateActionGasConsumptionRequest_Transfer
+	//	*EstimateActionGasConsumptionRequest_Execution
+	Action               isEstimateActionGasConsumptionRequest_Action `protobuf_oneof:"action"`
+	CallerAddress        string                                       `protobuf:"bytes,100,opt,name=callerAddress,proto3" json:"callerAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *EstimateActionGasConsumptionRequest) Reset()         { *m = EstimateActionGasConsumptionRequest{} }
+func (m *EstimateActionGasConsumptionRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateActionGasConsumptionRequest) ProtoMessage()    {}
+func (*EstimateActionGasConsumptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{54}
+}
+
+func (m *EstimateActionGasConsumptionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateActionGasConsumptionRequest.Unmarshal(m, b)
+}
+func (m *EstimateActionGasConsumptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateActionGasConsumptionRequest.Marshal(b, m, deterministic)
+}
+func (m *EstimateActionGasConsumptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateActionGasConsumptionRequest.Merge(m, src)
+}
+func (m *EstimateActionGasConsumptionRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateActionGasConsumptionRequest.Size(m)
+}
+func (m *EstimateActionGasConsumptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateActionGasConsumptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateActionGasConsumptionRequest proto.InternalMessageInfo
+
+type isEstimateActionGasConsumptionRequest_Action interface {
+	isEstimateActionGasConsumptionRequest_Action()
+}
+
+type EstimateActionGasConsumptionRequest_Transfer struct {
+	Transfer *iotextypes.Transfer `protobuf:"bytes,1,opt,name=transfer,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_Execution struct {
+	Execution *iotextypes.Execution `protobuf:"bytes,2,opt,name=execution,proto3,oneof"`
+}
+
+func (*EstimateActionGasConsumptionRequest_Transfer) isEstimateActionGasConsumptionRequest_Action() {}
+
+func (*EstimateActionGasConsumptionRequest_Execution) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (m *EstimateActionGasConsumptionRequest) GetAction() isEstimateActionGasConsumptionRequest_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *EstimateActionGasConsumptionRequest) GetTransfer() *iotextypes.Transfer {
+	if x, ok := m.GetAction().(*EstimateActionGasConsumptionRequest_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (m *EstimateActionGasConsumptionRequest) GetExecution() *iotextypes.Execution {
+	if x, ok := m.GetAction().(*EstimateActionGasConsumptionRequest_Execution); ok {
+		return x.Execution
+	}
+	return nil
+}
+
+func (m *EstimateActionGasConsumptionRequest) GetCallerAddress() string {
+	if m != nil {
+		return m.CallerAddress
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*EstimateActionGasConsumptionRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*EstimateActionGasConsumptionRequest_Transfer)(nil),
+		(*EstimateActionGasConsumptionRequest_Execution)(nil),
+	}
+}
+
+type EstimateActionGasConsumptionResponse struct {
+	Gas                  uint64   `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EstimateActionGasConsumptionResponse) Reset()         { *m = EstimateActionGasConsumptionResponse{} }
+func (m *EstimateActionGasConsumptionResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateActionGasConsumptionResponse) ProtoMessage()    {}
+func (*EstimateActionGasConsumptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{55}
+}
+
+func (m *EstimateActionGasConsumptionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateActionGasConsumptionResponse.Unmarshal(m, b)
+}
+func (m *EstimateActionGasConsumptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateActionGasConsumptionResponse.Marshal(b, m, deterministic)
+}
+func (m *EstimateActionGasConsumptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateActionGasConsumptionResponse.Merge(m, src)
+}
+func (m *EstimateActionGasConsumptionResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateActionGasConsumptionResponse.Size(m)
+}
+func (m *EstimateActionGasConsumptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateActionGasConsumptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateActionGasConsumptionResponse proto.InternalMessageInfo
+
+func (m *EstimateActionGasConsumptionResponse) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+type EstimateGasForActionResponse struct {
+	Gas                  uint64   `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EstimateGasForActionResponse) Reset()         { *m = EstimateGasForActionResponse{} }
+func (m *EstimateGasForActionResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateGasForActionResponse) ProtoMessage()    {}
+func (*EstimateGasForActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{56}
+}
+
+func (m *EstimateGasForActionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateGasForActionResponse.Unmarshal(m, b)
+}
+func (m *EstimateGasForActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateGasForActionResponse.Marshal(b, m, deterministic)
+}
+func (m *EstimateGasForActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateGasForActionResponse.Merge(m, src)
+}
+func (m *EstimateGasForActionResponse) XXX_Size() int {
+	return xxx_messageInfo_EstimateGasForActionResponse.Size(m)
+}
+func (m *EstimateGasForActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateGasForActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateGasForActionResponse proto.InternalMessageInfo
+
+func (m *EstimateGasForActionResponse) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+type ReadStateRequest struct {
+	ProtocolID []byte   `protobuf:"bytes,1,opt,name=protocolID,proto3" json:"protocolID,omitempty"`
+	MethodName []byte   `protobuf:"bytes,2,opt,name=methodName,proto3" json:"methodName,omitempty"`
+	Arguments  [][]byte `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	// the block height at the end of which to query, within the history state retention window, 0 meaning the tip
+	Height               uint64   `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadStateRequest) Reset()         { *m = ReadStateRequest{} }
+func (m *ReadStateRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadStateRequest) ProtoMessage()    {}
+func (*ReadStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{57}
+}
+
+func (m *ReadStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadStateRequest.Unmarshal(m, b)
+}
+func (m *ReadStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadStateRequest.Marshal(b, m, deterministic)
+}
+func (m *ReadStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadStateRequest.Merge(m, src)
+}
+func (m *ReadStateRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadStateRequest.Size(m)
+}
+func (m *ReadStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadStateRequest proto.InternalMessageInfo
+
+func (m *ReadStateRequest) GetProtocolID() []byte {
+	if m != nil {
+		return m.ProtocolID
+	}
+	return nil
+}
+
+func (m *ReadStateRequest) GetMethodName() []byte {
+	if m != nil {
+		return m.MethodName
+	}
+	return nil
+}
+
+func (m *ReadStateRequest) GetArguments() [][]byte {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
+func (m *ReadStateRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ReadStateResponse struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadStateResponse) Reset()         { *m = ReadStateResponse{} }
+func (m *ReadStateResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadStateResponse) ProtoMessage()    {}
+func (*ReadStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{58}
+}
+
+func (m *ReadStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadStateResponse.Unmarshal(m, b)
+}
+func (m *ReadStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadStateResponse.Marshal(b, m, deterministic)
+}
+func (m *ReadStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadStateResponse.Merge(m, src)
+}
+func (m *ReadStateResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadStateResponse.Size(m)
+}
+func (m *ReadStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadStateResponse proto.InternalMessageInfo
+
+func (m *ReadStateResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type GetEpochMetaRequest struct {
+	EpochNumber          uint64   `protobuf:"varint,1,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEpochMetaRequest) Reset()         { *m = GetEpochMetaRequest{} }
+func (m *GetEpochMetaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEpochMetaRequest) ProtoMessage()    {}
+func (*GetEpochMetaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{59}
+}
+
+func (m *GetEpochMetaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEpochMetaRequest.Unmarshal(m, b)
+}
+func (m *GetEpochMetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEpochMetaRequest.Marshal(b, m, deterministic)
+}
+func (m *GetEpochMetaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEpochMetaRequest.Merge(m, src)
+}
+func (m *GetEpochMetaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEpochMetaRequest.Size(m)
+}
+func (m *GetEpochMetaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEpochMetaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEpochMetaRequest proto.InternalMessageInfo
+
+func (m *GetEpochMetaRequest) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+type GetEpochMetaResponse struct {
+	EpochData            *iotextypes.EpochData `protobuf:"bytes,1,opt,name=epochData,proto3" json:"epochData,omitempty"`
+	TotalBlocks          uint64                `protobuf:"varint,2,opt,name=totalBlocks,proto3" json:"totalBlocks,omitempty"`
+	BlockProducersInfo   []*BlockProducerInfo  `protobuf:"bytes,3,rep,name=blockProducersInfo,proto3" json:"blockProducersInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetEpochMetaResponse) Reset()         { *m = GetEpochMetaResponse{} }
+func (m *GetEpochMetaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEpochMetaResponse) ProtoMessage()    {}
+func (*GetEpochMetaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{60}
+}
+
+func (m *GetEpochMetaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEpochMetaResponse.Unmarshal(m, b)
+}
+func (m *GetEpochMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEpochMetaResponse.Marshal(b, m, deterministic)
+}
+func (m *GetEpochMetaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEpochMetaResponse.Merge(m, src)
+}
+func (m *GetEpochMetaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEpochMetaResponse.Size(m)
+}
+func (m *GetEpochMetaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEpochMetaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEpochMetaResponse proto.InternalMessageInfo
+
+func (m *GetEpochMetaResponse) GetEpochData() *iotextypes.EpochData {
+	if m != nil {
+		return m.EpochData
+	}
+	return nil
+}
+
+func (m *GetEpochMetaResponse) GetTotalBlocks() uint64 {
+	if m != nil {
+		return m.TotalBlocks
+	}
+	return 0
+}
+
+func (m *GetEpochMetaResponse) GetBlockProducersInfo() []*BlockProducerInfo {
+	if m != nil {
+		return m.BlockProducersInfo
+	}
+	return nil
+}
+
+type GetRawBlocksRequest struct {
+	StartHeight          uint64   `protobuf:"varint,1,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	WithReceipts         bool     `protobuf:"varint,3,opt,name=withReceipts,proto3" json:"withReceipts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRawBlocksRequest) Reset()         { *m = GetRawBlocksRequest{} }
+func (m *GetRawBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRawBlocksRequest) ProtoMessage()    {}
+func (*GetRawBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{61}
+}
+
+func (m *GetRawBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRawBlocksRequest.Unmarshal(m, b)
+}
+func (m *GetRawBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRawBlocksRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRawBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRawBlocksRequest.Merge(m, src)
+}
+func (m *GetRawBlocksRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRawBlocksRequest.Size(m)
+}
+func (m *GetRawBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRawBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRawBlocksRequest proto.InternalMessageInfo
+
+func (m *GetRawBlocksRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GetRawBlocksRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *GetRawBlocksRequest) GetWithReceipts() bool {
+	if m != nil {
+		return m.WithReceipts
+	}
+	return false
+}
+
+type GetRawBlocksResponse struct {
+	Blocks               []*BlockInfo `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetRawBlocksResponse) Reset()         { *m = GetRawBlocksResponse{} }
+func (m *GetRawBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRawBlocksResponse) ProtoMessage()    {}
+func (*GetRawBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{62}
+}
+
+func (m *GetRawBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRawBlocksResponse.Unmarshal(m, b)
+}
+func (m *GetRawBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRawBlocksResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRawBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRawBlocksResponse.Merge(m, src)
+}
+func (m *GetRawBlocksResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRawBlocksResponse.Size(m)
+}
+func (m *GetRawBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRawBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRawBlocksResponse proto.InternalMessageInfo
+
+func (m *GetRawBlocksResponse) GetBlocks() []*BlockInfo {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type GetLogsByBlock struct {
+	BlockHash            []byte   `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLogsByBlock) Reset()         { *m = GetLogsByBlock{} }
+func (m *GetLogsByBlock) String() string { return proto.CompactTextString(m) }
+func (*GetLogsByBlock) ProtoMessage()    {}
+func (*GetLogsByBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{63}
+}
+
+func (m *GetLogsByBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogsByBlock.Unmarshal(m, b)
+}
+func (m *GetLogsByBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogsByBlock.Marshal(b, m, deterministic)
+}
+func (m *GetLogsByBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogsByBlock.Merge(m, src)
+}
+func (m *GetLogsByBlock) XXX_Size() int {
+	return xxx_messageInfo_GetLogsByBlock.Size(m)
+}
+func (m *GetLogsByBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogsByBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogsByBlock proto.InternalMessageInfo
+
+func (m *GetLogsByBlock) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+type GetLogsByRange struct {
+	FromBlock            uint64   `protobuf:"varint,1,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLogsByRange) Reset()         { *m = GetLogsByRange{} }
+func (m *GetLogsByRange) String() string { return proto.CompactTextString(m) }
+func (*GetLogsByRange) ProtoMessage()    {}
+func (*GetLogsByRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{64}
+}
+
+func (m *GetLogsByRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogsByRange.Unmarshal(m, b)
+}
+func (m *GetLogsByRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogsByRange.Marshal(b, m, deterministic)
+}
+func (m *GetLogsByRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogsByRange.Merge(m, src)
+}
+func (m *GetLogsByRange) XXX_Size() int {
+	return xxx_messageInfo_GetLogsByRange.Size(m)
+}
+func (m *GetLogsByRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogsByRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogsByRange proto.InternalMessageInfo
+
+func (m *GetLogsByRange) GetFromBlock() uint64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *GetLogsByRange) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type Topics struct {
+	Topic                [][]byte `protobuf:"bytes,1,rep,name=topic,proto3" json:"topic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Topics) Reset()         { *m = Topics{} }
+func (m *Topics) String() string { return proto.CompactTextString(m) }
+func (*Topics) ProtoMessage()    {}
+func (*Topics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{65}
+}
+
+func (m *Topics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Topics.Unmarshal(m, b)
+}
+func (m *Topics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Topics.Marshal(b, m, deterministic)
+}
+func (m *Topics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Topics.Merge(m, src)
+}
+func (m *Topics) XXX_Size() int {
+	return xxx_messageInfo_Topics.Size(m)
+}
+func (m *Topics) XXX_DiscardUnknown() {
+	xxx_messageInfo_Topics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Topics proto.InternalMessageInfo
+
+func (m *Topics) GetTopic() [][]byte {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
+}
+
+type LogsFilter struct {
+	Address              []string  `protobuf:"bytes,1,rep,name=address,proto3" json:"address,omitempty"`
+	Topics               []*Topics `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *LogsFilter) Reset()         { *m = LogsFilter{} }
+func (m *LogsFilter) String() string { return proto.CompactTextString(m) }
+func (*LogsFilter) ProtoMessage()    {}
+func (*LogsFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{66}
+}
+
+func (m *LogsFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogsFilter.Unmarshal(m, b)
+}
+func (m *LogsFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogsFilter.Marshal(b, m, deterministic)
+}
+func (m *LogsFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsFilter.Merge(m, src)
+}
+func (m *LogsFilter) XXX_Size() int {
+	return xxx_messageInfo_LogsFilter.Size(m)
+}
+func (m *LogsFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsFilter proto.InternalMessageInfo
+
+func (m *LogsFilter) GetAddress() []string {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *LogsFilter) GetTopics() []*Topics {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type GetLogsRequest struct {
+	Filter *LogsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Types that are valid to be assigned to Lookup:
+	//	*GetLogsRequest_ByBlock
+	//	*GetLogsRequest_ByRange
+	Lookup               isGetLogsRequest_Lookup `protobuf_oneof:"lookup"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetLogsRequest) Reset()         { *m = GetLogsRequest{} }
+func (m *GetLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLogsRequest) ProtoMessage()    {}
+func (*GetLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{67}
+}
+
+func (m *GetLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogsRequest.Unmarshal(m, b)
+}
+func (m *GetLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogsRequest.Merge(m, src)
+}
+func (m *GetLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLogsRequest.Size(m)
+}
+func (m *GetLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogsRequest proto.InternalMessageInfo
+
+func (m *GetLogsRequest) GetFilter() *LogsFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type isGetLogsRequest_Lookup interface {
+	isGetLogsRequest_Lookup()
+}
+
+type GetLogsRequest_ByBlock struct {
+	ByBlock *GetLogsByBlock `protobuf:"bytes,2,opt,name=byBlock,proto3,oneof"`
+}
+
+type GetLogsRequest_ByRange struct {
+	ByRange *GetLogsByRange `protobuf:"bytes,3,opt,name=byRange,proto3,oneof"`
+}
+
+func (*GetLogsRequest_ByBlock) isGetLogsRequest_Lookup() {}
+
+func (*GetLogsRequest_ByRange) isGetLogsRequest_Lookup() {}
+
+func (m *GetLogsRequest) GetLookup() isGetLogsRequest_Lookup {
+	if m != nil {
+		return m.Lookup
+	}
+	return nil
+}
+
+func (m *GetLogsRequest) GetByBlock() *GetLogsByBlock {
+	if x, ok := m.GetLookup().(*GetLogsRequest_ByBlock); ok {
+		return x.ByBlock
+	}
+	return nil
+}
+
+func (m *GetLogsRequest) GetByRange() *GetLogsByRange {
+	if x, ok := m.GetLookup().(*GetLogsRequest_ByRange); ok {
+		return x.ByRange
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*GetLogsRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*GetLogsRequest_ByBlock)(nil),
+		(*GetLogsRequest_ByRange)(nil),
+	}
+}
+
+type GetLogsResponse struct {
+	Logs                 []*iotextypes.Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetLogsResponse) Reset()         { *m = GetLogsResponse{} }
+func (m *GetLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLogsResponse) ProtoMessage()    {}
+func (*GetLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{68}
+}
+
+func (m *GetLogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogsResponse.Unmarshal(m, b)
+}
+func (m *GetLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogsResponse.Merge(m, src)
+}
+func (m *GetLogsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLogsResponse.Size(m)
+}
+func (m *GetLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogsResponse proto.InternalMessageInfo
+
+func (m *GetLogsResponse) GetLogs() []*iotextypes.Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+// below are streaming APIs
+type StreamBlocksRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamBlocksRequest) Reset()         { *m = StreamBlocksRequest{} }
+func (m *StreamBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamBlocksRequest) ProtoMessage()    {}
+func (*StreamBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{69}
+}
+
+func (m *StreamBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamBlocksRequest.Unmarshal(m, b)
+}
+func (m *StreamBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamBlocksRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBlocksRequest.Merge(m, src)
+}
+func (m *StreamBlocksRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamBlocksRequest.Size(m)
+}
+func (m *StreamBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamBlocksRequest proto.InternalMessageInfo
+
+type StreamBlocksResponse struct {
+	Block                *BlockInfo `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *StreamBlocksResponse) Reset()         { *m = StreamBlocksResponse{} }
+func (m *StreamBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamBlocksResponse) ProtoMessage()    {}
+func (*StreamBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{70}
+}
+
+func (m *StreamBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamBlocksResponse.Unmarshal(m, b)
+}
+func (m *StreamBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamBlocksResponse.Marshal(b, m, deterministic)
+}
+func (m *StreamBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBlocksResponse.Merge(m, src)
+}
+func (m *StreamBlocksResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamBlocksResponse.Size(m)
+}
+func (m *StreamBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamBlocksResponse proto.InternalMessageInfo
+
+func (m *StreamBlocksResponse) GetBlock() *BlockInfo {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+type StreamLogsRequest struct {
+	Filter               *LogsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *StreamLogsRequest) Reset()         { *m = StreamLogsRequest{} }
+func (m *StreamLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamLogsRequest) ProtoMessage()    {}
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{71}
+}
+
+func (m *StreamLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamLogsRequest.Unmarshal(m, b)
+}
+func (m *StreamLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamLogsRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamLogsRequest.Merge(m, src)
+}
+func (m *StreamLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamLogsRequest.Size(m)
+}
+func (m *StreamLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamLogsRequest proto.InternalMessageInfo
+
+func (m *StreamLogsRequest) GetFilter() *LogsFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type StreamLogsResponse struct {
+	Log                  *iotextypes.Log `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *StreamLogsResponse) Reset()         { *m = StreamLogsResponse{} }
+func (m *StreamLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamLogsResponse) ProtoMessage()    {}
+func (*StreamLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{72}
+}
+
+func (m *StreamLogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamLogsResponse.Unmarshal(m, b)
+}
+func (m *StreamLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamLogsResponse.Marshal(b, m, deterministic)
+}
+func (m *StreamLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamLogsResponse.Merge(m, src)
+}
+func (m *StreamLogsResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamLogsResponse.Size(m)
+}
+func (m *StreamLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamLogsResponse proto.InternalMessageInfo
+
+func (m *StreamLogsResponse) GetLog() *iotextypes.Log {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+// PendingActionFilter matches an action if it matches every non-empty field
+type PendingActionFilter struct {
+	Senders    []string `protobuf:"bytes,1,rep,name=senders,proto3" json:"senders,omitempty"`
+	Recipients []string `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// types are the action types, e.g., transfer, execution
+	Types                []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingActionFilter) Reset()         { *m = PendingActionFilter{} }
+func (m *PendingActionFilter) String() string { return proto.CompactTextString(m) }
+func (*PendingActionFilter) ProtoMessage()    {}
+func (*PendingActionFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{73}
+}
+
+func (m *PendingActionFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingActionFilter.Unmarshal(m, b)
+}
+func (m *PendingActionFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingActionFilter.Marshal(b, m, deterministic)
+}
+func (m *PendingActionFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingActionFilter.Merge(m, src)
+}
+func (m *PendingActionFilter) XXX_Size() int {
+	return xxx_messageInfo_PendingActionFilter.Size(m)
+}
+func (m *PendingActionFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingActionFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingActionFilter proto.InternalMessageInfo
+
+func (m *PendingActionFilter) GetSenders() []string {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+func (m *PendingActionFilter) GetRecipients() []string {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *PendingActionFilter) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+type StreamPendingActionsRequest struct {
+	Filter               *PendingActionFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *StreamPendingActionsRequest) Reset()         { *m = StreamPendingActionsRequest{} }
+func (m *StreamPendingActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamPendingActionsRequest) ProtoMessage()    {}
+func (*StreamPendingActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{74}
+}
+
+func (m *StreamPendingActionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamPendingActionsRequest.Unmarshal(m, b)
+}
+func (m *StreamPendingActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamPendingActionsRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamPendingActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPendingActionsRequest.Merge(m, src)
+}
+func (m *StreamPendingActionsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamPendingActionsRequest.Size(m)
+}
+func (m *StreamPendingActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPendingActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamPendingActionsRequest proto.InternalMessageInfo
+
+func (m *StreamPendingActionsRequest) GetFilter() *PendingActionFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type StreamPendingActionsResponse struct {
+	Event   PendingActionEvent `protobuf:"varint,1,opt,name=event,proto3,enum=iotexapi.PendingActionEvent" json:"event,omitempty"`
+	ActHash string             `protobuf:"bytes,2,opt,name=actHash,proto3" json:"actHash,omitempty"`
+	Sender  string             `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Action  *iotextypes.Action `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// replacedBy is the hash of the action replacing this one
+	ReplacedBy           string   `protobuf:"bytes,5,opt,name=replacedBy,proto3" json:"replacedBy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamPendingActionsResponse) Reset()         { *m = StreamPendingActionsResponse{} }
+func (m *StreamPendingActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamPendingActionsResponse) ProtoMessage()    {}
+func (*StreamPendingActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{75}
+}
+
+func (m *StreamPendingActionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamPendingActionsResponse.Unmarshal(m, b)
+}
+func (m *StreamPendingActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamPendingActionsResponse.Marshal(b, m, deterministic)
+}
+func (m *StreamPendingActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPendingActionsResponse.Merge(m, src)
+}
+func (m *StreamPendingActionsResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamPendingActionsResponse.Size(m)
+}
+func (m *StreamPendingActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPendingActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamPendingActionsResponse proto.InternalMessageInfo
+
+func (m *StreamPendingActionsResponse) GetEvent() PendingActionEvent {
+	if m != nil {
+		return m.Event
+	}
+	return PendingActionEvent_ACCEPTED
+}
+
+func (m *StreamPendingActionsResponse) GetActHash() string {
+	if m != nil {
+		return m.ActHash
+	}
+	return ""
+}
+
+func (m *StreamPendingActionsResponse) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *StreamPendingActionsResponse) GetAction() *iotextypes.Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *StreamPendingActionsResponse) GetReplacedBy() string {
+	if m != nil {
+		return m.ReplacedBy
+	}
+	return ""
+}
+
+// election APIs
+type GetElectionBucketsRequest struct {
+	EpochNum             uint64   `protobuf:"varint,1,opt,name=epochNum,proto3" json:"epochNum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetElectionBucketsRequest) Reset()         { *m = GetElectionBucketsRequest{} }
+func (m *GetElectionBucketsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetElectionBucketsRequest) ProtoMessage()    {}
+func (*GetElectionBucketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{76}
+}
+
+func (m *GetElectionBucketsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetElectionBucketsRequest.Unmarshal(m, b)
+}
+func (m *GetElectionBucketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetElectionBucketsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetElectionBucketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetElectionBucketsRequest.Merge(m, src)
+}
+func (m *GetElectionBucketsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetElectionBucketsRequest.Size(m)
+}
+func (m *GetElectionBucketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetElectionBucketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetElectionBucketsRequest proto.InternalMessageInfo
+
+func (m *GetElectionBucketsRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+type GetElectionBucketsResponse struct {
+	Buckets              []*iotextypes.ElectionBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *GetElectionBucketsResponse) Reset()         { *m = GetElectionBucketsResponse{} }
+func (m *GetElectionBucketsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetElectionBucketsResponse) ProtoMessage()    {}
+func (*GetElectionBucketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{77}
+}
+
+func (m *GetElectionBucketsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetElectionBucketsResponse.Unmarshal(m, b)
+}
+func (m *GetElectionBucketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetElectionBucketsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetElectionBucketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetElectionBucketsResponse.Merge(m, src)
+}
+func (m *GetElectionBucketsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetElectionBucketsResponse.Size(m)
+}
+func (m *GetElectionBucketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetElectionBucketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetElectionBucketsResponse proto.InternalMessageInfo
+
+func (m *GetElectionBucketsResponse) GetBuckets() []*iotextypes.ElectionBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("iotexapi.PendingActionEvent", PendingActionEvent_name, PendingActionEvent_value)
+	proto.RegisterType((*GetVotesRequest)(nil), "iotexapi.GetVotesRequest")
+	proto.RegisterType((*GetVotesResponse)(nil), "iotexapi.GetVotesResponse")
+	proto.RegisterType((*Bucket)(nil), "iotexapi.Bucket")
+	proto.RegisterType((*GetAccountRequest)(nil), "iotexapi.GetAccountRequest")
+	proto.RegisterType((*GetAccountResponse)(nil), "iotexapi.GetAccountResponse")
+	proto.RegisterType((*GetActionsRequest)(nil), "iotexapi.GetActionsRequest")
+	proto.RegisterType((*GetActionsByIndexRequest)(nil), "iotexapi.GetActionsByIndexRequest")
+	proto.RegisterType((*GetActionByHashRequest)(nil), "iotexapi.GetActionByHashRequest")
+	proto.RegisterType((*GetActionsByAddressRequest)(nil), "iotexapi.GetActionsByAddressRequest")
+	proto.RegisterType((*GetUnconfirmedActionsByAddressRequest)(nil), "iotexapi.GetUnconfirmedActionsByAddressRequest")
+	proto.RegisterType((*GetActionsByBlockRequest)(nil), "iotexapi.GetActionsByBlockRequest")
+	proto.RegisterType((*ActionInfo)(nil), "iotexapi.ActionInfo")
+	proto.RegisterType((*ReceiptInfo)(nil), "iotexapi.ReceiptInfo")
+	proto.RegisterType((*BlockProducerInfo)(nil), "iotexapi.BlockProducerInfo")
+	proto.RegisterType((*BlockInfo)(nil), "iotexapi.BlockInfo")
+	proto.RegisterType((*GetActionsResponse)(nil), "iotexapi.GetActionsResponse")
+	proto.RegisterType((*GetBlockMetasRequest)(nil), "iotexapi.GetBlockMetasRequest")
+	proto.RegisterType((*GetBlockMetasByIndexRequest)(nil), "iotexapi.GetBlockMetasByIndexRequest")
+	proto.RegisterType((*GetBlockMetaByHashRequest)(nil), "iotexapi.GetBlockMetaByHashRequest")
+	proto.RegisterType((*GetBlockMetasResponse)(nil), "iotexapi.GetBlockMetasResponse")
+	proto.RegisterType((*GetChainMetaRequest)(nil), "iotexapi.GetChainMetaRequest")
+	proto.RegisterType((*GetChainMetaResponse)(nil), "iotexapi.GetChainMetaResponse")
+	proto.RegisterType((*GetServerMetaRequest)(nil), "iotexapi.GetServerMetaRequest")
+	proto.RegisterType((*GetServerMetaResponse)(nil), "iotexapi.GetServerMetaResponse")
+	proto.RegisterType((*SendActionRequest)(nil), "iotexapi.SendActionRequest")
+	proto.RegisterType((*SendSignedActionBytesRequest)(nil), "iotexapi.SendSignedActionBytesRequest")
+	proto.RegisterType((*SendActionResponse)(nil), "iotexapi.SendActionResponse")
+	proto.RegisterType((*GetReceiptByActionRequest)(nil), "iotexapi.GetReceiptByActionRequest")
+	proto.RegisterType((*GetReceiptByActionResponse)(nil), "iotexapi.GetReceiptByActionResponse")
+	proto.RegisterType((*ReadContractRequest)(nil), "iotexapi.ReadContractRequest")
+	proto.RegisterType((*ReadContractResponse)(nil), "iotexapi.ReadContractResponse")
+	proto.RegisterType((*GetStateProofRequest)(nil), "iotexapi.GetStateProofRequest")
+	proto.RegisterType((*GetStateProofResponse)(nil), "iotexapi.GetStateProofResponse")
+	proto.RegisterType((*StorageProof)(nil), "iotexapi.StorageProof")
+	proto.RegisterType((*TraceTransactionRequest)(nil), "iotexapi.TraceTransactionRequest")
+	proto.RegisterType((*TraceCallRequest)(nil), "iotexapi.TraceCallRequest")
+	proto.RegisterType((*TraceResponse)(nil), "iotexapi.TraceResponse")
+	proto.RegisterType((*ExecutionTrace)(nil), "iotexapi.ExecutionTrace")
+	proto.RegisterType((*CallFrame)(nil), "iotexapi.CallFrame")
+	proto.RegisterType((*StructLog)(nil), "iotexapi.StructLog")
+	proto.RegisterMapType((map[string][]byte)(nil), "iotexapi.StructLog.StorageEntry")
+	proto.RegisterType((*SuggestGasPriceRequest)(nil), "iotexapi.SuggestGasPriceRequest")
+	proto.RegisterType((*SuggestGasPriceResponse)(nil), "iotexapi.SuggestGasPriceResponse")
+	proto.RegisterType((*SuggestGasPricesRequest)(nil), "iotexapi.SuggestGasPricesRequest")
+	proto.RegisterType((*SuggestGasPricesResponse)(nil), "iotexapi.SuggestGasPricesResponse")
+	proto.RegisterType((*GetFeeHistoryRequest)(nil), "iotexapi.GetFeeHistoryRequest")
+	proto.RegisterType((*FeeHistoryRewards)(nil), "iotexapi.FeeHistoryRewards")
+	proto.RegisterType((*GetFeeHistoryResponse)(nil), "iotexapi.GetFeeHistoryResponse")
+	proto.RegisterType((*SimulationStep)(nil), "iotexapi.SimulationStep")
+	proto.RegisterType((*SimulateActionsRequest)(nil), "iotexapi.SimulateActionsRequest")
+	proto.RegisterType((*AccountState)(nil), "iotexapi.AccountState")
+	proto.RegisterType((*AccountDiff)(nil), "iotexapi.AccountDiff")
+	proto.RegisterType((*SimulationResult)(nil), "iotexapi.SimulationResult")
+	proto.RegisterType((*SimulateActionsResponse)(nil), "iotexapi.SimulateActionsResponse")
+	proto.RegisterType((*EstimateGasForActionRequest)(nil), "iotexapi.EstimateGasForActionRequest")
+	proto.RegisterType((*EstimateActionGasConsumptionRequest)(nil), "iotexapi.EstimateActionGasConsumptionRequest")
+	proto.RegisterType((*EstimateActionGasConsumptionResponse)(nil), "iotexapi.EstimateActionGasConsumptionResponse")
+	proto.RegisterType((*EstimateGasForActionResponse)(nil), "iotexapi.EstimateGasForActionResponse")
+	proto.RegisterType((*ReadStateRequest)(nil), "iotexapi.ReadStateRequest")
+	proto.RegisterType((*ReadStateResponse)(nil), "iotexapi.ReadStateResponse")
+	proto.RegisterType((*GetEpochMetaRequest)(nil), "iotexapi.GetEpochMetaRequest")
+	proto.RegisterType((*GetEpochMetaResponse)(nil), "iotexapi.GetEpochMetaResponse")
+	proto.RegisterType((*GetRawBlocksRequest)(nil), "iotexapi.GetRawBlocksRequest")
+	proto.RegisterType((*GetRawBlocksResponse)(nil), "iotexapi.GetRawBlocksResponse")
+	proto.RegisterType((*GetLogsByBlock)(nil), "iotexapi.GetLogsByBlock")
+	proto.RegisterType((*GetLogsByRange)(nil), "iotexapi.GetLogsByRange")
+	proto.RegisterType((*Topics)(nil), "iotexapi.Topics")
+	proto.RegisterType((*LogsFilter)(nil), "iotexapi.LogsFilter")
+	proto.RegisterType((*GetLogsRequest)(nil), "iotexapi.GetLogsRequest")
+	proto.RegisterType((*GetLogsResponse)(nil), "iotexapi.GetLogsResponse")
+	proto.RegisterType((*StreamBlocksRequest)(nil), "iotexapi.StreamBlocksRequest")
+	proto.RegisterType((*StreamBlocksResponse)(nil), "iotexapi.StreamBlocksResponse")
+	proto.RegisterType((*StreamLogsRequest)(nil), "iotexapi.StreamLogsRequest")
+	proto.RegisterType((*StreamLogsResponse)(nil), "iotexapi.StreamLogsResponse")
+	proto.RegisterType((*PendingActionFilter)(nil), "iotexapi.PendingActionFilter")
+	proto.RegisterType((*StreamPendingActionsRequest)(nil), "iotexapi.StreamPendingActionsRequest")
+	proto.RegisterType((*StreamPendingActionsResponse)(nil), "iotexapi.StreamPendingActionsResponse")
+	proto.RegisterType((*GetElectionBucketsRequest)(nil), "iotexapi.GetElectionBucketsRequest")
+	proto.RegisterType((*GetElectionBucketsResponse)(nil), "iotexapi.GetElectionBucketsResponse")
+}
+
+func init() { proto.RegisterFile("proto/api/api.proto", fileDescriptor_ca6d5bbc959d58c0) }
+
+var fileDescriptor_ca6d5bbc959d58c0 = []byte{
+	// 3203 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x1a, 0xdb, 0x72, 0x1c, 0x47,
+	0xd5, 0x7b, 0xd1, 0x65, 0xcf, 0xae, 0x6d, 0x69, 0x2c, 0xcb, 0x9b, 0xb1, 0x22, 0x2b, 0x1d, 0x27,
+	0x71, 0x6e, 0xab, 0x44, 0x8e, 0xe3, 0x60, 0x20, 0xa0, 0xcb, 0x4a, 0x16, 0x36, 0xb1, 0x68, 0x29,
+	0xa9, 0x04, 0x28, 0xc8, 0xec, 0x6c, 0xef, 0x6a, 0xa2, 0xd9, 0xe9, 0xcd, 0x4c, 0xaf, 0x1d, 0x15,
+	0x54, 0xf1, 0x02, 0x54, 0x5e, 0x78, 0xe3, 0x81, 0x67, 0x3e, 0x81, 0xe2, 0x89, 0x27, 0xf8, 0x03,
+	0x3e, 0x81, 0x8f, 0xa0, 0x78, 0xa6, 0xfa, 0x36, 0xd3, 0x3d, 0x3b, 0xb3, 0x8a, 0x53, 0x14, 0x0f,
+	0xaa, 0xda, 0x73, 0xe9, 0x73, 0x9b, 0xd3, 0xa7, 0x4f, 0x9f, 0x16, 0x5c, 0x1b, 0xc7, 0x94, 0xd1,
+	0x4d, 0x6f, 0x1c, 0xf0, 0xbf, 0x8e, 0x80, 0x9c, 0xc5, 0x80, 0x32, 0xf2, 0x95, 0x37, 0x0e, 0xdc,
+	0xb6, 0x24, 0xb3, 0xf3, 0x31, 0x49, 0x36, 0x3d, 0x9f, 0x05, 0x34, 0x92, 0x3c, 0xee, 0x9a, 0x49,
+	0xe9, 0x85, 0xd4, 0x3f, 0xf3, 0x4f, 0xbd, 0x40, 0x53, 0x57, 0x4d, 0x6a, 0x44, 0xfb, 0x44, 0xe1,
+	0x5d, 0x13, 0x4f, 0x42, 0x62, 0x4a, 0xbc, 0x35, 0xa4, 0x74, 0x18, 0x92, 0x4d, 0x01, 0xf5, 0x26,
+	0x83, 0x4d, 0x16, 0x8c, 0x48, 0xc2, 0xbc, 0xd1, 0x58, 0x32, 0xa0, 0x11, 0x5c, 0x3d, 0x20, 0xec,
+	0x13, 0xca, 0x48, 0x82, 0xc9, 0x97, 0x13, 0x92, 0x30, 0x67, 0x05, 0xe6, 0x9e, 0x52, 0x46, 0x48,
+	0xbb, 0xb2, 0x51, 0xb9, 0xd3, 0xc0, 0x12, 0x70, 0x56, 0x61, 0xfe, 0x94, 0x04, 0xc3, 0x53, 0xd6,
+	0xae, 0x0a, 0xb4, 0x82, 0x38, 0x9e, 0x0e, 0x06, 0x09, 0x61, 0xed, 0xda, 0x46, 0xe5, 0xce, 0x65,
+	0xac, 0x20, 0x2e, 0x25, 0x0c, 0x46, 0x01, 0x6b, 0xd7, 0x05, 0x5a, 0x02, 0xe8, 0x43, 0x58, 0xca,
+	0xd4, 0x25, 0x63, 0x1a, 0x25, 0xc4, 0x79, 0x03, 0x16, 0x7a, 0x13, 0xff, 0x8c, 0xb0, 0xa4, 0x5d,
+	0xd9, 0xa8, 0xdd, 0x69, 0x6e, 0x2d, 0x75, 0x74, 0xac, 0x3a, 0x3b, 0x82, 0x80, 0x35, 0x03, 0xfa,
+	0xba, 0x02, 0xf3, 0x12, 0xa7, 0xcd, 0x8c, 0x4d, 0x33, 0x63, 0x8d, 0x4d, 0x94, 0x95, 0x12, 0x70,
+	0x6e, 0xc3, 0xe5, 0x67, 0xc2, 0x5c, 0xd2, 0x17, 0xba, 0x85, 0xad, 0x0d, 0x6c, 0x23, 0x9d, 0xb7,
+	0x60, 0x39, 0x26, 0x23, 0x2f, 0x88, 0x82, 0x68, 0xb8, 0x37, 0x89, 0x3d, 0x1e, 0x47, 0x61, 0x7e,
+	0x03, 0x4f, 0x13, 0x50, 0x17, 0x96, 0x0f, 0x08, 0xdb, 0xf6, 0x7d, 0x3a, 0x89, 0x98, 0x8e, 0x5d,
+	0x1b, 0x16, 0xbc, 0x7e, 0x3f, 0x26, 0x49, 0xa2, 0xcc, 0xd2, 0x60, 0x2e, 0x7e, 0x75, 0x1d, 0x3f,
+	0xf4, 0x04, 0x1c, 0x53, 0x8c, 0x8a, 0xc9, 0x77, 0xa0, 0xe9, 0x49, 0xd4, 0x8f, 0x09, 0xf3, 0x84,
+	0xac, 0xe6, 0xd6, 0x0d, 0x19, 0x17, 0xf1, 0xa1, 0x3b, 0xdb, 0x19, 0x19, 0x9b, 0xbc, 0xe8, 0x3f,
+	0x55, 0x65, 0x18, 0xb7, 0x32, 0xfd, 0xa8, 0x1f, 0xc2, 0x42, 0xef, 0xfc, 0x30, 0xea, 0x93, 0xaf,
+	0x94, 0x30, 0x94, 0x05, 0x39, 0xe3, 0xde, 0x91, 0x2c, 0x6a, 0xd1, 0xc3, 0x4b, 0x58, 0x2f, 0x72,
+	0x1e, 0xc0, 0x7c, 0xef, 0xfc, 0xa1, 0x97, 0x9c, 0x0a, 0xf3, 0x9b, 0x5b, 0x1b, 0x05, 0xcb, 0x77,
+	0x04, 0x43, 0xb6, 0x58, 0xad, 0x70, 0x3e, 0xe4, 0x6b, 0xb7, 0xfb, 0xfd, 0x58, 0x84, 0xbd, 0xb9,
+	0x75, 0xbb, 0x58, 0xf5, 0xb6, 0x8c, 0x94, 0xb5, 0x9e, 0xe3, 0x9c, 0x5f, 0xc2, 0xf2, 0x24, 0xf2,
+	0x69, 0x34, 0x08, 0xe2, 0x11, 0xe9, 0x4b, 0x46, 0xf1, 0x5d, 0x9a, 0x5b, 0x9b, 0x96, 0xa8, 0x8f,
+	0x33, 0xae, 0x72, 0xa9, 0xd3, 0xb2, 0x9c, 0x07, 0x30, 0xd7, 0x3b, 0xdf, 0x09, 0xcf, 0xda, 0x73,
+	0xb3, 0x42, 0xb3, 0xc3, 0x37, 0x64, 0x26, 0x47, 0x2e, 0xd9, 0x59, 0x84, 0xf9, 0x90, 0xd2, 0xb3,
+	0xc9, 0x18, 0xed, 0x43, 0xbb, 0x2c, 0x92, 0x3c, 0x2d, 0x13, 0xe6, 0xc5, 0x4c, 0x04, 0xbf, 0x8e,
+	0x25, 0xc0, 0xb1, 0xe2, 0xbb, 0xa9, 0x94, 0x90, 0x00, 0xfa, 0x39, 0xac, 0x16, 0x87, 0xd4, 0x59,
+	0x07, 0x90, 0xf5, 0x42, 0x7c, 0x08, 0x99, 0x60, 0x06, 0xc6, 0x41, 0xd0, 0xf2, 0x4f, 0x89, 0x7f,
+	0x76, 0x44, 0xa2, 0x7e, 0x10, 0x0d, 0x85, 0xd8, 0x45, 0x6c, 0xe1, 0x50, 0x0f, 0xdc, 0xf2, 0xa0,
+	0xcf, 0xc8, 0xdf, 0xd4, 0x83, 0x6a, 0xa1, 0x07, 0x35, 0xd3, 0x83, 0x11, 0xbc, 0xf2, 0x8d, 0xbe,
+	0xc6, 0xff, 0x48, 0xdd, 0xe7, 0xd0, 0x2e, 0xfb, 0x4e, 0x5c, 0x43, 0x2f, 0x3c, 0x33, 0xe2, 0xa5,
+	0xc1, 0xe7, 0xd2, 0xf0, 0xef, 0x0a, 0x80, 0x94, 0x7f, 0x18, 0x0d, 0xa8, 0xf3, 0x06, 0xcc, 0xcb,
+	0xa8, 0xab, 0xbd, 0xe4, 0xd8, 0x1b, 0x93, 0x53, 0xb0, 0xe2, 0x10, 0x2e, 0xfa, 0x2c, 0xdd, 0x39,
+	0x0d, 0xac, 0x41, 0xd3, 0xb4, 0x9a, 0x6d, 0xda, 0x1a, 0x34, 0xf8, 0x4f, 0x59, 0x2e, 0xe6, 0x84,
+	0x21, 0x19, 0x82, 0x57, 0x92, 0x84, 0x44, 0x7d, 0x12, 0xb7, 0xe7, 0x65, 0x25, 0x96, 0x10, 0xc7,
+	0x0f, 0xbd, 0x64, 0x9f, 0x90, 0xf6, 0x82, 0xc4, 0x4b, 0xc8, 0xf9, 0x00, 0x1a, 0x69, 0xd5, 0x57,
+	0xdb, 0xc6, 0xed, 0xc8, 0x73, 0xa1, 0xa3, 0xcf, 0x85, 0xce, 0x89, 0xe6, 0xc0, 0x19, 0x33, 0xfa,
+	0x04, 0x9a, 0x98, 0xf8, 0x24, 0x18, 0x33, 0xe1, 0xf6, 0xdb, 0xb0, 0x10, 0x4b, 0x50, 0xf9, 0x7d,
+	0xcd, 0xf4, 0x5b, 0x71, 0x62, 0xcd, 0x63, 0xfa, 0x57, 0xb5, 0xfc, 0x43, 0xbf, 0x82, 0x65, 0xf1,
+	0x91, 0x8e, 0x62, 0xda, 0x9f, 0xf8, 0x24, 0x16, 0xd2, 0x67, 0xe6, 0x42, 0x41, 0x4d, 0x5f, 0x95,
+	0x1f, 0xe1, 0x29, 0x11, 0xd1, 0x5b, 0xc4, 0x0a, 0xe2, 0x9b, 0x64, 0x2c, 0xe4, 0xa6, 0xe5, 0xbb,
+	0x8e, 0x0d, 0x0c, 0x22, 0xd0, 0x10, 0xca, 0x85, 0xd2, 0xd7, 0x60, 0x4e, 0x9c, 0xb3, 0xca, 0xa1,
+	0x65, 0xd3, 0x21, 0x99, 0x47, 0x92, 0xee, 0x6c, 0xc2, 0xa2, 0xf2, 0x8b, 0x9b, 0x51, 0x2b, 0x73,
+	0x3e, 0x65, 0x42, 0x9f, 0xab, 0xba, 0xae, 0xaa, 0xb0, 0xaa, 0xeb, 0x2b, 0x30, 0xc7, 0x28, 0xf3,
+	0x42, 0x9d, 0x74, 0x02, 0x70, 0xde, 0xd3, 0xfb, 0x9a, 0xdb, 0xa4, 0x0e, 0xc1, 0x95, 0xac, 0x08,
+	0x65, 0x99, 0x87, 0x0d, 0x3e, 0xf4, 0xe7, 0x0a, 0xac, 0x1c, 0x10, 0x26, 0xcc, 0xe4, 0x95, 0x3f,
+	0xdd, 0x55, 0xdb, 0xf9, 0x5a, 0xff, 0x8a, 0x55, 0xd0, 0xb2, 0x05, 0xe5, 0xe5, 0xfe, 0xfb, 0xb9,
+	0x72, 0xff, 0x72, 0xb1, 0x84, 0x92, 0x8a, 0x6f, 0x14, 0xc5, 0x43, 0xb8, 0x39, 0x43, 0xe5, 0x73,
+	0xd5, 0xc5, 0x7b, 0xf0, 0x42, 0xa9, 0xee, 0xf2, 0x7d, 0x8e, 0x3e, 0x87, 0xeb, 0xb9, 0x28, 0xcd,
+	0xfc, 0x16, 0xef, 0xc2, 0x62, 0x2f, 0x94, 0x9c, 0xea, 0x4b, 0x5c, 0x9f, 0x4a, 0x0a, 0x4e, 0xc5,
+	0x29, 0x1b, 0xba, 0x0e, 0xd7, 0x0e, 0x08, 0xdb, 0xe5, 0xad, 0x9a, 0xa0, 0x48, 0x93, 0xd0, 0x23,
+	0x58, 0xb1, 0xd1, 0x4a, 0xef, 0x5d, 0x68, 0xf8, 0x1a, 0xa9, 0x3e, 0x90, 0xa5, 0x22, 0x5b, 0x91,
+	0xf1, 0xa1, 0x55, 0x21, 0xec, 0x98, 0xc4, 0x4f, 0x49, 0x6c, 0x2a, 0x79, 0x02, 0xd7, 0x73, 0x78,
+	0xa5, 0xe5, 0x7d, 0x80, 0x24, 0xc5, 0x2a, 0x35, 0xab, 0xa6, 0x1a, 0x63, 0x8d, 0xc1, 0x89, 0x7e,
+	0x00, 0xcb, 0xc7, 0x24, 0x52, 0x15, 0x5b, 0x47, 0xf7, 0x39, 0x0a, 0x1e, 0x7a, 0x0c, 0x6b, 0x5c,
+	0xc0, 0x71, 0x30, 0x8c, 0x74, 0xe1, 0xdf, 0x39, 0x37, 0xda, 0xcb, 0xb7, 0x60, 0x39, 0xc9, 0xd3,
+	0xd4, 0x37, 0x9b, 0x26, 0xa0, 0xf7, 0xc0, 0x31, 0xcd, 0x51, 0xce, 0x5d, 0x70, 0x10, 0xa2, 0xef,
+	0x8a, 0x54, 0x51, 0x9b, 0x72, 0xe7, 0xdc, 0x76, 0xe6, 0xa2, 0xc5, 0x1f, 0x83, 0x5b, 0xb4, 0x58,
+	0xa9, 0xbe, 0x0f, 0xcd, 0x38, 0xab, 0x89, 0xf6, 0xf7, 0xe3, 0xdb, 0xc3, 0x28, 0x98, 0xd8, 0xe4,
+	0xe4, 0xad, 0xeb, 0x35, 0x4c, 0xbc, 0xfe, 0x2e, 0x8d, 0x58, 0xec, 0xf9, 0x69, 0xcb, 0x78, 0x17,
+	0x1a, 0xe4, 0x2b, 0xe2, 0x4f, 0x8c, 0xf0, 0x5a, 0xe9, 0xd0, 0xd5, 0x44, 0x9c, 0xf1, 0xf1, 0x86,
+	0xd6, 0xf7, 0xc2, 0x90, 0xc4, 0xea, 0x40, 0x55, 0xa5, 0xd1, 0x46, 0x1a, 0x3d, 0x67, 0xcd, 0xea,
+	0x39, 0x3f, 0x83, 0x15, 0xdb, 0x12, 0xe5, 0x9b, 0x03, 0xf5, 0xbe, 0xa7, 0xb2, 0xa5, 0x81, 0xc5,
+	0x6f, 0xb3, 0xe8, 0x57, 0x2f, 0x2e, 0xfa, 0xe8, 0x0b, 0x99, 0xa7, 0xcc, 0x63, 0xe4, 0x28, 0xa6,
+	0x74, 0x70, 0xf1, 0x49, 0xbf, 0x01, 0xcd, 0x84, 0xd1, 0xd8, 0x1b, 0x92, 0x47, 0xe4, 0x5c, 0x16,
+	0xd7, 0x16, 0x36, 0x51, 0xa5, 0x6e, 0xfc, 0xab, 0x02, 0xd7, 0x73, 0xca, 0x94, 0x23, 0x9b, 0x7c,
+	0x85, 0xd7, 0x27, 0x71, 0x51, 0xe7, 0x2c, 0xb6, 0xf0, 0x43, 0x41, 0xc6, 0x8a, 0xcd, 0x71, 0x61,
+	0x31, 0xa6, 0x34, 0x3b, 0xa6, 0x5b, 0x38, 0x85, 0xe5, 0x09, 0x9e, 0x35, 0x05, 0x2d, 0xac, 0x41,
+	0xde, 0x6f, 0xa9, 0x9f, 0x42, 0x7d, 0xbb, 0x2e, 0x6c, 0xb7, 0x70, 0xce, 0xf7, 0xe0, 0xb2, 0xf2,
+	0x45, 0xc0, 0x49, 0x7b, 0x6e, 0xa3, 0x96, 0x6d, 0x45, 0x9e, 0x31, 0xc7, 0x06, 0x19, 0xdb, 0xcc,
+	0xe8, 0x31, 0xb4, 0x4c, 0xb2, 0xb3, 0x04, 0xb5, 0x33, 0x72, 0x2e, 0xbc, 0x6a, 0x61, 0xfe, 0x53,
+	0x1c, 0x8e, 0x5e, 0x38, 0x21, 0xca, 0x6c, 0x09, 0x70, 0xec, 0x58, 0x98, 0x54, 0x13, 0x26, 0x49,
+	0x00, 0x7d, 0x06, 0x37, 0x4e, 0x62, 0xcf, 0x27, 0x27, 0xb1, 0x17, 0x25, 0xde, 0xf3, 0x6c, 0x0a,
+	0x4e, 0x4f, 0x58, 0x3c, 0xf1, 0xd9, 0x63, 0x3a, 0x4c, 0x54, 0x63, 0x69, 0x60, 0xd0, 0x1f, 0x2a,
+	0xb0, 0x24, 0x64, 0xef, 0x7a, 0x61, 0xf8, 0x7f, 0x48, 0x6d, 0xdb, 0x9e, 0xda, 0x94, 0x3d, 0x11,
+	0x5c, 0x16, 0xe6, 0xa4, 0x29, 0xd1, 0x81, 0x39, 0x9e, 0xec, 0x44, 0xd9, 0xd1, 0xce, 0xe2, 0x9f,
+	0x5a, 0x21, 0x17, 0x48, 0xb6, 0xe7, 0xcd, 0xfb, 0x08, 0xae, 0xd8, 0x72, 0x9c, 0xd7, 0xa0, 0xce,
+	0x4d, 0xb6, 0x5b, 0x25, 0xae, 0x8f, 0x47, 0x68, 0x3f, 0xf6, 0x46, 0x04, 0x0b, 0x06, 0xe7, 0x6e,
+	0x2e, 0xb4, 0x35, 0x9b, 0xfd, 0x58, 0xd3, 0x2c, 0xff, 0xfe, 0x54, 0x85, 0x46, 0x2a, 0x88, 0x6f,
+	0x5c, 0x6e, 0x97, 0xde, 0xb8, 0xfc, 0x37, 0xc7, 0x0d, 0x62, 0x3a, 0x52, 0xe1, 0x13, 0xbf, 0x9d,
+	0x2b, 0x50, 0x65, 0x54, 0x75, 0x9b, 0x55, 0x46, 0xb3, 0xe4, 0xa9, 0xab, 0xce, 0x8a, 0x03, 0x3c,
+	0xc9, 0x86, 0x5e, 0xa2, 0x1a, 0x4f, 0xfe, 0x93, 0x6f, 0x81, 0xa1, 0x97, 0x7c, 0x9c, 0x90, 0xbe,
+	0xe8, 0x39, 0xeb, 0x58, 0x83, 0x5c, 0x42, 0x10, 0x8d, 0x27, 0x4c, 0xf4, 0x9c, 0x2d, 0x2c, 0x01,
+	0x31, 0x14, 0x98, 0x30, 0x8e, 0x5e, 0x14, 0x68, 0x05, 0x71, 0x6e, 0x12, 0xc7, 0x34, 0x6e, 0x37,
+	0xa4, 0x3e, 0x01, 0xf0, 0x6d, 0x14, 0x93, 0xa7, 0x24, 0x66, 0x98, 0x78, 0x09, 0x8d, 0xda, 0x20,
+	0x88, 0x16, 0xce, 0x79, 0x1d, 0xe6, 0x78, 0xb0, 0x92, 0x76, 0x33, 0x1f, 0x9f, 0x2c, 0x9c, 0x92,
+	0x03, 0xfd, 0xa3, 0x0a, 0x8d, 0x34, 0x68, 0xdc, 0xe5, 0xb1, 0xaf, 0xda, 0x8b, 0xea, 0xd8, 0xe7,
+	0x30, 0x1d, 0xab, 0xa0, 0x54, 0xe9, 0x58, 0x3b, 0x5b, 0xcb, 0x3b, 0xbb, 0x4b, 0x13, 0xa6, 0xba,
+	0x47, 0x0d, 0x72, 0xb7, 0x46, 0x64, 0x44, 0xe3, 0x73, 0x11, 0x9b, 0x16, 0x56, 0x90, 0xea, 0x62,
+	0xfc, 0xb3, 0xf6, 0xbc, 0xdc, 0x6d, 0x02, 0x70, 0x1e, 0xc0, 0x82, 0xda, 0xcc, 0xed, 0x85, 0x8d,
+	0x9a, 0x7d, 0x67, 0x4e, 0xed, 0xd3, 0xbb, 0xbf, 0x1b, 0xb1, 0xf8, 0x1c, 0xeb, 0x05, 0x5c, 0x62,
+	0x9f, 0x8c, 0xd9, 0xa9, 0x88, 0xdf, 0x1c, 0x96, 0x00, 0xd7, 0x1f, 0x93, 0xc1, 0x24, 0xea, 0x8b,
+	0xf8, 0xd5, 0xb1, 0x82, 0xb2, 0xb0, 0x82, 0x11, 0x56, 0xf7, 0x01, 0xb4, 0x4c, 0xe1, 0x66, 0xed,
+	0x68, 0xcc, 0xa8, 0x1d, 0x0f, 0xaa, 0x1f, 0x54, 0x50, 0x1b, 0x56, 0x8f, 0x27, 0xc3, 0x21, 0x49,
+	0xd8, 0x81, 0x97, 0x1c, 0xc5, 0x81, 0x4f, 0xd4, 0x9e, 0x46, 0xf7, 0xe0, 0xc6, 0x14, 0x45, 0x6d,
+	0x31, 0x17, 0x16, 0x87, 0x0a, 0xa7, 0x02, 0x9e, 0xc2, 0xe8, 0x85, 0xa9, 0x65, 0xba, 0x21, 0x40,
+	0xbf, 0x80, 0xf6, 0x34, 0x29, 0x3b, 0x91, 0x92, 0x90, 0x3e, 0x53, 0xe2, 0xc4, 0x6f, 0xae, 0x26,
+	0x61, 0x5e, 0xd4, 0xf7, 0xe2, 0xbe, 0x6a, 0xdd, 0x52, 0x58, 0x24, 0xbd, 0x97, 0xe8, 0x83, 0x42,
+	0xfc, 0x46, 0xbf, 0x97, 0x7d, 0xf2, 0x3e, 0x21, 0x0f, 0x03, 0x1e, 0xdf, 0x73, 0xa3, 0xe6, 0x89,
+	0xe6, 0x7e, 0x57, 0xd4, 0x76, 0xa9, 0xc2, 0xc0, 0xf0, 0x93, 0x29, 0x22, 0xcf, 0x48, 0x22, 0x9b,
+	0x47, 0xa5, 0xcb, 0x44, 0xc9, 0x89, 0xd1, 0x33, 0x2f, 0xee, 0x1f, 0x91, 0xd8, 0x27, 0x11, 0x0b,
+	0x42, 0x31, 0x5b, 0xaa, 0xdd, 0xa9, 0xe0, 0x69, 0x02, 0x7a, 0x17, 0x96, 0x4d, 0x23, 0x38, 0x39,
+	0xe1, 0x77, 0x3d, 0x1d, 0x24, 0xd9, 0x70, 0x36, 0x70, 0x86, 0x40, 0x7f, 0x94, 0x47, 0x9c, 0xb9,
+	0x4c, 0x45, 0x66, 0x03, 0x9a, 0x34, 0xec, 0xa7, 0xc6, 0x49, 0xeb, 0x4d, 0x14, 0xdf, 0x56, 0x6a,
+	0x97, 0x62, 0x8f, 0x05, 0x54, 0x54, 0x96, 0x0a, 0xb6, 0x70, 0xce, 0x3d, 0x5e, 0xe5, 0x84, 0x21,
+	0xc2, 0xec, 0xe6, 0xd6, 0xcd, 0x2c, 0x47, 0xa7, 0x6c, 0xc5, 0x9a, 0x17, 0x0d, 0xe0, 0xca, 0x71,
+	0x30, 0x9a, 0x84, 0x5c, 0x46, 0x74, 0xcc, 0xc8, 0x78, 0xba, 0x6a, 0x57, 0x8a, 0xaa, 0x76, 0x27,
+	0xed, 0x23, 0xab, 0xd3, 0x0d, 0xa9, 0x6c, 0xb4, 0x76, 0x69, 0x4c, 0xd2, 0x5e, 0x72, 0x0c, 0xab,
+	0x4a, 0x0f, 0xc9, 0xcd, 0xb3, 0x3a, 0x7c, 0xcb, 0x91, 0xb1, 0xee, 0xd1, 0x8d, 0x72, 0x6e, 0x1b,
+	0x86, 0x25, 0x9b, 0xf3, 0x2a, 0x5c, 0x09, 0x22, 0x3f, 0x9c, 0xf4, 0x89, 0x3d, 0x1c, 0xc9, 0x61,
+	0xd1, 0xaf, 0xa1, 0xa5, 0x26, 0x6b, 0xa2, 0xad, 0xe0, 0x5b, 0x24, 0xa2, 0x51, 0x9a, 0xd0, 0x12,
+	0x10, 0xb7, 0x0d, 0x2f, 0xf4, 0x38, 0x5e, 0x5f, 0x6d, 0x25, 0x68, 0x74, 0x33, 0x98, 0x52, 0xdd,
+	0x30, 0x98, 0x28, 0x9e, 0xbe, 0x3e, 0xed, 0x13, 0x71, 0xce, 0xd6, 0x65, 0xab, 0xa1, 0x61, 0xf4,
+	0xbb, 0x0a, 0x34, 0x95, 0xfa, 0xbd, 0x60, 0x30, 0x98, 0xd1, 0x35, 0x75, 0x60, 0xbe, 0x47, 0x06,
+	0x34, 0x26, 0x76, 0x24, 0xe5, 0x75, 0x31, 0xb3, 0x1f, 0x2b, 0x2e, 0xe7, 0x2d, 0x98, 0xf3, 0x06,
+	0x8c, 0xe8, 0x11, 0x5c, 0x19, 0xbb, 0x64, 0x42, 0xbf, 0xad, 0xc0, 0x52, 0x16, 0x47, 0x4c, 0x92,
+	0x49, 0xc8, 0x9e, 0xf7, 0xfa, 0xff, 0x26, 0xcc, 0xf5, 0x83, 0xc1, 0x40, 0x9f, 0x68, 0xd7, 0xa7,
+	0x34, 0x72, 0x0f, 0xb1, 0xe4, 0xc9, 0x2a, 0x58, 0xcd, 0xa8, 0x60, 0xe8, 0x09, 0xdc, 0x98, 0xfa,
+	0xfc, 0x2a, 0xfd, 0xdf, 0xe3, 0xc6, 0x70, 0xb3, 0x74, 0x06, 0xb8, 0x45, 0x19, 0x20, 0x2d, 0xc7,
+	0x9a, 0x95, 0xdf, 0x46, 0xbb, 0x09, 0x0b, 0x46, 0x1e, 0x23, 0x07, 0x5e, 0xb2, 0x4f, 0xe3, 0x6f,
+	0x7f, 0xcd, 0xf9, 0x7b, 0x05, 0x5e, 0xd6, 0xb2, 0x24, 0xe9, 0x80, 0x9f, 0x12, 0x51, 0x32, 0x19,
+	0x8d, 0x4d, 0x99, 0x5b, 0xb0, 0xc8, 0x78, 0xbb, 0x35, 0x48, 0x9b, 0xd1, 0x15, 0x53, 0xea, 0x89,
+	0xa2, 0x3d, 0xbc, 0x84, 0x53, 0x3e, 0xe7, 0x9e, 0xd9, 0x37, 0x55, 0x67, 0xf4, 0x4d, 0x0f, 0x2f,
+	0xcd, 0xec, 0x9c, 0xfa, 0x05, 0x7b, 0x90, 0xdf, 0xcd, 0x95, 0x0b, 0x1f, 0xc0, 0xed, 0xd9, 0x1e,
+	0xa8, 0x58, 0xab, 0x23, 0xb2, 0x92, 0x1e, 0x91, 0xe8, 0x1d, 0x58, 0x2b, 0x8e, 0x63, 0xe9, 0x8a,
+	0xaf, 0x2b, 0xb0, 0xc4, 0xef, 0x1c, 0x32, 0xcd, 0xb2, 0x02, 0x2c, 0x06, 0x50, 0x3e, 0x0d, 0x0f,
+	0xf7, 0x54, 0x53, 0x6b, 0x60, 0x38, 0x7d, 0x44, 0xd8, 0x29, 0xed, 0x7f, 0xe4, 0x8d, 0xf4, 0x21,
+	0x65, 0x60, 0x78, 0xed, 0xf4, 0xe2, 0xe1, 0x64, 0x44, 0x22, 0x96, 0xa8, 0x4e, 0x37, 0x43, 0x18,
+	0xd7, 0x86, 0xba, 0x75, 0x6d, 0x78, 0x0d, 0x96, 0x0d, 0x4b, 0x0a, 0xae, 0x3e, 0x2d, 0x79, 0xf5,
+	0x41, 0xf7, 0xc5, 0xbd, 0xbe, 0x3b, 0xa6, 0xfe, 0xa9, 0x71, 0xe5, 0xe6, 0x5b, 0x9c, 0x70, 0xdc,
+	0x47, 0x93, 0x51, 0x4f, 0x7d, 0xd4, 0x3a, 0x36, 0x51, 0xe8, 0x6f, 0xf2, 0xc4, 0x31, 0x56, 0x66,
+	0x57, 0x7f, 0xc1, 0xb7, 0xe7, 0x15, 0x5f, 0xfd, 0xbb, 0x9a, 0x88, 0x33, 0x3e, 0xae, 0x4f, 0x8c,
+	0x26, 0x44, 0x55, 0x4f, 0xf4, 0x31, 0x64, 0xa0, 0x9c, 0x47, 0xe0, 0xf4, 0xcc, 0x79, 0x5a, 0x22,
+	0xae, 0xa6, 0x53, 0x05, 0x7d, 0x6a, 0xe6, 0x86, 0x0b, 0x96, 0xa1, 0x2f, 0x85, 0xd7, 0xd8, 0x7b,
+	0x26, 0x85, 0x1b, 0x5e, 0x8b, 0xe1, 0x8c, 0x9a, 0x4a, 0x2a, 0xaf, 0x0d, 0x54, 0xf1, 0xd4, 0x86,
+	0x9f, 0x42, 0xcf, 0x02, 0x76, 0x8a, 0xf5, 0xf0, 0x4c, 0xb6, 0xea, 0x16, 0x0e, 0xed, 0xc2, 0x8a,
+	0xad, 0x52, 0x85, 0xeb, 0x4d, 0x98, 0xef, 0x49, 0xa7, 0x2b, 0xf9, 0xae, 0x2f, 0x1d, 0xe1, 0x61,
+	0xc5, 0x82, 0x3a, 0x70, 0xe5, 0x80, 0x88, 0xe6, 0x58, 0x8d, 0x80, 0xe5, 0x18, 0x95, 0xdf, 0xf5,
+	0xf4, 0x95, 0xa6, 0x85, 0x33, 0x04, 0xda, 0x33, 0xf8, 0xb1, 0x17, 0x0d, 0x45, 0x3a, 0xf1, 0x2e,
+	0xd9, 0x3c, 0x50, 0x33, 0x44, 0xc9, 0x50, 0x6a, 0x1d, 0xe6, 0x4f, 0xe8, 0x38, 0xf0, 0x13, 0x39,
+	0x4e, 0x1a, 0x07, 0xbe, 0xb0, 0xb5, 0x85, 0x25, 0x80, 0x8e, 0x00, 0xb8, 0x8a, 0xfd, 0x20, 0x64,
+	0x24, 0xb6, 0xeb, 0x79, 0xcd, 0xac, 0xe7, 0x77, 0x60, 0x5e, 0x2c, 0xd0, 0xe5, 0xd2, 0x78, 0x03,
+	0x93, 0xf2, 0xb1, 0xa2, 0xa3, 0xbf, 0x56, 0x52, 0xc3, 0xb3, 0x91, 0xca, 0xfc, 0x40, 0x28, 0xb0,
+	0x2b, 0x0c, 0x5f, 0x9c, 0x29, 0xc7, 0x8a, 0x87, 0x97, 0xce, 0xde, 0x79, 0xd6, 0xd2, 0x58, 0x87,
+	0xa7, 0x1d, 0x41, 0x39, 0x11, 0x94, 0xee, 0x8b, 0x55, 0x22, 0x4e, 0xed, 0x5a, 0xe9, 0x2a, 0x41,
+	0x97, 0xab, 0xc4, 0x4f, 0x63, 0x10, 0xf8, 0x3e, 0x5c, 0x55, 0x6c, 0xe9, 0xe7, 0x7d, 0x19, 0xea,
+	0x21, 0x1d, 0xca, 0x50, 0x34, 0xb7, 0xae, 0x9a, 0x1b, 0x81, 0x5f, 0x77, 0x04, 0x91, 0x0f, 0xd7,
+	0x8e, 0x59, 0x4c, 0xbc, 0x91, 0x95, 0x8e, 0x68, 0x1b, 0x56, 0x6c, 0xb4, 0x92, 0xf9, 0xba, 0x3d,
+	0xd0, 0x2d, 0xcc, 0x18, 0xc9, 0x81, 0xb6, 0x61, 0x59, 0x8a, 0xf8, 0xd6, 0xa1, 0x44, 0xf7, 0xc1,
+	0x31, 0x45, 0x28, 0x1b, 0x5e, 0x82, 0x5a, 0x48, 0x87, 0x4a, 0xc0, 0x94, 0x5b, 0x9c, 0x86, 0x08,
+	0x5c, 0x53, 0x1d, 0x87, 0xac, 0x9c, 0x59, 0x7e, 0xc8, 0x61, 0x7e, 0x9a, 0x1f, 0x0a, 0xe4, 0xa5,
+	0x30, 0x26, 0x7e, 0x30, 0x0e, 0x44, 0xad, 0xab, 0x0a, 0xa2, 0x81, 0x11, 0xd9, 0xc7, 0x55, 0x88,
+	0x5d, 0xdf, 0xc0, 0x12, 0x40, 0x27, 0x70, 0x53, 0xda, 0x67, 0x29, 0x4b, 0x9d, 0xbd, 0x97, 0x73,
+	0xf6, 0xc5, 0xcc, 0xd9, 0x02, 0xeb, 0x52, 0xaf, 0xff, 0x59, 0x81, 0xb5, 0x62, 0xb1, 0x2a, 0x00,
+	0x5b, 0x30, 0x47, 0x9e, 0x12, 0xd5, 0x53, 0x5f, 0xd9, 0x5a, 0x2b, 0x11, 0xdb, 0xe5, 0x3c, 0x58,
+	0xb2, 0xce, 0x78, 0x27, 0xc9, 0xde, 0x3b, 0x6a, 0xd6, 0x7b, 0x47, 0x76, 0x5a, 0xd7, 0x2f, 0x7c,
+	0x85, 0x11, 0xe1, 0x1b, 0x87, 0x9e, 0xcf, 0xdf, 0xfc, 0xc4, 0xed, 0xad, 0x81, 0x0d, 0x0c, 0xba,
+	0x2f, 0x06, 0x86, 0x5d, 0xf5, 0x78, 0x2e, 0x5f, 0x98, 0xd3, 0x30, 0xb9, 0xb0, 0xa8, 0xab, 0xbb,
+	0xbe, 0xd7, 0x68, 0x18, 0x61, 0x70, 0x8b, 0x16, 0x66, 0x5d, 0x8a, 0xfd, 0xb4, 0xed, 0x5a, 0xd5,
+	0xde, 0x5a, 0x95, 0x3e, 0x72, 0xbf, 0xf1, 0x23, 0x70, 0xa6, 0xe3, 0xe4, 0xb4, 0x60, 0x71, 0x7b,
+	0x77, 0xb7, 0x7b, 0x74, 0xd2, 0xdd, 0x5b, 0xba, 0xc4, 0x21, 0xdc, 0x3d, 0x7a, 0xbc, 0xbd, 0xdb,
+	0xdd, 0x5b, 0xaa, 0x38, 0x4d, 0x58, 0xd8, 0xc3, 0x4f, 0x8e, 0x8e, 0xba, 0x7b, 0x4b, 0x55, 0x0e,
+	0x74, 0x3f, 0x3d, 0x3a, 0xc4, 0xdd, 0xbd, 0xa5, 0xda, 0xd6, 0x5f, 0x96, 0x01, 0xb6, 0x8f, 0x0e,
+	0xf9, 0xb0, 0x37, 0xf0, 0x89, 0x73, 0x08, 0x90, 0xbd, 0x36, 0x3b, 0x37, 0x73, 0x0f, 0x9d, 0xe6,
+	0x53, 0xb6, 0xbb, 0x56, 0x4c, 0x94, 0x9e, 0xa1, 0x4b, 0xa9, 0x28, 0xf1, 0xe9, 0xa7, 0x44, 0x99,
+	0x79, 0xe6, 0xae, 0x15, 0x13, 0x53, 0x51, 0x18, 0x2e, 0x5b, 0x23, 0x7a, 0x67, 0xbd, 0xe4, 0xc1,
+	0x42, 0x0b, 0xbc, 0x55, 0x4a, 0x4f, 0x65, 0x3e, 0x81, 0x96, 0x39, 0x7d, 0x77, 0x5e, 0xb4, 0x96,
+	0xe4, 0x87, 0xf5, 0xee, 0x7a, 0x19, 0x39, 0x67, 0x64, 0x36, 0x35, 0xcf, 0x19, 0x39, 0x35, 0x9a,
+	0x77, 0x6f, 0x95, 0xd2, 0xcd, 0x18, 0x66, 0xd3, 0x6d, 0x33, 0x86, 0x53, 0x23, 0x78, 0x77, 0xad,
+	0x98, 0x98, 0x8a, 0xf2, 0xc4, 0x7b, 0x53, 0x6e, 0x6a, 0xed, 0xd8, 0xef, 0x36, 0xc5, 0x03, 0x71,
+	0xf7, 0xf6, 0x6c, 0x26, 0x33, 0xa4, 0xe6, 0xd8, 0xd8, 0x0c, 0x69, 0xc1, 0x60, 0xdb, 0x5d, 0x2f,
+	0x23, 0xa7, 0x02, 0x3f, 0x85, 0xab, 0xb9, 0x9b, 0xbf, 0x63, 0xce, 0x48, 0x0a, 0x07, 0x10, 0xee,
+	0x4b, 0x33, 0x38, 0x52, 0xc9, 0x43, 0x58, 0x29, 0x6a, 0x50, 0x1d, 0xe3, 0x25, 0x6c, 0xc6, 0x45,
+	0xc0, 0x7d, 0xf5, 0x22, 0xb6, 0x54, 0xd1, 0x6f, 0xb2, 0x4e, 0xb8, 0xa8, 0x87, 0x76, 0xde, 0x9e,
+	0x96, 0x34, 0xe3, 0xb6, 0xe0, 0x76, 0xbe, 0x29, 0x7b, 0x6a, 0xc0, 0x3e, 0x34, 0xd2, 0x6e, 0xd6,
+	0x71, 0xed, 0x90, 0x9b, 0xcd, 0xb6, 0x7b, 0xb3, 0x90, 0x96, 0xdb, 0x2f, 0x69, 0xcb, 0x9a, 0xdb,
+	0x2f, 0xf9, 0x26, 0xd8, 0x5d, 0x2f, 0x23, 0xe7, 0x04, 0xa6, 0x4d, 0x5d, 0x4e, 0x60, 0xbe, 0xbf,
+	0x74, 0xd7, 0xcb, 0xc8, 0xa9, 0xc0, 0x1f, 0xc2, 0x82, 0xea, 0x20, 0x9c, 0xe9, 0xde, 0x43, 0x8b,
+	0x79, 0xa1, 0x80, 0x92, 0x4a, 0xd8, 0x85, 0x45, 0xfd, 0xdf, 0x47, 0x8e, 0xcd, 0x68, 0xfe, 0x03,
+	0x94, 0xeb, 0x16, 0x91, 0xf2, 0x75, 0x20, 0x7d, 0x74, 0xc8, 0xd7, 0x81, 0xfc, 0xd3, 0x87, 0x7b,
+	0xab, 0x94, 0x9e, 0xca, 0x3c, 0x52, 0xc3, 0x73, 0x63, 0x30, 0xef, 0x18, 0x79, 0x5e, 0x32, 0xb4,
+	0x77, 0x6f, 0xe4, 0x58, 0x0c, 0x89, 0x3b, 0xd0, 0x48, 0xc7, 0xf1, 0x66, 0x5a, 0xe4, 0x67, 0xf4,
+	0xb3, 0x64, 0xfc, 0x0c, 0x96, 0xf2, 0x83, 0x39, 0xa7, 0x7c, 0xf7, 0xa5, 0xe1, 0x43, 0xb3, 0x58,
+	0x72, 0x61, 0xcc, 0x66, 0x4c, 0xb9, 0x30, 0x4e, 0x4d, 0xeb, 0xdc, 0x5b, 0xa5, 0x74, 0xab, 0x9e,
+	0xd8, 0xf3, 0x02, 0xab, 0x9e, 0x14, 0x4e, 0x92, 0xdc, 0x97, 0x66, 0x70, 0xa4, 0x92, 0x7f, 0x02,
+	0x2d, 0xd9, 0xf1, 0x4c, 0x27, 0x73, 0x41, 0x77, 0xea, 0xae, 0x97, 0x91, 0xb5, 0xc0, 0x77, 0x2a,
+	0xce, 0x23, 0x80, 0xac, 0x77, 0xb4, 0x6a, 0x7f, 0xbe, 0x29, 0x75, 0xd7, 0x8a, 0x89, 0x86, 0xb0,
+	0x40, 0xb7, 0xc3, 0x76, 0x47, 0x66, 0xd6, 0xbb, 0x19, 0x8d, 0xa0, 0xfb, 0xea, 0x45, 0x6c, 0x86,
+	0x2a, 0x79, 0xd0, 0xe4, 0x3a, 0x9e, 0xdc, 0x41, 0x53, 0xdc, 0x48, 0xb9, 0xb7, 0x67, 0x33, 0x69,
+	0x25, 0x3b, 0xf7, 0x7e, 0x7a, 0x77, 0x18, 0xb0, 0xd3, 0x49, 0xaf, 0xe3, 0xd3, 0xd1, 0xa6, 0x58,
+	0x33, 0x8e, 0xe9, 0x17, 0xc4, 0x67, 0x12, 0x78, 0x5b, 0xfe, 0xc7, 0xe3, 0x90, 0x86, 0x5e, 0x34,
+	0xdc, 0xd4, 0x32, 0x7b, 0xf3, 0x02, 0x7d, 0xf7, 0xbf, 0x03, 0x00, 0x9e, 0xa5, 0x2c, 0x4e, 0x80,
+	0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIServiceClient is the client API for APIService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIServiceClient interface {
+	// get the address detail of an address
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	// get action(s) by:
+	// 1. start index and action count
+	// 2. action hash
+	// 3. address with start index and action count
+	// 4. get unconfirmed actions by address with start index and action count
+	// 5. block hash with start index and action count
+	GetActions(ctx context.Context, in *GetActionsRequest, opts ...grpc.CallOption) (*GetActionsResponse, error)
+	// get block metadata(s) by:
+	// 1. start index and block count
+	// 2. block hash
+	GetBlockMetas(ctx context.Context, in *GetBlockMetasRequest, opts ...grpc.CallOption) (*GetBlockMetasResponse, error)
+	// get chain metadata
+	GetChainMeta(ctx context.Context, in *GetChainMetaRequest, opts ...grpc.CallOption) (*GetChainMetaResponse, error)
+	// get server version
+	GetServerMeta(ctx context.Context, in *GetServerMetaRequest, opts ...grpc.CallOption) (*GetServerMetaResponse, error)
+	// sendAction
+	SendAction(ctx context.Context, in *SendActionRequest, opts ...grpc.CallOption) (*SendActionResponse, error)
+	// get receipt by action Hash
+	GetReceiptByAction(ctx context.Context, in *GetReceiptByActionRequest, opts ...grpc.CallOption) (*GetReceiptByActionResponse, error)
+	// TODO: read contract
+	ReadContract(ctx context.Context, in *ReadContractRequest, opts ...grpc.CallOption) (*ReadContractResponse, error)
+	// suggest gas price
+	SuggestGasPrice(ctx context.Context, in *SuggestGasPriceRequest, opts ...grpc.CallOption) (*SuggestGasPriceResponse, error)
+	// estimate gas for action, to be deprecated
+	EstimateGasForAction(ctx context.Context, in *EstimateGasForActionRequest, opts ...grpc.CallOption) (*EstimateGasForActionResponse, error)
+	// estimate gas for action and transfer not sealed
+	EstimateActionGasConsumption(ctx context.Context, in *EstimateActionGasConsumptionRequest, opts ...grpc.CallOption) (*EstimateActionGasConsumptionResponse, error)
+	// read state from blockchain
+	ReadState(ctx context.Context, in *ReadStateRequest, opts ...grpc.CallOption) (*ReadStateResponse, error)
+	// get epoch metadata
+	GetEpochMeta(ctx context.Context, in *GetEpochMetaRequest, opts ...grpc.CallOption) (*GetEpochMetaResponse, error)
+	// get raw blocks data
+	GetRawBlocks(ctx context.Context, in *GetRawBlocksRequest, opts ...grpc.CallOption) (*GetRawBlocksResponse, error)
+	// get logs filtered by contract address and topics
+	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
+	// GetVotes get a single address' votes
+	GetVotes(ctx context.Context, in *GetVotesRequest, opts ...grpc.CallOption) (*GetVotesResponse, error)
+	// GetStateProof returns the Merkle proof of an account and the storage slots of the contract, along with the header
+	// of the block committing to the state root which the proof is against
+	GetStateProof(ctx context.Context, in *GetStateProofRequest, opts ...grpc.CallOption) (*GetStateProofResponse, error)
+	// TraceTransaction re-executes a committed execution and returns its trace
+	TraceTransaction(ctx context.Context, in *TraceTransactionRequest, opts ...grpc.CallOption) (*TraceResponse, error)
+	// TraceCall simulates an execution on top of the tip and returns its trace
+	TraceCall(ctx context.Context, in *TraceCallRequest, opts ...grpc.CallOption) (*TraceResponse, error)
+	// SuggestGasPrices suggests the gas prices of the tiers
+	SuggestGasPrices(ctx context.Context, in *SuggestGasPricesRequest, opts ...grpc.CallOption) (*SuggestGasPricesResponse, error)
+	// GetFeeHistory returns the gas usage and the gas prices of the recent blocks
+	GetFeeHistory(ctx context.Context, in *GetFeeHistoryRequest, opts ...grpc.CallOption) (*GetFeeHistoryResponse, error)
+	// SimulateActions runs the actions one after another on top of the tip, and returns the outcome of each action
+	SimulateActions(ctx context.Context, in *SimulateActionsRequest, opts ...grpc.CallOption) (*SimulateActionsResponse, error)
+	// get block info in stream
+	StreamBlocks(ctx context.Context, in *StreamBlocksRequest, opts ...grpc.CallOption) (APIService_StreamBlocksClient, error)
+	// get logs filtered by contract address and topics in stream
+	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (APIService_StreamLogsClient, error)
+	// StreamPendingActions streams the changes of the actions in the action pool
+	StreamPendingActions(ctx context.Context, in *StreamPendingActionsRequest, opts ...grpc.CallOption) (APIService_StreamPendingActionsClient, error)
+	//
+	// election APIs
+	GetElectionBuckets(ctx context.Context, in *GetElectionBucketsRequest, opts ...grpc.CallOption) (*GetElectionBucketsResponse, error)
+}
+
+type aPIServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIServiceClient(cc *grpc.ClientConn) APIServiceClient {
+	return &aPIServiceClient{cc}
+}
+
+func (c *aPIServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetActions(ctx context.Context, in *GetActionsRequest, opts ...grpc.CallOption) (*GetActionsResponse, error) {
+	out := new(GetActionsResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetBlockMetas(ctx context.Context, in *GetBlockMetasRequest, opts ...grpc.CallOption) (*GetBlockMetasResponse, error) {
+	out := new(GetBlockMetasResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetBlockMetas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetChainMeta(ctx context.Context, in *GetChainMetaRequest, opts ...grpc.CallOption) (*GetChainMetaResponse, error) {
+	out := new(GetChainMetaResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetChainMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetServerMeta(ctx context.Context, in *GetServerMetaRequest, opts ...grpc.CallOption) (*GetServerMetaResponse, error) {
+	out := new(GetServerMetaResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetServerMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SendAction(ctx context.Context, in *SendActionRequest, opts ...grpc.CallOption) (*SendActionResponse, error) {
+	out := new(SendActionResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/SendAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetReceiptByAction(ctx context.Context, in *GetReceiptByActionRequest, opts ...grpc.CallOption) (*GetReceiptByActionResponse, error) {
+	out := new(GetReceiptByActionResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetReceiptByAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ReadContract(ctx context.Context, in *ReadContractRequest, opts ...grpc.CallOption) (*ReadContractResponse, error) {
+	out := new(ReadContractResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/ReadContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SuggestGasPrice(ctx context.Context, in *SuggestGasPriceRequest, opts ...grpc.CallOption) (*SuggestGasPriceResponse, error) {
+	out := new(SuggestGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/SuggestGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) EstimateGasForAction(ctx context.Context, in *EstimateGasForActionRequest, opts ...grpc.CallOption) (*EstimateGasForActionResponse, error) {
+	out := new(EstimateGasForActionResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/EstimateGasForAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) EstimateActionGasConsumption(ctx context.Context, in *EstimateActionGasConsumptionRequest, opts ...grpc.CallOption) (*EstimateActionGasConsumptionResponse, error) {
+	out := new(EstimateActionGasConsumptionResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/EstimateActionGasConsumption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ReadState(ctx context.Context, in *ReadStateRequest, opts ...grpc.CallOption) (*ReadStateResponse, error) {
+	out := new(ReadStateResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/ReadState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetEpochMeta(ctx context.Context, in *GetEpochMetaRequest, opts ...grpc.CallOption) (*GetEpochMetaResponse, error) {
+	out := new(GetEpochMetaResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetEpochMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetRawBlocks(ctx context.Context, in *GetRawBlocksRequest, opts ...grpc.CallOption) (*GetRawBlocksResponse, error) {
+	out := new(GetRawBlocksResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetRawBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error) {
+	out := new(GetLogsResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetVotes(ctx context.Context, in *GetVotesRequest, opts ...grpc.CallOption) (*GetVotesResponse, error) {
+	out := new(GetVotesResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetStateProof(ctx context.Context, in *GetStateProofRequest, opts ...grpc.CallOption) (*GetStateProofResponse, error) {
+	out := new(GetStateProofResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetStateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) TraceTransaction(ctx context.Context, in *TraceTransactionRequest, opts ...grpc.CallOption) (*TraceResponse, error) {
+	out := new(TraceResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/TraceTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) TraceCall(ctx context.Context, in *TraceCallRequest, opts ...grpc.CallOption) (*TraceResponse, error) {
+	out := new(TraceResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/TraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SuggestGasPrices(ctx context.Context, in *SuggestGasPricesRequest, opts ...grpc.CallOption) (*SuggestGasPricesResponse, error) {
+	out := new(SuggestGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/SuggestGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetFeeHistory(ctx context.Context, in *GetFeeHistoryRequest, opts ...grpc.CallOption) (*GetFeeHistoryResponse, error) {
+	out := new(GetFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetFeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SimulateActions(ctx context.Context, in *SimulateActionsRequest, opts ...grpc.CallOption) (*SimulateActionsResponse, error) {
+	out := new(SimulateActionsResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/SimulateActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) StreamBlocks(ctx context.Context, in *StreamBlocksRequest, opts ...grpc.CallOption) (APIService_StreamBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[0], "/iotexapi.APIService/StreamBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceStreamBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_StreamBlocksClient interface {
+	Recv() (*StreamBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceStreamBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceStreamBlocksClient) Recv() (*StreamBlocksResponse, error) {
+	m := new(StreamBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIServiceClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (APIService_StreamLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[1], "/iotexapi.APIService/StreamLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceStreamLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_StreamLogsClient interface {
+	Recv() (*StreamLogsResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceStreamLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceStreamLogsClient) Recv() (*StreamLogsResponse, error) {
+	m := new(StreamLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIServiceClient) StreamPendingActions(ctx context.Context, in *StreamPendingActionsRequest, opts ...grpc.CallOption) (APIService_StreamPendingActionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[2], "/iotexapi.APIService/StreamPendingActions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceStreamPendingActionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_StreamPendingActionsClient interface {
+	Recv() (*StreamPendingActionsResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceStreamPendingActionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceStreamPendingActionsClient) Recv() (*StreamPendingActionsResponse, error) {
+	m := new(StreamPendingActionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIServiceClient) GetElectionBuckets(ctx context.Context, in *GetElectionBucketsRequest, opts ...grpc.CallOption) (*GetElectionBucketsResponse, error) {
+	out := new(GetElectionBucketsResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetElectionBuckets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIServiceServer is the server API for APIService service.
+type APIServiceServer interface {
+	// get the address detail of an address
+	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	// get action(s) by:
+	// 1. start index and action count
+	// 2. action hash
+	// 3. address with start index and action count
+	// 4. get unconfirmed actions by address with start index and action count
+	// 5. block hash with start index and action count
+	GetActions(context.Context, *GetActionsRequest) (*GetActionsResponse, error)
+	// get block metadata(s) by:
+	// 1. start index and block count
+	// 2. block hash
+	GetBlockMetas(context.Context, *GetBlockMetasRequest) (*GetBlockMetasResponse, error)
+	// get chain metadata
+	GetChainMeta(context.Context, *GetChainMetaRequest) (*GetChainMetaResponse, error)
+	// get server version
+	GetServerMeta(context.Context, *GetServerMetaRequest) (*GetServerMetaResponse, error)
+	// sendAction
+	SendAction(context.Context, *SendActionRequest) (*SendActionResponse, error)
+	// get receipt by action Hash
+	GetReceiptByAction(context.Context, *GetReceiptByActionRequest) (*GetReceiptByActionResponse, error)
+	// TODO: read contract
+	ReadContract(context.Context, *ReadContractRequest) (*ReadContractResponse, error)
+	// suggest gas price
+	SuggestGasPrice(context.Context, *SuggestGasPriceRequest) (*SuggestGasPriceResponse, error)
+	// estimate gas for action, to be deprecated
+	EstimateGasForAction(context.Context, *EstimateGasForActionRequest) (*EstimateGasForActionResponse, error)
+	// estimate gas for action and transfer not sealed
+	EstimateActionGasConsumption(context.Context, *EstimateActionGasConsumptionRequest) (*EstimateActionGasConsumptionResponse, error)
+	// read state from blockchain
+	ReadState(context.Context, *ReadStateRequest) (*ReadStateResponse, error)
+	// get epoch metadata
+	GetEpochMeta(context.Context, *GetEpochMetaRequest) (*GetEpochMetaResponse, error)
+	// get raw blocks data
+	GetRawBlocks(context.Context, *GetRawBlocksRequest) (*GetRawBlocksResponse, error)
+	// get logs filtered by contract address and topics
+	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
+	// GetVotes get a single address' votes
+	GetVotes(context.Context, *GetVotesRequest) (*GetVotesResponse, error)
+	// GetStateProof returns the Merkle proof of an account and the storage slots of the contract, along with the header
+	// of the block committing to the state root which the proof is against
+	GetStateProof(context.Context, *GetStateProofRequest) (*GetStateProofResponse, error)
+	// TraceTransaction re-executes a committed execution and returns its trace
+	TraceTransaction(context.Context, *TraceTransactionRequest) (*TraceResponse, error)
+	// TraceCall simulates an execution on top of the tip and returns its trace
+	TraceCall(context.Context, *TraceCallRequest) (*TraceResponse, error)
+	// SuggestGasPrices suggests the gas prices of the tiers
+	SuggestGasPrices(context.Context, *SuggestGasPricesRequest) (*SuggestGasPricesResponse, error)
+	// GetFeeHistory returns the gas usage and the gas prices of the recent blocks
+	GetFeeHistory(context.Context, *GetFeeHistoryRequest) (*GetFeeHistoryResponse, error)
+	// SimulateActions runs the actions one after another on top of the tip, and returns the outcome of each action
+	SimulateActions(context.Context, *SimulateActionsRequest) (*SimulateActionsResponse, error)
+	// get block info in stream
+	StreamBlocks(*StreamBlocksRequest, APIService_StreamBlocksServer) error
+	// get logs filtered by contract address and topics in stream
+	StreamLogs(*StreamLogsRequest, APIService_StreamLogsServer) error
+	// StreamPendingActions streams the changes of the actions in the action pool
+	StreamPendingActions(*StreamPendingActionsRequest, APIService_StreamPendingActionsServer) error
+	//
+	// election APIs
+	GetElectionBuckets(context.Context, *GetElectionBucketsRequest) (*GetElectionBucketsResponse, error)
+}
+
+// UnimplementedAPIServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIServiceServer struct {
+}
+
+func (*UnimplementedAPIServiceServer) GetAccount(ctx context.Context, req *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetActions(ctx context.Context, req *GetActionsRequest) (*GetActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActions not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetBlockMetas(ctx context.Context, req *GetBlockMetasRequest) (*GetBlockMetasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockMetas not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetChainMeta(ctx context.Context, req *GetChainMetaRequest) (*GetChainMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainMeta not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetServerMeta(ctx context.Context, req *GetServerMetaRequest) (*GetServerMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerMeta not implemented")
+}
+func (*UnimplementedAPIServiceServer) SendAction(ctx context.Context, req *SendActionRequest) (*SendActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAction not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetReceiptByAction(ctx context.Context, req *GetReceiptByActionRequest) (*GetReceiptByActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceiptByAction not implemented")
+}
+func (*UnimplementedAPIServiceServer) ReadContract(ctx context.Context, req *ReadContractRequest) (*ReadContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadContract not implemented")
+}
+func (*UnimplementedAPIServiceServer) SuggestGasPrice(ctx context.Context, req *SuggestGasPriceRequest) (*SuggestGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestGasPrice not implemented")
+}
+func (*UnimplementedAPIServiceServer) EstimateGasForAction(ctx context.Context, req *EstimateGasForActionRequest) (*EstimateGasForActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasForAction not implemented")
+}
+func (*UnimplementedAPIServiceServer) EstimateActionGasConsumption(ctx context.Context, req *EstimateActionGasConsumptionRequest) (*EstimateActionGasConsumptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateActionGasConsumption not implemented")
+}
+func (*UnimplementedAPIServiceServer) ReadState(ctx context.Context, req *ReadStateRequest) (*ReadStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadState not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetEpochMeta(ctx context.Context, req *GetEpochMetaRequest) (*GetEpochMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpochMeta not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetRawBlocks(ctx context.Context, req *GetRawBlocksRequest) (*GetRawBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRawBlocks not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetLogs(ctx context.Context, req *GetLogsRequest) (*GetLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetVotes(ctx context.Context, req *GetVotesRequest) (*GetVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVotes not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetStateProof(ctx context.Context, req *GetStateProofRequest) (*GetStateProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateProof not implemented")
+}
+func (*UnimplementedAPIServiceServer) TraceTransaction(ctx context.Context, req *TraceTransactionRequest) (*TraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceTransaction not implemented")
+}
+func (*UnimplementedAPIServiceServer) TraceCall(ctx context.Context, req *TraceCallRequest) (*TraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
+func (*UnimplementedAPIServiceServer) SuggestGasPrices(ctx context.Context, req *SuggestGasPricesRequest) (*SuggestGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestGasPrices not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetFeeHistory(ctx context.Context, req *GetFeeHistoryRequest) (*GetFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeeHistory not implemented")
+}
+func (*UnimplementedAPIServiceServer) SimulateActions(ctx context.Context, req *SimulateActionsRequest) (*SimulateActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateActions not implemented")
+}
+func (*UnimplementedAPIServiceServer) StreamBlocks(req *StreamBlocksRequest, srv APIService_StreamBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlocks not implemented")
+}
+func (*UnimplementedAPIServiceServer) StreamLogs(req *StreamLogsRequest, srv APIService_StreamLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
+func (*UnimplementedAPIServiceServer) StreamPendingActions(req *StreamPendingActionsRequest, srv APIService_StreamPendingActionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPendingActions not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetElectionBuckets(ctx context.Context, req *GetElectionBucketsRequest) (*GetElectionBucketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetElectionBuckets not implemented")
+}
+
+func RegisterAPIServiceServer(s *grpc.Server, srv APIServiceServer) {
+	s.RegisterService(&_APIService_serviceDesc, srv)
+}
+
+func _APIService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetActions(ctx, req.(*GetActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetBlockMetas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockMetasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetBlockMetas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetBlockMetas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetBlockMetas(ctx, req.(*GetBlockMetasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetChainMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetChainMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetChainMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetChainMeta(ctx, req.(*GetChainMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetServerMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetServerMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetServerMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetServerMeta(ctx, req.(*GetServerMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SendAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SendAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/SendAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SendAction(ctx, req.(*SendActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetReceiptByAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiptByActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetReceiptByAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetReceiptByAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetReceiptByAction(ctx, req.(*GetReceiptByActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ReadContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ReadContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/ReadContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ReadContract(ctx, req.(*ReadContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SuggestGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SuggestGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/SuggestGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SuggestGasPrice(ctx, req.(*SuggestGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_EstimateGasForAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasForActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).EstimateGasForAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/EstimateGasForAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).EstimateGasForAction(ctx, req.(*EstimateGasForActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_EstimateActionGasConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateActionGasConsumptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).EstimateActionGasConsumption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/EstimateActionGasConsumption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).EstimateActionGasConsumption(ctx, req.(*EstimateActionGasConsumptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ReadState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ReadState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/ReadState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ReadState(ctx, req.(*ReadStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetEpochMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpochMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetEpochMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetEpochMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetEpochMeta(ctx, req.(*GetEpochMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetRawBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetRawBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetRawBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetRawBlocks(ctx, req.(*GetRawBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetLogs(ctx, req.(*GetLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetVotes(ctx, req.(*GetVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetStateProof(ctx, req.(*GetStateProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_TraceTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).TraceTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/TraceTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).TraceTransaction(ctx, req.(*TraceTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/TraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).TraceCall(ctx, req.(*TraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SuggestGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SuggestGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/SuggestGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SuggestGasPrices(ctx, req.(*SuggestGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetFeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetFeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetFeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetFeeHistory(ctx, req.(*GetFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SimulateActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SimulateActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/SimulateActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SimulateActions(ctx, req.(*SimulateActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_StreamBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).StreamBlocks(m, &aPIServiceStreamBlocksServer{stream})
+}
+
+type APIService_StreamBlocksServer interface {
+	Send(*StreamBlocksResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceStreamBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceStreamBlocksServer) Send(m *StreamBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _APIService_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).StreamLogs(m, &aPIServiceStreamLogsServer{stream})
+}
+
+type APIService_StreamLogsServer interface {
+	Send(*StreamLogsResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceStreamLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceStreamLogsServer) Send(m *StreamLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _APIService_StreamPendingActions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPendingActionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).StreamPendingActions(m, &aPIServiceStreamPendingActionsServer{stream})
+}
+
+type APIService_StreamPendingActionsServer interface {
+	Send(*StreamPendingActionsResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceStreamPendingActionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceStreamPendingActionsServer) Send(m *StreamPendingActionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _APIService_GetElectionBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetElectionBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetElectionBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetElectionBuckets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetElectionBuckets(ctx, req.(*GetElectionBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _APIService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "iotexapi.APIService",
+	HandlerType: (*APIServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccount",
+			Handler:    _APIService_GetAccount_Handler,
+		},
+		{
+			MethodName: "GetActions",
+			Handler:    _APIService_GetActions_Handler,
+		},
+		{
+			MethodName: "GetBlockMetas",
+			Handler:    _APIService_GetBlockMetas_Handler,
+		},
+		{
+			MethodName: "GetChainMeta",
+			Handler:    _APIService_GetChainMeta_Handler,
+		},
+		{
+			MethodName: "GetServerMeta",
+			Handler:    _APIService_GetServerMeta_Handler,
+		},
+		{
+			MethodName: "SendAction",
+			Handler:    _APIService_SendAction_Handler,
+		},
+		{
+			MethodName: "GetReceiptByAction",
+			Handler:    _APIService_GetReceiptByAction_Handler,
+		},
+		{
+			MethodName: "ReadContract",
+			Handler:    _APIService_ReadContract_Handler,
+		},
+		{
+			MethodName: "SuggestGasPrice",
+			Handler:    _APIService_SuggestGasPrice_Handler,
+		},
+		{
+			MethodName: "EstimateGasForAction",
+			Handler:    _APIService_EstimateGasForAction_Handler,
+		},
+		{
+			MethodName: "EstimateActionGasConsumption",
+			Handler:    _APIService_EstimateActionGasConsumption_Handler,
+		},
+		{
+			MethodName: "ReadState",
+			Handler:    _APIService_ReadState_Handler,
+		},
+		{
+			MethodName: "GetEpochMeta",
+			Handler:    _APIService_GetEpochMeta_Handler,
+		},
+		{
+			MethodName: "GetRawBlocks",
+			Handler:    _APIService_GetRawBlocks_Handler,
+		},
+		{
+			MethodName: "GetLogs",
+			Handler:    _APIService_GetLogs_Handler,
+		},
+		{
+			MethodName: "GetVotes",
+			Handler:    _APIService_GetVotes_Handler,
+		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _APIService_GetStateProof_Handler,
+		},
+		{
+			MethodName: "TraceTransaction",
+			Handler:    _APIService_TraceTransaction_Handler,
+		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _APIService_TraceCall_Handler,
+		},
+		{
+			MethodName: "SuggestGasPrices",
+			Handler:    _APIService_SuggestGasPrices_Handler,
+		},
+		{
+			MethodName: "GetFeeHistory",
+			Handler:    _APIService_GetFeeHistory_Handler,
+		},
+		{
+			MethodName: "SimulateActions",
+			Handler:    _APIService_SimulateActions_Handler,
+		},
+		{
+			MethodName: "GetElectionBuckets",
+			Handler:    _APIService_GetElectionBuckets_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBlocks",
+			Handler:       _APIService_StreamBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _APIService_StreamLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPendingActions",
+			Handler:       _APIService_StreamPendingActions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/api/api.proto",
+}
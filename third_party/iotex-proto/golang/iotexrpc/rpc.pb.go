@@ -0,0 +1,483 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/rpc/rpc.proto
+
+package iotexrpc
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	iotextypes "github.com/iotexproject/iotex-proto/golang/iotextypes"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type MessageType int32
+
+const (
+	MessageType_UNKNOWN       MessageType = 0
+	MessageType_ACTION        MessageType = 1
+	MessageType_BLOCK         MessageType = 2
+	MessageType_CONSENSUS     MessageType = 3
+	MessageType_BLOCK_REQUEST MessageType = 4
+	MessageType_BLOCK_BATCH   MessageType = 5
+	MessageType_TEST          MessageType = 10001
+)
+
+var MessageType_name = map[int32]string{
+	0:     "UNKNOWN",
+	1:     "ACTION",
+	2:     "BLOCK",
+	3:     "CONSENSUS",
+	4:     "BLOCK_REQUEST",
+	5:     "BLOCK_BATCH",
+	10001: "TEST",
+}
+
+var MessageType_value = map[string]int32{
+	"UNKNOWN":       0,
+	"ACTION":        1,
+	"BLOCK":         2,
+	"CONSENSUS":     3,
+	"BLOCK_REQUEST": 4,
+	"BLOCK_BATCH":   5,
+	"TEST":          10001,
+}
+
+func (x MessageType) String() string {
+	return proto.EnumName(MessageType_name, int32(x))
+}
+
+func (MessageType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_59d40974ffbedc26, []int{0}
+}
+
+type BlockSync struct {
+	Start uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint64 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	// the capability of batched responses of the requester, which only accepts single blocks if not set
+	Capability           *SyncCapability `protobuf:"bytes,4,opt,name=capability,proto3" json:"capability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *BlockSync) Reset()         { *m = BlockSync{} }
+func (m *BlockSync) String() string { return proto.CompactTextString(m) }
+func (*BlockSync) ProtoMessage()    {}
+func (*BlockSync) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59d40974ffbedc26, []int{0}
+}
+
+func (m *BlockSync) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockSync.Unmarshal(m, b)
+}
+func (m *BlockSync) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockSync.Marshal(b, m, deterministic)
+}
+func (m *BlockSync) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSync.Merge(m, src)
+}
+func (m *BlockSync) XXX_Size() int {
+	return xxx_messageInfo_BlockSync.Size(m)
+}
+func (m *BlockSync) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSync.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSync proto.InternalMessageInfo
+
+func (m *BlockSync) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *BlockSync) GetEnd() uint64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *BlockSync) GetCapability() *SyncCapability {
+	if m != nil {
+		return m.Capability
+	}
+	return nil
+}
+
+type SyncCapability struct {
+	BatchVersion uint32 `protobuf:"varint,1,opt,name=batchVersion,proto3" json:"batchVersion,omitempty"`
+	Compression  bool   `protobuf:"varint,2,opt,name=compression,proto3" json:"compression,omitempty"`
+	// headersOnly requests the headers and footers of the blocks without the bodies
+	HeadersOnly          bool     `protobuf:"varint,3,opt,name=headersOnly,proto3" json:"headersOnly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncCapability) Reset()         { *m = SyncCapability{} }
+func (m *SyncCapability) String() string { return proto.CompactTextString(m) }
+func (*SyncCapability) ProtoMessage()    {}
+func (*SyncCapability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59d40974ffbedc26, []int{1}
+}
+
+func (m *SyncCapability) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncCapability.Unmarshal(m, b)
+}
+func (m *SyncCapability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncCapability.Marshal(b, m, deterministic)
+}
+func (m *SyncCapability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncCapability.Merge(m, src)
+}
+func (m *SyncCapability) XXX_Size() int {
+	return xxx_messageInfo_SyncCapability.Size(m)
+}
+func (m *SyncCapability) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncCapability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncCapability proto.InternalMessageInfo
+
+func (m *SyncCapability) GetBatchVersion() uint32 {
+	if m != nil {
+		return m.BatchVersion
+	}
+	return 0
+}
+
+func (m *SyncCapability) GetCompression() bool {
+	if m != nil {
+		return m.Compression
+	}
+	return false
+}
+
+func (m *SyncCapability) GetHeadersOnly() bool {
+	if m != nil {
+		return m.HeadersOnly
+	}
+	return false
+}
+
+// BlockBatch is a response to a block sync request with multiple blocks
+type BlockBatch struct {
+	Version    uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Compressed bool   `protobuf:"varint,2,opt,name=compressed,proto3" json:"compressed,omitempty"`
+	// serialized Blocks, which are gzipped if compressed
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// the blocks only have the headers and footers if headersOnly
+	HeadersOnly          bool     `protobuf:"varint,4,opt,name=headersOnly,proto3" json:"headersOnly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockBatch) Reset()         { *m = BlockBatch{} }
+func (m *BlockBatch) String() string { return proto.CompactTextString(m) }
+func (*BlockBatch) ProtoMessage()    {}
+func (*BlockBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59d40974ffbedc26, []int{2}
+}
+
+func (m *BlockBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockBatch.Unmarshal(m, b)
+}
+func (m *BlockBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockBatch.Marshal(b, m, deterministic)
+}
+func (m *BlockBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockBatch.Merge(m, src)
+}
+func (m *BlockBatch) XXX_Size() int {
+	return xxx_messageInfo_BlockBatch.Size(m)
+}
+func (m *BlockBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockBatch proto.InternalMessageInfo
+
+func (m *BlockBatch) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *BlockBatch) GetCompressed() bool {
+	if m != nil {
+		return m.Compressed
+	}
+	return false
+}
+
+func (m *BlockBatch) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *BlockBatch) GetHeadersOnly() bool {
+	if m != nil {
+		return m.HeadersOnly
+	}
+	return false
+}
+
+type Blocks struct {
+	Blocks               []*iotextypes.Block `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Blocks) Reset()         { *m = Blocks{} }
+func (m *Blocks) String() string { return proto.CompactTextString(m) }
+func (*Blocks) ProtoMessage()    {}
+func (*Blocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59d40974ffbedc26, []int{3}
+}
+
+func (m *Blocks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Blocks.Unmarshal(m, b)
+}
+func (m *Blocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Blocks.Marshal(b, m, deterministic)
+}
+func (m *Blocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Blocks.Merge(m, src)
+}
+func (m *Blocks) XXX_Size() int {
+	return xxx_messageInfo_Blocks.Size(m)
+}
+func (m *Blocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_Blocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Blocks proto.InternalMessageInfo
+
+func (m *Blocks) GetBlocks() []*iotextypes.Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type BroadcastMsg struct {
+	ChainId              uint32               `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	MsgType              MessageType          `protobuf:"varint,2,opt,name=msg_type,json=msgType,proto3,enum=iotexrpc.MessageType" json:"msg_type,omitempty"`
+	MsgBody              []byte               `protobuf:"bytes,3,opt,name=msg_body,json=msgBody,proto3" json:"msg_body,omitempty"`
+	PeerId               string               `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BroadcastMsg) Reset()         { *m = BroadcastMsg{} }
+func (m *BroadcastMsg) String() string { return proto.CompactTextString(m) }
+func (*BroadcastMsg) ProtoMessage()    {}
+func (*BroadcastMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59d40974ffbedc26, []int{4}
+}
+
+func (m *BroadcastMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BroadcastMsg.Unmarshal(m, b)
+}
+func (m *BroadcastMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BroadcastMsg.Marshal(b, m, deterministic)
+}
+func (m *BroadcastMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BroadcastMsg.Merge(m, src)
+}
+func (m *BroadcastMsg) XXX_Size() int {
+	return xxx_messageInfo_BroadcastMsg.Size(m)
+}
+func (m *BroadcastMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_BroadcastMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BroadcastMsg proto.InternalMessageInfo
+
+func (m *BroadcastMsg) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *BroadcastMsg) GetMsgType() MessageType {
+	if m != nil {
+		return m.MsgType
+	}
+	return MessageType_UNKNOWN
+}
+
+func (m *BroadcastMsg) GetMsgBody() []byte {
+	if m != nil {
+		return m.MsgBody
+	}
+	return nil
+}
+
+func (m *BroadcastMsg) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *BroadcastMsg) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type UnicastMsg struct {
+	ChainId              uint32               `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Addr                 string               `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	MsgType              MessageType          `protobuf:"varint,3,opt,name=msg_type,json=msgType,proto3,enum=iotexrpc.MessageType" json:"msg_type,omitempty"`
+	MsgBody              []byte               `protobuf:"bytes,4,opt,name=msg_body,json=msgBody,proto3" json:"msg_body,omitempty"`
+	PeerId               string               `protobuf:"bytes,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UnicastMsg) Reset()         { *m = UnicastMsg{} }
+func (m *UnicastMsg) String() string { return proto.CompactTextString(m) }
+func (*UnicastMsg) ProtoMessage()    {}
+func (*UnicastMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59d40974ffbedc26, []int{5}
+}
+
+func (m *UnicastMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnicastMsg.Unmarshal(m, b)
+}
+func (m *UnicastMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnicastMsg.Marshal(b, m, deterministic)
+}
+func (m *UnicastMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnicastMsg.Merge(m, src)
+}
+func (m *UnicastMsg) XXX_Size() int {
+	return xxx_messageInfo_UnicastMsg.Size(m)
+}
+func (m *UnicastMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnicastMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnicastMsg proto.InternalMessageInfo
+
+func (m *UnicastMsg) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *UnicastMsg) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *UnicastMsg) GetMsgType() MessageType {
+	if m != nil {
+		return m.MsgType
+	}
+	return MessageType_UNKNOWN
+}
+
+func (m *UnicastMsg) GetMsgBody() []byte {
+	if m != nil {
+		return m.MsgBody
+	}
+	return nil
+}
+
+func (m *UnicastMsg) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *UnicastMsg) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("iotexrpc.MessageType", MessageType_name, MessageType_value)
+	proto.RegisterType((*BlockSync)(nil), "iotexrpc.BlockSync")
+	proto.RegisterType((*SyncCapability)(nil), "iotexrpc.SyncCapability")
+	proto.RegisterType((*BlockBatch)(nil), "iotexrpc.BlockBatch")
+	proto.RegisterType((*Blocks)(nil), "iotexrpc.Blocks")
+	proto.RegisterType((*BroadcastMsg)(nil), "iotexrpc.BroadcastMsg")
+	proto.RegisterType((*UnicastMsg)(nil), "iotexrpc.UnicastMsg")
+}
+
+func init() { proto.RegisterFile("proto/rpc/rpc.proto", fileDescriptor_59d40974ffbedc26) }
+
+var fileDescriptor_59d40974ffbedc26 = []byte{
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xd1, 0x6e, 0x94, 0x4c,
+	0x18, 0xfd, 0xe9, 0xb2, 0xec, 0xf2, 0xd1, 0xf6, 0xa7, 0xa3, 0x46, 0xda, 0x18, 0xdd, 0x70, 0xb5,
+	0x9a, 0x08, 0xa6, 0x8d, 0x89, 0xb7, 0x65, 0xd3, 0xc4, 0xa6, 0x96, 0x8d, 0xb3, 0xac, 0x26, 0xde,
+	0x34, 0x03, 0x8c, 0x2c, 0x0a, 0x0c, 0x61, 0xa6, 0xa6, 0xdc, 0xfa, 0x06, 0x3e, 0x96, 0xcf, 0xe0,
+	0xcb, 0x98, 0x19, 0x4a, 0xbb, 0xab, 0x69, 0x62, 0x2f, 0x48, 0xe6, 0x9c, 0xef, 0xcc, 0xcc, 0x39,
+	0xdf, 0x37, 0x01, 0x1e, 0xd4, 0x0d, 0x13, 0xcc, 0x6f, 0xea, 0x44, 0x7e, 0x9e, 0x42, 0x68, 0x9c,
+	0x33, 0x41, 0xaf, 0x9a, 0x3a, 0x39, 0x78, 0xd2, 0x95, 0x45, 0x5b, 0x53, 0xee, 0xc7, 0x05, 0x4b,
+	0xbe, 0x26, 0x2b, 0x92, 0x57, 0x9d, 0xee, 0xe0, 0x59, 0xc6, 0x58, 0x56, 0x50, 0x5f, 0xa1, 0xf8,
+	0xf2, 0xb3, 0x2f, 0xf2, 0x92, 0x72, 0x41, 0xca, 0xba, 0x13, 0xb8, 0x25, 0x98, 0x81, 0xdc, 0xb4,
+	0x68, 0xab, 0x04, 0x3d, 0x84, 0x21, 0x17, 0xa4, 0x11, 0xce, 0xd6, 0x44, 0x9b, 0xea, 0xb8, 0x03,
+	0xc8, 0x86, 0x01, 0xad, 0x52, 0x67, 0xa0, 0x38, 0xb9, 0x44, 0x6f, 0x00, 0x12, 0x52, 0x93, 0x38,
+	0x2f, 0x72, 0xd1, 0x3a, 0xfa, 0x44, 0x9b, 0x5a, 0x87, 0x8e, 0xd7, 0x5b, 0xf2, 0xe4, 0x59, 0xb3,
+	0x9b, 0x3a, 0x5e, 0xd3, 0xba, 0x57, 0xb0, 0xbb, 0x59, 0x45, 0x2e, 0x6c, 0xc7, 0x44, 0x24, 0xab,
+	0x0f, 0xb4, 0xe1, 0x39, 0xab, 0x1c, 0x6d, 0xa2, 0x4d, 0x77, 0xf0, 0x06, 0x87, 0x26, 0x60, 0x25,
+	0xac, 0xac, 0x1b, 0xca, 0x95, 0x44, 0xba, 0x1b, 0xe3, 0x75, 0x4a, 0x2a, 0x56, 0x94, 0xa4, 0xb4,
+	0xe1, 0xf3, 0xaa, 0x68, 0x95, 0xd7, 0x31, 0x5e, 0xa7, 0xdc, 0xef, 0x1a, 0x80, 0x4a, 0x1a, 0xc8,
+	0x93, 0x91, 0x03, 0xa3, 0x6f, 0x1b, 0x37, 0xf6, 0x10, 0x3d, 0x05, 0xe8, 0x4f, 0xa6, 0xe9, 0xf5,
+	0x5d, 0x6b, 0x8c, 0xdc, 0x59, 0x93, 0xb6, 0x60, 0xa4, 0x6b, 0xc9, 0x36, 0xee, 0xe1, 0x9f, 0x26,
+	0xf4, 0xbf, 0x4d, 0x1c, 0x81, 0xa1, 0x3c, 0x70, 0xf4, 0x1c, 0x0c, 0x35, 0x2c, 0xee, 0x68, 0x93,
+	0xc1, 0xd4, 0x3a, 0xdc, 0xeb, 0xda, 0xa7, 0xc6, 0xe8, 0x29, 0x0d, 0xbe, 0x16, 0xb8, 0x3f, 0x35,
+	0xd8, 0x0e, 0x1a, 0x46, 0xd2, 0x84, 0x70, 0x71, 0xce, 0x33, 0xb4, 0x0f, 0x63, 0x35, 0xe3, 0x8b,
+	0x3c, 0xed, 0xcd, 0x2b, 0x7c, 0x9a, 0xa2, 0x57, 0x30, 0x2e, 0x79, 0x76, 0x21, 0x8f, 0x51, 0xd6,
+	0x77, 0x0f, 0x1f, 0xdd, 0xce, 0xe5, 0x9c, 0x72, 0x4e, 0x32, 0x1a, 0xb5, 0x35, 0xc5, 0xa3, 0x92,
+	0x67, 0x72, 0x81, 0xf6, 0xbb, 0x1d, 0x31, 0x4b, 0xdb, 0x3e, 0x4f, 0xc9, 0xb3, 0x80, 0xa5, 0x2d,
+	0x7a, 0x0c, 0xa3, 0x9a, 0xd2, 0x46, 0x5e, 0x23, 0xb3, 0x98, 0xd8, 0x90, 0xf0, 0x54, 0xce, 0xdf,
+	0xbc, 0x79, 0x47, 0xce, 0x50, 0x8d, 0xff, 0xc0, 0xeb, 0x5e, 0x9a, 0xd7, 0xbf, 0x34, 0x2f, 0xea,
+	0x15, 0xf8, 0x56, 0xec, 0xfe, 0xd2, 0x00, 0x96, 0x55, 0xfe, 0x0f, 0x49, 0x10, 0xe8, 0x24, 0x4d,
+	0x1b, 0x95, 0xc2, 0xc4, 0x6a, 0xbd, 0x91, 0x6e, 0x70, 0xef, 0x74, 0xfa, 0x9d, 0xe9, 0x86, 0x77,
+	0xa7, 0x33, 0xee, 0x91, 0xee, 0x05, 0x03, 0x6b, 0xcd, 0x05, 0xb2, 0x60, 0xb4, 0x0c, 0xcf, 0xc2,
+	0xf9, 0xc7, 0xd0, 0xfe, 0x0f, 0x01, 0x18, 0xc7, 0xb3, 0xe8, 0x74, 0x1e, 0xda, 0x1a, 0x32, 0x61,
+	0x18, 0xbc, 0x9b, 0xcf, 0xce, 0xec, 0x2d, 0xb4, 0x03, 0xe6, 0x6c, 0x1e, 0x2e, 0x4e, 0xc2, 0xc5,
+	0x72, 0x61, 0x0f, 0xd0, 0x1e, 0xec, 0xa8, 0xca, 0x05, 0x3e, 0x79, 0xbf, 0x3c, 0x59, 0x44, 0xb6,
+	0x8e, 0xfe, 0x07, 0xab, 0xa3, 0x82, 0xe3, 0x68, 0xf6, 0xd6, 0x1e, 0x22, 0x13, 0xf4, 0x48, 0x96,
+	0x7e, 0x84, 0xc1, 0xeb, 0x4f, 0x47, 0x59, 0x2e, 0x56, 0x97, 0xb1, 0x97, 0xb0, 0xd2, 0x57, 0xad,
+	0xa8, 0x1b, 0xf6, 0x85, 0x26, 0xa2, 0x03, 0x2f, 0xbb, 0x9f, 0x43, 0xc6, 0x0a, 0x52, 0x65, 0x7e,
+	0xdf, 0xaa, 0xd8, 0x50, 0xf4, 0xd1, 0xef, 0x01, 0x00, 0xfb, 0xd8, 0x92, 0x11, 0x5b, 0x04, 0x00,
+	0x00,
+}
@@ -0,0 +1,3384 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/types/action.proto
+
+package iotextypes
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Encoding is how an action is encoded to compute the hash signed by its sender
+type Encoding int32
+
+const (
+	// the hash of the serialized action core
+	Encoding_IOTEX_PROTOBUF Encoding = 0
+	// the signing hash of the Ethereum transaction converted from the action, as signed by web3 wallets
+	Encoding_ETHEREUM_RLP Encoding = 1
+)
+
+var Encoding_name = map[int32]string{
+	0: "IOTEX_PROTOBUF",
+	1: "ETHEREUM_RLP",
+}
+
+var Encoding_value = map[string]int32{
+	"IOTEX_PROTOBUF": 0,
+	"ETHEREUM_RLP":   1,
+}
+
+func (x Encoding) String() string {
+	return proto.EnumName(Encoding_name, int32(x))
+}
+
+func (Encoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{0}
+}
+
+type RewardType int32
+
+const (
+	RewardType_BlockReward RewardType = 0
+	RewardType_EpochReward RewardType = 1
+)
+
+var RewardType_name = map[int32]string{
+	0: "BlockReward",
+	1: "EpochReward",
+}
+
+var RewardType_value = map[string]int32{
+	"BlockReward": 0,
+	"EpochReward": 1,
+}
+
+func (x RewardType) String() string {
+	return proto.EnumName(RewardType_name, int32(x))
+}
+
+func (RewardType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{1}
+}
+
+type Transfer struct {
+	// used by state-based model
+	Amount               string   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Recipient            string   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Transfer) Reset()         { *m = Transfer{} }
+func (m *Transfer) String() string { return proto.CompactTextString(m) }
+func (*Transfer) ProtoMessage()    {}
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{0}
+}
+
+func (m *Transfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Transfer.Unmarshal(m, b)
+}
+func (m *Transfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Transfer.Marshal(b, m, deterministic)
+}
+func (m *Transfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transfer.Merge(m, src)
+}
+func (m *Transfer) XXX_Size() int {
+	return xxx_messageInfo_Transfer.Size(m)
+}
+func (m *Transfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transfer proto.InternalMessageInfo
+
+func (m *Transfer) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *Transfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Transfer) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// Candidates and list of candidates
+type Candidate struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Votes                []byte   `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	PubKey               []byte   `protobuf:"bytes,3,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	RewardAddress        string   `protobuf:"bytes,4,opt,name=rewardAddress,proto3" json:"rewardAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Candidate) Reset()         { *m = Candidate{} }
+func (m *Candidate) String() string { return proto.CompactTextString(m) }
+func (*Candidate) ProtoMessage()    {}
+func (*Candidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{1}
+}
+
+func (m *Candidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Candidate.Unmarshal(m, b)
+}
+func (m *Candidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Candidate.Marshal(b, m, deterministic)
+}
+func (m *Candidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candidate.Merge(m, src)
+}
+func (m *Candidate) XXX_Size() int {
+	return xxx_messageInfo_Candidate.Size(m)
+}
+func (m *Candidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candidate proto.InternalMessageInfo
+
+func (m *Candidate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Candidate) GetVotes() []byte {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *Candidate) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *Candidate) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+type CandidateList struct {
+	Candidates           []*Candidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CandidateList) Reset()         { *m = CandidateList{} }
+func (m *CandidateList) String() string { return proto.CompactTextString(m) }
+func (*CandidateList) ProtoMessage()    {}
+func (*CandidateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{2}
+}
+
+func (m *CandidateList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateList.Unmarshal(m, b)
+}
+func (m *CandidateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateList.Marshal(b, m, deterministic)
+}
+func (m *CandidateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateList.Merge(m, src)
+}
+func (m *CandidateList) XXX_Size() int {
+	return xxx_messageInfo_CandidateList.Size(m)
+}
+func (m *CandidateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateList proto.InternalMessageInfo
+
+func (m *CandidateList) GetCandidates() []*Candidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+type PutPollResult struct {
+	Height               uint64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Candidates           *CandidateList `protobuf:"bytes,2,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PutPollResult) Reset()         { *m = PutPollResult{} }
+func (m *PutPollResult) String() string { return proto.CompactTextString(m) }
+func (*PutPollResult) ProtoMessage()    {}
+func (*PutPollResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{3}
+}
+
+func (m *PutPollResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutPollResult.Unmarshal(m, b)
+}
+func (m *PutPollResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutPollResult.Marshal(b, m, deterministic)
+}
+func (m *PutPollResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutPollResult.Merge(m, src)
+}
+func (m *PutPollResult) XXX_Size() int {
+	return xxx_messageInfo_PutPollResult.Size(m)
+}
+func (m *PutPollResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutPollResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutPollResult proto.InternalMessageInfo
+
+func (m *PutPollResult) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PutPollResult) GetCandidates() *CandidateList {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+type Execution struct {
+	Amount               string   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Contract             string   `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Execution) Reset()         { *m = Execution{} }
+func (m *Execution) String() string { return proto.CompactTextString(m) }
+func (*Execution) ProtoMessage()    {}
+func (*Execution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{4}
+}
+
+func (m *Execution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Execution.Unmarshal(m, b)
+}
+func (m *Execution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Execution.Marshal(b, m, deterministic)
+}
+func (m *Execution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Execution.Merge(m, src)
+}
+func (m *Execution) XXX_Size() int {
+	return xxx_messageInfo_Execution.Size(m)
+}
+func (m *Execution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Execution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Execution proto.InternalMessageInfo
+
+func (m *Execution) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *Execution) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *Execution) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type StartSubChain struct {
+	// TODO: chainID chould be assigned by system and returned via a receipt
+	ChainID              uint32   `protobuf:"varint,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	SecurityDeposit      string   `protobuf:"bytes,2,opt,name=securityDeposit,proto3" json:"securityDeposit,omitempty"`
+	OperationDeposit     string   `protobuf:"bytes,3,opt,name=operationDeposit,proto3" json:"operationDeposit,omitempty"`
+	StartHeight          uint64   `protobuf:"varint,4,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	ParentHeightOffset   uint64   `protobuf:"varint,5,opt,name=parentHeightOffset,proto3" json:"parentHeightOffset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartSubChain) Reset()         { *m = StartSubChain{} }
+func (m *StartSubChain) String() string { return proto.CompactTextString(m) }
+func (*StartSubChain) ProtoMessage()    {}
+func (*StartSubChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{5}
+}
+
+func (m *StartSubChain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartSubChain.Unmarshal(m, b)
+}
+func (m *StartSubChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartSubChain.Marshal(b, m, deterministic)
+}
+func (m *StartSubChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartSubChain.Merge(m, src)
+}
+func (m *StartSubChain) XXX_Size() int {
+	return xxx_messageInfo_StartSubChain.Size(m)
+}
+func (m *StartSubChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartSubChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartSubChain proto.InternalMessageInfo
+
+func (m *StartSubChain) GetChainID() uint32 {
+	if m != nil {
+		return m.ChainID
+	}
+	return 0
+}
+
+func (m *StartSubChain) GetSecurityDeposit() string {
+	if m != nil {
+		return m.SecurityDeposit
+	}
+	return ""
+}
+
+func (m *StartSubChain) GetOperationDeposit() string {
+	if m != nil {
+		return m.OperationDeposit
+	}
+	return ""
+}
+
+func (m *StartSubChain) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *StartSubChain) GetParentHeightOffset() uint64 {
+	if m != nil {
+		return m.ParentHeightOffset
+	}
+	return 0
+}
+
+type StopSubChain struct {
+	ChainID              uint32   `protobuf:"varint,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	StopHeight           uint64   `protobuf:"varint,2,opt,name=stopHeight,proto3" json:"stopHeight,omitempty"`
+	SubChainAddress      string   `protobuf:"bytes,3,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopSubChain) Reset()         { *m = StopSubChain{} }
+func (m *StopSubChain) String() string { return proto.CompactTextString(m) }
+func (*StopSubChain) ProtoMessage()    {}
+func (*StopSubChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{6}
+}
+
+func (m *StopSubChain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopSubChain.Unmarshal(m, b)
+}
+func (m *StopSubChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopSubChain.Marshal(b, m, deterministic)
+}
+func (m *StopSubChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopSubChain.Merge(m, src)
+}
+func (m *StopSubChain) XXX_Size() int {
+	return xxx_messageInfo_StopSubChain.Size(m)
+}
+func (m *StopSubChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopSubChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopSubChain proto.InternalMessageInfo
+
+func (m *StopSubChain) GetChainID() uint32 {
+	if m != nil {
+		return m.ChainID
+	}
+	return 0
+}
+
+func (m *StopSubChain) GetStopHeight() uint64 {
+	if m != nil {
+		return m.StopHeight
+	}
+	return 0
+}
+
+func (m *StopSubChain) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+type MerkleRoot struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MerkleRoot) Reset()         { *m = MerkleRoot{} }
+func (m *MerkleRoot) String() string { return proto.CompactTextString(m) }
+func (*MerkleRoot) ProtoMessage()    {}
+func (*MerkleRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{7}
+}
+
+func (m *MerkleRoot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerkleRoot.Unmarshal(m, b)
+}
+func (m *MerkleRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerkleRoot.Marshal(b, m, deterministic)
+}
+func (m *MerkleRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleRoot.Merge(m, src)
+}
+func (m *MerkleRoot) XXX_Size() int {
+	return xxx_messageInfo_MerkleRoot.Size(m)
+}
+func (m *MerkleRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleRoot proto.InternalMessageInfo
+
+func (m *MerkleRoot) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MerkleRoot) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type PutBlock struct {
+	SubChainAddress      string        `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	Height               uint64        `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Roots                []*MerkleRoot `protobuf:"bytes,3,rep,name=roots,proto3" json:"roots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PutBlock) Reset()         { *m = PutBlock{} }
+func (m *PutBlock) String() string { return proto.CompactTextString(m) }
+func (*PutBlock) ProtoMessage()    {}
+func (*PutBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{8}
+}
+
+func (m *PutBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutBlock.Unmarshal(m, b)
+}
+func (m *PutBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutBlock.Marshal(b, m, deterministic)
+}
+func (m *PutBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutBlock.Merge(m, src)
+}
+func (m *PutBlock) XXX_Size() int {
+	return xxx_messageInfo_PutBlock.Size(m)
+}
+func (m *PutBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutBlock proto.InternalMessageInfo
+
+func (m *PutBlock) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PutBlock) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PutBlock) GetRoots() []*MerkleRoot {
+	if m != nil {
+		return m.Roots
+	}
+	return nil
+}
+
+type CreateDeposit struct {
+	ChainID              uint32   `protobuf:"varint,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Recipient            string   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateDeposit) Reset()         { *m = CreateDeposit{} }
+func (m *CreateDeposit) String() string { return proto.CompactTextString(m) }
+func (*CreateDeposit) ProtoMessage()    {}
+func (*CreateDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{9}
+}
+
+func (m *CreateDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeposit.Unmarshal(m, b)
+}
+func (m *CreateDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeposit.Marshal(b, m, deterministic)
+}
+func (m *CreateDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeposit.Merge(m, src)
+}
+func (m *CreateDeposit) XXX_Size() int {
+	return xxx_messageInfo_CreateDeposit.Size(m)
+}
+func (m *CreateDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeposit proto.InternalMessageInfo
+
+func (m *CreateDeposit) GetChainID() uint32 {
+	if m != nil {
+		return m.ChainID
+	}
+	return 0
+}
+
+func (m *CreateDeposit) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *CreateDeposit) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type SettleDeposit struct {
+	Amount               string   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Recipient            string   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Index                uint64   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SettleDeposit) Reset()         { *m = SettleDeposit{} }
+func (m *SettleDeposit) String() string { return proto.CompactTextString(m) }
+func (*SettleDeposit) ProtoMessage()    {}
+func (*SettleDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{10}
+}
+
+func (m *SettleDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettleDeposit.Unmarshal(m, b)
+}
+func (m *SettleDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettleDeposit.Marshal(b, m, deterministic)
+}
+func (m *SettleDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleDeposit.Merge(m, src)
+}
+func (m *SettleDeposit) XXX_Size() int {
+	return xxx_messageInfo_SettleDeposit.Size(m)
+}
+func (m *SettleDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleDeposit proto.InternalMessageInfo
+
+func (m *SettleDeposit) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *SettleDeposit) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *SettleDeposit) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// plum main chain APIs
+type CreatePlumChain struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreatePlumChain) Reset()         { *m = CreatePlumChain{} }
+func (m *CreatePlumChain) String() string { return proto.CompactTextString(m) }
+func (*CreatePlumChain) ProtoMessage()    {}
+func (*CreatePlumChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{11}
+}
+
+func (m *CreatePlumChain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePlumChain.Unmarshal(m, b)
+}
+func (m *CreatePlumChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePlumChain.Marshal(b, m, deterministic)
+}
+func (m *CreatePlumChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePlumChain.Merge(m, src)
+}
+func (m *CreatePlumChain) XXX_Size() int {
+	return xxx_messageInfo_CreatePlumChain.Size(m)
+}
+func (m *CreatePlumChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePlumChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePlumChain proto.InternalMessageInfo
+
+type TerminatePlumChain struct {
+	SubChainAddress      string   `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TerminatePlumChain) Reset()         { *m = TerminatePlumChain{} }
+func (m *TerminatePlumChain) String() string { return proto.CompactTextString(m) }
+func (*TerminatePlumChain) ProtoMessage()    {}
+func (*TerminatePlumChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{12}
+}
+
+func (m *TerminatePlumChain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TerminatePlumChain.Unmarshal(m, b)
+}
+func (m *TerminatePlumChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TerminatePlumChain.Marshal(b, m, deterministic)
+}
+func (m *TerminatePlumChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminatePlumChain.Merge(m, src)
+}
+func (m *TerminatePlumChain) XXX_Size() int {
+	return xxx_messageInfo_TerminatePlumChain.Size(m)
+}
+func (m *TerminatePlumChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminatePlumChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminatePlumChain proto.InternalMessageInfo
+
+func (m *TerminatePlumChain) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+type PlumPutBlock struct {
+	SubChainAddress      string            `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	Height               uint64            `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Roots                map[string][]byte `protobuf:"bytes,3,rep,name=roots,proto3" json:"roots,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PlumPutBlock) Reset()         { *m = PlumPutBlock{} }
+func (m *PlumPutBlock) String() string { return proto.CompactTextString(m) }
+func (*PlumPutBlock) ProtoMessage()    {}
+func (*PlumPutBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{13}
+}
+
+func (m *PlumPutBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumPutBlock.Unmarshal(m, b)
+}
+func (m *PlumPutBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumPutBlock.Marshal(b, m, deterministic)
+}
+func (m *PlumPutBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumPutBlock.Merge(m, src)
+}
+func (m *PlumPutBlock) XXX_Size() int {
+	return xxx_messageInfo_PlumPutBlock.Size(m)
+}
+func (m *PlumPutBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumPutBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumPutBlock proto.InternalMessageInfo
+
+func (m *PlumPutBlock) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PlumPutBlock) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PlumPutBlock) GetRoots() map[string][]byte {
+	if m != nil {
+		return m.Roots
+	}
+	return nil
+}
+
+type PlumCreateDeposit struct {
+	SubChainAddress      string   `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Recipient            string   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlumCreateDeposit) Reset()         { *m = PlumCreateDeposit{} }
+func (m *PlumCreateDeposit) String() string { return proto.CompactTextString(m) }
+func (*PlumCreateDeposit) ProtoMessage()    {}
+func (*PlumCreateDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{14}
+}
+
+func (m *PlumCreateDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumCreateDeposit.Unmarshal(m, b)
+}
+func (m *PlumCreateDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumCreateDeposit.Marshal(b, m, deterministic)
+}
+func (m *PlumCreateDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumCreateDeposit.Merge(m, src)
+}
+func (m *PlumCreateDeposit) XXX_Size() int {
+	return xxx_messageInfo_PlumCreateDeposit.Size(m)
+}
+func (m *PlumCreateDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumCreateDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumCreateDeposit proto.InternalMessageInfo
+
+func (m *PlumCreateDeposit) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PlumCreateDeposit) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *PlumCreateDeposit) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type PlumStartExit struct {
+	SubChainAddress             string   `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	PreviousTransfer            []byte   `protobuf:"bytes,2,opt,name=previousTransfer,proto3" json:"previousTransfer,omitempty"`
+	PreviousTransferBlockProof  []byte   `protobuf:"bytes,3,opt,name=previousTransferBlockProof,proto3" json:"previousTransferBlockProof,omitempty"`
+	PreviousTransferBlockHeight uint64   `protobuf:"varint,4,opt,name=previousTransferBlockHeight,proto3" json:"previousTransferBlockHeight,omitempty"`
+	ExitTransfer                []byte   `protobuf:"bytes,5,opt,name=exitTransfer,proto3" json:"exitTransfer,omitempty"`
+	ExitTransferBlockProof      []byte   `protobuf:"bytes,6,opt,name=exitTransferBlockProof,proto3" json:"exitTransferBlockProof,omitempty"`
+	ExitTransferBlockHeight     uint64   `protobuf:"varint,7,opt,name=exitTransferBlockHeight,proto3" json:"exitTransferBlockHeight,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
+}
+
+func (m *PlumStartExit) Reset()         { *m = PlumStartExit{} }
+func (m *PlumStartExit) String() string { return proto.CompactTextString(m) }
+func (*PlumStartExit) ProtoMessage()    {}
+func (*PlumStartExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{15}
+}
+
+func (m *PlumStartExit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumStartExit.Unmarshal(m, b)
+}
+func (m *PlumStartExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumStartExit.Marshal(b, m, deterministic)
+}
+func (m *PlumStartExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumStartExit.Merge(m, src)
+}
+func (m *PlumStartExit) XXX_Size() int {
+	return xxx_messageInfo_PlumStartExit.Size(m)
+}
+func (m *PlumStartExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumStartExit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumStartExit proto.InternalMessageInfo
+
+func (m *PlumStartExit) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PlumStartExit) GetPreviousTransfer() []byte {
+	if m != nil {
+		return m.PreviousTransfer
+	}
+	return nil
+}
+
+func (m *PlumStartExit) GetPreviousTransferBlockProof() []byte {
+	if m != nil {
+		return m.PreviousTransferBlockProof
+	}
+	return nil
+}
+
+func (m *PlumStartExit) GetPreviousTransferBlockHeight() uint64 {
+	if m != nil {
+		return m.PreviousTransferBlockHeight
+	}
+	return 0
+}
+
+func (m *PlumStartExit) GetExitTransfer() []byte {
+	if m != nil {
+		return m.ExitTransfer
+	}
+	return nil
+}
+
+func (m *PlumStartExit) GetExitTransferBlockProof() []byte {
+	if m != nil {
+		return m.ExitTransferBlockProof
+	}
+	return nil
+}
+
+func (m *PlumStartExit) GetExitTransferBlockHeight() uint64 {
+	if m != nil {
+		return m.ExitTransferBlockHeight
+	}
+	return 0
+}
+
+type PlumChallengeExit struct {
+	SubChainAddress              string   `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	CoinID                       uint64   `protobuf:"varint,2,opt,name=coinID,proto3" json:"coinID,omitempty"`
+	ChallengeTransfer            []byte   `protobuf:"bytes,3,opt,name=challengeTransfer,proto3" json:"challengeTransfer,omitempty"`
+	ChallengeTransferBlockProof  []byte   `protobuf:"bytes,4,opt,name=challengeTransferBlockProof,proto3" json:"challengeTransferBlockProof,omitempty"`
+	ChallengeTransferBlockHeight uint64   `protobuf:"varint,5,opt,name=challengeTransferBlockHeight,proto3" json:"challengeTransferBlockHeight,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *PlumChallengeExit) Reset()         { *m = PlumChallengeExit{} }
+func (m *PlumChallengeExit) String() string { return proto.CompactTextString(m) }
+func (*PlumChallengeExit) ProtoMessage()    {}
+func (*PlumChallengeExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{16}
+}
+
+func (m *PlumChallengeExit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumChallengeExit.Unmarshal(m, b)
+}
+func (m *PlumChallengeExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumChallengeExit.Marshal(b, m, deterministic)
+}
+func (m *PlumChallengeExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumChallengeExit.Merge(m, src)
+}
+func (m *PlumChallengeExit) XXX_Size() int {
+	return xxx_messageInfo_PlumChallengeExit.Size(m)
+}
+func (m *PlumChallengeExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumChallengeExit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumChallengeExit proto.InternalMessageInfo
+
+func (m *PlumChallengeExit) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PlumChallengeExit) GetCoinID() uint64 {
+	if m != nil {
+		return m.CoinID
+	}
+	return 0
+}
+
+func (m *PlumChallengeExit) GetChallengeTransfer() []byte {
+	if m != nil {
+		return m.ChallengeTransfer
+	}
+	return nil
+}
+
+func (m *PlumChallengeExit) GetChallengeTransferBlockProof() []byte {
+	if m != nil {
+		return m.ChallengeTransferBlockProof
+	}
+	return nil
+}
+
+func (m *PlumChallengeExit) GetChallengeTransferBlockHeight() uint64 {
+	if m != nil {
+		return m.ChallengeTransferBlockHeight
+	}
+	return 0
+}
+
+type PlumResponseChallengeExit struct {
+	SubChainAddress             string   `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	CoinID                      uint64   `protobuf:"varint,2,opt,name=coinID,proto3" json:"coinID,omitempty"`
+	ChallengeTransfer           []byte   `protobuf:"bytes,3,opt,name=challengeTransfer,proto3" json:"challengeTransfer,omitempty"`
+	ResponseTransfer            []byte   `protobuf:"bytes,4,opt,name=responseTransfer,proto3" json:"responseTransfer,omitempty"`
+	ResponseTransferBlockProof  []byte   `protobuf:"bytes,5,opt,name=responseTransferBlockProof,proto3" json:"responseTransferBlockProof,omitempty"`
+	PreviousTransferBlockHeight uint64   `protobuf:"varint,6,opt,name=previousTransferBlockHeight,proto3" json:"previousTransferBlockHeight,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
+}
+
+func (m *PlumResponseChallengeExit) Reset()         { *m = PlumResponseChallengeExit{} }
+func (m *PlumResponseChallengeExit) String() string { return proto.CompactTextString(m) }
+func (*PlumResponseChallengeExit) ProtoMessage()    {}
+func (*PlumResponseChallengeExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{17}
+}
+
+func (m *PlumResponseChallengeExit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumResponseChallengeExit.Unmarshal(m, b)
+}
+func (m *PlumResponseChallengeExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumResponseChallengeExit.Marshal(b, m, deterministic)
+}
+func (m *PlumResponseChallengeExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumResponseChallengeExit.Merge(m, src)
+}
+func (m *PlumResponseChallengeExit) XXX_Size() int {
+	return xxx_messageInfo_PlumResponseChallengeExit.Size(m)
+}
+func (m *PlumResponseChallengeExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumResponseChallengeExit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumResponseChallengeExit proto.InternalMessageInfo
+
+func (m *PlumResponseChallengeExit) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PlumResponseChallengeExit) GetCoinID() uint64 {
+	if m != nil {
+		return m.CoinID
+	}
+	return 0
+}
+
+func (m *PlumResponseChallengeExit) GetChallengeTransfer() []byte {
+	if m != nil {
+		return m.ChallengeTransfer
+	}
+	return nil
+}
+
+func (m *PlumResponseChallengeExit) GetResponseTransfer() []byte {
+	if m != nil {
+		return m.ResponseTransfer
+	}
+	return nil
+}
+
+func (m *PlumResponseChallengeExit) GetResponseTransferBlockProof() []byte {
+	if m != nil {
+		return m.ResponseTransferBlockProof
+	}
+	return nil
+}
+
+func (m *PlumResponseChallengeExit) GetPreviousTransferBlockHeight() uint64 {
+	if m != nil {
+		return m.PreviousTransferBlockHeight
+	}
+	return 0
+}
+
+type PlumFinalizeExit struct {
+	SubChainAddress      string   `protobuf:"bytes,1,opt,name=subChainAddress,proto3" json:"subChainAddress,omitempty"`
+	CoinID               uint64   `protobuf:"varint,2,opt,name=coinID,proto3" json:"coinID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlumFinalizeExit) Reset()         { *m = PlumFinalizeExit{} }
+func (m *PlumFinalizeExit) String() string { return proto.CompactTextString(m) }
+func (*PlumFinalizeExit) ProtoMessage()    {}
+func (*PlumFinalizeExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{18}
+}
+
+func (m *PlumFinalizeExit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumFinalizeExit.Unmarshal(m, b)
+}
+func (m *PlumFinalizeExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumFinalizeExit.Marshal(b, m, deterministic)
+}
+func (m *PlumFinalizeExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumFinalizeExit.Merge(m, src)
+}
+func (m *PlumFinalizeExit) XXX_Size() int {
+	return xxx_messageInfo_PlumFinalizeExit.Size(m)
+}
+func (m *PlumFinalizeExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumFinalizeExit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumFinalizeExit proto.InternalMessageInfo
+
+func (m *PlumFinalizeExit) GetSubChainAddress() string {
+	if m != nil {
+		return m.SubChainAddress
+	}
+	return ""
+}
+
+func (m *PlumFinalizeExit) GetCoinID() uint64 {
+	if m != nil {
+		return m.CoinID
+	}
+	return 0
+}
+
+// plum sub chain APIs
+type PlumSettleDeposit struct {
+	CoinID               uint64   `protobuf:"varint,1,opt,name=coinID,proto3" json:"coinID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlumSettleDeposit) Reset()         { *m = PlumSettleDeposit{} }
+func (m *PlumSettleDeposit) String() string { return proto.CompactTextString(m) }
+func (*PlumSettleDeposit) ProtoMessage()    {}
+func (*PlumSettleDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{19}
+}
+
+func (m *PlumSettleDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumSettleDeposit.Unmarshal(m, b)
+}
+func (m *PlumSettleDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumSettleDeposit.Marshal(b, m, deterministic)
+}
+func (m *PlumSettleDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumSettleDeposit.Merge(m, src)
+}
+func (m *PlumSettleDeposit) XXX_Size() int {
+	return xxx_messageInfo_PlumSettleDeposit.Size(m)
+}
+func (m *PlumSettleDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumSettleDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumSettleDeposit proto.InternalMessageInfo
+
+func (m *PlumSettleDeposit) GetCoinID() uint64 {
+	if m != nil {
+		return m.CoinID
+	}
+	return 0
+}
+
+type PlumTransfer struct {
+	CoinID               uint64   `protobuf:"varint,1,opt,name=coinID,proto3" json:"coinID,omitempty"`
+	Denomination         []byte   `protobuf:"bytes,2,opt,name=denomination,proto3" json:"denomination,omitempty"`
+	Owner                string   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Recipient            string   `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlumTransfer) Reset()         { *m = PlumTransfer{} }
+func (m *PlumTransfer) String() string { return proto.CompactTextString(m) }
+func (*PlumTransfer) ProtoMessage()    {}
+func (*PlumTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{20}
+}
+
+func (m *PlumTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlumTransfer.Unmarshal(m, b)
+}
+func (m *PlumTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlumTransfer.Marshal(b, m, deterministic)
+}
+func (m *PlumTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlumTransfer.Merge(m, src)
+}
+func (m *PlumTransfer) XXX_Size() int {
+	return xxx_messageInfo_PlumTransfer.Size(m)
+}
+func (m *PlumTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlumTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlumTransfer proto.InternalMessageInfo
+
+func (m *PlumTransfer) GetCoinID() uint64 {
+	if m != nil {
+		return m.CoinID
+	}
+	return 0
+}
+
+func (m *PlumTransfer) GetDenomination() []byte {
+	if m != nil {
+		return m.Denomination
+	}
+	return nil
+}
+
+func (m *PlumTransfer) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PlumTransfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type ActionCore struct {
+	Version  uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Nonce    uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	GasPrice string `protobuf:"bytes,4,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+	// the chain ID an action encoded in Ethereum RLP is signed for, which is 0 if it isn't replay-protected
+	ChainID uint32 `protobuf:"varint,5,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	// Types that are valid to be assigned to Action:
+	//	*ActionCore_Transfer
+	//	*ActionCore_Execution
+	//	*ActionCore_StartSubChain
+	//	*ActionCore_StopSubChain
+	//	*ActionCore_PutBlock
+	//	*ActionCore_CreateDeposit
+	//	*ActionCore_SettleDeposit
+	//	*ActionCore_CreatePlumChain
+	//	*ActionCore_TerminatePlumChain
+	//	*ActionCore_PlumPutBlock
+	//	*ActionCore_PlumCreateDeposit
+	//	*ActionCore_PlumStartExit
+	//	*ActionCore_PlumChallengeExit
+	//	*ActionCore_PlumResponseChallengeExit
+	//	*ActionCore_PlumFinalizeExit
+	//	*ActionCore_PlumSettleDeposit
+	//	*ActionCore_PlumTransfer
+	//	*ActionCore_DepositToRewardingFund
+	//	*ActionCore_ClaimFromRewardingFund
+	//	*ActionCore_GrantReward
+	//	*ActionCore_StakeCreate
+	//	*ActionCore_StakeUnstake
+	//	*ActionCore_StakeWithdraw
+	//	*ActionCore_StakeAddDeposit
+	//	*ActionCore_StakeRestake
+	//	*ActionCore_StakeChangeCandidate
+	//	*ActionCore_StakeTransferOwnership
+	//	*ActionCore_CandidateRegister
+	//	*ActionCore_CandidateUnregister
+	//	*ActionCore_PutPollResult
+	//	*ActionCore_BatchTransfer
+	//	*ActionCore_SetMultisigPolicy
+	//	*ActionCore_ScheduleAction
+	//	*ActionCore_CancelScheduledAction
+	//	*ActionCore_ExecuteScheduledActions
+	Action               isActionCore_Action `protobuf_oneof:"action"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ActionCore) Reset()         { *m = ActionCore{} }
+func (m *ActionCore) String() string { return proto.CompactTextString(m) }
+func (*ActionCore) ProtoMessage()    {}
+func (*ActionCore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{21}
+}
+
+func (m *ActionCore) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionCore.Unmarshal(m, b)
+}
+func (m *ActionCore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionCore.Marshal(b, m, deterministic)
+}
+func (m *ActionCore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionCore.Merge(m, src)
+}
+func (m *ActionCore) XXX_Size() int {
+	return xxx_messageInfo_ActionCore.Size(m)
+}
+func (m *ActionCore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionCore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionCore proto.InternalMessageInfo
+
+func (m *ActionCore) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ActionCore) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ActionCore) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *ActionCore) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *ActionCore) GetChainID() uint32 {
+	if m != nil {
+		return m.ChainID
+	}
+	return 0
+}
+
+type isActionCore_Action interface {
+	isActionCore_Action()
+}
+
+type ActionCore_Transfer struct {
+	Transfer *Transfer `protobuf:"bytes,10,opt,name=transfer,proto3,oneof"`
+}
+
+type ActionCore_Execution struct {
+	Execution *Execution `protobuf:"bytes,12,opt,name=execution,proto3,oneof"`
+}
+
+type ActionCore_StartSubChain struct {
+	StartSubChain *StartSubChain `protobuf:"bytes,13,opt,name=startSubChain,proto3,oneof"`
+}
+
+type ActionCore_StopSubChain struct {
+	StopSubChain *StopSubChain `protobuf:"bytes,14,opt,name=stopSubChain,proto3,oneof"`
+}
+
+type ActionCore_PutBlock struct {
+	PutBlock *PutBlock `protobuf:"bytes,15,opt,name=putBlock,proto3,oneof"`
+}
+
+type ActionCore_CreateDeposit struct {
+	CreateDeposit *CreateDeposit `protobuf:"bytes,16,opt,name=createDeposit,proto3,oneof"`
+}
+
+type ActionCore_SettleDeposit struct {
+	SettleDeposit *SettleDeposit `protobuf:"bytes,17,opt,name=settleDeposit,proto3,oneof"`
+}
+
+type ActionCore_CreatePlumChain struct {
+	CreatePlumChain *CreatePlumChain `protobuf:"bytes,18,opt,name=createPlumChain,proto3,oneof"`
+}
+
+type ActionCore_TerminatePlumChain struct {
+	TerminatePlumChain *TerminatePlumChain `protobuf:"bytes,19,opt,name=terminatePlumChain,proto3,oneof"`
+}
+
+type ActionCore_PlumPutBlock struct {
+	PlumPutBlock *PlumPutBlock `protobuf:"bytes,20,opt,name=plumPutBlock,proto3,oneof"`
+}
+
+type ActionCore_PlumCreateDeposit struct {
+	PlumCreateDeposit *PlumCreateDeposit `protobuf:"bytes,21,opt,name=plumCreateDeposit,proto3,oneof"`
+}
+
+type ActionCore_PlumStartExit struct {
+	PlumStartExit *PlumStartExit `protobuf:"bytes,22,opt,name=plumStartExit,proto3,oneof"`
+}
+
+type ActionCore_PlumChallengeExit struct {
+	PlumChallengeExit *PlumChallengeExit `protobuf:"bytes,23,opt,name=plumChallengeExit,proto3,oneof"`
+}
+
+type ActionCore_PlumResponseChallengeExit struct {
+	PlumResponseChallengeExit *PlumResponseChallengeExit `protobuf:"bytes,24,opt,name=plumResponseChallengeExit,proto3,oneof"`
+}
+
+type ActionCore_PlumFinalizeExit struct {
+	PlumFinalizeExit *PlumFinalizeExit `protobuf:"bytes,25,opt,name=plumFinalizeExit,proto3,oneof"`
+}
+
+type ActionCore_PlumSettleDeposit struct {
+	PlumSettleDeposit *PlumSettleDeposit `protobuf:"bytes,26,opt,name=plumSettleDeposit,proto3,oneof"`
+}
+
+type ActionCore_PlumTransfer struct {
+	PlumTransfer *PlumTransfer `protobuf:"bytes,27,opt,name=plumTransfer,proto3,oneof"`
+}
+
+type ActionCore_DepositToRewardingFund struct {
+	DepositToRewardingFund *DepositToRewardingFund `protobuf:"bytes,30,opt,name=depositToRewardingFund,proto3,oneof"`
+}
+
+type ActionCore_ClaimFromRewardingFund struct {
+	ClaimFromRewardingFund *ClaimFromRewardingFund `protobuf:"bytes,31,opt,name=claimFromRewardingFund,proto3,oneof"`
+}
+
+type ActionCore_GrantReward struct {
+	GrantReward *GrantReward `protobuf:"bytes,32,opt,name=grantReward,proto3,oneof"`
+}
+
+type ActionCore_StakeCreate struct {
+	StakeCreate *StakeCreate `protobuf:"bytes,40,opt,name=stakeCreate,proto3,oneof"`
+}
+
+type ActionCore_StakeUnstake struct {
+	StakeUnstake *StakeReclaim `protobuf:"bytes,41,opt,name=stakeUnstake,proto3,oneof"`
+}
+
+type ActionCore_StakeWithdraw struct {
+	StakeWithdraw *StakeReclaim `protobuf:"bytes,42,opt,name=stakeWithdraw,proto3,oneof"`
+}
+
+type ActionCore_StakeAddDeposit struct {
+	StakeAddDeposit *StakeAddDeposit `protobuf:"bytes,43,opt,name=stakeAddDeposit,proto3,oneof"`
+}
+
+type ActionCore_StakeRestake struct {
+	StakeRestake *StakeRestake `protobuf:"bytes,44,opt,name=stakeRestake,proto3,oneof"`
+}
+
+type ActionCore_StakeChangeCandidate struct {
+	StakeChangeCandidate *StakeChangeCandidate `protobuf:"bytes,45,opt,name=stakeChangeCandidate,proto3,oneof"`
+}
+
+type ActionCore_StakeTransferOwnership struct {
+	StakeTransferOwnership *StakeTransferOwnership `protobuf:"bytes,46,opt,name=stakeTransferOwnership,proto3,oneof"`
+}
+
+type ActionCore_CandidateRegister struct {
+	CandidateRegister *CandidateRegister `protobuf:"bytes,47,opt,name=candidateRegister,proto3,oneof"`
+}
+
+type ActionCore_CandidateUnregister struct {
+	CandidateUnregister *CandidateUnregister `protobuf:"bytes,48,opt,name=candidateUnregister,proto3,oneof"`
+}
+
+type ActionCore_PutPollResult struct {
+	PutPollResult *PutPollResult `protobuf:"bytes,50,opt,name=putPollResult,proto3,oneof"`
+}
+
+type ActionCore_BatchTransfer struct {
+	BatchTransfer *BatchTransfer `protobuf:"bytes,60,opt,name=batchTransfer,proto3,oneof"`
+}
+
+type ActionCore_SetMultisigPolicy struct {
+	SetMultisigPolicy *SetMultisigPolicy `protobuf:"bytes,61,opt,name=setMultisigPolicy,proto3,oneof"`
+}
+
+type ActionCore_ScheduleAction struct {
+	ScheduleAction *ScheduleAction `protobuf:"bytes,62,opt,name=scheduleAction,proto3,oneof"`
+}
+
+type ActionCore_CancelScheduledAction struct {
+	CancelScheduledAction *CancelScheduledAction `protobuf:"bytes,63,opt,name=cancelScheduledAction,proto3,oneof"`
+}
+
+type ActionCore_ExecuteScheduledActions struct {
+	ExecuteScheduledActions *ExecuteScheduledActions `protobuf:"bytes,64,opt,name=executeScheduledActions,proto3,oneof"`
+}
+
+func (*ActionCore_Transfer) isActionCore_Action() {}
+
+func (*ActionCore_Execution) isActionCore_Action() {}
+
+func (*ActionCore_StartSubChain) isActionCore_Action() {}
+
+func (*ActionCore_StopSubChain) isActionCore_Action() {}
+
+func (*ActionCore_PutBlock) isActionCore_Action() {}
+
+func (*ActionCore_CreateDeposit) isActionCore_Action() {}
+
+func (*ActionCore_SettleDeposit) isActionCore_Action() {}
+
+func (*ActionCore_CreatePlumChain) isActionCore_Action() {}
+
+func (*ActionCore_TerminatePlumChain) isActionCore_Action() {}
+
+func (*ActionCore_PlumPutBlock) isActionCore_Action() {}
+
+func (*ActionCore_PlumCreateDeposit) isActionCore_Action() {}
+
+func (*ActionCore_PlumStartExit) isActionCore_Action() {}
+
+func (*ActionCore_PlumChallengeExit) isActionCore_Action() {}
+
+func (*ActionCore_PlumResponseChallengeExit) isActionCore_Action() {}
+
+func (*ActionCore_PlumFinalizeExit) isActionCore_Action() {}
+
+func (*ActionCore_PlumSettleDeposit) isActionCore_Action() {}
+
+func (*ActionCore_PlumTransfer) isActionCore_Action() {}
+
+func (*ActionCore_DepositToRewardingFund) isActionCore_Action() {}
+
+func (*ActionCore_ClaimFromRewardingFund) isActionCore_Action() {}
+
+func (*ActionCore_GrantReward) isActionCore_Action() {}
+
+func (*ActionCore_StakeCreate) isActionCore_Action() {}
+
+func (*ActionCore_StakeUnstake) isActionCore_Action() {}
+
+func (*ActionCore_StakeWithdraw) isActionCore_Action() {}
+
+func (*ActionCore_StakeAddDeposit) isActionCore_Action() {}
+
+func (*ActionCore_StakeRestake) isActionCore_Action() {}
+
+func (*ActionCore_StakeChangeCandidate) isActionCore_Action() {}
+
+func (*ActionCore_StakeTransferOwnership) isActionCore_Action() {}
+
+func (*ActionCore_CandidateRegister) isActionCore_Action() {}
+
+func (*ActionCore_CandidateUnregister) isActionCore_Action() {}
+
+func (*ActionCore_PutPollResult) isActionCore_Action() {}
+
+func (*ActionCore_BatchTransfer) isActionCore_Action() {}
+
+func (*ActionCore_SetMultisigPolicy) isActionCore_Action() {}
+
+func (*ActionCore_ScheduleAction) isActionCore_Action() {}
+
+func (*ActionCore_CancelScheduledAction) isActionCore_Action() {}
+
+func (*ActionCore_ExecuteScheduledActions) isActionCore_Action() {}
+
+func (m *ActionCore) GetAction() isActionCore_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *ActionCore) GetTransfer() *Transfer {
+	if x, ok := m.GetAction().(*ActionCore_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (m *ActionCore) GetExecution() *Execution {
+	if x, ok := m.GetAction().(*ActionCore_Execution); ok {
+		return x.Execution
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStartSubChain() *StartSubChain {
+	if x, ok := m.GetAction().(*ActionCore_StartSubChain); ok {
+		return x.StartSubChain
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStopSubChain() *StopSubChain {
+	if x, ok := m.GetAction().(*ActionCore_StopSubChain); ok {
+		return x.StopSubChain
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPutBlock() *PutBlock {
+	if x, ok := m.GetAction().(*ActionCore_PutBlock); ok {
+		return x.PutBlock
+	}
+	return nil
+}
+
+func (m *ActionCore) GetCreateDeposit() *CreateDeposit {
+	if x, ok := m.GetAction().(*ActionCore_CreateDeposit); ok {
+		return x.CreateDeposit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetSettleDeposit() *SettleDeposit {
+	if x, ok := m.GetAction().(*ActionCore_SettleDeposit); ok {
+		return x.SettleDeposit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetCreatePlumChain() *CreatePlumChain {
+	if x, ok := m.GetAction().(*ActionCore_CreatePlumChain); ok {
+		return x.CreatePlumChain
+	}
+	return nil
+}
+
+func (m *ActionCore) GetTerminatePlumChain() *TerminatePlumChain {
+	if x, ok := m.GetAction().(*ActionCore_TerminatePlumChain); ok {
+		return x.TerminatePlumChain
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumPutBlock() *PlumPutBlock {
+	if x, ok := m.GetAction().(*ActionCore_PlumPutBlock); ok {
+		return x.PlumPutBlock
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumCreateDeposit() *PlumCreateDeposit {
+	if x, ok := m.GetAction().(*ActionCore_PlumCreateDeposit); ok {
+		return x.PlumCreateDeposit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumStartExit() *PlumStartExit {
+	if x, ok := m.GetAction().(*ActionCore_PlumStartExit); ok {
+		return x.PlumStartExit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumChallengeExit() *PlumChallengeExit {
+	if x, ok := m.GetAction().(*ActionCore_PlumChallengeExit); ok {
+		return x.PlumChallengeExit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumResponseChallengeExit() *PlumResponseChallengeExit {
+	if x, ok := m.GetAction().(*ActionCore_PlumResponseChallengeExit); ok {
+		return x.PlumResponseChallengeExit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumFinalizeExit() *PlumFinalizeExit {
+	if x, ok := m.GetAction().(*ActionCore_PlumFinalizeExit); ok {
+		return x.PlumFinalizeExit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumSettleDeposit() *PlumSettleDeposit {
+	if x, ok := m.GetAction().(*ActionCore_PlumSettleDeposit); ok {
+		return x.PlumSettleDeposit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPlumTransfer() *PlumTransfer {
+	if x, ok := m.GetAction().(*ActionCore_PlumTransfer); ok {
+		return x.PlumTransfer
+	}
+	return nil
+}
+
+func (m *ActionCore) GetDepositToRewardingFund() *DepositToRewardingFund {
+	if x, ok := m.GetAction().(*ActionCore_DepositToRewardingFund); ok {
+		return x.DepositToRewardingFund
+	}
+	return nil
+}
+
+func (m *ActionCore) GetClaimFromRewardingFund() *ClaimFromRewardingFund {
+	if x, ok := m.GetAction().(*ActionCore_ClaimFromRewardingFund); ok {
+		return x.ClaimFromRewardingFund
+	}
+	return nil
+}
+
+func (m *ActionCore) GetGrantReward() *GrantReward {
+	if x, ok := m.GetAction().(*ActionCore_GrantReward); ok {
+		return x.GrantReward
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeCreate() *StakeCreate {
+	if x, ok := m.GetAction().(*ActionCore_StakeCreate); ok {
+		return x.StakeCreate
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeUnstake() *StakeReclaim {
+	if x, ok := m.GetAction().(*ActionCore_StakeUnstake); ok {
+		return x.StakeUnstake
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeWithdraw() *StakeReclaim {
+	if x, ok := m.GetAction().(*ActionCore_StakeWithdraw); ok {
+		return x.StakeWithdraw
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeAddDeposit() *StakeAddDeposit {
+	if x, ok := m.GetAction().(*ActionCore_StakeAddDeposit); ok {
+		return x.StakeAddDeposit
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeRestake() *StakeRestake {
+	if x, ok := m.GetAction().(*ActionCore_StakeRestake); ok {
+		return x.StakeRestake
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeChangeCandidate() *StakeChangeCandidate {
+	if x, ok := m.GetAction().(*ActionCore_StakeChangeCandidate); ok {
+		return x.StakeChangeCandidate
+	}
+	return nil
+}
+
+func (m *ActionCore) GetStakeTransferOwnership() *StakeTransferOwnership {
+	if x, ok := m.GetAction().(*ActionCore_StakeTransferOwnership); ok {
+		return x.StakeTransferOwnership
+	}
+	return nil
+}
+
+func (m *ActionCore) GetCandidateRegister() *CandidateRegister {
+	if x, ok := m.GetAction().(*ActionCore_CandidateRegister); ok {
+		return x.CandidateRegister
+	}
+	return nil
+}
+
+func (m *ActionCore) GetCandidateUnregister() *CandidateUnregister {
+	if x, ok := m.GetAction().(*ActionCore_CandidateUnregister); ok {
+		return x.CandidateUnregister
+	}
+	return nil
+}
+
+func (m *ActionCore) GetPutPollResult() *PutPollResult {
+	if x, ok := m.GetAction().(*ActionCore_PutPollResult); ok {
+		return x.PutPollResult
+	}
+	return nil
+}
+
+func (m *ActionCore) GetBatchTransfer() *BatchTransfer {
+	if x, ok := m.GetAction().(*ActionCore_BatchTransfer); ok {
+		return x.BatchTransfer
+	}
+	return nil
+}
+
+func (m *ActionCore) GetSetMultisigPolicy() *SetMultisigPolicy {
+	if x, ok := m.GetAction().(*ActionCore_SetMultisigPolicy); ok {
+		return x.SetMultisigPolicy
+	}
+	return nil
+}
+
+func (m *ActionCore) GetScheduleAction() *ScheduleAction {
+	if x, ok := m.GetAction().(*ActionCore_ScheduleAction); ok {
+		return x.ScheduleAction
+	}
+	return nil
+}
+
+func (m *ActionCore) GetCancelScheduledAction() *CancelScheduledAction {
+	if x, ok := m.GetAction().(*ActionCore_CancelScheduledAction); ok {
+		return x.CancelScheduledAction
+	}
+	return nil
+}
+
+func (m *ActionCore) GetExecuteScheduledActions() *ExecuteScheduledActions {
+	if x, ok := m.GetAction().(*ActionCore_ExecuteScheduledActions); ok {
+		return x.ExecuteScheduledActions
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ActionCore) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ActionCore_Transfer)(nil),
+		(*ActionCore_Execution)(nil),
+		(*ActionCore_StartSubChain)(nil),
+		(*ActionCore_StopSubChain)(nil),
+		(*ActionCore_PutBlock)(nil),
+		(*ActionCore_CreateDeposit)(nil),
+		(*ActionCore_SettleDeposit)(nil),
+		(*ActionCore_CreatePlumChain)(nil),
+		(*ActionCore_TerminatePlumChain)(nil),
+		(*ActionCore_PlumPutBlock)(nil),
+		(*ActionCore_PlumCreateDeposit)(nil),
+		(*ActionCore_PlumStartExit)(nil),
+		(*ActionCore_PlumChallengeExit)(nil),
+		(*ActionCore_PlumResponseChallengeExit)(nil),
+		(*ActionCore_PlumFinalizeExit)(nil),
+		(*ActionCore_PlumSettleDeposit)(nil),
+		(*ActionCore_PlumTransfer)(nil),
+		(*ActionCore_DepositToRewardingFund)(nil),
+		(*ActionCore_ClaimFromRewardingFund)(nil),
+		(*ActionCore_GrantReward)(nil),
+		(*ActionCore_StakeCreate)(nil),
+		(*ActionCore_StakeUnstake)(nil),
+		(*ActionCore_StakeWithdraw)(nil),
+		(*ActionCore_StakeAddDeposit)(nil),
+		(*ActionCore_StakeRestake)(nil),
+		(*ActionCore_StakeChangeCandidate)(nil),
+		(*ActionCore_StakeTransferOwnership)(nil),
+		(*ActionCore_CandidateRegister)(nil),
+		(*ActionCore_CandidateUnregister)(nil),
+		(*ActionCore_PutPollResult)(nil),
+		(*ActionCore_BatchTransfer)(nil),
+		(*ActionCore_SetMultisigPolicy)(nil),
+		(*ActionCore_ScheduleAction)(nil),
+		(*ActionCore_CancelScheduledAction)(nil),
+		(*ActionCore_ExecuteScheduledActions)(nil),
+	}
+}
+
+type Action struct {
+	Core         *ActionCore `protobuf:"bytes,1,opt,name=core,proto3" json:"core,omitempty"`
+	SenderPubKey []byte      `protobuf:"bytes,2,opt,name=senderPubKey,proto3" json:"senderPubKey,omitempty"`
+	Signature    []byte      `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// cosignatures replace the signature for an account under a multisig policy
+	Cosignatures []*Cosignature `protobuf:"bytes,4,rep,name=cosignatures,proto3" json:"cosignatures,omitempty"`
+	// the account paying the gas of a sponsored action
+	SponsorPubKey    []byte `protobuf:"bytes,5,opt,name=sponsorPubKey,proto3" json:"sponsorPubKey,omitempty"`
+	SponsorSignature []byte `protobuf:"bytes,6,opt,name=sponsorSignature,proto3" json:"sponsorSignature,omitempty"`
+	// the encoding the signature is computed over
+	Encoding Encoding `protobuf:"varint,7,opt,name=encoding,proto3,enum=iotextypes.Encoding" json:"encoding,omitempty"`
+	// sponsorCosignatures replace the signature of the sponsor under a multisig policy
+	SponsorCosignatures  []*Cosignature `protobuf:"bytes,8,rep,name=sponsorCosignatures,proto3" json:"sponsorCosignatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Action) Reset()         { *m = Action{} }
+func (m *Action) String() string { return proto.CompactTextString(m) }
+func (*Action) ProtoMessage()    {}
+func (*Action) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{22}
+}
+
+func (m *Action) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Action.Unmarshal(m, b)
+}
+func (m *Action) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Action.Marshal(b, m, deterministic)
+}
+func (m *Action) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Action.Merge(m, src)
+}
+func (m *Action) XXX_Size() int {
+	return xxx_messageInfo_Action.Size(m)
+}
+func (m *Action) XXX_DiscardUnknown() {
+	xxx_messageInfo_Action.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Action proto.InternalMessageInfo
+
+func (m *Action) GetCore() *ActionCore {
+	if m != nil {
+		return m.Core
+	}
+	return nil
+}
+
+func (m *Action) GetSenderPubKey() []byte {
+	if m != nil {
+		return m.SenderPubKey
+	}
+	return nil
+}
+
+func (m *Action) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *Action) GetCosignatures() []*Cosignature {
+	if m != nil {
+		return m.Cosignatures
+	}
+	return nil
+}
+
+func (m *Action) GetSponsorPubKey() []byte {
+	if m != nil {
+		return m.SponsorPubKey
+	}
+	return nil
+}
+
+func (m *Action) GetSponsorSignature() []byte {
+	if m != nil {
+		return m.SponsorSignature
+	}
+	return nil
+}
+
+func (m *Action) GetEncoding() Encoding {
+	if m != nil {
+		return m.Encoding
+	}
+	return Encoding_IOTEX_PROTOBUF
+}
+
+func (m *Action) GetSponsorCosignatures() []*Cosignature {
+	if m != nil {
+		return m.SponsorCosignatures
+	}
+	return nil
+}
+
+// Cosignature is the signature of the action core by one of the keys of a multisig account
+type Cosignature struct {
+	PubKey               []byte   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Cosignature) Reset()         { *m = Cosignature{} }
+func (m *Cosignature) String() string { return proto.CompactTextString(m) }
+func (*Cosignature) ProtoMessage()    {}
+func (*Cosignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{23}
+}
+
+func (m *Cosignature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Cosignature.Unmarshal(m, b)
+}
+func (m *Cosignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Cosignature.Marshal(b, m, deterministic)
+}
+func (m *Cosignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cosignature.Merge(m, src)
+}
+func (m *Cosignature) XXX_Size() int {
+	return xxx_messageInfo_Cosignature.Size(m)
+}
+func (m *Cosignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cosignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cosignature proto.InternalMessageInfo
+
+func (m *Cosignature) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *Cosignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type Receipt struct {
+	Status               uint64   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	BlkHeight            uint64   `protobuf:"varint,2,opt,name=blkHeight,proto3" json:"blkHeight,omitempty"`
+	ActHash              []byte   `protobuf:"bytes,3,opt,name=actHash,proto3" json:"actHash,omitempty"`
+	GasConsumed          uint64   `protobuf:"varint,4,opt,name=gasConsumed,proto3" json:"gasConsumed,omitempty"`
+	ContractAddress      string   `protobuf:"bytes,5,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Logs                 []*Log   `protobuf:"bytes,6,rep,name=logs,proto3" json:"logs,omitempty"`
+	ExecutionRevertMsg   string   `protobuf:"bytes,7,opt,name=executionRevertMsg,proto3" json:"executionRevertMsg,omitempty"`
+	GasPayer             string   `protobuf:"bytes,8,opt,name=gasPayer,proto3" json:"gasPayer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Receipt) Reset()         { *m = Receipt{} }
+func (m *Receipt) String() string { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()    {}
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{24}
+}
+
+func (m *Receipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Receipt.Unmarshal(m, b)
+}
+func (m *Receipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Receipt.Marshal(b, m, deterministic)
+}
+func (m *Receipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Receipt.Merge(m, src)
+}
+func (m *Receipt) XXX_Size() int {
+	return xxx_messageInfo_Receipt.Size(m)
+}
+func (m *Receipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_Receipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Receipt proto.InternalMessageInfo
+
+func (m *Receipt) GetStatus() uint64 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Receipt) GetBlkHeight() uint64 {
+	if m != nil {
+		return m.BlkHeight
+	}
+	return 0
+}
+
+func (m *Receipt) GetActHash() []byte {
+	if m != nil {
+		return m.ActHash
+	}
+	return nil
+}
+
+func (m *Receipt) GetGasConsumed() uint64 {
+	if m != nil {
+		return m.GasConsumed
+	}
+	return 0
+}
+
+func (m *Receipt) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *Receipt) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *Receipt) GetExecutionRevertMsg() string {
+	if m != nil {
+		return m.ExecutionRevertMsg
+	}
+	return ""
+}
+
+func (m *Receipt) GetGasPayer() string {
+	if m != nil {
+		return m.GasPayer
+	}
+	return ""
+}
+
+type Log struct {
+	ContractAddress      string   `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Topics               [][]byte `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	BlkHeight            uint64   `protobuf:"varint,4,opt,name=blkHeight,proto3" json:"blkHeight,omitempty"`
+	ActHash              []byte   `protobuf:"bytes,5,opt,name=actHash,proto3" json:"actHash,omitempty"`
+	Index                uint32   `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Log) Reset()         { *m = Log{} }
+func (m *Log) String() string { return proto.CompactTextString(m) }
+func (*Log) ProtoMessage()    {}
+func (*Log) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{25}
+}
+
+func (m *Log) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Log.Unmarshal(m, b)
+}
+func (m *Log) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Log.Marshal(b, m, deterministic)
+}
+func (m *Log) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Log.Merge(m, src)
+}
+func (m *Log) XXX_Size() int {
+	return xxx_messageInfo_Log.Size(m)
+}
+func (m *Log) XXX_DiscardUnknown() {
+	xxx_messageInfo_Log.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Log proto.InternalMessageInfo
+
+func (m *Log) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *Log) GetTopics() [][]byte {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *Log) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Log) GetBlkHeight() uint64 {
+	if m != nil {
+		return m.BlkHeight
+	}
+	return 0
+}
+
+func (m *Log) GetActHash() []byte {
+	if m != nil {
+		return m.ActHash
+	}
+	return nil
+}
+
+func (m *Log) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type DepositToRewardingFund struct {
+	Amount               string   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DepositToRewardingFund) Reset()         { *m = DepositToRewardingFund{} }
+func (m *DepositToRewardingFund) String() string { return proto.CompactTextString(m) }
+func (*DepositToRewardingFund) ProtoMessage()    {}
+func (*DepositToRewardingFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{26}
+}
+
+func (m *DepositToRewardingFund) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DepositToRewardingFund.Unmarshal(m, b)
+}
+func (m *DepositToRewardingFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DepositToRewardingFund.Marshal(b, m, deterministic)
+}
+func (m *DepositToRewardingFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositToRewardingFund.Merge(m, src)
+}
+func (m *DepositToRewardingFund) XXX_Size() int {
+	return xxx_messageInfo_DepositToRewardingFund.Size(m)
+}
+func (m *DepositToRewardingFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositToRewardingFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositToRewardingFund proto.InternalMessageInfo
+
+func (m *DepositToRewardingFund) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *DepositToRewardingFund) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ClaimFromRewardingFund struct {
+	Amount               string   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClaimFromRewardingFund) Reset()         { *m = ClaimFromRewardingFund{} }
+func (m *ClaimFromRewardingFund) String() string { return proto.CompactTextString(m) }
+func (*ClaimFromRewardingFund) ProtoMessage()    {}
+func (*ClaimFromRewardingFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{27}
+}
+
+func (m *ClaimFromRewardingFund) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClaimFromRewardingFund.Unmarshal(m, b)
+}
+func (m *ClaimFromRewardingFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClaimFromRewardingFund.Marshal(b, m, deterministic)
+}
+func (m *ClaimFromRewardingFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimFromRewardingFund.Merge(m, src)
+}
+func (m *ClaimFromRewardingFund) XXX_Size() int {
+	return xxx_messageInfo_ClaimFromRewardingFund.Size(m)
+}
+func (m *ClaimFromRewardingFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimFromRewardingFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimFromRewardingFund proto.InternalMessageInfo
+
+func (m *ClaimFromRewardingFund) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *ClaimFromRewardingFund) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type GrantReward struct {
+	Type                 RewardType `protobuf:"varint,1,opt,name=type,proto3,enum=iotextypes.RewardType" json:"type,omitempty"`
+	Height               uint64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GrantReward) Reset()         { *m = GrantReward{} }
+func (m *GrantReward) String() string { return proto.CompactTextString(m) }
+func (*GrantReward) ProtoMessage()    {}
+func (*GrantReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{28}
+}
+
+func (m *GrantReward) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantReward.Unmarshal(m, b)
+}
+func (m *GrantReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantReward.Marshal(b, m, deterministic)
+}
+func (m *GrantReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantReward.Merge(m, src)
+}
+func (m *GrantReward) XXX_Size() int {
+	return xxx_messageInfo_GrantReward.Size(m)
+}
+func (m *GrantReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantReward proto.InternalMessageInfo
+
+func (m *GrantReward) GetType() RewardType {
+	if m != nil {
+		return m.Type
+	}
+	return RewardType_BlockReward
+}
+
+func (m *GrantReward) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type BatchTransfer struct {
+	Items                []*BatchTransferItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Payload              []byte               `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BatchTransfer) Reset()         { *m = BatchTransfer{} }
+func (m *BatchTransfer) String() string { return proto.CompactTextString(m) }
+func (*BatchTransfer) ProtoMessage()    {}
+func (*BatchTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{29}
+}
+
+func (m *BatchTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchTransfer.Unmarshal(m, b)
+}
+func (m *BatchTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchTransfer.Marshal(b, m, deterministic)
+}
+func (m *BatchTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTransfer.Merge(m, src)
+}
+func (m *BatchTransfer) XXX_Size() int {
+	return xxx_messageInfo_BatchTransfer.Size(m)
+}
+func (m *BatchTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchTransfer proto.InternalMessageInfo
+
+func (m *BatchTransfer) GetItems() []*BatchTransferItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *BatchTransfer) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type BatchTransferItem struct {
+	Recipient            string   `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchTransferItem) Reset()         { *m = BatchTransferItem{} }
+func (m *BatchTransferItem) String() string { return proto.CompactTextString(m) }
+func (*BatchTransferItem) ProtoMessage()    {}
+func (*BatchTransferItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{30}
+}
+
+func (m *BatchTransferItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchTransferItem.Unmarshal(m, b)
+}
+func (m *BatchTransferItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchTransferItem.Marshal(b, m, deterministic)
+}
+func (m *BatchTransferItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTransferItem.Merge(m, src)
+}
+func (m *BatchTransferItem) XXX_Size() int {
+	return xxx_messageInfo_BatchTransferItem.Size(m)
+}
+func (m *BatchTransferItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTransferItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchTransferItem proto.InternalMessageInfo
+
+func (m *BatchTransferItem) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *BatchTransferItem) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type StakeCreate struct {
+	CandidateName        string   `protobuf:"bytes,1,opt,name=candidateName,proto3" json:"candidateName,omitempty"`
+	StakedAmount         string   `protobuf:"bytes,2,opt,name=stakedAmount,proto3" json:"stakedAmount,omitempty"`
+	StakedDuration       uint32   `protobuf:"varint,3,opt,name=stakedDuration,proto3" json:"stakedDuration,omitempty"`
+	AutoStake            bool     `protobuf:"varint,4,opt,name=autoStake,proto3" json:"autoStake,omitempty"`
+	Payload              []byte   `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeCreate) Reset()         { *m = StakeCreate{} }
+func (m *StakeCreate) String() string { return proto.CompactTextString(m) }
+func (*StakeCreate) ProtoMessage()    {}
+func (*StakeCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{31}
+}
+
+func (m *StakeCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeCreate.Unmarshal(m, b)
+}
+func (m *StakeCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeCreate.Marshal(b, m, deterministic)
+}
+func (m *StakeCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeCreate.Merge(m, src)
+}
+func (m *StakeCreate) XXX_Size() int {
+	return xxx_messageInfo_StakeCreate.Size(m)
+}
+func (m *StakeCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeCreate proto.InternalMessageInfo
+
+func (m *StakeCreate) GetCandidateName() string {
+	if m != nil {
+		return m.CandidateName
+	}
+	return ""
+}
+
+func (m *StakeCreate) GetStakedAmount() string {
+	if m != nil {
+		return m.StakedAmount
+	}
+	return ""
+}
+
+func (m *StakeCreate) GetStakedDuration() uint32 {
+	if m != nil {
+		return m.StakedDuration
+	}
+	return 0
+}
+
+func (m *StakeCreate) GetAutoStake() bool {
+	if m != nil {
+		return m.AutoStake
+	}
+	return false
+}
+
+func (m *StakeCreate) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// StakeReclaim is used by both unstaking and withdrawing a bucket
+type StakeReclaim struct {
+	BucketIndex          uint64   `protobuf:"varint,1,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeReclaim) Reset()         { *m = StakeReclaim{} }
+func (m *StakeReclaim) String() string { return proto.CompactTextString(m) }
+func (*StakeReclaim) ProtoMessage()    {}
+func (*StakeReclaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{32}
+}
+
+func (m *StakeReclaim) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeReclaim.Unmarshal(m, b)
+}
+func (m *StakeReclaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeReclaim.Marshal(b, m, deterministic)
+}
+func (m *StakeReclaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeReclaim.Merge(m, src)
+}
+func (m *StakeReclaim) XXX_Size() int {
+	return xxx_messageInfo_StakeReclaim.Size(m)
+}
+func (m *StakeReclaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeReclaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeReclaim proto.InternalMessageInfo
+
+func (m *StakeReclaim) GetBucketIndex() uint64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *StakeReclaim) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type StakeAddDeposit struct {
+	BucketIndex          uint64   `protobuf:"varint,1,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeAddDeposit) Reset()         { *m = StakeAddDeposit{} }
+func (m *StakeAddDeposit) String() string { return proto.CompactTextString(m) }
+func (*StakeAddDeposit) ProtoMessage()    {}
+func (*StakeAddDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{33}
+}
+
+func (m *StakeAddDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeAddDeposit.Unmarshal(m, b)
+}
+func (m *StakeAddDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeAddDeposit.Marshal(b, m, deterministic)
+}
+func (m *StakeAddDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeAddDeposit.Merge(m, src)
+}
+func (m *StakeAddDeposit) XXX_Size() int {
+	return xxx_messageInfo_StakeAddDeposit.Size(m)
+}
+func (m *StakeAddDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeAddDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeAddDeposit proto.InternalMessageInfo
+
+func (m *StakeAddDeposit) GetBucketIndex() uint64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *StakeAddDeposit) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *StakeAddDeposit) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type StakeRestake struct {
+	BucketIndex          uint64   `protobuf:"varint,1,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	StakedDuration       uint32   `protobuf:"varint,2,opt,name=stakedDuration,proto3" json:"stakedDuration,omitempty"`
+	AutoStake            bool     `protobuf:"varint,3,opt,name=autoStake,proto3" json:"autoStake,omitempty"`
+	Payload              []byte   `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeRestake) Reset()         { *m = StakeRestake{} }
+func (m *StakeRestake) String() string { return proto.CompactTextString(m) }
+func (*StakeRestake) ProtoMessage()    {}
+func (*StakeRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{34}
+}
+
+func (m *StakeRestake) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeRestake.Unmarshal(m, b)
+}
+func (m *StakeRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeRestake.Marshal(b, m, deterministic)
+}
+func (m *StakeRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeRestake.Merge(m, src)
+}
+func (m *StakeRestake) XXX_Size() int {
+	return xxx_messageInfo_StakeRestake.Size(m)
+}
+func (m *StakeRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeRestake proto.InternalMessageInfo
+
+func (m *StakeRestake) GetBucketIndex() uint64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *StakeRestake) GetStakedDuration() uint32 {
+	if m != nil {
+		return m.StakedDuration
+	}
+	return 0
+}
+
+func (m *StakeRestake) GetAutoStake() bool {
+	if m != nil {
+		return m.AutoStake
+	}
+	return false
+}
+
+func (m *StakeRestake) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type StakeChangeCandidate struct {
+	BucketIndex          uint64   `protobuf:"varint,1,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	CandidateName        string   `protobuf:"bytes,2,opt,name=candidateName,proto3" json:"candidateName,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeChangeCandidate) Reset()         { *m = StakeChangeCandidate{} }
+func (m *StakeChangeCandidate) String() string { return proto.CompactTextString(m) }
+func (*StakeChangeCandidate) ProtoMessage()    {}
+func (*StakeChangeCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{35}
+}
+
+func (m *StakeChangeCandidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeChangeCandidate.Unmarshal(m, b)
+}
+func (m *StakeChangeCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeChangeCandidate.Marshal(b, m, deterministic)
+}
+func (m *StakeChangeCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeChangeCandidate.Merge(m, src)
+}
+func (m *StakeChangeCandidate) XXX_Size() int {
+	return xxx_messageInfo_StakeChangeCandidate.Size(m)
+}
+func (m *StakeChangeCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeChangeCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeChangeCandidate proto.InternalMessageInfo
+
+func (m *StakeChangeCandidate) GetBucketIndex() uint64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *StakeChangeCandidate) GetCandidateName() string {
+	if m != nil {
+		return m.CandidateName
+	}
+	return ""
+}
+
+func (m *StakeChangeCandidate) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type StakeTransferOwnership struct {
+	BucketIndex          uint64   `protobuf:"varint,1,opt,name=bucketIndex,proto3" json:"bucketIndex,omitempty"`
+	VoterAddress         string   `protobuf:"bytes,2,opt,name=voterAddress,proto3" json:"voterAddress,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StakeTransferOwnership) Reset()         { *m = StakeTransferOwnership{} }
+func (m *StakeTransferOwnership) String() string { return proto.CompactTextString(m) }
+func (*StakeTransferOwnership) ProtoMessage()    {}
+func (*StakeTransferOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{36}
+}
+
+func (m *StakeTransferOwnership) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeTransferOwnership.Unmarshal(m, b)
+}
+func (m *StakeTransferOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeTransferOwnership.Marshal(b, m, deterministic)
+}
+func (m *StakeTransferOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeTransferOwnership.Merge(m, src)
+}
+func (m *StakeTransferOwnership) XXX_Size() int {
+	return xxx_messageInfo_StakeTransferOwnership.Size(m)
+}
+func (m *StakeTransferOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeTransferOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeTransferOwnership proto.InternalMessageInfo
+
+func (m *StakeTransferOwnership) GetBucketIndex() uint64 {
+	if m != nil {
+		return m.BucketIndex
+	}
+	return 0
+}
+
+func (m *StakeTransferOwnership) GetVoterAddress() string {
+	if m != nil {
+		return m.VoterAddress
+	}
+	return ""
+}
+
+func (m *StakeTransferOwnership) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type CandidateRegister struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OperatorAddress      string   `protobuf:"bytes,2,opt,name=operatorAddress,proto3" json:"operatorAddress,omitempty"`
+	RewardAddress        string   `protobuf:"bytes,3,opt,name=rewardAddress,proto3" json:"rewardAddress,omitempty"`
+	StakedAmount         string   `protobuf:"bytes,4,opt,name=stakedAmount,proto3" json:"stakedAmount,omitempty"`
+	StakedDuration       uint32   `protobuf:"varint,5,opt,name=stakedDuration,proto3" json:"stakedDuration,omitempty"`
+	AutoStake            bool     `protobuf:"varint,6,opt,name=autoStake,proto3" json:"autoStake,omitempty"`
+	Payload              []byte   `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CandidateRegister) Reset()         { *m = CandidateRegister{} }
+func (m *CandidateRegister) String() string { return proto.CompactTextString(m) }
+func (*CandidateRegister) ProtoMessage()    {}
+func (*CandidateRegister) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{37}
+}
+
+func (m *CandidateRegister) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateRegister.Unmarshal(m, b)
+}
+func (m *CandidateRegister) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateRegister.Marshal(b, m, deterministic)
+}
+func (m *CandidateRegister) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateRegister.Merge(m, src)
+}
+func (m *CandidateRegister) XXX_Size() int {
+	return xxx_messageInfo_CandidateRegister.Size(m)
+}
+func (m *CandidateRegister) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateRegister.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateRegister proto.InternalMessageInfo
+
+func (m *CandidateRegister) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CandidateRegister) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *CandidateRegister) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *CandidateRegister) GetStakedAmount() string {
+	if m != nil {
+		return m.StakedAmount
+	}
+	return ""
+}
+
+func (m *CandidateRegister) GetStakedDuration() uint32 {
+	if m != nil {
+		return m.StakedDuration
+	}
+	return 0
+}
+
+func (m *CandidateRegister) GetAutoStake() bool {
+	if m != nil {
+		return m.AutoStake
+	}
+	return false
+}
+
+func (m *CandidateRegister) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type CandidateUnregister struct {
+	Payload              []byte   `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CandidateUnregister) Reset()         { *m = CandidateUnregister{} }
+func (m *CandidateUnregister) String() string { return proto.CompactTextString(m) }
+func (*CandidateUnregister) ProtoMessage()    {}
+func (*CandidateUnregister) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{38}
+}
+
+func (m *CandidateUnregister) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateUnregister.Unmarshal(m, b)
+}
+func (m *CandidateUnregister) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateUnregister.Marshal(b, m, deterministic)
+}
+func (m *CandidateUnregister) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateUnregister.Merge(m, src)
+}
+func (m *CandidateUnregister) XXX_Size() int {
+	return xxx_messageInfo_CandidateUnregister.Size(m)
+}
+func (m *CandidateUnregister) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateUnregister.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateUnregister proto.InternalMessageInfo
+
+func (m *CandidateUnregister) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type SetMultisigPolicy struct {
+	Policy               *MultisigPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetMultisigPolicy) Reset()         { *m = SetMultisigPolicy{} }
+func (m *SetMultisigPolicy) String() string { return proto.CompactTextString(m) }
+func (*SetMultisigPolicy) ProtoMessage()    {}
+func (*SetMultisigPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{39}
+}
+
+func (m *SetMultisigPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMultisigPolicy.Unmarshal(m, b)
+}
+func (m *SetMultisigPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMultisigPolicy.Marshal(b, m, deterministic)
+}
+func (m *SetMultisigPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMultisigPolicy.Merge(m, src)
+}
+func (m *SetMultisigPolicy) XXX_Size() int {
+	return xxx_messageInfo_SetMultisigPolicy.Size(m)
+}
+func (m *SetMultisigPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMultisigPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMultisigPolicy proto.InternalMessageInfo
+
+func (m *SetMultisigPolicy) GetPolicy() *MultisigPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type MultisigPolicy struct {
+	Keys                 []*WeightedKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Threshold            uint64         `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MultisigPolicy) Reset()         { *m = MultisigPolicy{} }
+func (m *MultisigPolicy) String() string { return proto.CompactTextString(m) }
+func (*MultisigPolicy) ProtoMessage()    {}
+func (*MultisigPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{40}
+}
+
+func (m *MultisigPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultisigPolicy.Unmarshal(m, b)
+}
+func (m *MultisigPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultisigPolicy.Marshal(b, m, deterministic)
+}
+func (m *MultisigPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigPolicy.Merge(m, src)
+}
+func (m *MultisigPolicy) XXX_Size() int {
+	return xxx_messageInfo_MultisigPolicy.Size(m)
+}
+func (m *MultisigPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigPolicy proto.InternalMessageInfo
+
+func (m *MultisigPolicy) GetKeys() []*WeightedKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *MultisigPolicy) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+type WeightedKey struct {
+	PubKey               []byte   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Weight               uint64   `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WeightedKey) Reset()         { *m = WeightedKey{} }
+func (m *WeightedKey) String() string { return proto.CompactTextString(m) }
+func (*WeightedKey) ProtoMessage()    {}
+func (*WeightedKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{41}
+}
+
+func (m *WeightedKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WeightedKey.Unmarshal(m, b)
+}
+func (m *WeightedKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WeightedKey.Marshal(b, m, deterministic)
+}
+func (m *WeightedKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedKey.Merge(m, src)
+}
+func (m *WeightedKey) XXX_Size() int {
+	return xxx_messageInfo_WeightedKey.Size(m)
+}
+func (m *WeightedKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedKey proto.InternalMessageInfo
+
+func (m *WeightedKey) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *WeightedKey) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// ScheduleAction schedules a transfer, or a contract call if the gas limit of the call is set, at the target height
+type ScheduleAction struct {
+	TargetHeight         uint64   `protobuf:"varint,1,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	Recipient            string   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount               string   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Data                 []byte   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	CallGasLimit         uint64   `protobuf:"varint,5,opt,name=callGasLimit,proto3" json:"callGasLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduleAction) Reset()         { *m = ScheduleAction{} }
+func (m *ScheduleAction) String() string { return proto.CompactTextString(m) }
+func (*ScheduleAction) ProtoMessage()    {}
+func (*ScheduleAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{42}
+}
+
+func (m *ScheduleAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduleAction.Unmarshal(m, b)
+}
+func (m *ScheduleAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduleAction.Marshal(b, m, deterministic)
+}
+func (m *ScheduleAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleAction.Merge(m, src)
+}
+func (m *ScheduleAction) XXX_Size() int {
+	return xxx_messageInfo_ScheduleAction.Size(m)
+}
+func (m *ScheduleAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleAction proto.InternalMessageInfo
+
+func (m *ScheduleAction) GetTargetHeight() uint64 {
+	if m != nil {
+		return m.TargetHeight
+	}
+	return 0
+}
+
+func (m *ScheduleAction) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ScheduleAction) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *ScheduleAction) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ScheduleAction) GetCallGasLimit() uint64 {
+	if m != nil {
+		return m.CallGasLimit
+	}
+	return 0
+}
+
+type CancelScheduledAction struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelScheduledAction) Reset()         { *m = CancelScheduledAction{} }
+func (m *CancelScheduledAction) String() string { return proto.CompactTextString(m) }
+func (*CancelScheduledAction) ProtoMessage()    {}
+func (*CancelScheduledAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{43}
+}
+
+func (m *CancelScheduledAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelScheduledAction.Unmarshal(m, b)
+}
+func (m *CancelScheduledAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelScheduledAction.Marshal(b, m, deterministic)
+}
+func (m *CancelScheduledAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelScheduledAction.Merge(m, src)
+}
+func (m *CancelScheduledAction) XXX_Size() int {
+	return xxx_messageInfo_CancelScheduledAction.Size(m)
+}
+func (m *CancelScheduledAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelScheduledAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelScheduledAction proto.InternalMessageInfo
+
+func (m *CancelScheduledAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// ExecuteScheduledActions is the system action executing the scheduled actions due at the height
+type ExecuteScheduledActions struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecuteScheduledActions) Reset()         { *m = ExecuteScheduledActions{} }
+func (m *ExecuteScheduledActions) String() string { return proto.CompactTextString(m) }
+func (*ExecuteScheduledActions) ProtoMessage()    {}
+func (*ExecuteScheduledActions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4dd5ed50f883f28, []int{44}
+}
+
+func (m *ExecuteScheduledActions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecuteScheduledActions.Unmarshal(m, b)
+}
+func (m *ExecuteScheduledActions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecuteScheduledActions.Marshal(b, m, deterministic)
+}
+func (m *ExecuteScheduledActions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteScheduledActions.Merge(m, src)
+}
+func (m *ExecuteScheduledActions) XXX_Size() int {
+	return xxx_messageInfo_ExecuteScheduledActions.Size(m)
+}
+func (m *ExecuteScheduledActions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteScheduledActions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteScheduledActions proto.InternalMessageInfo
+
+func (m *ExecuteScheduledActions) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("iotextypes.Encoding", Encoding_name, Encoding_value)
+	proto.RegisterEnum("iotextypes.RewardType", RewardType_name, RewardType_value)
+	proto.RegisterType((*Transfer)(nil), "iotextypes.Transfer")
+	proto.RegisterType((*Candidate)(nil), "iotextypes.Candidate")
+	proto.RegisterType((*CandidateList)(nil), "iotextypes.CandidateList")
+	proto.RegisterType((*PutPollResult)(nil), "iotextypes.PutPollResult")
+	proto.RegisterType((*Execution)(nil), "iotextypes.Execution")
+	proto.RegisterType((*StartSubChain)(nil), "iotextypes.StartSubChain")
+	proto.RegisterType((*StopSubChain)(nil), "iotextypes.StopSubChain")
+	proto.RegisterType((*MerkleRoot)(nil), "iotextypes.MerkleRoot")
+	proto.RegisterType((*PutBlock)(nil), "iotextypes.PutBlock")
+	proto.RegisterType((*CreateDeposit)(nil), "iotextypes.CreateDeposit")
+	proto.RegisterType((*SettleDeposit)(nil), "iotextypes.SettleDeposit")
+	proto.RegisterType((*CreatePlumChain)(nil), "iotextypes.CreatePlumChain")
+	proto.RegisterType((*TerminatePlumChain)(nil), "iotextypes.TerminatePlumChain")
+	proto.RegisterType((*PlumPutBlock)(nil), "iotextypes.PlumPutBlock")
+	proto.RegisterMapType((map[string][]byte)(nil), "iotextypes.PlumPutBlock.RootsEntry")
+	proto.RegisterType((*PlumCreateDeposit)(nil), "iotextypes.PlumCreateDeposit")
+	proto.RegisterType((*PlumStartExit)(nil), "iotextypes.PlumStartExit")
+	proto.RegisterType((*PlumChallengeExit)(nil), "iotextypes.PlumChallengeExit")
+	proto.RegisterType((*PlumResponseChallengeExit)(nil), "iotextypes.PlumResponseChallengeExit")
+	proto.RegisterType((*PlumFinalizeExit)(nil), "iotextypes.PlumFinalizeExit")
+	proto.RegisterType((*PlumSettleDeposit)(nil), "iotextypes.PlumSettleDeposit")
+	proto.RegisterType((*PlumTransfer)(nil), "iotextypes.PlumTransfer")
+	proto.RegisterType((*ActionCore)(nil), "iotextypes.ActionCore")
+	proto.RegisterType((*Action)(nil), "iotextypes.Action")
+	proto.RegisterType((*Cosignature)(nil), "iotextypes.Cosignature")
+	proto.RegisterType((*Receipt)(nil), "iotextypes.Receipt")
+	proto.RegisterType((*Log)(nil), "iotextypes.Log")
+	proto.RegisterType((*DepositToRewardingFund)(nil), "iotextypes.DepositToRewardingFund")
+	proto.RegisterType((*ClaimFromRewardingFund)(nil), "iotextypes.ClaimFromRewardingFund")
+	proto.RegisterType((*GrantReward)(nil), "iotextypes.GrantReward")
+	proto.RegisterType((*BatchTransfer)(nil), "iotextypes.BatchTransfer")
+	proto.RegisterType((*BatchTransferItem)(nil), "iotextypes.BatchTransferItem")
+	proto.RegisterType((*StakeCreate)(nil), "iotextypes.StakeCreate")
+	proto.RegisterType((*StakeReclaim)(nil), "iotextypes.StakeReclaim")
+	proto.RegisterType((*StakeAddDeposit)(nil), "iotextypes.StakeAddDeposit")
+	proto.RegisterType((*StakeRestake)(nil), "iotextypes.StakeRestake")
+	proto.RegisterType((*StakeChangeCandidate)(nil), "iotextypes.StakeChangeCandidate")
+	proto.RegisterType((*StakeTransferOwnership)(nil), "iotextypes.StakeTransferOwnership")
+	proto.RegisterType((*CandidateRegister)(nil), "iotextypes.CandidateRegister")
+	proto.RegisterType((*CandidateUnregister)(nil), "iotextypes.CandidateUnregister")
+	proto.RegisterType((*SetMultisigPolicy)(nil), "iotextypes.SetMultisigPolicy")
+	proto.RegisterType((*MultisigPolicy)(nil), "iotextypes.MultisigPolicy")
+	proto.RegisterType((*WeightedKey)(nil), "iotextypes.WeightedKey")
+	proto.RegisterType((*ScheduleAction)(nil), "iotextypes.ScheduleAction")
+	proto.RegisterType((*CancelScheduledAction)(nil), "iotextypes.CancelScheduledAction")
+	proto.RegisterType((*ExecuteScheduledActions)(nil), "iotextypes.ExecuteScheduledActions")
+}
+
+func init() { proto.RegisterFile("proto/types/action.proto", fileDescriptor_d4dd5ed50f883f28) }
+
+var fileDescriptor_d4dd5ed50f883f28 = []byte{
+	// 2491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcd, 0x72, 0xdc, 0xc6,
+	0xf1, 0x5f, 0xec, 0x2e, 0x57, 0x64, 0x93, 0xcb, 0x8f, 0x11, 0x45, 0x41, 0x1f, 0x96, 0xf9, 0x87,
+	0xff, 0x49, 0x18, 0x4a, 0x26, 0x15, 0xb9, 0xe4, 0xc8, 0x71, 0xac, 0x48, 0xfc, 0x90, 0x96, 0xb6,
+	0x14, 0x31, 0x43, 0x2a, 0x4e, 0xec, 0x54, 0x54, 0x20, 0x30, 0xda, 0x45, 0x88, 0xc5, 0xa0, 0x80,
+	0x59, 0x89, 0xeb, 0x43, 0x0e, 0xb9, 0xe5, 0x96, 0x53, 0x1e, 0x20, 0xb7, 0xdc, 0x72, 0x4b, 0xe5,
+	0x01, 0xf2, 0x00, 0x79, 0x9e, 0x54, 0xaa, 0x52, 0xf3, 0x01, 0x60, 0x06, 0xc0, 0x2e, 0x29, 0x97,
+	0xab, 0x72, 0xe2, 0x76, 0xcf, 0xaf, 0x7b, 0x7a, 0x7a, 0x1a, 0x3d, 0x3d, 0x3d, 0x04, 0x3b, 0x4e,
+	0x28, 0xa3, 0xdb, 0x6c, 0x1c, 0x93, 0x74, 0xdb, 0xf5, 0x58, 0x40, 0xa3, 0x2d, 0xc1, 0x42, 0x10,
+	0x50, 0x46, 0xce, 0xc4, 0x80, 0xf3, 0x15, 0xcc, 0x1e, 0x27, 0x6e, 0x94, 0xbe, 0x26, 0x09, 0x5a,
+	0x83, 0x8e, 0x3b, 0xa4, 0xa3, 0x88, 0xd9, 0xd6, 0xba, 0xb5, 0x31, 0x87, 0x15, 0x85, 0x6e, 0xc2,
+	0x5c, 0x42, 0xbc, 0x20, 0x0e, 0x48, 0xc4, 0xec, 0xa6, 0x18, 0x2a, 0x18, 0xc8, 0x86, 0x4b, 0xb1,
+	0x3b, 0x0e, 0xa9, 0xeb, 0xdb, 0xad, 0x75, 0x6b, 0x63, 0x01, 0x67, 0xa4, 0x33, 0x86, 0xb9, 0x5d,
+	0x37, 0xf2, 0x03, 0xdf, 0x65, 0x84, 0xc3, 0x5c, 0xdf, 0x4f, 0x48, 0x9a, 0x2a, 0xed, 0x19, 0x89,
+	0x56, 0x61, 0xe6, 0x0d, 0x65, 0x24, 0x15, 0xaa, 0x17, 0xb0, 0x24, 0xb8, 0x31, 0xf1, 0xe8, 0xe4,
+	0x0b, 0x32, 0x56, 0x5a, 0x15, 0x85, 0xfe, 0x1f, 0xba, 0x09, 0x79, 0xeb, 0x26, 0xfe, 0x63, 0xa5,
+	0xad, 0x2d, 0xb4, 0x99, 0x4c, 0xe7, 0x09, 0x74, 0xf3, 0xa9, 0x9f, 0x05, 0x29, 0x43, 0xf7, 0x01,
+	0xbc, 0x8c, 0xc1, 0x2d, 0x68, 0x6d, 0xcc, 0xdf, 0xbb, 0xb2, 0x55, 0x38, 0x62, 0x2b, 0x87, 0x63,
+	0x0d, 0xe8, 0x9c, 0x40, 0xf7, 0x70, 0xc4, 0x0e, 0x69, 0x18, 0x62, 0x92, 0x8e, 0x42, 0xc6, 0xcd,
+	0x1a, 0x90, 0xa0, 0x3f, 0x90, 0x3e, 0x6a, 0x63, 0x45, 0xa1, 0x4f, 0x0c, 0xfd, 0x7c, 0x25, 0xf3,
+	0xf7, 0xae, 0xd5, 0xea, 0xe7, 0xe6, 0x18, 0x73, 0x1c, 0xc1, 0xdc, 0xfe, 0x19, 0xf1, 0x46, 0x7c,
+	0x87, 0x26, 0xee, 0xc1, 0x75, 0x98, 0xf5, 0x68, 0xc4, 0x12, 0xd7, 0xcb, 0xb6, 0x20, 0xa7, 0x11,
+	0x82, 0xb6, 0xef, 0x32, 0x57, 0x39, 0x4a, 0xfc, 0x76, 0xfe, 0x65, 0x41, 0xf7, 0x88, 0xb9, 0x09,
+	0x3b, 0x1a, 0x9d, 0xec, 0x0e, 0xdc, 0x20, 0xe2, 0x1b, 0xe0, 0xf1, 0x1f, 0x07, 0x7b, 0x42, 0x75,
+	0x17, 0x67, 0x24, 0xda, 0x80, 0xa5, 0x94, 0x78, 0xa3, 0x24, 0x60, 0xe3, 0x3d, 0x12, 0xd3, 0x34,
+	0xc8, 0xa6, 0x28, 0xb3, 0xd1, 0x26, 0x2c, 0xd3, 0x98, 0x24, 0x2e, 0x37, 0x35, 0x83, 0xb6, 0x04,
+	0xb4, 0xc2, 0x47, 0xeb, 0x30, 0x9f, 0x72, 0x03, 0x7a, 0xd2, 0x5d, 0x6d, 0xe1, 0x2e, 0x9d, 0x85,
+	0xb6, 0x00, 0xc5, 0x6e, 0x42, 0x22, 0x45, 0xbf, 0x78, 0xfd, 0x3a, 0x25, 0xcc, 0x9e, 0x11, 0xc0,
+	0x9a, 0x11, 0x27, 0x81, 0x85, 0x23, 0x46, 0xe3, 0x0b, 0xac, 0xe8, 0x16, 0x40, 0xca, 0x68, 0xac,
+	0xa6, 0x6e, 0x0a, 0x8d, 0x1a, 0x47, 0xac, 0x58, 0x69, 0xc9, 0xc2, 0xa8, 0xa5, 0x56, 0x6c, 0xb2,
+	0x9d, 0x8f, 0x01, 0x9e, 0x93, 0xe4, 0x34, 0x24, 0x98, 0x52, 0xe1, 0xe9, 0xc8, 0x1d, 0x12, 0xb5,
+	0x37, 0xe2, 0xb7, 0x08, 0x5f, 0x37, 0x1c, 0x91, 0x3c, 0x7c, 0x39, 0xe1, 0x7c, 0x03, 0xb3, 0x87,
+	0x23, 0xb6, 0x13, 0x52, 0xef, 0xb4, 0x6e, 0x36, 0xab, 0x76, 0x36, 0x2d, 0xba, 0x9a, 0x46, 0x74,
+	0xdd, 0x81, 0x99, 0x84, 0x52, 0xc6, 0xad, 0xe4, 0x81, 0xbb, 0xa6, 0x07, 0x56, 0x61, 0x1e, 0x96,
+	0x20, 0xe7, 0x15, 0x74, 0x77, 0x13, 0xe2, 0x32, 0x92, 0x6d, 0xc5, 0x64, 0x47, 0x15, 0xe1, 0xd6,
+	0x9c, 0xfc, 0xc9, 0xb7, 0x4a, 0x9f, 0xbc, 0xf3, 0x35, 0x74, 0x8f, 0x08, 0x63, 0x61, 0x3e, 0xc1,
+	0xb7, 0xcb, 0x1c, 0xab, 0x30, 0x13, 0x44, 0x3e, 0x39, 0x13, 0x13, 0xb4, 0xb1, 0x24, 0x9c, 0x15,
+	0x58, 0x92, 0xd6, 0x1f, 0x86, 0xa3, 0xa1, 0xf0, 0x8e, 0xf3, 0x10, 0xd0, 0x31, 0x49, 0x86, 0x41,
+	0xa4, 0x73, 0x2f, 0xee, 0x56, 0xe7, 0x9f, 0x16, 0x2c, 0x70, 0xb9, 0xef, 0x70, 0x47, 0x3e, 0x31,
+	0x77, 0xe4, 0x03, 0x7d, 0x47, 0xf4, 0xa9, 0xb6, 0xf8, 0xc6, 0xa4, 0xfb, 0x11, 0x4b, 0xc6, 0x6a,
+	0x7b, 0xae, 0x3f, 0x00, 0x28, 0x98, 0x68, 0x19, 0x5a, 0xa7, 0x64, 0xac, 0xa6, 0xe7, 0x3f, 0xeb,
+	0x03, 0xea, 0x27, 0xcd, 0x07, 0x96, 0x93, 0xc2, 0x8a, 0x58, 0xbe, 0xb1, 0xb9, 0xef, 0xb4, 0x96,
+	0x6f, 0xb1, 0xd9, 0xff, 0x69, 0x42, 0x97, 0xcf, 0x2a, 0xb2, 0xc9, 0xfe, 0xd9, 0x3b, 0xcd, 0xb8,
+	0x09, 0xcb, 0x71, 0x42, 0xde, 0x04, 0x74, 0x94, 0x66, 0xa7, 0x8c, 0x5a, 0x55, 0x85, 0x8f, 0x1e,
+	0xc2, 0xf5, 0x32, 0x4f, 0x78, 0xf0, 0x30, 0xa1, 0xf4, 0xb5, 0xca, 0x6d, 0x53, 0x10, 0xe8, 0x11,
+	0xdc, 0xa8, 0x1d, 0x35, 0xf2, 0xcf, 0x34, 0x08, 0x72, 0x60, 0x81, 0x9c, 0x05, 0x2c, 0xb7, 0x74,
+	0x46, 0xcc, 0x69, 0xf0, 0xd0, 0xc7, 0xb0, 0xa6, 0xd3, 0x9a, 0x85, 0x1d, 0x81, 0x9e, 0x30, 0x8a,
+	0x1e, 0xc0, 0xd5, 0xca, 0x88, 0xb2, 0xec, 0x92, 0xb0, 0x6c, 0xd2, 0xb0, 0xf3, 0xc7, 0xa6, 0xda,
+	0xf5, 0x81, 0x1b, 0x86, 0x24, 0xea, 0x93, 0x77, 0xdc, 0x83, 0x35, 0xe8, 0x78, 0x54, 0x7c, 0xfb,
+	0x2a, 0x82, 0x25, 0x85, 0xee, 0xc0, 0x8a, 0x97, 0xa9, 0xcc, 0x97, 0x2c, 0xdd, 0x5c, 0x1d, 0xe0,
+	0xde, 0xad, 0x30, 0xb5, 0xc5, 0xb7, 0x85, 0xdc, 0x34, 0x08, 0xda, 0x81, 0x9b, 0xf5, 0xc3, 0xca,
+	0x0d, 0x32, 0xef, 0x4f, 0xc5, 0x38, 0xff, 0x68, 0xc2, 0x35, 0xee, 0x0b, 0x4c, 0xd2, 0x98, 0x46,
+	0x29, 0xf9, 0xdf, 0xfa, 0x64, 0x13, 0x96, 0x13, 0x65, 0x48, 0x0e, 0x96, 0x8e, 0xa8, 0xf0, 0x79,
+	0x74, 0x97, 0x79, 0x9a, 0xfb, 0x64, 0xa4, 0x4d, 0x41, 0x9c, 0x17, 0xdd, 0x9d, 0x73, 0xa3, 0xdb,
+	0x39, 0x86, 0x65, 0xee, 0xba, 0x27, 0x41, 0xe4, 0x86, 0xc1, 0x37, 0xdf, 0x91, 0xc7, 0x9c, 0xdb,
+	0x32, 0x38, 0x2b, 0xc7, 0x81, 0x02, 0x5b, 0x06, 0xf8, 0xf7, 0x32, 0x0d, 0xeb, 0x05, 0x67, 0x1d,
+	0x8e, 0x7f, 0x88, 0x3e, 0x89, 0xa8, 0x48, 0xf8, 0x01, 0x8d, 0x54, 0xca, 0x30, 0x78, 0x3c, 0x4b,
+	0xd2, 0xb7, 0x91, 0xda, 0x9e, 0x39, 0x2c, 0x09, 0x33, 0x95, 0xb5, 0xcb, 0xa9, 0xec, 0x6f, 0x57,
+	0x00, 0x1e, 0x8b, 0x4a, 0x78, 0x97, 0x26, 0xa2, 0x24, 0x7d, 0x43, 0x92, 0x94, 0xcf, 0xa0, 0x8e,
+	0x45, 0x45, 0x72, 0xe5, 0x11, 0x8d, 0x3c, 0xa2, 0x16, 0x2b, 0x09, 0x5e, 0x83, 0xf5, 0xdd, 0xf4,
+	0x59, 0x30, 0x54, 0x55, 0x4f, 0x1b, 0xe7, 0xb4, 0x1a, 0x3b, 0x4c, 0x02, 0x8f, 0xa8, 0x79, 0x73,
+	0x5a, 0x3f, 0x7e, 0x67, 0xcc, 0xe3, 0xf7, 0x1e, 0xcc, 0xb2, 0x2c, 0x72, 0x40, 0xd4, 0x8c, 0xab,
+	0xfa, 0x41, 0x92, 0x39, 0xaa, 0xd7, 0xc0, 0x39, 0x0e, 0xdd, 0x87, 0x39, 0x92, 0x95, 0x8b, 0xf6,
+	0xc2, 0xba, 0x55, 0x2e, 0x64, 0xf3, 0x5a, 0xb2, 0xd7, 0xc0, 0x05, 0x12, 0x3d, 0x86, 0x6e, 0xaa,
+	0xd7, 0x83, 0x76, 0xb7, 0x5a, 0xa3, 0x1a, 0x05, 0x63, 0xaf, 0x81, 0x4d, 0x09, 0xf4, 0x10, 0x16,
+	0x52, 0xad, 0xfe, 0xb2, 0x17, 0x85, 0x06, 0xdb, 0xd4, 0x50, 0x8c, 0xf7, 0x1a, 0xd8, 0xc0, 0xf3,
+	0xd5, 0xc6, 0xea, 0x58, 0xb4, 0x97, 0xaa, 0xab, 0xcd, 0x8e, 0x4c, 0xbe, 0xda, 0x0c, 0xc7, 0xcd,
+	0xf6, 0xf4, 0xe3, 0xce, 0x5e, 0xae, 0x29, 0xad, 0x75, 0x00, 0x37, 0xdb, 0x90, 0x10, 0x2b, 0xd7,
+	0xc3, 0xd3, 0x5e, 0xa9, 0x59, 0xb9, 0x0e, 0x10, 0x2b, 0xd7, 0x19, 0xe8, 0x29, 0x2c, 0x79, 0x66,
+	0x4d, 0x62, 0x23, 0xa1, 0xe4, 0x46, 0xd5, 0x8e, 0x1c, 0xd2, 0x6b, 0xe0, 0xb2, 0x14, 0x3a, 0x04,
+	0xc4, 0x2a, 0x95, 0x8c, 0x7d, 0x59, 0xe8, 0xba, 0x65, 0x6c, 0x7d, 0x05, 0xd5, 0x6b, 0xe0, 0x1a,
+	0x59, 0xbe, 0x29, 0xb1, 0x56, 0x6f, 0xd8, 0xab, 0xd5, 0x4d, 0xd1, 0xeb, 0x11, 0xbe, 0x29, 0x3a,
+	0x1e, 0x3d, 0x87, 0x95, 0xb8, 0x5c, 0x53, 0xd8, 0x57, 0x84, 0x92, 0xf7, 0xca, 0x4a, 0xca, 0x8e,
+	0xae, 0x4a, 0x72, 0x67, 0xc7, 0x7a, 0xb1, 0x60, 0xaf, 0x55, 0x9d, 0x6d, 0x54, 0x13, 0xdc, 0xd9,
+	0x86, 0x44, 0x6e, 0x91, 0x9e, 0xdb, 0xed, 0xab, 0x13, 0x2c, 0xd2, 0x41, 0xb9, 0x45, 0x3a, 0x13,
+	0x11, 0xb8, 0x16, 0x4f, 0x3a, 0x32, 0x6c, 0x5b, 0xa8, 0xfd, 0x5e, 0x59, 0x6d, 0x2d, 0xb8, 0xd7,
+	0xc0, 0x93, 0x35, 0xa1, 0xcf, 0x61, 0x39, 0x2e, 0xa5, 0x57, 0xfb, 0x9a, 0xd0, 0x7e, 0xb3, 0xac,
+	0x5d, 0xc7, 0xf4, 0x1a, 0xb8, 0x22, 0x97, 0x79, 0xc0, 0x08, 0x4a, 0xfb, 0x7a, 0xbd, 0x07, 0xca,
+	0x91, 0x5b, 0x95, 0xcc, 0x42, 0x24, 0x3f, 0xa3, 0x6e, 0xd4, 0x87, 0x88, 0x96, 0x6d, 0x0c, 0x3c,
+	0xfa, 0x0d, 0xac, 0xf9, 0x52, 0xd5, 0x31, 0xc5, 0xe2, 0x9a, 0x1d, 0x44, 0xfd, 0x27, 0xa3, 0xc8,
+	0xb7, 0x6f, 0x09, 0x4d, 0x8e, 0xae, 0x69, 0xaf, 0x16, 0xd9, 0x6b, 0xe0, 0x09, 0x3a, 0xb8, 0x76,
+	0x2f, 0x74, 0x83, 0xe1, 0x93, 0x84, 0x0e, 0x4d, 0xed, 0xef, 0x57, 0xb5, 0xef, 0xd6, 0x22, 0xb9,
+	0xf6, 0x7a, 0x1d, 0xe8, 0x53, 0x98, 0xef, 0x27, 0x6e, 0xc4, 0x24, 0xd7, 0x5e, 0x17, 0x2a, 0xaf,
+	0xea, 0x2a, 0x9f, 0x16, 0xc3, 0xbd, 0x06, 0xd6, 0xd1, 0x5c, 0x38, 0x65, 0xee, 0x29, 0x91, 0x21,
+	0x6e, 0x6f, 0x54, 0x85, 0x8f, 0x8a, 0x61, 0x2e, 0xac, 0xa1, 0x65, 0xb6, 0x74, 0x4f, 0xc9, 0xcb,
+	0x48, 0xfc, 0xb1, 0x7f, 0x58, 0x97, 0x2d, 0xdd, 0x53, 0x82, 0x89, 0x30, 0x5d, 0x66, 0xcb, 0x02,
+	0x8f, 0x1e, 0x89, 0x84, 0x7d, 0x4a, 0xbe, 0x0c, 0xd8, 0xc0, 0x4f, 0xdc, 0xb7, 0xf6, 0xe6, 0xb9,
+	0x0a, 0x4c, 0x01, 0x9e, 0xb5, 0x04, 0xe3, 0xb1, 0xef, 0x67, 0x41, 0x74, 0xbb, 0x9a, 0xb5, 0x8e,
+	0x4c, 0x08, 0xcf, 0x5a, 0x25, 0xa9, 0x7c, 0x29, 0x98, 0x88, 0x3f, 0xf6, 0x9d, 0x89, 0x96, 0x88,
+	0xf1, 0x7c, 0x29, 0x8a, 0x46, 0xbf, 0x84, 0x55, 0xe9, 0x99, 0x81, 0x1b, 0xf5, 0x49, 0xde, 0x09,
+	0xb1, 0x3f, 0x14, 0x7a, 0xd6, 0xab, 0x0e, 0x35, 0x71, 0xbd, 0x06, 0xae, 0x95, 0xe7, 0xa1, 0x23,
+	0xf8, 0x59, 0xa4, 0xbe, 0xe0, 0x35, 0x40, 0x3a, 0x08, 0x62, 0x7b, 0xab, 0x1a, 0x3a, 0x47, 0xb5,
+	0x48, 0x1e, 0x3a, 0xf5, 0x3a, 0xf8, 0x57, 0x98, 0x77, 0x69, 0x30, 0xe9, 0x07, 0x29, 0x23, 0x89,
+	0xbd, 0x5d, 0xfd, 0x0a, 0x77, 0xcb, 0x20, 0xfe, 0x15, 0x56, 0x24, 0xd1, 0x11, 0x5c, 0xce, 0x99,
+	0x2f, 0xa3, 0x24, 0x53, 0x78, 0x57, 0x28, 0x7c, 0xbf, 0x56, 0x61, 0x01, 0xeb, 0x35, 0x70, 0x9d,
+	0xb4, 0x48, 0xb7, 0x7a, 0x7f, 0xca, 0xbe, 0x57, 0x93, 0x6e, 0x75, 0x80, 0x48, 0xb7, 0x3a, 0x83,
+	0xab, 0x38, 0x71, 0x99, 0x37, 0xc8, 0xd3, 0xc3, 0x4f, 0xab, 0x2a, 0x76, 0x74, 0x00, 0x57, 0x61,
+	0x48, 0x70, 0x4f, 0xa5, 0x84, 0x3d, 0x1f, 0x85, 0x2c, 0x48, 0x83, 0xfe, 0x21, 0x0d, 0x03, 0x6f,
+	0x6c, 0x7f, 0x56, 0xf5, 0xd4, 0x51, 0x19, 0xc4, 0x3d, 0x55, 0x91, 0x44, 0x7b, 0xb0, 0x98, 0x7a,
+	0x03, 0xe2, 0x8f, 0x42, 0x22, 0xab, 0x35, 0xfb, 0xa1, 0xd0, 0x75, 0xdd, 0xd0, 0x65, 0x20, 0x7a,
+	0x0d, 0x5c, 0x92, 0x41, 0xbf, 0x86, 0x2b, 0x9e, 0x1b, 0x79, 0x24, 0xcc, 0x90, 0xbe, 0x52, 0xf6,
+	0x33, 0xa1, 0xec, 0xff, 0x4a, 0x1e, 0xaf, 0x02, 0x7b, 0x0d, 0x5c, 0xaf, 0x01, 0xbd, 0x82, 0xab,
+	0xb2, 0xb0, 0x22, 0xa5, 0x91, 0xd4, 0x7e, 0xb4, 0x6e, 0x95, 0xdb, 0x01, 0xfb, 0xf5, 0xd0, 0x5e,
+	0x03, 0x4f, 0xd2, 0xb2, 0x33, 0x0b, 0x1d, 0xd9, 0xb1, 0x75, 0xfe, 0xd0, 0x82, 0x8e, 0x9a, 0x75,
+	0x13, 0xda, 0x1e, 0x4d, 0x64, 0xf3, 0xa9, 0xd4, 0x03, 0x2a, 0x8a, 0x5a, 0x2c, 0x30, 0xbc, 0x82,
+	0x4e, 0x49, 0xe4, 0x93, 0xe4, 0x50, 0xf6, 0x50, 0x55, 0x05, 0xad, 0xf3, 0x78, 0xad, 0x9c, 0x06,
+	0xfd, 0xc8, 0x65, 0xa3, 0x84, 0xa8, 0x4b, 0x4e, 0xc1, 0x40, 0x9f, 0xc2, 0x82, 0x47, 0x73, 0x92,
+	0xb7, 0x59, 0x5b, 0xe5, 0xe4, 0xb7, 0x5b, 0x8c, 0x63, 0x03, 0xcc, 0x9b, 0xb4, 0xe2, 0x8c, 0xa4,
+	0xd9, 0xfc, 0xf2, 0x82, 0x63, 0x32, 0xf9, 0xfd, 0x49, 0x31, 0x8e, 0x72, 0x3b, 0xe4, 0x2d, 0xba,
+	0xc2, 0x47, 0x77, 0x61, 0x96, 0x44, 0x1e, 0xe5, 0x79, 0x5d, 0x5c, 0x98, 0x17, 0xcd, 0xda, 0x71,
+	0x5f, 0x8d, 0xe1, 0x1c, 0x85, 0x0e, 0xe0, 0xb2, 0xd2, 0xb2, 0xab, 0xaf, 0x63, 0x76, 0xfa, 0x3a,
+	0xea, 0x64, 0x9c, 0x5d, 0x98, 0xd7, 0x68, 0xad, 0x35, 0x6d, 0x19, 0xad, 0x69, 0xc3, 0xa1, 0xcd,
+	0x92, 0x43, 0x9d, 0x3f, 0x37, 0xe1, 0x12, 0x26, 0x1e, 0x09, 0x62, 0x71, 0x41, 0x4a, 0x99, 0xcb,
+	0x46, 0x69, 0x76, 0xf1, 0x91, 0x14, 0xd7, 0x70, 0x12, 0x9e, 0x1a, 0x6d, 0xcb, 0x82, 0x21, 0x5a,
+	0xe8, 0x1e, 0xeb, 0xb9, 0xe9, 0x20, 0xeb, 0xb4, 0x2b, 0x92, 0xf7, 0x5a, 0xfb, 0x6e, 0xba, 0x4b,
+	0xa3, 0x74, 0x34, 0x24, 0x7e, 0xd6, 0x6b, 0xd5, 0x58, 0xfc, 0xa6, 0x97, 0xf5, 0x8b, 0xb3, 0x9b,
+	0xde, 0x8c, 0xbc, 0xe9, 0x95, 0xd8, 0xe8, 0x03, 0x68, 0x87, 0xb4, 0x9f, 0xda, 0x1d, 0xe1, 0xa8,
+	0x25, 0xdd, 0x51, 0xcf, 0x68, 0x1f, 0x8b, 0x41, 0xde, 0xba, 0xcd, 0xaf, 0x16, 0x98, 0xbc, 0x21,
+	0x09, 0x7b, 0x9e, 0xca, 0x8d, 0x99, 0xc3, 0x35, 0x23, 0xd9, 0xf5, 0xc8, 0x1d, 0x93, 0xc4, 0x9e,
+	0x2d, 0xae, 0x47, 0x9c, 0x76, 0xfe, 0x6a, 0x41, 0xeb, 0x19, 0xed, 0xd7, 0x99, 0x68, 0xd5, 0x9b,
+	0xb8, 0x06, 0x1d, 0x46, 0xe3, 0xc0, 0xe3, 0x8d, 0xf6, 0x16, 0xdf, 0x00, 0x49, 0xd5, 0x35, 0xc2,
+	0x4d, 0x97, 0xb6, 0xa7, 0xb8, 0x74, 0xc6, 0x74, 0x69, 0xde, 0x9c, 0xec, 0x88, 0x2b, 0x9b, 0x24,
+	0x9c, 0x3d, 0x58, 0xab, 0x2f, 0x70, 0x26, 0xb6, 0x40, 0x33, 0x9b, 0x9a, 0x5a, 0x73, 0x7e, 0x0f,
+	0xd6, 0xea, 0x0b, 0x99, 0x77, 0xd2, 0xf2, 0x0b, 0x98, 0xd7, 0x6a, 0x17, 0x9e, 0x1e, 0xf8, 0x2e,
+	0x09, 0xc1, 0x45, 0x33, 0x3d, 0x48, 0xc4, 0xf1, 0x38, 0x26, 0x58, 0x60, 0x26, 0x75, 0x35, 0x9d,
+	0xdf, 0x42, 0xd7, 0x48, 0xf5, 0xe8, 0x23, 0x98, 0x09, 0x18, 0x19, 0x66, 0x2f, 0x26, 0xef, 0x4d,
+	0x3c, 0x14, 0x0e, 0x18, 0x19, 0x62, 0x89, 0xd5, 0x5f, 0x84, 0x9a, 0xe6, 0x8b, 0xd0, 0x01, 0xac,
+	0x54, 0xa4, 0xcc, 0x3b, 0xbb, 0x55, 0x6e, 0x12, 0x4f, 0x68, 0x5a, 0x3a, 0x7f, 0xb7, 0x60, 0x5e,
+	0xab, 0xbe, 0x78, 0xca, 0xc9, 0x0f, 0xc8, 0x9f, 0x17, 0x3d, 0x7a, 0x93, 0x29, 0xf2, 0x22, 0x17,
+	0xf2, 0x1f, 0xeb, 0x3a, 0x0d, 0x1e, 0xfa, 0x3e, 0x2c, 0x4a, 0x7a, 0x6f, 0x24, 0x5f, 0x34, 0x44,
+	0x3c, 0x75, 0x71, 0x89, 0xcb, 0xed, 0x76, 0x47, 0x8c, 0x0a, 0x23, 0x44, 0x64, 0xcd, 0xe2, 0x82,
+	0xa1, 0x3b, 0x61, 0xc6, 0x74, 0xc2, 0xe7, 0xfc, 0x19, 0xa3, 0xa8, 0xdb, 0xf8, 0xc7, 0x7b, 0x32,
+	0xf2, 0x4e, 0x09, 0x3b, 0x10, 0xf1, 0x26, 0x33, 0x82, 0xce, 0x9a, 0xe2, 0x50, 0x02, 0x4b, 0xa5,
+	0xfa, 0xed, 0x02, 0xea, 0x26, 0xf5, 0x81, 0x27, 0xbf, 0xe4, 0xfd, 0xc9, 0xca, 0x6d, 0x96, 0x15,
+	0xdd, 0xf9, 0x93, 0x54, 0xbd, 0xd8, 0x3c, 0xdf, 0x8b, 0xad, 0x29, 0x5e, 0x6c, 0x9b, 0x26, 0x9d,
+	0xc1, 0x6a, 0x5d, 0xad, 0x78, 0x01, 0xcb, 0x2a, 0x91, 0xd2, 0xac, 0x8b, 0x94, 0xc9, 0xce, 0x38,
+	0x83, 0xb5, 0xfa, 0x5a, 0xf2, 0x02, 0x73, 0x3b, 0xb0, 0xc0, 0x9f, 0x37, 0x93, 0x2c, 0xc1, 0xa9,
+	0xf8, 0xd3, 0x79, 0x53, 0x66, 0xfe, 0xb7, 0x05, 0x2b, 0x95, 0x6a, 0xb3, 0xf6, 0x51, 0x6a, 0x03,
+	0x96, 0xe4, 0x83, 0x1c, 0x2d, 0x4d, 0x55, 0x66, 0x57, 0xdf, 0x53, 0x5b, 0x35, 0xef, 0xa9, 0x95,
+	0xef, 0xa6, 0x7d, 0xa1, 0xef, 0x66, 0xe6, 0xfc, 0x1d, 0xef, 0x4c, 0xd9, 0xf1, 0x4b, 0xe6, 0xea,
+	0xb7, 0xe1, 0x72, 0x4d, 0x65, 0xac, 0x0b, 0x58, 0xa6, 0xc0, 0x53, 0x58, 0xa9, 0x54, 0x9c, 0xe8,
+	0x1e, 0x74, 0x62, 0xf1, 0xcb, 0xb6, 0xaa, 0x45, 0xa5, 0x89, 0xc5, 0x0a, 0xe9, 0x7c, 0x0d, 0x8b,
+	0x25, 0x2d, 0xb7, 0xa1, 0x7d, 0x4a, 0xc6, 0x59, 0x5a, 0x34, 0xaa, 0x89, 0x2f, 0x45, 0x2a, 0x25,
+	0xfe, 0x17, 0x64, 0x8c, 0x05, 0x88, 0x2f, 0x98, 0x0d, 0x12, 0x92, 0x0e, 0x68, 0xe8, 0x67, 0xa7,
+	0x7a, 0xce, 0x70, 0x3e, 0x83, 0x79, 0x4d, 0x64, 0x62, 0x71, 0xb1, 0x06, 0x9d, 0xb7, 0x46, 0xca,
+	0x96, 0x94, 0xf3, 0x17, 0x0b, 0x16, 0xcd, 0x5a, 0x98, 0x6f, 0x16, 0x73, 0x93, 0x3e, 0xc9, 0x9e,
+	0x5e, 0x65, 0x1c, 0x1a, 0xbc, 0x73, 0x5e, 0xe6, 0x8a, 0x0c, 0xd1, 0xaa, 0x3d, 0x86, 0xda, 0xda,
+	0x01, 0xeb, 0xc0, 0x82, 0xe7, 0x86, 0xe1, 0xd3, 0xac, 0x33, 0x2a, 0xfb, 0xf8, 0x06, 0xcf, 0xf9,
+	0x01, 0x5c, 0xa9, 0x2d, 0xb1, 0xd1, 0x22, 0x34, 0x03, 0x5f, 0x19, 0xd8, 0x0c, 0x7c, 0xe7, 0x47,
+	0x70, 0x75, 0x42, 0xb9, 0x3c, 0xe9, 0xe5, 0x7d, 0xf3, 0x2e, 0xcc, 0x66, 0xd5, 0x1f, 0x42, 0xb0,
+	0x78, 0xf0, 0xe2, 0x78, 0xff, 0x57, 0xaf, 0x0e, 0xf1, 0x8b, 0xe3, 0x17, 0x3b, 0x2f, 0x9f, 0x2c,
+	0x37, 0xd0, 0x32, 0x2c, 0xec, 0x1f, 0xf7, 0xf6, 0xf1, 0xfe, 0xcb, 0xe7, 0xaf, 0xf0, 0xb3, 0xc3,
+	0x65, 0x6b, 0x73, 0x0b, 0xa0, 0x38, 0x11, 0xd1, 0x12, 0xcc, 0x8b, 0x4e, 0x98, 0x64, 0x2d, 0x37,
+	0x38, 0x63, 0x3f, 0xa6, 0xde, 0x40, 0x31, 0xac, 0x9d, 0x1f, 0x7f, 0x75, 0xbf, 0x1f, 0xb0, 0xc1,
+	0xe8, 0x64, 0xcb, 0xa3, 0xc3, 0x6d, 0xb1, 0xd5, 0x71, 0x42, 0x7f, 0x47, 0x3c, 0x26, 0x89, 0x0f,
+	0xe5, 0x7f, 0x5a, 0xf4, 0x69, 0xe8, 0x46, 0xfd, 0xed, 0x22, 0x14, 0x4e, 0x3a, 0x62, 0xe0, 0xa3,
+	0xff, 0x0e, 0x00, 0xe1, 0xc0, 0x92, 0xaf, 0x8b, 0x21, 0x00, 0x00,
+}
@@ -0,0 +1,100 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/types/endorsement.proto
+
+package iotextypes
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Endorsement struct {
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Endorser             []byte               `protobuf:"bytes,2,opt,name=endorser,proto3" json:"endorser,omitempty"`
+	Signature            []byte               `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Endorsement) Reset()         { *m = Endorsement{} }
+func (m *Endorsement) String() string { return proto.CompactTextString(m) }
+func (*Endorsement) ProtoMessage()    {}
+func (*Endorsement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f7e62a7e1c60498, []int{0}
+}
+
+func (m *Endorsement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Endorsement.Unmarshal(m, b)
+}
+func (m *Endorsement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Endorsement.Marshal(b, m, deterministic)
+}
+func (m *Endorsement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Endorsement.Merge(m, src)
+}
+func (m *Endorsement) XXX_Size() int {
+	return xxx_messageInfo_Endorsement.Size(m)
+}
+func (m *Endorsement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Endorsement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Endorsement proto.InternalMessageInfo
+
+func (m *Endorsement) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Endorsement) GetEndorser() []byte {
+	if m != nil {
+		return m.Endorser
+	}
+	return nil
+}
+
+func (m *Endorsement) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Endorsement)(nil), "iotextypes.Endorsement")
+}
+
+func init() { proto.RegisterFile("proto/types/endorsement.proto", fileDescriptor_8f7e62a7e1c60498) }
+
+var fileDescriptor_8f7e62a7e1c60498 = []byte{
+	// 198 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x8f, 0x31, 0x4b, 0xc5, 0x30,
+	0x14, 0x85, 0x89, 0x82, 0xd8, 0xd4, 0x29, 0x53, 0x29, 0x8a, 0xc5, 0xa9, 0x8b, 0x09, 0x28, 0xa2,
+	0xb3, 0xe0, 0x1f, 0x28, 0x4e, 0x6e, 0x6d, 0xbd, 0xc6, 0x48, 0x93, 0x1b, 0x92, 0x5b, 0xf0, 0x8d,
+	0xef, 0x9f, 0x3f, 0x48, 0x5e, 0x9b, 0xf1, 0xde, 0xef, 0xe3, 0x70, 0x0e, 0xbf, 0xf3, 0x01, 0x09,
+	0x15, 0x1d, 0x3c, 0x44, 0x05, 0xee, 0x1b, 0x43, 0x04, 0x0b, 0x8e, 0x64, 0xfa, 0x0b, 0x6e, 0x90,
+	0xe0, 0x3f, 0xd1, 0xf6, 0x5e, 0x23, 0xea, 0x05, 0x54, 0x22, 0xd3, 0xfa, 0xa3, 0xc8, 0x58, 0x88,
+	0x34, 0x5a, 0x9f, 0xe5, 0x87, 0x23, 0xe3, 0xf5, 0x47, 0x89, 0x10, 0x6f, 0xbc, 0xda, 0x95, 0x86,
+	0x75, 0xac, 0xaf, 0x9f, 0x5a, 0x99, 0x43, 0xe4, 0x16, 0x22, 0x3f, 0x37, 0x63, 0x28, 0xb2, 0x68,
+	0xf9, 0xf5, 0xb9, 0x4b, 0x68, 0x2e, 0x3a, 0xd6, 0xdf, 0x0c, 0xfb, 0x2d, 0x6e, 0x79, 0x15, 0x8d,
+	0x76, 0x23, 0xad, 0x01, 0x9a, 0xcb, 0x04, 0xcb, 0xe3, 0xfd, 0xf5, 0xeb, 0x45, 0x1b, 0xfa, 0x5d,
+	0x27, 0x39, 0xa3, 0x55, 0xa9, 0xbd, 0x0f, 0xf8, 0x07, 0x33, 0xe5, 0xe3, 0x31, 0xef, 0xd5, 0xb8,
+	0x8c, 0x4e, 0xab, 0xb2, 0x6e, 0xba, 0x4a, 0xe0, 0xf9, 0x14, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x40,
+	0x35, 0x4b, 0x11, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,116 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/types/node.proto
+
+package iotextypes
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Server Metadata
+type ServerMeta struct {
+	PackageVersion       string   `protobuf:"bytes,1,opt,name=packageVersion,proto3" json:"packageVersion,omitempty"`
+	PackageCommitID      string   `protobuf:"bytes,2,opt,name=packageCommitID,proto3" json:"packageCommitID,omitempty"`
+	GitStatus            string   `protobuf:"bytes,3,opt,name=gitStatus,proto3" json:"gitStatus,omitempty"`
+	GoVersion            string   `protobuf:"bytes,4,opt,name=goVersion,proto3" json:"goVersion,omitempty"`
+	BuildTime            string   `protobuf:"bytes,5,opt,name=buildTime,proto3" json:"buildTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServerMeta) Reset()         { *m = ServerMeta{} }
+func (m *ServerMeta) String() string { return proto.CompactTextString(m) }
+func (*ServerMeta) ProtoMessage()    {}
+func (*ServerMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3acb45306dda8a73, []int{0}
+}
+
+func (m *ServerMeta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServerMeta.Unmarshal(m, b)
+}
+func (m *ServerMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServerMeta.Marshal(b, m, deterministic)
+}
+func (m *ServerMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerMeta.Merge(m, src)
+}
+func (m *ServerMeta) XXX_Size() int {
+	return xxx_messageInfo_ServerMeta.Size(m)
+}
+func (m *ServerMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServerMeta proto.InternalMessageInfo
+
+func (m *ServerMeta) GetPackageVersion() string {
+	if m != nil {
+		return m.PackageVersion
+	}
+	return ""
+}
+
+func (m *ServerMeta) GetPackageCommitID() string {
+	if m != nil {
+		return m.PackageCommitID
+	}
+	return ""
+}
+
+func (m *ServerMeta) GetGitStatus() string {
+	if m != nil {
+		return m.GitStatus
+	}
+	return ""
+}
+
+func (m *ServerMeta) GetGoVersion() string {
+	if m != nil {
+		return m.GoVersion
+	}
+	return ""
+}
+
+func (m *ServerMeta) GetBuildTime() string {
+	if m != nil {
+		return m.BuildTime
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ServerMeta)(nil), "iotextypes.ServerMeta")
+}
+
+func init() { proto.RegisterFile("proto/types/node.proto", fileDescriptor_3acb45306dda8a73) }
+
+var fileDescriptor_3acb45306dda8a73 = []byte{
+	// 204 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0xd7, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xcb, 0x4f, 0x49, 0xd5, 0x03, 0x0b, 0x08,
+	0x71, 0x65, 0xe6, 0x97, 0xa4, 0x56, 0x80, 0x85, 0x95, 0x76, 0x30, 0x72, 0x71, 0x05, 0xa7, 0x16,
+	0x95, 0xa5, 0x16, 0xf9, 0xa6, 0x96, 0x24, 0x0a, 0xa9, 0x71, 0xf1, 0x15, 0x24, 0x26, 0x67, 0x27,
+	0xa6, 0xa7, 0x86, 0xa5, 0x16, 0x15, 0x67, 0xe6, 0xe7, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06,
+	0xa1, 0x89, 0x0a, 0x69, 0x70, 0xf1, 0x43, 0x45, 0x9c, 0xf3, 0x73, 0x73, 0x33, 0x4b, 0x3c, 0x5d,
+	0x24, 0x98, 0xc0, 0x0a, 0xd1, 0x85, 0x85, 0x64, 0xb8, 0x38, 0xd3, 0x33, 0x4b, 0x82, 0x4b, 0x12,
+	0x4b, 0x4a, 0x8b, 0x25, 0x98, 0xc1, 0x6a, 0x10, 0x02, 0x60, 0xd9, 0x7c, 0x98, 0x55, 0x2c, 0x50,
+	0x59, 0x98, 0x00, 0x48, 0x36, 0xa9, 0x34, 0x33, 0x27, 0x25, 0x24, 0x33, 0x37, 0x55, 0x82, 0x15,
+	0x22, 0x0b, 0x17, 0x70, 0x32, 0x8f, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
+	0xcf, 0xd5, 0x07, 0xfb, 0xa9, 0xa0, 0x28, 0x3f, 0x2b, 0x35, 0xb9, 0x04, 0xc2, 0xd1, 0x85, 0x78,
+	0x3f, 0x3d, 0x3f, 0x27, 0x31, 0x2f, 0x5d, 0x1f, 0xe1, 0xe7, 0x24, 0x36, 0xb0, 0x84, 0x31, 0x20,
+	0x00, 0x00, 0xff, 0xff, 0x24, 0x4b, 0xb2, 0x09, 0x20, 0x01, 0x00, 0x00,
+}
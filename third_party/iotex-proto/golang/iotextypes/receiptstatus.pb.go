@@ -0,0 +1,104 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/types/receiptstatus.proto
+
+package iotextypes
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// BELOW ARE DEFINITIONS FOR EVM ERROR CLASSIFICATION IN RECEIPT STATUS
+type ReceiptStatus int32
+
+const (
+	ReceiptStatus_Failure ReceiptStatus = 0
+	ReceiptStatus_Success ReceiptStatus = 1
+	//1xx for EVM ErrorCode
+	ReceiptStatus_ErrUnknown                  ReceiptStatus = 100
+	ReceiptStatus_ErrOutOfGas                 ReceiptStatus = 101
+	ReceiptStatus_ErrCodeStoreOutOfGas        ReceiptStatus = 102
+	ReceiptStatus_ErrDepth                    ReceiptStatus = 103
+	ReceiptStatus_ErrContractAddressCollision ReceiptStatus = 104
+	ReceiptStatus_ErrNoCompatibleInterpreter  ReceiptStatus = 105
+	ReceiptStatus_ErrExecutionReverted        ReceiptStatus = 106
+	ReceiptStatus_ErrMaxCodeSizeExceeded      ReceiptStatus = 107
+	ReceiptStatus_ErrWriteProtection          ReceiptStatus = 108
+)
+
+var ReceiptStatus_name = map[int32]string{
+	0:   "Failure",
+	1:   "Success",
+	100: "ErrUnknown",
+	101: "ErrOutOfGas",
+	102: "ErrCodeStoreOutOfGas",
+	103: "ErrDepth",
+	104: "ErrContractAddressCollision",
+	105: "ErrNoCompatibleInterpreter",
+	106: "ErrExecutionReverted",
+	107: "ErrMaxCodeSizeExceeded",
+	108: "ErrWriteProtection",
+}
+
+var ReceiptStatus_value = map[string]int32{
+	"Failure":                     0,
+	"Success":                     1,
+	"ErrUnknown":                  100,
+	"ErrOutOfGas":                 101,
+	"ErrCodeStoreOutOfGas":        102,
+	"ErrDepth":                    103,
+	"ErrContractAddressCollision": 104,
+	"ErrNoCompatibleInterpreter":  105,
+	"ErrExecutionReverted":        106,
+	"ErrMaxCodeSizeExceeded":      107,
+	"ErrWriteProtection":          108,
+}
+
+func (x ReceiptStatus) String() string {
+	return proto.EnumName(ReceiptStatus_name, int32(x))
+}
+
+func (ReceiptStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b91d1deedbe7f532, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("iotextypes.ReceiptStatus", ReceiptStatus_name, ReceiptStatus_value)
+}
+
+func init() { proto.RegisterFile("proto/types/receiptstatus.proto", fileDescriptor_b91d1deedbe7f532) }
+
+var fileDescriptor_b91d1deedbe7f532 = []byte{
+	// 292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0x4f, 0x4b, 0x03, 0x31,
+	0x10, 0x47, 0xd5, 0x83, 0x4a, 0xea, 0x9f, 0x10, 0xa4, 0x48, 0x05, 0x7b, 0x17, 0xec, 0x1e, 0x44,
+	0x3c, 0x6b, 0x8d, 0xe2, 0x41, 0x2b, 0x2d, 0x22, 0x78, 0x4b, 0x93, 0xe9, 0x36, 0xed, 0x36, 0x13,
+	0x26, 0xb3, 0xba, 0x7a, 0xf3, 0x9b, 0x4b, 0xb3, 0xa2, 0xc7, 0x1f, 0xef, 0x85, 0x0c, 0x4f, 0xf4,
+	0x23, 0x21, 0x63, 0xc1, 0x9f, 0x11, 0x52, 0x41, 0x60, 0xc1, 0x47, 0x4e, 0x6c, 0xb8, 0x4e, 0x83,
+	0x4c, 0x94, 0xf0, 0xc8, 0xd0, 0x64, 0x7e, 0xf6, 0xbd, 0x25, 0xf6, 0xc7, 0xad, 0x33, 0xc9, 0x8e,
+	0xea, 0x88, 0x9d, 0x3b, 0xe3, 0xab, 0x9a, 0x40, 0x6e, 0xac, 0xc7, 0xa4, 0xb6, 0x16, 0x52, 0x92,
+	0x9b, 0xea, 0x40, 0x08, 0x4d, 0xf4, 0x12, 0x96, 0x01, 0x3f, 0x82, 0x74, 0xea, 0x50, 0x74, 0x34,
+	0xd1, 0xa8, 0xe6, 0xd1, 0xec, 0xde, 0x24, 0x09, 0xea, 0x58, 0x1c, 0x69, 0xa2, 0x21, 0x3a, 0x98,
+	0x30, 0x12, 0xfc, 0x91, 0x99, 0xda, 0x13, 0xbb, 0x9a, 0xe8, 0x16, 0x22, 0xcf, 0x65, 0xa9, 0xfa,
+	0xe2, 0x24, 0x7b, 0x81, 0xc9, 0x58, 0xbe, 0x76, 0x8e, 0x20, 0xa5, 0x21, 0x56, 0x95, 0x4f, 0x1e,
+	0x83, 0x9c, 0xab, 0x53, 0xd1, 0xd3, 0x44, 0x4f, 0x38, 0xc4, 0x55, 0x34, 0xec, 0xa7, 0x15, 0x3c,
+	0x04, 0x06, 0x8a, 0x04, 0x0c, 0x24, 0xfd, 0xef, 0x47, 0xba, 0x01, 0x5b, 0xb3, 0xc7, 0x30, 0x86,
+	0x77, 0x20, 0x06, 0x27, 0x17, 0xaa, 0x27, 0xba, 0x9a, 0xe8, 0xd1, 0x34, 0xf9, 0x0a, 0xff, 0x05,
+	0xba, 0xb1, 0x00, 0x0e, 0x9c, 0x5c, 0xaa, 0xae, 0x50, 0x9a, 0xe8, 0x95, 0x3c, 0xc3, 0x33, 0x21,
+	0x83, 0x5d, 0xbf, 0x95, 0xd5, 0xcd, 0xd5, 0xdb, 0x65, 0xe9, 0x79, 0x5e, 0x4f, 0x07, 0x16, 0x57,
+	0x45, 0x8e, 0x13, 0x09, 0x17, 0x60, 0xb9, 0x1d, 0xe7, 0x6d, 0xd0, 0x12, 0x2b, 0x13, 0xca, 0xe2,
+	0x3f, 0xde, 0x74, 0x3b, 0x83, 0x8b, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x69, 0xe2, 0x8d, 0x4c,
+	0x72, 0x01, 0x00, 0x00,
+}
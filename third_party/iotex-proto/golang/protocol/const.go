@@ -0,0 +1,8 @@
+package protocol
+
+const (
+	RewardingProtocolID = "rewarding"
+	ReadAvailableBalanceMethodName = "AvailableBalance"
+	ReadTotalBalanceMethodName = "TotalBalance"
+	ReadUnclaimedBalanceMethodName = "UnclaimedBalance"
+)
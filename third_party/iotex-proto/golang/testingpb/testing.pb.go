@@ -0,0 +1,79 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/testing/testing.proto
+
+package testingpb
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type TestPayload struct {
+	MsgBody              []byte   `protobuf:"bytes,1,opt,name=msg_body,json=msgBody,proto3" json:"msg_body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TestPayload) Reset()         { *m = TestPayload{} }
+func (m *TestPayload) String() string { return proto.CompactTextString(m) }
+func (*TestPayload) ProtoMessage()    {}
+func (*TestPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b5c04d05c6a93e8, []int{0}
+}
+
+func (m *TestPayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestPayload.Unmarshal(m, b)
+}
+func (m *TestPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestPayload.Marshal(b, m, deterministic)
+}
+func (m *TestPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestPayload.Merge(m, src)
+}
+func (m *TestPayload) XXX_Size() int {
+	return xxx_messageInfo_TestPayload.Size(m)
+}
+func (m *TestPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestPayload proto.InternalMessageInfo
+
+func (m *TestPayload) GetMsgBody() []byte {
+	if m != nil {
+		return m.MsgBody
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TestPayload)(nil), "testingpb.TestPayload")
+}
+
+func init() { proto.RegisterFile("proto/testing/testing.proto", fileDescriptor_9b5c04d05c6a93e8) }
+
+var fileDescriptor_9b5c04d05c6a93e8 = []byte{
+	// 136 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0x28, 0xca, 0x2f,
+	0xc9, 0xd7, 0x2f, 0x49, 0x2d, 0x2e, 0xc9, 0xcc, 0x4b, 0x87, 0xd1, 0x7a, 0x60, 0x51, 0x21, 0x4e,
+	0x28, 0xb7, 0x20, 0x49, 0x49, 0x83, 0x8b, 0x3b, 0x24, 0xb5, 0xb8, 0x24, 0x20, 0xb1, 0x32, 0x27,
+	0x3f, 0x31, 0x45, 0x48, 0x92, 0x8b, 0x23, 0xb7, 0x38, 0x3d, 0x3e, 0x29, 0x3f, 0xa5, 0x52, 0x82,
+	0x51, 0x81, 0x51, 0x83, 0x27, 0x88, 0x3d, 0xb7, 0x38, 0xdd, 0x29, 0x3f, 0xa5, 0xd2, 0xc9, 0x2c,
+	0xca, 0x24, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x33, 0xbf, 0x24,
+	0xb5, 0xa2, 0xa0, 0x28, 0x3f, 0x2b, 0x35, 0xb9, 0x04, 0xc2, 0xd1, 0x85, 0xd8, 0x98, 0x9e, 0x9f,
+	0x93, 0x88, 0xb0, 0xb0, 0x20, 0x29, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
+	0x8f, 0xa0, 0x5d, 0x92, 0x92, 0x00, 0x00, 0x00,
+}
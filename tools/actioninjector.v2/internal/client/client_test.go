@@ -56,6 +56,8 @@ func TestClient(t *testing.T) {
 	bc.EXPECT().AddSubscriber(gomock.Any()).Return(nil).AnyTimes()
 	ap.EXPECT().GetPendingNonce(gomock.Any()).Return(uint64(1), nil).AnyTimes()
 	ap.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ap.EXPECT().AddSubscriber(gomock.Any()).Return(nil).AnyTimes()
+	ap.EXPECT().RemoveSubscriber(gomock.Any()).Return(nil).AnyTimes()
 	newOption := api.WithBroadcastOutbound(func(_ context.Context, _ uint32, _ proto.Message) error {
 		return nil
 	})
@@ -0,0 +1,121 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// This is a tool that migrates a database (chain.db, trie.db, index.db, etc.) from one KV store backend to another.
+// The node should be stopped while migrating. An interrupted migration resumes from the checkpoint when the same
+// command is run again.
+// To use, run "make build-dbmigrator" and
+// "./bin/dbmigrator -src-path=chain.db -src-backend=boltdb -dst-path=chain.level -dst-backend=leveldb"
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/tools/dbmigrator/internal/migrator"
+)
+
+var (
+	srcPath    string
+	srcBackend string
+	dstPath    string
+	dstBackend string
+	checkpoint string
+	batchSize  int
+	batchBytes int
+)
+
+func init() {
+	flag.StringVar(&srcPath, "src-path", "", "Path of the source database")
+	flag.StringVar(&srcBackend, "src-backend", config.BoltDBBackend, "Backend of the source database")
+	flag.StringVar(&dstPath, "dst-path", "", "Path of the destination database")
+	flag.StringVar(&dstBackend, "dst-backend", config.LevelDBBackend, "Backend of the destination database")
+	flag.StringVar(&checkpoint, "checkpoint", "", "Path of the checkpoint file, default to dst-path + \".migrate\"")
+	flag.IntVar(&batchSize, "batch-size", 10000, "Max number of records written in one batch")
+	flag.IntVar(&batchBytes, "batch-bytes", 16*1024*1024, "Max bytes of records written in one batch")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr,
+			"usage: dbmigrator -src-path=[string] -src-backend=[string] -dst-path=[string] -dst-backend=[string]\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+}
+
+func main() {
+	if srcPath == "" || dstPath == "" {
+		flag.Usage()
+	}
+	if checkpoint == "" {
+		checkpoint = dstPath + ".migrate"
+	}
+	// exit with a non-zero code on any failure, including the verification one, after the dbs are closed
+	if err := migrate(); err != nil {
+		log.L().Error("Failed to migrate db.", zap.Error(err))
+		os.Exit(1)
+	}
+	log.S().Infof("Success to migrate %s (%s) to %s (%s)", srcPath, srcBackend, dstPath, dstBackend)
+}
+
+func migrate() error {
+	src, err := newKVStore(srcPath, srcBackend)
+	if err != nil {
+		return errors.Wrap(err, "failed to create source db")
+	}
+	dst, err := newKVStore(dstPath, dstBackend)
+	if err != nil {
+		return errors.Wrap(err, "failed to create destination db")
+	}
+	ctx := context.Background()
+	if err := src.Start(ctx); err != nil {
+		return errors.Wrap(err, "failed to open source db")
+	}
+	defer func() {
+		if err := src.Stop(ctx); err != nil {
+			log.L().Error("Failed to close source db.", zap.Error(err))
+		}
+	}()
+	if err := dst.Start(ctx); err != nil {
+		return errors.Wrap(err, "failed to open destination db")
+	}
+	defer func() {
+		if err := dst.Stop(ctx); err != nil {
+			log.L().Error("Failed to close destination db.", zap.Error(err))
+		}
+	}()
+
+	m, err := migrator.New(src, dst, migrator.Config{
+		BatchSize:  batchSize,
+		BatchBytes: batchBytes,
+		Checkpoint: checkpoint,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create migrator")
+	}
+	return m.Run()
+}
+
+func newKVStore(path, backend string) (db.KVStoreWithForEach, error) {
+	if backend != config.BoltDBBackend && backend != config.LevelDBBackend {
+		return nil, errors.Errorf("unknown backend %s", backend)
+	}
+	cfg := config.Default.DB
+	cfg.DbPath = path
+	cfg.Backend = backend
+	kv, ok := db.NewKVStore(cfg).(db.KVStoreWithForEach)
+	if !ok {
+		return nil, errors.Errorf("backend %s does not support iteration", backend)
+	}
+	return kv, nil
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package migrator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/fileutil"
+)
+
+// ErrVerify indicates that the destination does not match the source after migration
+var ErrVerify = errors.New("migration verification failed")
+
+type (
+	// Config is the config of migration
+	Config struct {
+		// BatchSize is the max number of records written in one batch
+		BatchSize int
+		// BatchBytes is the max size of keys and values written in one batch
+		BatchBytes int
+		// Checkpoint is the path of the file recording the progress, which allows to resume the migration
+		Checkpoint string
+	}
+
+	// Migrator copies all namespaces and records from a source KV store into a destination
+	Migrator struct {
+		src db.KVStoreWithForEach
+		dst db.KVStoreWithForEach
+		cfg Config
+		cp  *checkpoint
+	}
+
+	// checkpoint keeps the batch limits in use, because the digests depend on how records are grouped into batches
+	checkpoint struct {
+		BatchSize  int         `json:"batchSize"`
+		BatchBytes int         `json:"batchBytes"`
+		Namespaces []*progress `json:"namespaces"`
+	}
+
+	// progress of a namespace. The digest is rolled over the digest of each batch, and LastKey is the last key of
+	// the last batch written into the destination.
+	progress struct {
+		Namespace string `json:"namespace"`
+		LastKey   string `json:"lastKey"`
+		Count     uint64 `json:"count"`
+		Digest    string `json:"digest"`
+		Copied    bool   `json:"copied"`
+		Verified  bool   `json:"verified"`
+	}
+
+	// batcher groups records into bounded batches and rolls the digest over them
+	batcher struct {
+		namespace  string
+		batchSize  int
+		batchBytes int
+		batch      db.KVStoreBatch
+		size       int
+		count      uint64
+		digest     hash.Hash256
+		lastKey    []byte
+	}
+)
+
+// New creates a migrator. Both KV stores should have been started.
+func New(src, dst db.KVStoreWithForEach, cfg Config) (*Migrator, error) {
+	if cfg.BatchSize <= 0 || cfg.BatchBytes <= 0 {
+		return nil, errors.New("batch size should be positive")
+	}
+	if cfg.Checkpoint == "" {
+		return nil, errors.New("checkpoint path is empty")
+	}
+	return &Migrator{
+		src: src,
+		dst: dst,
+		cfg: cfg,
+	}, nil
+}
+
+// Run migrates the namespaces one by one, resuming from the checkpoint if there is one, and verifies the count and
+// digest of each namespace in the destination against the source
+func (m *Migrator) Run() error {
+	if err := m.loadCheckpoint(); err != nil {
+		return err
+	}
+	for _, p := range m.cp.Namespaces {
+		if !p.Copied {
+			if err := m.copyNamespace(p); err != nil {
+				return err
+			}
+		}
+		if !p.Verified {
+			if err := m.verifyNamespace(p); err != nil {
+				return err
+			}
+		}
+	}
+	log.L().Info("Migration completed.", zap.Int("namespaces", len(m.cp.Namespaces)))
+	return nil
+}
+
+func (m *Migrator) loadCheckpoint() error {
+	if fileutil.FileExists(m.cfg.Checkpoint) {
+		data, err := ioutil.ReadFile(m.cfg.Checkpoint)
+		if err != nil {
+			return errors.Wrap(err, "failed to read checkpoint")
+		}
+		cp := &checkpoint{}
+		if err := json.Unmarshal(data, cp); err != nil {
+			return errors.Wrap(err, "failed to decode checkpoint")
+		}
+		if cp.BatchSize <= 0 || cp.BatchBytes <= 0 {
+			return errors.New("invalid batch size in checkpoint")
+		}
+		m.cp = cp
+		m.cfg.BatchSize = cp.BatchSize
+		m.cfg.BatchBytes = cp.BatchBytes
+		log.L().Info("Resume migration from checkpoint.", zap.String("checkpoint", m.cfg.Checkpoint))
+		return nil
+	}
+	namespaces, err := m.src.GetBucketByPrefix(nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to list namespaces of source")
+	}
+	m.cp = &checkpoint{
+		BatchSize:  m.cfg.BatchSize,
+		BatchBytes: m.cfg.BatchBytes,
+	}
+	for _, ns := range namespaces {
+		m.cp.Namespaces = append(m.cp.Namespaces, &progress{
+			Namespace: string(ns),
+			Digest:    hex.EncodeToString(hash.ZeroHash256[:]),
+		})
+	}
+	return m.saveCheckpoint()
+}
+
+func (m *Migrator) saveCheckpoint() error {
+	data, err := json.Marshal(m.cp)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode checkpoint")
+	}
+	// write to a temp file first, so that an interruption never leaves a partial checkpoint
+	tmp := m.cfg.Checkpoint + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return errors.Wrap(err, "failed to write checkpoint")
+	}
+	return os.Rename(tmp, m.cfg.Checkpoint)
+}
+
+func (m *Migrator) copyNamespace(p *progress) error {
+	b, err := m.newBatcher(p)
+	if err != nil {
+		return err
+	}
+	start := b.lastKey
+	flush := func() error {
+		if b.batch.Size() == 0 {
+			return nil
+		}
+		b.seal()
+		if err := m.dst.WriteBatch(b.batch); err != nil {
+			return errors.Wrapf(err, "failed to write batch of namespace %s", p.Namespace)
+		}
+		b.update(p)
+		return m.saveCheckpoint()
+	}
+	err = m.src.ForEach(p.Namespace, start, func(k, v []byte) error {
+		// the record of the last key has been copied before interruption
+		if p.Count > 0 && bytes.Equal(k, start) {
+			return nil
+		}
+		if b.add(k, v) {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil && errors.Cause(err) != db.ErrNotExist {
+		return errors.Wrapf(err, "failed to copy namespace %s", p.Namespace)
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	p.Copied = true
+	log.L().Info("Copied namespace.", zap.String("namespace", p.Namespace), zap.Uint64("count", p.Count))
+	return m.saveCheckpoint()
+}
+
+func (m *Migrator) verifyNamespace(p *progress) error {
+	b, err := m.newBatcher(&progress{
+		Namespace: p.Namespace,
+		Digest:    hex.EncodeToString(hash.ZeroHash256[:]),
+	})
+	if err != nil {
+		return err
+	}
+	err = m.dst.ForEach(p.Namespace, nil, func(k, v []byte) error {
+		if b.add(k, v) {
+			b.seal()
+			b.batch.Clear()
+		}
+		return nil
+	})
+	if err != nil && errors.Cause(err) != db.ErrNotExist {
+		return errors.Wrapf(err, "failed to read namespace %s", p.Namespace)
+	}
+	if b.batch.Size() > 0 {
+		b.seal()
+	}
+	digest := hex.EncodeToString(b.digest[:])
+	if b.count != p.Count || digest != p.Digest {
+		return errors.Wrapf(
+			ErrVerify,
+			"namespace %s: source has %d records with digest %s, destination has %d records with digest %s",
+			p.Namespace,
+			p.Count,
+			p.Digest,
+			b.count,
+			digest,
+		)
+	}
+	p.Verified = true
+	log.L().Info("Verified namespace.", zap.String("namespace", p.Namespace), zap.String("digest", digest))
+	return m.saveCheckpoint()
+}
+
+func (m *Migrator) newBatcher(p *progress) (*batcher, error) {
+	lastKey, err := hex.DecodeString(p.LastKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid last key in checkpoint")
+	}
+	d, err := hex.DecodeString(p.Digest)
+	if err != nil || len(d) != len(hash.ZeroHash256) {
+		return nil, errors.Errorf("invalid digest in checkpoint for namespace %s", p.Namespace)
+	}
+	return &batcher{
+		namespace:  p.Namespace,
+		batchSize:  m.cfg.BatchSize,
+		batchBytes: m.cfg.BatchBytes,
+		batch:      db.NewBatch(),
+		count:      p.Count,
+		digest:     hash.BytesToHash256(d),
+		lastKey:    lastKey,
+	}, nil
+}
+
+// add puts a record into the batch, and returns true if the batch is full
+func (b *batcher) add(k, v []byte) bool {
+	key := make([]byte, len(k))
+	copy(key, k)
+	value := make([]byte, len(v))
+	copy(value, v)
+	b.batch.Put(b.namespace, key, value, "failed to put key %x", key)
+	b.size += len(key) + len(value)
+	b.lastKey = key
+	return b.batch.Size() >= b.batchSize || b.size >= b.batchBytes
+}
+
+// seal rolls the digest and count over the current batch
+func (b *batcher) seal() {
+	d := b.batch.Digest()
+	b.digest = hash.Hash256b(append(b.digest[:], d[:]...))
+	b.count += uint64(b.batch.Size())
+	b.size = 0
+}
+
+func (b *batcher) update(p *progress) {
+	p.LastKey = hex.EncodeToString(b.lastKey)
+	p.Count = b.count
+	p.Digest = hex.EncodeToString(b.digest[:])
+}
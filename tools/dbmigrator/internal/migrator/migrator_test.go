@@ -0,0 +1,136 @@
+// Copyright (c) 2019 IoTeX Foundation
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package migrator
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+var errInterrupted = errors.New("interrupted")
+
+// interruptedKVStore fails the write after a number of batches
+type interruptedKVStore struct {
+	db.KVStoreWithForEach
+	batches int
+}
+
+func (kv *interruptedKVStore) WriteBatch(b db.KVStoreBatch) error {
+	if kv.batches == 0 {
+		return errInterrupted
+	}
+	kv.batches--
+	return kv.KVStoreWithForEach.WriteBatch(b)
+}
+
+func tempPath(t *testing.T, name string) string {
+	f, err := ioutil.TempFile(os.TempDir(), name)
+	require.NoError(t, err)
+	path := f.Name()
+	require.NoError(t, f.Close())
+	testutil.CleanupPath(t, path)
+	return path
+}
+
+func newKVStore(t *testing.T, path, backend string) db.KVStoreWithForEach {
+	cfg := config.Default.DB
+	cfg.DbPath = path
+	cfg.Backend = backend
+	kv := db.NewKVStore(cfg).(db.KVStoreWithForEach)
+	require.NoError(t, kv.Start(context.Background()))
+	return kv
+}
+
+func TestMigrator(t *testing.T) {
+	require := require.New(t)
+
+	srcPath := tempPath(t, "migrator-src")
+	dstPath := tempPath(t, "migrator-dst")
+	cpPath := tempPath(t, "migrator-checkpoint")
+	defer func() {
+		testutil.CleanupPath(t, srcPath)
+		testutil.CleanupPath(t, dstPath)
+		testutil.CleanupPath(t, cpPath)
+	}()
+
+	ctx := context.Background()
+	src := newKVStore(t, srcPath, config.BoltDBBackend)
+	defer func() {
+		require.NoError(src.Stop(ctx))
+	}()
+	records := map[string]int{
+		"Account":     25,
+		"Account\x01": 3,
+		"Contract":    10,
+		"Blockchain":  1,
+	}
+	for ns, n := range records {
+		for i := 0; i < n; i++ {
+			require.NoError(src.Put(ns, []byte(fmt.Sprintf("key-%03d", i)), []byte(fmt.Sprintf("%s-%d", ns, i))))
+		}
+	}
+
+	_, err := New(src, nil, Config{BatchSize: 0, BatchBytes: 1, Checkpoint: cpPath})
+	require.Error(err)
+	_, err = New(src, nil, Config{BatchSize: 1, BatchBytes: 1})
+	require.Error(err)
+
+	cfg := Config{
+		BatchSize:  4,
+		BatchBytes: 1024,
+		Checkpoint: cpPath,
+	}
+	dst := newKVStore(t, dstPath, config.LevelDBBackend)
+	defer func() {
+		require.NoError(dst.Stop(ctx))
+	}()
+	// interrupt in the middle of the first namespaces
+	m, err := New(src, &interruptedKVStore{KVStoreWithForEach: dst, batches: 3}, cfg)
+	require.NoError(err)
+	require.Equal(errInterrupted, errors.Cause(m.Run()))
+	// resume with a different batch size, which is overridden by the checkpoint
+	cfg.BatchSize = 7
+	m, err = New(src, &interruptedKVStore{KVStoreWithForEach: dst, batches: 5}, cfg)
+	require.NoError(err)
+	require.Equal(errInterrupted, errors.Cause(m.Run()))
+	m, err = New(src, dst, cfg)
+	require.NoError(err)
+	require.NoError(m.Run())
+	require.Equal(4, m.cfg.BatchSize)
+
+	for ns, n := range records {
+		for i := 0; i < n; i++ {
+			v, err := dst.Get(ns, []byte(fmt.Sprintf("key-%03d", i)))
+			require.NoError(err)
+			require.Equal(fmt.Sprintf("%s-%d", ns, i), string(v))
+		}
+	}
+	for _, p := range m.cp.Namespaces {
+		require.EqualValues(records[p.Namespace], p.Count)
+		require.True(p.Copied)
+		require.True(p.Verified)
+	}
+	// running again is a no-op
+	require.NoError(m.Run())
+
+	// an extra record in the destination fails the verification
+	testutil.CleanupPath(t, cpPath)
+	require.NoError(dst.Put("Contract", []byte("key-999"), []byte("extra")))
+	m, err = New(src, dst, cfg)
+	require.NoError(err)
+	require.Equal(ErrVerify, errors.Cause(m.Run()))
+}